@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+)
+
+func main() {
+	var input, output string
+	var minimumCount int
+	flag.StringVar(&input, "input", "", "Path to the downloaded pwned passwords file, ordered by hash.")
+	flag.StringVar(&output, "output", "", "Path to write the index to.")
+	flag.IntVar(&minimumCount, "min-count", 1, "Skip hashes seen fewer times than this. Default is 1.")
+	flag.Parse()
+	if input == "" || output == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	source, goerr := os.Open(input)
+	if goerr != nil {
+		log.Fatal(goerr)
+	}
+	defer source.Close()
+	destination, goerr := os.Create(output)
+	if goerr != nil {
+		log.Fatal(goerr)
+	}
+	written, goerr := adapters.WritePasswordScreenerIndex(source, destination, minimumCount)
+	if goerr != nil {
+		destination.Close()
+		os.Remove(output)
+		log.Fatal(goerr)
+	}
+	goerr = destination.Close()
+	if goerr != nil {
+		log.Fatal(goerr)
+	}
+	log.Printf("indexed %d password hashes into %s", written, output)
+}
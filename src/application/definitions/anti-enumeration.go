@@ -0,0 +1,11 @@
+package definitions
+
+// With Enabled, logins spend the same work whether the account exists or not
+// and signups answer the same way whether the email is taken or not, the
+// owner of a taken email gets a notice instead. DummyPasswordHash is made
+// with the current hashing parameters so comparing against it costs as much
+// as comparing against a real hash.
+type AntiEnumerationOptions struct {
+	Enabled           bool
+	DummyPasswordHash string
+}
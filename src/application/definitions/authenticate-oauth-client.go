@@ -0,0 +1,23 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+const ClientAssertionTypeJwtBearer = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+type AuthenticateOAuthClientDTO struct {
+	ClientId            string
+	ClientSecret        string
+	ClientAssertionType string
+	ClientAssertion     string
+}
+
+type AuthenticateOAuthClientResult = entities.OAuthClientEntity
+
+type AuthenticateOAuthClient interface {
+	Execute(ctx context.Context, data *AuthenticateOAuthClientDTO) (*AuthenticateOAuthClientResult, *shared.Error)
+}
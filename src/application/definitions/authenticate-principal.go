@@ -0,0 +1,44 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// Roles lets a caller through when its role in the session organization is
+// any of them, Scopes when its credential was granted all of them. Leaving
+// both empty only asks for a valid credential.
+type AuthenticatePrincipalDTO struct {
+	SessionKey string
+	IpAddress  string
+	Roles      []string
+	Scopes     []string
+}
+
+// Roles is empty when the caller has no membership in the organization, like
+// a client acting on its own behalf.
+type AuthenticatePrincipalResult struct {
+	SessionKey     string
+	UserId         string
+	OrganizationId string
+	ApiKeyId       string
+	ClientId       string
+	Roles          []string
+	Scopes         []string
+}
+
+type AuthenticatePrincipal interface {
+	Execute(ctx context.Context, data *AuthenticatePrincipalDTO) (*AuthenticatePrincipalResult, *shared.Error)
+}
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, principal *AuthenticatePrincipalResult) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (*AuthenticatePrincipalResult, bool) {
+	principal, ok := ctx.Value(principalKey{}).(*AuthenticatePrincipalResult)
+	return principal, ok && principal != nil
+}
@@ -0,0 +1,32 @@
+package definitions
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type AuthenticateSessionDTO struct {
+	SessionKey string
+	IpAddress  string
+}
+
+// A nil Scopes means the credential is a session key, which is not
+// restricted; api keys and sessions handed out through oauth are limited to
+// the scopes they were created with, an empty Scopes allows nothing.
+// Tokens issued to a client on its own behalf have a ClientId and no UserId.
+type AuthenticateSessionResult struct {
+	SessionKey     string
+	UserId         string
+	OrganizationId string
+	ApiKeyId       string
+	ClientId       string
+	Scopes         []string
+	Audiences      []string
+	ExpiresAt      time.Time
+}
+
+type AuthenticateSession interface {
+	Execute(ctx context.Context, data *AuthenticateSessionDTO) (*AuthenticateSessionResult, *shared.Error)
+}
@@ -0,0 +1,27 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type AuthorizeDTO struct {
+	Request        *AuthorizationRequestDTO
+	Login          string
+	Password       string
+	OrganizationId string
+	MfaCode        string
+	IpAddress      string
+	UserAgent      string
+}
+
+type AuthorizeResult struct {
+	RedirectUri string
+	Code        string
+	State       string
+}
+
+type Authorize interface {
+	Execute(ctx context.Context, data *AuthorizeDTO) (*AuthorizeResult, *shared.Error)
+}
@@ -0,0 +1,26 @@
+package definitions
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// An empty Login leaves the choice of account to a discoverable credential.
+type BeginWebAuthnLoginDTO struct {
+	Login          string
+	OrganizationId string
+}
+
+// Challenge and AllowCredentials are base64url encoded.
+type BeginWebAuthnLoginResult struct {
+	Challenge        string
+	RpId             string
+	AllowCredentials []string
+	Timeout          time.Duration
+}
+
+type BeginWebAuthnLogin interface {
+	Execute(ctx context.Context, data *BeginWebAuthnLoginDTO) (*BeginWebAuthnLoginResult, *shared.Error)
+}
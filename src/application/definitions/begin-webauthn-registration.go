@@ -0,0 +1,27 @@
+package definitions
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type BeginWebAuthnRegistrationDTO struct {
+	ActorId string
+}
+
+// Challenge, UserHandle and ExcludeCredentials are base64url encoded.
+type BeginWebAuthnRegistrationResult struct {
+	Challenge          string
+	RpId               string
+	RpName             string
+	UserHandle         string
+	UserName           string
+	ExcludeCredentials []string
+	Timeout            time.Duration
+}
+
+type BeginWebAuthnRegistration interface {
+	Execute(ctx context.Context, data *BeginWebAuthnRegistrationDTO) (*BeginWebAuthnRegistrationResult, *shared.Error)
+}
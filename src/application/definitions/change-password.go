@@ -0,0 +1,19 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type ChangePasswordDTO struct {
+	ActorId         string
+	CurrentPassword string
+	NewPassword     string
+	IpAddress       string
+	UserAgent       string
+}
+
+type ChangePassword interface {
+	Execute(ctx context.Context, data *ChangePasswordDTO) *shared.Error
+}
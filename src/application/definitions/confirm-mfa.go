@@ -0,0 +1,16 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type ConfirmMfaDTO struct {
+	ActorId string
+	Code    string
+}
+
+type ConfirmMfa interface {
+	Execute(ctx context.Context, data *ConfirmMfaDTO) *shared.Error
+}
@@ -0,0 +1,31 @@
+package definitions
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// ActorScopes are the scopes of the credential making the call, nil when it
+// is not restricted. A restricted one can only create keys within them.
+type CreateApiKeyDTO struct {
+	ActorId        string
+	ActorScopes    []string
+	OrganizationId string
+	ServiceAccount bool
+	Name           string
+	Scopes         []string
+	AllowedIps     []string
+	ExpiresAt      time.Time
+}
+
+type CreateApiKeyResult struct {
+	ApiKey *entities.ApiKeyEntity
+	Key    string
+}
+
+type CreateApiKey interface {
+	Execute(ctx context.Context, data *CreateApiKeyDTO) (*CreateApiKeyResult, *shared.Error)
+}
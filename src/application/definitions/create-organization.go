@@ -0,0 +1,19 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type CreateOrganizationDTO struct {
+	ActorId string
+	Name    string
+}
+
+type CreateOrganizationResult = entities.OrganizationEntity
+
+type CreateOrganization interface {
+	Execute(ctx context.Context, data *CreateOrganizationDTO) (*CreateOrganizationResult, *shared.Error)
+}
@@ -1,20 +1,32 @@
 package definitions
 
 import (
+	"context"
+
 	"github.com/AndreyArthur/oganessone/src/core/entities"
 	"github.com/AndreyArthur/oganessone/src/core/shared"
 )
 
 type CreateSessionDTO struct {
-	Login    string
-	Password string
+	Login          string
+	Password       string
+	OrganizationId string
+	IpAddress      string
+	UserAgent      string
 }
 
+// When the user has multi-factor authentication enabled no session is
+// created, MfaRequired is set and MfaToken must be sent to VerifyMfa along
+// with a one-time code.
 type CreateSessionResult struct {
-	User       *entities.UserEntity
-	SessionKey string
+	User           *entities.UserEntity
+	SessionKey     string
+	OrganizationId string
+	ExpirationDate string
+	MfaRequired    bool
+	MfaToken       string
 }
 
 type CreateSession interface {
-	Execute(data *CreateSessionDTO) (*CreateSessionResult, *shared.Error)
+	Execute(ctx context.Context, data *CreateSessionDTO) (*CreateSessionResult, *shared.Error)
 }
@@ -1,18 +1,23 @@
 package definitions
 
 import (
+	"context"
+
 	"github.com/AndreyArthur/oganessone/src/core/entities"
 	"github.com/AndreyArthur/oganessone/src/core/shared"
 )
 
 type CreateUserDTO struct {
-	Username string
-	Email    string
-	Password string
+	Username       string
+	Email          string
+	Password       string
+	OrganizationId string
+	IpAddress      string
+	UserAgent      string
 }
 
 type CreateUserResult = entities.UserEntity
 
 type CreateUser interface {
-	Execute(data *CreateUserDTO) (*CreateUserResult, *shared.Error)
+	Execute(ctx context.Context, data *CreateUserDTO) (*CreateUserResult, *shared.Error)
 }
@@ -0,0 +1,22 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type EnrollMfaDTO struct {
+	ActorId string
+}
+
+// The recovery codes are only ever shown here, the server keeps their hashes.
+type EnrollMfaResult struct {
+	Secret        string
+	Uri           string
+	RecoveryCodes []string
+}
+
+type EnrollMfa interface {
+	Execute(ctx context.Context, data *EnrollMfaDTO) (*EnrollMfaResult, *shared.Error)
+}
@@ -0,0 +1,26 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type ExchangeAuthorizationCodeDTO struct {
+	Code         string
+	RedirectUri  string
+	Client       *AuthenticateOAuthClientDTO
+	CodeVerifier string
+}
+
+type ExchangeAuthorizationCodeResult struct {
+	AccessToken string
+	TokenType   string
+	ExpiresIn   int64
+	Scopes      []string
+	IdToken     string
+}
+
+type ExchangeAuthorizationCode interface {
+	Execute(ctx context.Context, data *ExchangeAuthorizationCodeDTO) (*ExchangeAuthorizationCodeResult, *shared.Error)
+}
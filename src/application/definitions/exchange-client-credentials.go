@@ -0,0 +1,25 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type ExchangeClientCredentialsDTO struct {
+	Client    *AuthenticateOAuthClientDTO
+	Scope     string
+	Audiences []string
+}
+
+type ExchangeClientCredentialsResult struct {
+	AccessToken string
+	TokenType   string
+	ExpiresIn   int64
+	Scopes      []string
+	Audiences   []string
+}
+
+type ExchangeClientCredentials interface {
+	Execute(ctx context.Context, data *ExchangeClientCredentialsDTO) (*ExchangeClientCredentialsResult, *shared.Error)
+}
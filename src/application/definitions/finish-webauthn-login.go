@@ -0,0 +1,25 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// Every field is base64url encoded, as found in the
+// AuthenticatorAssertionResponse, UserHandle may be empty.
+type FinishWebAuthnLoginDTO struct {
+	CredentialId      string
+	ClientDataJson    string
+	AuthenticatorData string
+	Signature         string
+	UserHandle        string
+	IpAddress         string
+	UserAgent         string
+}
+
+type FinishWebAuthnLoginResult = CreateSessionResult
+
+type FinishWebAuthnLogin interface {
+	Execute(ctx context.Context, data *FinishWebAuthnLoginDTO) (*FinishWebAuthnLoginResult, *shared.Error)
+}
@@ -0,0 +1,23 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// ClientDataJson and AttestationObject are base64url encoded, as found in the
+// AuthenticatorAttestationResponse.
+type FinishWebAuthnRegistrationDTO struct {
+	ActorId           string
+	ClientDataJson    string
+	AttestationObject string
+	Transports        []string
+}
+
+type FinishWebAuthnRegistrationResult = entities.WebAuthnCredentialEntity
+
+type FinishWebAuthnRegistration interface {
+	Execute(ctx context.Context, data *FinishWebAuthnRegistrationDTO) (*FinishWebAuthnRegistrationResult, *shared.Error)
+}
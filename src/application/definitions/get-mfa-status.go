@@ -0,0 +1,20 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type GetMfaStatusDTO struct {
+	ActorId string
+}
+
+type GetMfaStatusResult struct {
+	Enabled                bool
+	RecoveryCodesRemaining int
+}
+
+type GetMfaStatus interface {
+	Execute(ctx context.Context, data *GetMfaStatusDTO) (*GetMfaStatusResult, *shared.Error)
+}
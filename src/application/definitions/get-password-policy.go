@@ -0,0 +1,22 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type GetPasswordPolicyDTO struct{}
+
+type GetPasswordPolicyResult struct {
+	MinLength               int
+	MaxLength               int
+	RequiredClasses         []string
+	MaxRepeatedCharacters   int
+	DisallowUserInfo        bool
+	DisallowDictionaryWords bool
+}
+
+type GetPasswordPolicy interface {
+	Execute(ctx context.Context, data *GetPasswordPolicyDTO) (*GetPasswordPolicyResult, *shared.Error)
+}
@@ -0,0 +1,18 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type GetUserInfoDTO struct {
+	UserId string
+	Scopes []string
+}
+
+type GetUserInfoResult = map[string]interface{}
+
+type GetUserInfo interface {
+	Execute(ctx context.Context, data *GetUserInfoDTO) (GetUserInfoResult, *shared.Error)
+}
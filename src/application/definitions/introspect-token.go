@@ -0,0 +1,29 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type IntrospectTokenDTO struct {
+	Client        *AuthenticateOAuthClientDTO
+	Token         string
+	TokenTypeHint string
+}
+
+// Only Active is meaningful for an inactive token, the rest is left empty so
+// nothing about unknown, expired or foreign tokens leaks to the caller.
+type IntrospectTokenResult struct {
+	Active         bool
+	Subject        string
+	ClientId       string
+	OrganizationId string
+	Scopes         []string
+	Audiences      []string
+	ExpiresAt      int64
+}
+
+type IntrospectToken interface {
+	Execute(ctx context.Context, data *IntrospectTokenDTO) (*IntrospectTokenResult, *shared.Error)
+}
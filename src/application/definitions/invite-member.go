@@ -0,0 +1,23 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type InviteMemberDTO struct {
+	ActorId        string
+	OrganizationId string
+	UserId         string
+	Role           string
+	IpAddress      string
+	UserAgent      string
+}
+
+type InviteMemberResult = entities.MembershipEntity
+
+type InviteMember interface {
+	Execute(ctx context.Context, data *InviteMemberDTO) (*InviteMemberResult, *shared.Error)
+}
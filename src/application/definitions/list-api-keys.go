@@ -0,0 +1,19 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type ListApiKeysDTO struct {
+	ActorId        string
+	OrganizationId string
+}
+
+type ListApiKeysResult = []*entities.ApiKeyEntity
+
+type ListApiKeys interface {
+	Execute(ctx context.Context, data *ListApiKeysDTO) (ListApiKeysResult, *shared.Error)
+}
@@ -0,0 +1,6 @@
+package definitions
+
+// Issuer is the account label shown by authenticator apps.
+type MfaOptions struct {
+	Issuer string
+}
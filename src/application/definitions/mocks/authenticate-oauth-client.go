@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/authenticate-oauth-client.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuthenticateOAuthClient is a mock of AuthenticateOAuthClient interface.
+type MockAuthenticateOAuthClient struct {
+        ctrl     *gomock.Controller
+        recorder *MockAuthenticateOAuthClientMockRecorder
+}
+
+// MockAuthenticateOAuthClientMockRecorder is the mock recorder for MockAuthenticateOAuthClient.
+type MockAuthenticateOAuthClientMockRecorder struct {
+        mock *MockAuthenticateOAuthClient
+}
+
+// NewMockAuthenticateOAuthClient creates a new mock instance.
+func NewMockAuthenticateOAuthClient(ctrl *gomock.Controller) *MockAuthenticateOAuthClient {
+        mock := &MockAuthenticateOAuthClient{ctrl: ctrl}
+        mock.recorder = &MockAuthenticateOAuthClientMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthenticateOAuthClient) EXPECT() *MockAuthenticateOAuthClientMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockAuthenticateOAuthClient) Execute(ctx context.Context, data *definitions.AuthenticateOAuthClientDTO) (*definitions.AuthenticateOAuthClientResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.AuthenticateOAuthClientResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockAuthenticateOAuthClientMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockAuthenticateOAuthClient)(nil).Execute), ctx, data)
+}
\ No newline at end of file
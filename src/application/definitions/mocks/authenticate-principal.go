@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/authenticate-principal.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuthenticatePrincipal is a mock of AuthenticatePrincipal interface.
+type MockAuthenticatePrincipal struct {
+        ctrl     *gomock.Controller
+        recorder *MockAuthenticatePrincipalMockRecorder
+}
+
+// MockAuthenticatePrincipalMockRecorder is the mock recorder for MockAuthenticatePrincipal.
+type MockAuthenticatePrincipalMockRecorder struct {
+        mock *MockAuthenticatePrincipal
+}
+
+// NewMockAuthenticatePrincipal creates a new mock instance.
+func NewMockAuthenticatePrincipal(ctrl *gomock.Controller) *MockAuthenticatePrincipal {
+        mock := &MockAuthenticatePrincipal{ctrl: ctrl}
+        mock.recorder = &MockAuthenticatePrincipalMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthenticatePrincipal) EXPECT() *MockAuthenticatePrincipalMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockAuthenticatePrincipal) Execute(ctx context.Context, data *definitions.AuthenticatePrincipalDTO) (*definitions.AuthenticatePrincipalResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.AuthenticatePrincipalResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockAuthenticatePrincipalMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockAuthenticatePrincipal)(nil).Execute), ctx, data)
+}
\ No newline at end of file
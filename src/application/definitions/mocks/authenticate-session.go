@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/authenticate-session.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuthenticateSession is a mock of AuthenticateSession interface.
+type MockAuthenticateSession struct {
+        ctrl     *gomock.Controller
+        recorder *MockAuthenticateSessionMockRecorder
+}
+
+// MockAuthenticateSessionMockRecorder is the mock recorder for MockAuthenticateSession.
+type MockAuthenticateSessionMockRecorder struct {
+        mock *MockAuthenticateSession
+}
+
+// NewMockAuthenticateSession creates a new mock instance.
+func NewMockAuthenticateSession(ctrl *gomock.Controller) *MockAuthenticateSession {
+        mock := &MockAuthenticateSession{ctrl: ctrl}
+        mock.recorder = &MockAuthenticateSessionMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthenticateSession) EXPECT() *MockAuthenticateSessionMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockAuthenticateSession) Execute(ctx context.Context, data *definitions.AuthenticateSessionDTO) (*definitions.AuthenticateSessionResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.AuthenticateSessionResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockAuthenticateSessionMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockAuthenticateSession)(nil).Execute), ctx, data)
+}
\ No newline at end of file
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/authorize.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuthorize is a mock of Authorize interface.
+type MockAuthorize struct {
+        ctrl     *gomock.Controller
+        recorder *MockAuthorizeMockRecorder
+}
+
+// MockAuthorizeMockRecorder is the mock recorder for MockAuthorize.
+type MockAuthorizeMockRecorder struct {
+        mock *MockAuthorize
+}
+
+// NewMockAuthorize creates a new mock instance.
+func NewMockAuthorize(ctrl *gomock.Controller) *MockAuthorize {
+        mock := &MockAuthorize{ctrl: ctrl}
+        mock.recorder = &MockAuthorizeMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorize) EXPECT() *MockAuthorizeMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockAuthorize) Execute(ctx context.Context, data *definitions.AuthorizeDTO) (*definitions.AuthorizeResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.AuthorizeResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockAuthorizeMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockAuthorize)(nil).Execute), ctx, data)
+}
\ No newline at end of file
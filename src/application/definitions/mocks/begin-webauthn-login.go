@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/begin-webauthn-login.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockBeginWebAuthnLogin is a mock of BeginWebAuthnLogin interface.
+type MockBeginWebAuthnLogin struct {
+        ctrl     *gomock.Controller
+        recorder *MockBeginWebAuthnLoginMockRecorder
+}
+
+// MockBeginWebAuthnLoginMockRecorder is the mock recorder for MockBeginWebAuthnLogin.
+type MockBeginWebAuthnLoginMockRecorder struct {
+        mock *MockBeginWebAuthnLogin
+}
+
+// NewMockBeginWebAuthnLogin creates a new mock instance.
+func NewMockBeginWebAuthnLogin(ctrl *gomock.Controller) *MockBeginWebAuthnLogin {
+        mock := &MockBeginWebAuthnLogin{ctrl: ctrl}
+        mock.recorder = &MockBeginWebAuthnLoginMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeginWebAuthnLogin) EXPECT() *MockBeginWebAuthnLoginMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockBeginWebAuthnLogin) Execute(ctx context.Context, data *definitions.BeginWebAuthnLoginDTO) (*definitions.BeginWebAuthnLoginResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.BeginWebAuthnLoginResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockBeginWebAuthnLoginMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockBeginWebAuthnLogin)(nil).Execute), ctx, data)
+}
\ No newline at end of file
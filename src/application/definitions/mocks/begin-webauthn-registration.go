@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/begin-webauthn-registration.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockBeginWebAuthnRegistration is a mock of BeginWebAuthnRegistration interface.
+type MockBeginWebAuthnRegistration struct {
+        ctrl     *gomock.Controller
+        recorder *MockBeginWebAuthnRegistrationMockRecorder
+}
+
+// MockBeginWebAuthnRegistrationMockRecorder is the mock recorder for MockBeginWebAuthnRegistration.
+type MockBeginWebAuthnRegistrationMockRecorder struct {
+        mock *MockBeginWebAuthnRegistration
+}
+
+// NewMockBeginWebAuthnRegistration creates a new mock instance.
+func NewMockBeginWebAuthnRegistration(ctrl *gomock.Controller) *MockBeginWebAuthnRegistration {
+        mock := &MockBeginWebAuthnRegistration{ctrl: ctrl}
+        mock.recorder = &MockBeginWebAuthnRegistrationMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBeginWebAuthnRegistration) EXPECT() *MockBeginWebAuthnRegistrationMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockBeginWebAuthnRegistration) Execute(ctx context.Context, data *definitions.BeginWebAuthnRegistrationDTO) (*definitions.BeginWebAuthnRegistrationResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.BeginWebAuthnRegistrationResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockBeginWebAuthnRegistrationMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockBeginWebAuthnRegistration)(nil).Execute), ctx, data)
+}
\ No newline at end of file
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/change-password.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockChangePassword is a mock of ChangePassword interface.
+type MockChangePassword struct {
+        ctrl     *gomock.Controller
+        recorder *MockChangePasswordMockRecorder
+}
+
+// MockChangePasswordMockRecorder is the mock recorder for MockChangePassword.
+type MockChangePasswordMockRecorder struct {
+        mock *MockChangePassword
+}
+
+// NewMockChangePassword creates a new mock instance.
+func NewMockChangePassword(ctrl *gomock.Controller) *MockChangePassword {
+        mock := &MockChangePassword{ctrl: ctrl}
+        mock.recorder = &MockChangePasswordMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangePassword) EXPECT() *MockChangePasswordMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockChangePassword) Execute(ctx context.Context, data *definitions.ChangePasswordDTO) *shared.Error {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*shared.Error)
+        return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockChangePasswordMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockChangePassword)(nil).Execute), ctx, data)
+}
\ No newline at end of file
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/confirm-mfa.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockConfirmMfa is a mock of ConfirmMfa interface.
+type MockConfirmMfa struct {
+        ctrl     *gomock.Controller
+        recorder *MockConfirmMfaMockRecorder
+}
+
+// MockConfirmMfaMockRecorder is the mock recorder for MockConfirmMfa.
+type MockConfirmMfaMockRecorder struct {
+        mock *MockConfirmMfa
+}
+
+// NewMockConfirmMfa creates a new mock instance.
+func NewMockConfirmMfa(ctrl *gomock.Controller) *MockConfirmMfa {
+        mock := &MockConfirmMfa{ctrl: ctrl}
+        mock.recorder = &MockConfirmMfaMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfirmMfa) EXPECT() *MockConfirmMfaMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockConfirmMfa) Execute(ctx context.Context, data *definitions.ConfirmMfaDTO) *shared.Error {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*shared.Error)
+        return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockConfirmMfaMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockConfirmMfa)(nil).Execute), ctx, data)
+}
\ No newline at end of file
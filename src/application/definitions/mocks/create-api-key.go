@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/create-api-key.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockCreateApiKey is a mock of CreateApiKey interface.
+type MockCreateApiKey struct {
+        ctrl     *gomock.Controller
+        recorder *MockCreateApiKeyMockRecorder
+}
+
+// MockCreateApiKeyMockRecorder is the mock recorder for MockCreateApiKey.
+type MockCreateApiKeyMockRecorder struct {
+        mock *MockCreateApiKey
+}
+
+// NewMockCreateApiKey creates a new mock instance.
+func NewMockCreateApiKey(ctrl *gomock.Controller) *MockCreateApiKey {
+        mock := &MockCreateApiKey{ctrl: ctrl}
+        mock.recorder = &MockCreateApiKeyMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateApiKey) EXPECT() *MockCreateApiKeyMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCreateApiKey) Execute(ctx context.Context, data *definitions.CreateApiKeyDTO) (*definitions.CreateApiKeyResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.CreateApiKeyResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCreateApiKeyMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateApiKey)(nil).Execute), ctx, data)
+}
\ No newline at end of file
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/create-organization.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockCreateOrganization is a mock of CreateOrganization interface.
+type MockCreateOrganization struct {
+        ctrl     *gomock.Controller
+        recorder *MockCreateOrganizationMockRecorder
+}
+
+// MockCreateOrganizationMockRecorder is the mock recorder for MockCreateOrganization.
+type MockCreateOrganizationMockRecorder struct {
+        mock *MockCreateOrganization
+}
+
+// NewMockCreateOrganization creates a new mock instance.
+func NewMockCreateOrganization(ctrl *gomock.Controller) *MockCreateOrganization {
+        mock := &MockCreateOrganization{ctrl: ctrl}
+        mock.recorder = &MockCreateOrganizationMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateOrganization) EXPECT() *MockCreateOrganizationMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCreateOrganization) Execute(ctx context.Context, data *definitions.CreateOrganizationDTO) (*definitions.CreateOrganizationResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.CreateOrganizationResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCreateOrganizationMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateOrganization)(nil).Execute), ctx, data)
+}
\ No newline at end of file
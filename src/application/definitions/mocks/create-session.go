@@ -5,6 +5,7 @@
 package mock_definitions
 
 import (
+        context "context"
         reflect "reflect"
 
         definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
@@ -36,16 +37,16 @@ func (m *MockCreateSession) EXPECT() *MockCreateSessionMockRecorder {
 }
 
 // Execute mocks base method.
-func (m *MockCreateSession) Execute(data *definitions.CreateSessionDTO) (*definitions.CreateSessionResult, *shared.Error) {
+func (m *MockCreateSession) Execute(ctx context.Context, data *definitions.CreateSessionDTO) (*definitions.CreateSessionResult, *shared.Error) {
         m.ctrl.T.Helper()
-        ret := m.ctrl.Call(m, "Execute", data)
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
         ret0, _ := ret[0].(*definitions.CreateSessionResult)
         ret1, _ := ret[1].(*shared.Error)
         return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute.
-func (mr *MockCreateSessionMockRecorder) Execute(data interface{}) *gomock.Call {
+func (mr *MockCreateSessionMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
         mr.mock.ctrl.T.Helper()
-        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateSession)(nil).Execute), data)
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateSession)(nil).Execute), ctx, data)
 }
\ No newline at end of file
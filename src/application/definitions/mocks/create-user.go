@@ -5,6 +5,7 @@
 package mock_definitions
 
 import (
+        context "context"
         reflect "reflect"
 
         definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
@@ -36,16 +37,16 @@ func (m *MockCreateUser) EXPECT() *MockCreateUserMockRecorder {
 }
 
 // Execute mocks base method.
-func (m *MockCreateUser) Execute(data *definitions.CreateUserDTO) (*definitions.CreateUserResult, *shared.Error) {
+func (m *MockCreateUser) Execute(ctx context.Context, data *definitions.CreateUserDTO) (*definitions.CreateUserResult, *shared.Error) {
         m.ctrl.T.Helper()
-        ret := m.ctrl.Call(m, "Execute", data)
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
         ret0, _ := ret[0].(*definitions.CreateUserResult)
         ret1, _ := ret[1].(*shared.Error)
         return ret0, ret1
 }
 
 // Execute indicates an expected call of Execute.
-func (mr *MockCreateUserMockRecorder) Execute(data interface{}) *gomock.Call {
+func (mr *MockCreateUserMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
         mr.mock.ctrl.T.Helper()
-        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateUser)(nil).Execute), data)
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateUser)(nil).Execute), ctx, data)
 }
\ No newline at end of file
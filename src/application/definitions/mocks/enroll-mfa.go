@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/enroll-mfa.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockEnrollMfa is a mock of EnrollMfa interface.
+type MockEnrollMfa struct {
+        ctrl     *gomock.Controller
+        recorder *MockEnrollMfaMockRecorder
+}
+
+// MockEnrollMfaMockRecorder is the mock recorder for MockEnrollMfa.
+type MockEnrollMfaMockRecorder struct {
+        mock *MockEnrollMfa
+}
+
+// NewMockEnrollMfa creates a new mock instance.
+func NewMockEnrollMfa(ctrl *gomock.Controller) *MockEnrollMfa {
+        mock := &MockEnrollMfa{ctrl: ctrl}
+        mock.recorder = &MockEnrollMfaMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEnrollMfa) EXPECT() *MockEnrollMfaMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockEnrollMfa) Execute(ctx context.Context, data *definitions.EnrollMfaDTO) (*definitions.EnrollMfaResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.EnrollMfaResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockEnrollMfaMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockEnrollMfa)(nil).Execute), ctx, data)
+}
\ No newline at end of file
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/exchange-authorization-code.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockExchangeAuthorizationCode is a mock of ExchangeAuthorizationCode interface.
+type MockExchangeAuthorizationCode struct {
+        ctrl     *gomock.Controller
+        recorder *MockExchangeAuthorizationCodeMockRecorder
+}
+
+// MockExchangeAuthorizationCodeMockRecorder is the mock recorder for MockExchangeAuthorizationCode.
+type MockExchangeAuthorizationCodeMockRecorder struct {
+        mock *MockExchangeAuthorizationCode
+}
+
+// NewMockExchangeAuthorizationCode creates a new mock instance.
+func NewMockExchangeAuthorizationCode(ctrl *gomock.Controller) *MockExchangeAuthorizationCode {
+        mock := &MockExchangeAuthorizationCode{ctrl: ctrl}
+        mock.recorder = &MockExchangeAuthorizationCodeMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeAuthorizationCode) EXPECT() *MockExchangeAuthorizationCodeMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockExchangeAuthorizationCode) Execute(ctx context.Context, data *definitions.ExchangeAuthorizationCodeDTO) (*definitions.ExchangeAuthorizationCodeResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.ExchangeAuthorizationCodeResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockExchangeAuthorizationCodeMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockExchangeAuthorizationCode)(nil).Execute), ctx, data)
+}
\ No newline at end of file
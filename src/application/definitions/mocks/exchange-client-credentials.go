@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/exchange-client-credentials.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockExchangeClientCredentials is a mock of ExchangeClientCredentials interface.
+type MockExchangeClientCredentials struct {
+        ctrl     *gomock.Controller
+        recorder *MockExchangeClientCredentialsMockRecorder
+}
+
+// MockExchangeClientCredentialsMockRecorder is the mock recorder for MockExchangeClientCredentials.
+type MockExchangeClientCredentialsMockRecorder struct {
+        mock *MockExchangeClientCredentials
+}
+
+// NewMockExchangeClientCredentials creates a new mock instance.
+func NewMockExchangeClientCredentials(ctrl *gomock.Controller) *MockExchangeClientCredentials {
+        mock := &MockExchangeClientCredentials{ctrl: ctrl}
+        mock.recorder = &MockExchangeClientCredentialsMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeClientCredentials) EXPECT() *MockExchangeClientCredentialsMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockExchangeClientCredentials) Execute(ctx context.Context, data *definitions.ExchangeClientCredentialsDTO) (*definitions.ExchangeClientCredentialsResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.ExchangeClientCredentialsResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockExchangeClientCredentialsMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockExchangeClientCredentials)(nil).Execute), ctx, data)
+}
\ No newline at end of file
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/finish-webauthn-login.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockFinishWebAuthnLogin is a mock of FinishWebAuthnLogin interface.
+type MockFinishWebAuthnLogin struct {
+        ctrl     *gomock.Controller
+        recorder *MockFinishWebAuthnLoginMockRecorder
+}
+
+// MockFinishWebAuthnLoginMockRecorder is the mock recorder for MockFinishWebAuthnLogin.
+type MockFinishWebAuthnLoginMockRecorder struct {
+        mock *MockFinishWebAuthnLogin
+}
+
+// NewMockFinishWebAuthnLogin creates a new mock instance.
+func NewMockFinishWebAuthnLogin(ctrl *gomock.Controller) *MockFinishWebAuthnLogin {
+        mock := &MockFinishWebAuthnLogin{ctrl: ctrl}
+        mock.recorder = &MockFinishWebAuthnLoginMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFinishWebAuthnLogin) EXPECT() *MockFinishWebAuthnLoginMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockFinishWebAuthnLogin) Execute(ctx context.Context, data *definitions.FinishWebAuthnLoginDTO) (*definitions.FinishWebAuthnLoginResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.FinishWebAuthnLoginResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockFinishWebAuthnLoginMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockFinishWebAuthnLogin)(nil).Execute), ctx, data)
+}
\ No newline at end of file
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/finish-webauthn-registration.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockFinishWebAuthnRegistration is a mock of FinishWebAuthnRegistration interface.
+type MockFinishWebAuthnRegistration struct {
+        ctrl     *gomock.Controller
+        recorder *MockFinishWebAuthnRegistrationMockRecorder
+}
+
+// MockFinishWebAuthnRegistrationMockRecorder is the mock recorder for MockFinishWebAuthnRegistration.
+type MockFinishWebAuthnRegistrationMockRecorder struct {
+        mock *MockFinishWebAuthnRegistration
+}
+
+// NewMockFinishWebAuthnRegistration creates a new mock instance.
+func NewMockFinishWebAuthnRegistration(ctrl *gomock.Controller) *MockFinishWebAuthnRegistration {
+        mock := &MockFinishWebAuthnRegistration{ctrl: ctrl}
+        mock.recorder = &MockFinishWebAuthnRegistrationMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFinishWebAuthnRegistration) EXPECT() *MockFinishWebAuthnRegistrationMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockFinishWebAuthnRegistration) Execute(ctx context.Context, data *definitions.FinishWebAuthnRegistrationDTO) (*definitions.FinishWebAuthnRegistrationResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.FinishWebAuthnRegistrationResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockFinishWebAuthnRegistrationMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockFinishWebAuthnRegistration)(nil).Execute), ctx, data)
+}
\ No newline at end of file
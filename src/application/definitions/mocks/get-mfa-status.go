@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/get-mfa-status.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockGetMfaStatus is a mock of GetMfaStatus interface.
+type MockGetMfaStatus struct {
+        ctrl     *gomock.Controller
+        recorder *MockGetMfaStatusMockRecorder
+}
+
+// MockGetMfaStatusMockRecorder is the mock recorder for MockGetMfaStatus.
+type MockGetMfaStatusMockRecorder struct {
+        mock *MockGetMfaStatus
+}
+
+// NewMockGetMfaStatus creates a new mock instance.
+func NewMockGetMfaStatus(ctrl *gomock.Controller) *MockGetMfaStatus {
+        mock := &MockGetMfaStatus{ctrl: ctrl}
+        mock.recorder = &MockGetMfaStatusMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMfaStatus) EXPECT() *MockGetMfaStatusMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetMfaStatus) Execute(ctx context.Context, data *definitions.GetMfaStatusDTO) (*definitions.GetMfaStatusResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.GetMfaStatusResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetMfaStatusMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetMfaStatus)(nil).Execute), ctx, data)
+}
\ No newline at end of file
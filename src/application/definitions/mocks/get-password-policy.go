@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/get-password-policy.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockGetPasswordPolicy is a mock of GetPasswordPolicy interface.
+type MockGetPasswordPolicy struct {
+        ctrl     *gomock.Controller
+        recorder *MockGetPasswordPolicyMockRecorder
+}
+
+// MockGetPasswordPolicyMockRecorder is the mock recorder for MockGetPasswordPolicy.
+type MockGetPasswordPolicyMockRecorder struct {
+        mock *MockGetPasswordPolicy
+}
+
+// NewMockGetPasswordPolicy creates a new mock instance.
+func NewMockGetPasswordPolicy(ctrl *gomock.Controller) *MockGetPasswordPolicy {
+        mock := &MockGetPasswordPolicy{ctrl: ctrl}
+        mock.recorder = &MockGetPasswordPolicyMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetPasswordPolicy) EXPECT() *MockGetPasswordPolicyMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetPasswordPolicy) Execute(ctx context.Context, data *definitions.GetPasswordPolicyDTO) (*definitions.GetPasswordPolicyResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.GetPasswordPolicyResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetPasswordPolicyMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetPasswordPolicy)(nil).Execute), ctx, data)
+}
\ No newline at end of file
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/get-user-info.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockGetUserInfo is a mock of GetUserInfo interface.
+type MockGetUserInfo struct {
+        ctrl     *gomock.Controller
+        recorder *MockGetUserInfoMockRecorder
+}
+
+// MockGetUserInfoMockRecorder is the mock recorder for MockGetUserInfo.
+type MockGetUserInfoMockRecorder struct {
+        mock *MockGetUserInfo
+}
+
+// NewMockGetUserInfo creates a new mock instance.
+func NewMockGetUserInfo(ctrl *gomock.Controller) *MockGetUserInfo {
+        mock := &MockGetUserInfo{ctrl: ctrl}
+        mock.recorder = &MockGetUserInfoMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUserInfo) EXPECT() *MockGetUserInfoMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetUserInfo) Execute(ctx context.Context, data *definitions.GetUserInfoDTO) (definitions.GetUserInfoResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(definitions.GetUserInfoResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetUserInfoMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetUserInfo)(nil).Execute), ctx, data)
+}
\ No newline at end of file
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/introspect-token.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockIntrospectToken is a mock of IntrospectToken interface.
+type MockIntrospectToken struct {
+        ctrl     *gomock.Controller
+        recorder *MockIntrospectTokenMockRecorder
+}
+
+// MockIntrospectTokenMockRecorder is the mock recorder for MockIntrospectToken.
+type MockIntrospectTokenMockRecorder struct {
+        mock *MockIntrospectToken
+}
+
+// NewMockIntrospectToken creates a new mock instance.
+func NewMockIntrospectToken(ctrl *gomock.Controller) *MockIntrospectToken {
+        mock := &MockIntrospectToken{ctrl: ctrl}
+        mock.recorder = &MockIntrospectTokenMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIntrospectToken) EXPECT() *MockIntrospectTokenMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockIntrospectToken) Execute(ctx context.Context, data *definitions.IntrospectTokenDTO) (*definitions.IntrospectTokenResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.IntrospectTokenResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockIntrospectTokenMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockIntrospectToken)(nil).Execute), ctx, data)
+}
\ No newline at end of file
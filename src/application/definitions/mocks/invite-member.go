@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/invite-member.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockInviteMember is a mock of InviteMember interface.
+type MockInviteMember struct {
+        ctrl     *gomock.Controller
+        recorder *MockInviteMemberMockRecorder
+}
+
+// MockInviteMemberMockRecorder is the mock recorder for MockInviteMember.
+type MockInviteMemberMockRecorder struct {
+        mock *MockInviteMember
+}
+
+// NewMockInviteMember creates a new mock instance.
+func NewMockInviteMember(ctrl *gomock.Controller) *MockInviteMember {
+        mock := &MockInviteMember{ctrl: ctrl}
+        mock.recorder = &MockInviteMemberMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInviteMember) EXPECT() *MockInviteMemberMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockInviteMember) Execute(ctx context.Context, data *definitions.InviteMemberDTO) (*definitions.InviteMemberResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.InviteMemberResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockInviteMemberMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockInviteMember)(nil).Execute), ctx, data)
+}
\ No newline at end of file
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/list-api-keys.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockListApiKeys is a mock of ListApiKeys interface.
+type MockListApiKeys struct {
+        ctrl     *gomock.Controller
+        recorder *MockListApiKeysMockRecorder
+}
+
+// MockListApiKeysMockRecorder is the mock recorder for MockListApiKeys.
+type MockListApiKeysMockRecorder struct {
+        mock *MockListApiKeys
+}
+
+// NewMockListApiKeys creates a new mock instance.
+func NewMockListApiKeys(ctrl *gomock.Controller) *MockListApiKeys {
+        mock := &MockListApiKeys{ctrl: ctrl}
+        mock.recorder = &MockListApiKeysMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockListApiKeys) EXPECT() *MockListApiKeysMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockListApiKeys) Execute(ctx context.Context, data *definitions.ListApiKeysDTO) (definitions.ListApiKeysResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(definitions.ListApiKeysResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockListApiKeysMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockListApiKeys)(nil).Execute), ctx, data)
+}
\ No newline at end of file
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/query-audit-log.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockQueryAuditLog is a mock of QueryAuditLog interface.
+type MockQueryAuditLog struct {
+        ctrl     *gomock.Controller
+        recorder *MockQueryAuditLogMockRecorder
+}
+
+// MockQueryAuditLogMockRecorder is the mock recorder for MockQueryAuditLog.
+type MockQueryAuditLogMockRecorder struct {
+        mock *MockQueryAuditLog
+}
+
+// NewMockQueryAuditLog creates a new mock instance.
+func NewMockQueryAuditLog(ctrl *gomock.Controller) *MockQueryAuditLog {
+        mock := &MockQueryAuditLog{ctrl: ctrl}
+        mock.recorder = &MockQueryAuditLogMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQueryAuditLog) EXPECT() *MockQueryAuditLogMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockQueryAuditLog) Execute(ctx context.Context, data *definitions.QueryAuditLogDTO) (*definitions.QueryAuditLogResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.QueryAuditLogResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockQueryAuditLogMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockQueryAuditLog)(nil).Execute), ctx, data)
+}
\ No newline at end of file
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/regenerate-recovery-codes.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockRegenerateRecoveryCodes is a mock of RegenerateRecoveryCodes interface.
+type MockRegenerateRecoveryCodes struct {
+        ctrl     *gomock.Controller
+        recorder *MockRegenerateRecoveryCodesMockRecorder
+}
+
+// MockRegenerateRecoveryCodesMockRecorder is the mock recorder for MockRegenerateRecoveryCodes.
+type MockRegenerateRecoveryCodesMockRecorder struct {
+        mock *MockRegenerateRecoveryCodes
+}
+
+// NewMockRegenerateRecoveryCodes creates a new mock instance.
+func NewMockRegenerateRecoveryCodes(ctrl *gomock.Controller) *MockRegenerateRecoveryCodes {
+        mock := &MockRegenerateRecoveryCodes{ctrl: ctrl}
+        mock.recorder = &MockRegenerateRecoveryCodesMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegenerateRecoveryCodes) EXPECT() *MockRegenerateRecoveryCodesMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRegenerateRecoveryCodes) Execute(ctx context.Context, data *definitions.RegenerateRecoveryCodesDTO) (*definitions.RegenerateRecoveryCodesResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.RegenerateRecoveryCodesResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRegenerateRecoveryCodesMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRegenerateRecoveryCodes)(nil).Execute), ctx, data)
+}
\ No newline at end of file
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/register-oauth-client.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockRegisterOAuthClient is a mock of RegisterOAuthClient interface.
+type MockRegisterOAuthClient struct {
+        ctrl     *gomock.Controller
+        recorder *MockRegisterOAuthClientMockRecorder
+}
+
+// MockRegisterOAuthClientMockRecorder is the mock recorder for MockRegisterOAuthClient.
+type MockRegisterOAuthClientMockRecorder struct {
+        mock *MockRegisterOAuthClient
+}
+
+// NewMockRegisterOAuthClient creates a new mock instance.
+func NewMockRegisterOAuthClient(ctrl *gomock.Controller) *MockRegisterOAuthClient {
+        mock := &MockRegisterOAuthClient{ctrl: ctrl}
+        mock.recorder = &MockRegisterOAuthClientMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegisterOAuthClient) EXPECT() *MockRegisterOAuthClientMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRegisterOAuthClient) Execute(ctx context.Context, data *definitions.RegisterOAuthClientDTO) (*definitions.RegisterOAuthClientResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.RegisterOAuthClientResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRegisterOAuthClientMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRegisterOAuthClient)(nil).Execute), ctx, data)
+}
\ No newline at end of file
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/remove-member.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockRemoveMember is a mock of RemoveMember interface.
+type MockRemoveMember struct {
+        ctrl     *gomock.Controller
+        recorder *MockRemoveMemberMockRecorder
+}
+
+// MockRemoveMemberMockRecorder is the mock recorder for MockRemoveMember.
+type MockRemoveMemberMockRecorder struct {
+        mock *MockRemoveMember
+}
+
+// NewMockRemoveMember creates a new mock instance.
+func NewMockRemoveMember(ctrl *gomock.Controller) *MockRemoveMember {
+        mock := &MockRemoveMember{ctrl: ctrl}
+        mock.recorder = &MockRemoveMemberMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemoveMember) EXPECT() *MockRemoveMemberMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRemoveMember) Execute(ctx context.Context, data *definitions.RemoveMemberDTO) *shared.Error {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*shared.Error)
+        return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRemoveMemberMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRemoveMember)(nil).Execute), ctx, data)
+}
\ No newline at end of file
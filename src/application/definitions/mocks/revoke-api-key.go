@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/revoke-api-key.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockRevokeApiKey is a mock of RevokeApiKey interface.
+type MockRevokeApiKey struct {
+        ctrl     *gomock.Controller
+        recorder *MockRevokeApiKeyMockRecorder
+}
+
+// MockRevokeApiKeyMockRecorder is the mock recorder for MockRevokeApiKey.
+type MockRevokeApiKeyMockRecorder struct {
+        mock *MockRevokeApiKey
+}
+
+// NewMockRevokeApiKey creates a new mock instance.
+func NewMockRevokeApiKey(ctrl *gomock.Controller) *MockRevokeApiKey {
+        mock := &MockRevokeApiKey{ctrl: ctrl}
+        mock.recorder = &MockRevokeApiKeyMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevokeApiKey) EXPECT() *MockRevokeApiKeyMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRevokeApiKey) Execute(ctx context.Context, data *definitions.RevokeApiKeyDTO) (*definitions.RevokeApiKeyResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.RevokeApiKeyResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRevokeApiKeyMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRevokeApiKey)(nil).Execute), ctx, data)
+}
\ No newline at end of file
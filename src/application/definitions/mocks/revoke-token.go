@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/revoke-token.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockRevokeToken is a mock of RevokeToken interface.
+type MockRevokeToken struct {
+        ctrl     *gomock.Controller
+        recorder *MockRevokeTokenMockRecorder
+}
+
+// MockRevokeTokenMockRecorder is the mock recorder for MockRevokeToken.
+type MockRevokeTokenMockRecorder struct {
+        mock *MockRevokeToken
+}
+
+// NewMockRevokeToken creates a new mock instance.
+func NewMockRevokeToken(ctrl *gomock.Controller) *MockRevokeToken {
+        mock := &MockRevokeToken{ctrl: ctrl}
+        mock.recorder = &MockRevokeTokenMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevokeToken) EXPECT() *MockRevokeTokenMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRevokeToken) Execute(ctx context.Context, data *definitions.RevokeTokenDTO) *shared.Error {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*shared.Error)
+        return ret0
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRevokeTokenMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRevokeToken)(nil).Execute), ctx, data)
+}
\ No newline at end of file
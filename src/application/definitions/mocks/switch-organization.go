@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/switch-organization.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockSwitchOrganization is a mock of SwitchOrganization interface.
+type MockSwitchOrganization struct {
+        ctrl     *gomock.Controller
+        recorder *MockSwitchOrganizationMockRecorder
+}
+
+// MockSwitchOrganizationMockRecorder is the mock recorder for MockSwitchOrganization.
+type MockSwitchOrganizationMockRecorder struct {
+        mock *MockSwitchOrganization
+}
+
+// NewMockSwitchOrganization creates a new mock instance.
+func NewMockSwitchOrganization(ctrl *gomock.Controller) *MockSwitchOrganization {
+        mock := &MockSwitchOrganization{ctrl: ctrl}
+        mock.recorder = &MockSwitchOrganizationMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSwitchOrganization) EXPECT() *MockSwitchOrganizationMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockSwitchOrganization) Execute(ctx context.Context, data *definitions.SwitchOrganizationDTO) (*definitions.SwitchOrganizationResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.SwitchOrganizationResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockSwitchOrganizationMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockSwitchOrganization)(nil).Execute), ctx, data)
+}
\ No newline at end of file
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/validate-authorization-request.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockValidateAuthorizationRequest is a mock of ValidateAuthorizationRequest interface.
+type MockValidateAuthorizationRequest struct {
+        ctrl     *gomock.Controller
+        recorder *MockValidateAuthorizationRequestMockRecorder
+}
+
+// MockValidateAuthorizationRequestMockRecorder is the mock recorder for MockValidateAuthorizationRequest.
+type MockValidateAuthorizationRequestMockRecorder struct {
+        mock *MockValidateAuthorizationRequest
+}
+
+// NewMockValidateAuthorizationRequest creates a new mock instance.
+func NewMockValidateAuthorizationRequest(ctrl *gomock.Controller) *MockValidateAuthorizationRequest {
+        mock := &MockValidateAuthorizationRequest{ctrl: ctrl}
+        mock.recorder = &MockValidateAuthorizationRequestMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockValidateAuthorizationRequest) EXPECT() *MockValidateAuthorizationRequestMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockValidateAuthorizationRequest) Execute(ctx context.Context, data *definitions.AuthorizationRequestDTO) (*definitions.ValidateAuthorizationRequestResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.ValidateAuthorizationRequestResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockValidateAuthorizationRequestMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockValidateAuthorizationRequest)(nil).Execute), ctx, data)
+}
\ No newline at end of file
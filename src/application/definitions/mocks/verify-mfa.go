@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/definitions/verify-mfa.go
+
+// Package mock_definitions is a generated GoMock package.
+package mock_definitions
+
+import (
+        context "context"
+        reflect "reflect"
+
+        definitions "github.com/AndreyArthur/oganessone/src/application/definitions"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockVerifyMfa is a mock of VerifyMfa interface.
+type MockVerifyMfa struct {
+        ctrl     *gomock.Controller
+        recorder *MockVerifyMfaMockRecorder
+}
+
+// MockVerifyMfaMockRecorder is the mock recorder for MockVerifyMfa.
+type MockVerifyMfaMockRecorder struct {
+        mock *MockVerifyMfa
+}
+
+// NewMockVerifyMfa creates a new mock instance.
+func NewMockVerifyMfa(ctrl *gomock.Controller) *MockVerifyMfa {
+        mock := &MockVerifyMfa{ctrl: ctrl}
+        mock.recorder = &MockVerifyMfaMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyMfa) EXPECT() *MockVerifyMfaMockRecorder {
+        return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockVerifyMfa) Execute(ctx context.Context, data *definitions.VerifyMfaDTO) (*definitions.VerifyMfaResult, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Execute", ctx, data)
+        ret0, _ := ret[0].(*definitions.VerifyMfaResult)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockVerifyMfaMockRecorder) Execute(ctx, data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockVerifyMfa)(nil).Execute), ctx, data)
+}
\ No newline at end of file
@@ -0,0 +1,8 @@
+package definitions
+
+import "time"
+
+type OpenIdOptions struct {
+	Issuer          string
+	IdTokenLifetime time.Duration
+}
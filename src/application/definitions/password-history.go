@@ -0,0 +1,10 @@
+package definitions
+
+import "time"
+
+// Size is how many previous passwords can't be reused, the current one never
+// can. A zero Retention keeps them until they fall out of the last Size.
+type PasswordHistoryOptions struct {
+	Size      int
+	Retention time.Duration
+}
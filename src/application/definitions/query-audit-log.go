@@ -0,0 +1,34 @@
+package definitions
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// ActorId and OrganizationId come from the session of the caller, the other
+// fields filter the events. A zero PageSize takes the default.
+type QueryAuditLogDTO struct {
+	ActorId              string
+	OrganizationId       string
+	Type                 string
+	FilterActorId        string
+	FilterTargetId       string
+	FilterOrganizationId string
+	Since                time.Time
+	Until                time.Time
+	PageSize             int
+	PageToken            string
+}
+
+// NextPageToken is empty on the last page.
+type QueryAuditLogResult struct {
+	Events        []*entities.AuditEventEntity
+	NextPageToken string
+}
+
+type QueryAuditLog interface {
+	Execute(ctx context.Context, data *QueryAuditLogDTO) (*QueryAuditLogResult, *shared.Error)
+}
@@ -0,0 +1,20 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type RegenerateRecoveryCodesDTO struct {
+	ActorId  string
+	Password string
+}
+
+type RegenerateRecoveryCodesResult struct {
+	RecoveryCodes []string
+}
+
+type RegenerateRecoveryCodes interface {
+	Execute(ctx context.Context, data *RegenerateRecoveryCodesDTO) (*RegenerateRecoveryCodesResult, *shared.Error)
+}
@@ -0,0 +1,29 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type RegisterOAuthClientDTO struct {
+	ActorId        string
+	OrganizationId string
+	Name           string
+	RedirectUris   []string
+	AuthMethod     string
+	PublicKey      string
+	Scopes         []string
+	Audiences      []string
+}
+
+// The client secret is only returned here, it is stored hashed.
+type RegisterOAuthClientResult struct {
+	Client       *entities.OAuthClientEntity
+	ClientSecret string
+}
+
+type RegisterOAuthClient interface {
+	Execute(ctx context.Context, data *RegisterOAuthClientDTO) (*RegisterOAuthClientResult, *shared.Error)
+}
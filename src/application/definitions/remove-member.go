@@ -0,0 +1,17 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type RemoveMemberDTO struct {
+	ActorId        string
+	OrganizationId string
+	UserId         string
+}
+
+type RemoveMember interface {
+	Execute(ctx context.Context, data *RemoveMemberDTO) *shared.Error
+}
@@ -0,0 +1,19 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type RevokeApiKeyDTO struct {
+	ActorId  string
+	ApiKeyId string
+}
+
+type RevokeApiKeyResult = entities.ApiKeyEntity
+
+type RevokeApiKey interface {
+	Execute(ctx context.Context, data *RevokeApiKeyDTO) (*RevokeApiKeyResult, *shared.Error)
+}
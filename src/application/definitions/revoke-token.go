@@ -0,0 +1,19 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type RevokeTokenDTO struct {
+	Client        *AuthenticateOAuthClientDTO
+	Token         string
+	TokenTypeHint string
+	IpAddress     string
+	UserAgent     string
+}
+
+type RevokeToken interface {
+	Execute(ctx context.Context, data *RevokeTokenDTO) *shared.Error
+}
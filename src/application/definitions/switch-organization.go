@@ -0,0 +1,22 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type SwitchOrganizationDTO struct {
+	SessionKey     string
+	UserId         string
+	OrganizationId string
+}
+
+type SwitchOrganizationResult struct {
+	SessionKey     string
+	OrganizationId string
+}
+
+type SwitchOrganization interface {
+	Execute(ctx context.Context, data *SwitchOrganizationDTO) (*SwitchOrganizationResult, *shared.Error)
+}
@@ -0,0 +1,8 @@
+package definitions
+
+// TenancyOptions tells whether usernames and emails must be unique per
+// organization instead of across the whole instance.
+type TenancyOptions struct {
+	ScopedUsernames bool
+	ScopedEmails    bool
+}
@@ -0,0 +1,28 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type AuthorizationRequestDTO struct {
+	ResponseType        string
+	ClientId            string
+	RedirectUri         string
+	Scope               string
+	State               string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+}
+
+type ValidateAuthorizationRequestResult struct {
+	Client *entities.OAuthClientEntity
+	Scopes []string
+}
+
+type ValidateAuthorizationRequest interface {
+	Execute(ctx context.Context, data *AuthorizationRequestDTO) (*ValidateAuthorizationRequestResult, *shared.Error)
+}
@@ -0,0 +1,20 @@
+package definitions
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type VerifyMfaDTO struct {
+	MfaToken  string
+	Code      string
+	IpAddress string
+	UserAgent string
+}
+
+type VerifyMfaResult = CreateSessionResult
+
+type VerifyMfa interface {
+	Execute(ctx context.Context, data *VerifyMfaDTO) (*VerifyMfaResult, *shared.Error)
+}
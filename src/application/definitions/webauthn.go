@@ -0,0 +1,12 @@
+package definitions
+
+import "time"
+
+// Origins lists every origin allowed to run the ceremonies, each one must be
+// the relying party id or one of its subdomains.
+type WebAuthnOptions struct {
+	RpId    string
+	RpName  string
+	Origins []string
+	Timeout time.Duration
+}
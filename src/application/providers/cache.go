@@ -1,9 +1,15 @@
 package providers
 
-import "github.com/AndreyArthur/oganessone/src/core/shared"
+import (
+	"context"
+	"time"
 
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// A value reads as missing once expiresAt has passed.
 type CacheProvider interface {
-	Set(key string, value string) *shared.Error
-	Get(key string) (string, *shared.Error)
-	Delete(key string) *shared.Error
+	Set(ctx context.Context, key string, value string, expiresAt time.Time) *shared.Error
+	Get(ctx context.Context, key string) (string, *shared.Error)
+	Delete(ctx context.Context, key string) *shared.Error
 }
@@ -0,0 +1,10 @@
+package providers
+
+import "github.com/AndreyArthur/oganessone/src/core/shared"
+
+// Unlike the encrypter, a cipher is reversible, it is meant for secrets the
+// server has to read back, such as the seeds of one-time passwords.
+type CipherProvider interface {
+	Encrypt(text string) (string, *shared.Error)
+	Decrypt(ciphertext string) (string, *shared.Error)
+}
@@ -0,0 +1,8 @@
+package providers
+
+// For secrets that are random and long enough not to need a slow hash, like
+// api keys and recovery codes, so checking them stays cheap.
+type DigestProvider interface {
+	Digest(text string) string
+	Matches(text string, digest string) bool
+}
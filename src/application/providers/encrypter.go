@@ -1,8 +1,15 @@
 package providers
 
-import "github.com/AndreyArthur/oganessone/src/core/shared"
+import (
+	"context"
 
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// NeedsRehash reports whether a hash was made with another algorithm or with
+// parameters other than the ones Hash currently uses.
 type EncrypterProvider interface {
-	Hash(text string) (string, *shared.Error)
-	Compare(text string, hash string) (bool, *shared.Error)
+	Hash(ctx context.Context, text string) (string, *shared.Error)
+	Compare(ctx context.Context, text string, hash string) (bool, *shared.Error)
+	NeedsRehash(hash string) (bool, *shared.Error)
 }
@@ -0,0 +1,13 @@
+package providers
+
+import "context"
+
+// Values that are errors are logged through their Error method.
+type LogFields map[string]interface{}
+
+type LoggerProvider interface {
+	Debug(ctx context.Context, message string, fields LogFields)
+	Info(ctx context.Context, message string, fields LogFields)
+	Warn(ctx context.Context, message string, fields LogFields)
+	Error(ctx context.Context, message string, fields LogFields)
+}
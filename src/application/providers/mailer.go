@@ -0,0 +1,7 @@
+package providers
+
+import "github.com/AndreyArthur/oganessone/src/core/shared"
+
+type MailerProvider interface {
+	Send(to string, subject string, body string) *shared.Error
+}
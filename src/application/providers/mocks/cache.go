@@ -5,7 +5,9 @@
 package mock_providers
 
 import (
+        context "context"
         reflect "reflect"
+        time "time"
 
         shared "github.com/AndreyArthur/oganessone/src/core/shared"
         gomock "github.com/golang/mock/gomock"
@@ -35,44 +37,44 @@ func (m *MockCacheProvider) EXPECT() *MockCacheProviderMockRecorder {
 }
 
 // Delete mocks base method.
-func (m *MockCacheProvider) Delete(key string) *shared.Error {
+func (m *MockCacheProvider) Delete(ctx context.Context, key string) *shared.Error {
         m.ctrl.T.Helper()
-        ret := m.ctrl.Call(m, "Delete", key)
+        ret := m.ctrl.Call(m, "Delete", ctx, key)
         ret0, _ := ret[0].(*shared.Error)
         return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockCacheProviderMockRecorder) Delete(key interface{}) *gomock.Call {
+func (mr *MockCacheProviderMockRecorder) Delete(ctx, key interface{}) *gomock.Call {
         mr.mock.ctrl.T.Helper()
-        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCacheProvider)(nil).Delete), key)
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCacheProvider)(nil).Delete), ctx, key)
 }
 
 // Get mocks base method.
-func (m *MockCacheProvider) Get(key string) (string, *shared.Error) {
+func (m *MockCacheProvider) Get(ctx context.Context, key string) (string, *shared.Error) {
         m.ctrl.T.Helper()
-        ret := m.ctrl.Call(m, "Get", key)
+        ret := m.ctrl.Call(m, "Get", ctx, key)
         ret0, _ := ret[0].(string)
         ret1, _ := ret[1].(*shared.Error)
         return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockCacheProviderMockRecorder) Get(key interface{}) *gomock.Call {
+func (mr *MockCacheProviderMockRecorder) Get(ctx, key interface{}) *gomock.Call {
         mr.mock.ctrl.T.Helper()
-        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCacheProvider)(nil).Get), key)
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCacheProvider)(nil).Get), ctx, key)
 }
 
 // Set mocks base method.
-func (m *MockCacheProvider) Set(key, value string) *shared.Error {
+func (m *MockCacheProvider) Set(ctx context.Context, key, value string, expiresAt time.Time) *shared.Error {
         m.ctrl.T.Helper()
-        ret := m.ctrl.Call(m, "Set", key, value)
+        ret := m.ctrl.Call(m, "Set", ctx, key, value, expiresAt)
         ret0, _ := ret[0].(*shared.Error)
         return ret0
 }
 
 // Set indicates an expected call of Set.
-func (mr *MockCacheProviderMockRecorder) Set(key, value interface{}) *gomock.Call {
+func (mr *MockCacheProviderMockRecorder) Set(ctx, key, value, expiresAt interface{}) *gomock.Call {
         mr.mock.ctrl.T.Helper()
-        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockCacheProvider)(nil).Set), key, value)
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockCacheProvider)(nil).Set), ctx, key, value, expiresAt)
 }
\ No newline at end of file
@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/providers/cipher.go
+
+// Package mock_providers is a generated GoMock package.
+package mock_providers
+
+import (
+        reflect "reflect"
+
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockCipherProvider is a mock of CipherProvider interface.
+type MockCipherProvider struct {
+        ctrl     *gomock.Controller
+        recorder *MockCipherProviderMockRecorder
+}
+
+// MockCipherProviderMockRecorder is the mock recorder for MockCipherProvider.
+type MockCipherProviderMockRecorder struct {
+        mock *MockCipherProvider
+}
+
+// NewMockCipherProvider creates a new mock instance.
+func NewMockCipherProvider(ctrl *gomock.Controller) *MockCipherProvider {
+        mock := &MockCipherProvider{ctrl: ctrl}
+        mock.recorder = &MockCipherProviderMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCipherProvider) EXPECT() *MockCipherProviderMockRecorder {
+        return m.recorder
+}
+
+// Decrypt mocks base method.
+func (m *MockCipherProvider) Decrypt(ciphertext string) (string, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Decrypt", ciphertext)
+        ret0, _ := ret[0].(string)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt.
+func (mr *MockCipherProviderMockRecorder) Decrypt(ciphertext interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockCipherProvider)(nil).Decrypt), ciphertext)
+}
+
+// Encrypt mocks base method.
+func (m *MockCipherProvider) Encrypt(text string) (string, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Encrypt", text)
+        ret0, _ := ret[0].(string)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Encrypt indicates an expected call of Encrypt.
+func (mr *MockCipherProviderMockRecorder) Encrypt(text interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockCipherProvider)(nil).Encrypt), text)
+}
\ No newline at end of file
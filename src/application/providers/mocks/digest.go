@@ -0,0 +1,62 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/providers/digest.go
+
+// Package mock_providers is a generated GoMock package.
+package mock_providers
+
+import (
+        reflect "reflect"
+
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockDigestProvider is a mock of DigestProvider interface.
+type MockDigestProvider struct {
+        ctrl     *gomock.Controller
+        recorder *MockDigestProviderMockRecorder
+}
+
+// MockDigestProviderMockRecorder is the mock recorder for MockDigestProvider.
+type MockDigestProviderMockRecorder struct {
+        mock *MockDigestProvider
+}
+
+// NewMockDigestProvider creates a new mock instance.
+func NewMockDigestProvider(ctrl *gomock.Controller) *MockDigestProvider {
+        mock := &MockDigestProvider{ctrl: ctrl}
+        mock.recorder = &MockDigestProviderMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDigestProvider) EXPECT() *MockDigestProviderMockRecorder {
+        return m.recorder
+}
+
+// Digest mocks base method.
+func (m *MockDigestProvider) Digest(text string) string {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Digest", text)
+        ret0, _ := ret[0].(string)
+        return ret0
+}
+
+// Digest indicates an expected call of Digest.
+func (mr *MockDigestProviderMockRecorder) Digest(text interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Digest", reflect.TypeOf((*MockDigestProvider)(nil).Digest), text)
+}
+
+// Matches mocks base method.
+func (m *MockDigestProvider) Matches(text, digest string) bool {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Matches", text, digest)
+        ret0, _ := ret[0].(bool)
+        return ret0
+}
+
+// Matches indicates an expected call of Matches.
+func (mr *MockDigestProviderMockRecorder) Matches(text, digest interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Matches", reflect.TypeOf((*MockDigestProvider)(nil).Matches), text, digest)
+}
\ No newline at end of file
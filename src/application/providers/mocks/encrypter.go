@@ -5,6 +5,7 @@
 package mock_providers
 
 import (
+        context "context"
         reflect "reflect"
 
         shared "github.com/AndreyArthur/oganessone/src/core/shared"
@@ -35,31 +36,46 @@ func (m *MockEncrypterProvider) EXPECT() *MockEncrypterProviderMockRecorder {
 }
 
 // Compare mocks base method.
-func (m *MockEncrypterProvider) Compare(text, hash string) (bool, *shared.Error) {
+func (m *MockEncrypterProvider) Compare(ctx context.Context, text, hash string) (bool, *shared.Error) {
         m.ctrl.T.Helper()
-        ret := m.ctrl.Call(m, "Compare", text, hash)
+        ret := m.ctrl.Call(m, "Compare", ctx, text, hash)
         ret0, _ := ret[0].(bool)
         ret1, _ := ret[1].(*shared.Error)
         return ret0, ret1
 }
 
 // Compare indicates an expected call of Compare.
-func (mr *MockEncrypterProviderMockRecorder) Compare(text, hash interface{}) *gomock.Call {
+func (mr *MockEncrypterProviderMockRecorder) Compare(ctx, text, hash interface{}) *gomock.Call {
         mr.mock.ctrl.T.Helper()
-        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compare", reflect.TypeOf((*MockEncrypterProvider)(nil).Compare), text, hash)
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compare", reflect.TypeOf((*MockEncrypterProvider)(nil).Compare), ctx, text, hash)
 }
 
 // Hash mocks base method.
-func (m *MockEncrypterProvider) Hash(text string) (string, *shared.Error) {
+func (m *MockEncrypterProvider) Hash(ctx context.Context, text string) (string, *shared.Error) {
         m.ctrl.T.Helper()
-        ret := m.ctrl.Call(m, "Hash", text)
+        ret := m.ctrl.Call(m, "Hash", ctx, text)
         ret0, _ := ret[0].(string)
         ret1, _ := ret[1].(*shared.Error)
         return ret0, ret1
 }
 
 // Hash indicates an expected call of Hash.
-func (mr *MockEncrypterProviderMockRecorder) Hash(text interface{}) *gomock.Call {
+func (mr *MockEncrypterProviderMockRecorder) Hash(ctx, text interface{}) *gomock.Call {
         mr.mock.ctrl.T.Helper()
-        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hash", reflect.TypeOf((*MockEncrypterProvider)(nil).Hash), text)
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Hash", reflect.TypeOf((*MockEncrypterProvider)(nil).Hash), ctx, text)
+}
+
+// NeedsRehash mocks base method.
+func (m *MockEncrypterProvider) NeedsRehash(hash string) (bool, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "NeedsRehash", hash)
+        ret0, _ := ret[0].(bool)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// NeedsRehash indicates an expected call of NeedsRehash.
+func (mr *MockEncrypterProviderMockRecorder) NeedsRehash(hash interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NeedsRehash", reflect.TypeOf((*MockEncrypterProvider)(nil).NeedsRehash), hash)
 }
\ No newline at end of file
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/providers/logger.go
+
+// Package mock_providers is a generated GoMock package.
+package mock_providers
+
+import (
+        context "context"
+        reflect "reflect"
+
+        providers "github.com/AndreyArthur/oganessone/src/application/providers"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockLoggerProvider is a mock of LoggerProvider interface.
+type MockLoggerProvider struct {
+        ctrl     *gomock.Controller
+        recorder *MockLoggerProviderMockRecorder
+}
+
+// MockLoggerProviderMockRecorder is the mock recorder for MockLoggerProvider.
+type MockLoggerProviderMockRecorder struct {
+        mock *MockLoggerProvider
+}
+
+// NewMockLoggerProvider creates a new mock instance.
+func NewMockLoggerProvider(ctrl *gomock.Controller) *MockLoggerProvider {
+        mock := &MockLoggerProvider{ctrl: ctrl}
+        mock.recorder = &MockLoggerProviderMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoggerProvider) EXPECT() *MockLoggerProviderMockRecorder {
+        return m.recorder
+}
+
+// Debug mocks base method.
+func (m *MockLoggerProvider) Debug(ctx context.Context, message string, fields providers.LogFields) {
+        m.ctrl.T.Helper()
+        m.ctrl.Call(m, "Debug", ctx, message, fields)
+}
+
+// Debug indicates an expected call of Debug.
+func (mr *MockLoggerProviderMockRecorder) Debug(ctx, message, fields interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Debug", reflect.TypeOf((*MockLoggerProvider)(nil).Debug), ctx, message, fields)
+}
+
+// Error mocks base method.
+func (m *MockLoggerProvider) Error(ctx context.Context, message string, fields providers.LogFields) {
+        m.ctrl.T.Helper()
+        m.ctrl.Call(m, "Error", ctx, message, fields)
+}
+
+// Error indicates an expected call of Error.
+func (mr *MockLoggerProviderMockRecorder) Error(ctx, message, fields interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Error", reflect.TypeOf((*MockLoggerProvider)(nil).Error), ctx, message, fields)
+}
+
+// Info mocks base method.
+func (m *MockLoggerProvider) Info(ctx context.Context, message string, fields providers.LogFields) {
+        m.ctrl.T.Helper()
+        m.ctrl.Call(m, "Info", ctx, message, fields)
+}
+
+// Info indicates an expected call of Info.
+func (mr *MockLoggerProviderMockRecorder) Info(ctx, message, fields interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Info", reflect.TypeOf((*MockLoggerProvider)(nil).Info), ctx, message, fields)
+}
+
+// Warn mocks base method.
+func (m *MockLoggerProvider) Warn(ctx context.Context, message string, fields providers.LogFields) {
+        m.ctrl.T.Helper()
+        m.ctrl.Call(m, "Warn", ctx, message, fields)
+}
+
+// Warn indicates an expected call of Warn.
+func (mr *MockLoggerProviderMockRecorder) Warn(ctx, message, fields interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Warn", reflect.TypeOf((*MockLoggerProvider)(nil).Warn), ctx, message, fields)
+}
\ No newline at end of file
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/providers/mailer.go
+
+// Package mock_providers is a generated GoMock package.
+package mock_providers
+
+import (
+        reflect "reflect"
+
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockMailerProvider is a mock of MailerProvider interface.
+type MockMailerProvider struct {
+        ctrl     *gomock.Controller
+        recorder *MockMailerProviderMockRecorder
+}
+
+// MockMailerProviderMockRecorder is the mock recorder for MockMailerProvider.
+type MockMailerProviderMockRecorder struct {
+        mock *MockMailerProvider
+}
+
+// NewMockMailerProvider creates a new mock instance.
+func NewMockMailerProvider(ctrl *gomock.Controller) *MockMailerProvider {
+        mock := &MockMailerProvider{ctrl: ctrl}
+        mock.recorder = &MockMailerProviderMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMailerProvider) EXPECT() *MockMailerProviderMockRecorder {
+        return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockMailerProvider) Send(to, subject, body string) *shared.Error {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Send", to, subject, body)
+        ret0, _ := ret[0].(*shared.Error)
+        return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockMailerProviderMockRecorder) Send(to, subject, body interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockMailerProvider)(nil).Send), to, subject, body)
+}
\ No newline at end of file
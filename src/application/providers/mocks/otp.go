@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/providers/otp.go
+
+// Package mock_providers is a generated GoMock package.
+package mock_providers
+
+import (
+        reflect "reflect"
+
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockOtpProvider is a mock of OtpProvider interface.
+type MockOtpProvider struct {
+        ctrl     *gomock.Controller
+        recorder *MockOtpProviderMockRecorder
+}
+
+// MockOtpProviderMockRecorder is the mock recorder for MockOtpProvider.
+type MockOtpProviderMockRecorder struct {
+        mock *MockOtpProvider
+}
+
+// NewMockOtpProvider creates a new mock instance.
+func NewMockOtpProvider(ctrl *gomock.Controller) *MockOtpProvider {
+        mock := &MockOtpProvider{ctrl: ctrl}
+        mock.recorder = &MockOtpProviderMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOtpProvider) EXPECT() *MockOtpProviderMockRecorder {
+        return m.recorder
+}
+
+// Code mocks base method.
+func (m *MockOtpProvider) Code(secret string, step int64) (string, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Code", secret, step)
+        ret0, _ := ret[0].(string)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Code indicates an expected call of Code.
+func (mr *MockOtpProviderMockRecorder) Code(secret, step interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Code", reflect.TypeOf((*MockOtpProvider)(nil).Code), secret, step)
+}
+
+// Secret mocks base method.
+func (m *MockOtpProvider) Secret() (string, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Secret")
+        ret0, _ := ret[0].(string)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Secret indicates an expected call of Secret.
+func (mr *MockOtpProviderMockRecorder) Secret() *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Secret", reflect.TypeOf((*MockOtpProvider)(nil).Secret))
+}
\ No newline at end of file
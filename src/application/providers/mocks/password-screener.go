@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/providers/password-screener.go
+
+// Package mock_providers is a generated GoMock package.
+package mock_providers
+
+import (
+        context "context"
+        reflect "reflect"
+
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockPasswordScreenerProvider is a mock of PasswordScreenerProvider interface.
+type MockPasswordScreenerProvider struct {
+        ctrl     *gomock.Controller
+        recorder *MockPasswordScreenerProviderMockRecorder
+}
+
+// MockPasswordScreenerProviderMockRecorder is the mock recorder for MockPasswordScreenerProvider.
+type MockPasswordScreenerProviderMockRecorder struct {
+        mock *MockPasswordScreenerProvider
+}
+
+// NewMockPasswordScreenerProvider creates a new mock instance.
+func NewMockPasswordScreenerProvider(ctrl *gomock.Controller) *MockPasswordScreenerProvider {
+        mock := &MockPasswordScreenerProvider{ctrl: ctrl}
+        mock.recorder = &MockPasswordScreenerProviderMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordScreenerProvider) EXPECT() *MockPasswordScreenerProviderMockRecorder {
+        return m.recorder
+}
+
+// IsBreached mocks base method.
+func (m *MockPasswordScreenerProvider) IsBreached(ctx context.Context, password string) (bool, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "IsBreached", ctx, password)
+        ret0, _ := ret[0].(bool)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// IsBreached indicates an expected call of IsBreached.
+func (mr *MockPasswordScreenerProviderMockRecorder) IsBreached(ctx, password interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBreached", reflect.TypeOf((*MockPasswordScreenerProvider)(nil).IsBreached), ctx, password)
+}
\ No newline at end of file
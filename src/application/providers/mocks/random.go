@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/providers/random.go
+
+// Package mock_providers is a generated GoMock package.
+package mock_providers
+
+import (
+        reflect "reflect"
+
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockRandomProvider is a mock of RandomProvider interface.
+type MockRandomProvider struct {
+        ctrl     *gomock.Controller
+        recorder *MockRandomProviderMockRecorder
+}
+
+// MockRandomProviderMockRecorder is the mock recorder for MockRandomProvider.
+type MockRandomProviderMockRecorder struct {
+        mock *MockRandomProvider
+}
+
+// NewMockRandomProvider creates a new mock instance.
+func NewMockRandomProvider(ctrl *gomock.Controller) *MockRandomProvider {
+        mock := &MockRandomProvider{ctrl: ctrl}
+        mock.recorder = &MockRandomProviderMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRandomProvider) EXPECT() *MockRandomProviderMockRecorder {
+        return m.recorder
+}
+
+// String mocks base method.
+func (m *MockRandomProvider) String(length int) (string, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "String", length)
+        ret0, _ := ret[0].(string)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// String indicates an expected call of String.
+func (mr *MockRandomProviderMockRecorder) String(length interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "String", reflect.TypeOf((*MockRandomProvider)(nil).String), length)
+}
\ No newline at end of file
@@ -36,16 +36,16 @@ func (m *MockSessionProvider) EXPECT() *MockSessionProviderMockRecorder {
 }
 
 // Generate mocks base method.
-func (m *MockSessionProvider) Generate(userId string) (*providers.SessionData, *shared.Error) {
+func (m *MockSessionProvider) Generate(userId, organizationId string) (*providers.SessionData, *shared.Error) {
         m.ctrl.T.Helper()
-        ret := m.ctrl.Call(m, "Generate", userId)
+        ret := m.ctrl.Call(m, "Generate", userId, organizationId)
         ret0, _ := ret[0].(*providers.SessionData)
         ret1, _ := ret[1].(*shared.Error)
         return ret0, ret1
 }
 
 // Generate indicates an expected call of Generate.
-func (mr *MockSessionProviderMockRecorder) Generate(userId interface{}) *gomock.Call {
+func (mr *MockSessionProviderMockRecorder) Generate(userId, organizationId interface{}) *gomock.Call {
         mr.mock.ctrl.T.Helper()
-        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockSessionProvider)(nil).Generate), userId)
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Generate", reflect.TypeOf((*MockSessionProvider)(nil).Generate), userId, organizationId)
 }
\ No newline at end of file
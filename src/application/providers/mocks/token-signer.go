@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/providers/token-signer.go
+
+// Package mock_providers is a generated GoMock package.
+package mock_providers
+
+import (
+        reflect "reflect"
+
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockTokenSignerProvider is a mock of TokenSignerProvider interface.
+type MockTokenSignerProvider struct {
+        ctrl     *gomock.Controller
+        recorder *MockTokenSignerProviderMockRecorder
+}
+
+// MockTokenSignerProviderMockRecorder is the mock recorder for MockTokenSignerProvider.
+type MockTokenSignerProviderMockRecorder struct {
+        mock *MockTokenSignerProvider
+}
+
+// NewMockTokenSignerProvider creates a new mock instance.
+func NewMockTokenSignerProvider(ctrl *gomock.Controller) *MockTokenSignerProvider {
+        mock := &MockTokenSignerProvider{ctrl: ctrl}
+        mock.recorder = &MockTokenSignerProviderMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenSignerProvider) EXPECT() *MockTokenSignerProviderMockRecorder {
+        return m.recorder
+}
+
+// Sign mocks base method.
+func (m *MockTokenSignerProvider) Sign(claims map[string]interface{}) (string, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Sign", claims)
+        ret0, _ := ret[0].(string)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Sign indicates an expected call of Sign.
+func (mr *MockTokenSignerProviderMockRecorder) Sign(claims interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockTokenSignerProvider)(nil).Sign), claims)
+}
\ No newline at end of file
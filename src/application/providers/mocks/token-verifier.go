@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/providers/token-verifier.go
+
+// Package mock_providers is a generated GoMock package.
+package mock_providers
+
+import (
+        reflect "reflect"
+
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockTokenVerifierProvider is a mock of TokenVerifierProvider interface.
+type MockTokenVerifierProvider struct {
+        ctrl     *gomock.Controller
+        recorder *MockTokenVerifierProviderMockRecorder
+}
+
+// MockTokenVerifierProviderMockRecorder is the mock recorder for MockTokenVerifierProvider.
+type MockTokenVerifierProviderMockRecorder struct {
+        mock *MockTokenVerifierProvider
+}
+
+// NewMockTokenVerifierProvider creates a new mock instance.
+func NewMockTokenVerifierProvider(ctrl *gomock.Controller) *MockTokenVerifierProvider {
+        mock := &MockTokenVerifierProvider{ctrl: ctrl}
+        mock.recorder = &MockTokenVerifierProviderMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenVerifierProvider) EXPECT() *MockTokenVerifierProviderMockRecorder {
+        return m.recorder
+}
+
+// Verify mocks base method.
+func (m *MockTokenVerifierProvider) Verify(token, publicKey string) (map[string]interface{}, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Verify", token, publicKey)
+        ret0, _ := ret[0].(map[string]interface{})
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Verify indicates an expected call of Verify.
+func (mr *MockTokenVerifierProviderMockRecorder) Verify(token, publicKey interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Verify", reflect.TypeOf((*MockTokenVerifierProvider)(nil).Verify), token, publicKey)
+}
\ No newline at end of file
@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/providers/tracer.go
+
+// Package mock_providers is a generated GoMock package.
+package mock_providers
+
+import (
+        context "context"
+        reflect "reflect"
+
+        providers "github.com/AndreyArthur/oganessone/src/application/providers"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockSpan is a mock of Span interface.
+type MockSpan struct {
+        ctrl     *gomock.Controller
+        recorder *MockSpanMockRecorder
+}
+
+// MockSpanMockRecorder is the mock recorder for MockSpan.
+type MockSpanMockRecorder struct {
+        mock *MockSpan
+}
+
+// NewMockSpan creates a new mock instance.
+func NewMockSpan(ctrl *gomock.Controller) *MockSpan {
+        mock := &MockSpan{ctrl: ctrl}
+        mock.recorder = &MockSpanMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSpan) EXPECT() *MockSpanMockRecorder {
+        return m.recorder
+}
+
+// End mocks base method.
+func (m *MockSpan) End() {
+        m.ctrl.T.Helper()
+        m.ctrl.Call(m, "End")
+}
+
+// End indicates an expected call of End.
+func (mr *MockSpanMockRecorder) End() *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "End", reflect.TypeOf((*MockSpan)(nil).End))
+}
+
+// RecordError mocks base method.
+func (m *MockSpan) RecordError(err *shared.Error) {
+        m.ctrl.T.Helper()
+        m.ctrl.Call(m, "RecordError", err)
+}
+
+// RecordError indicates an expected call of RecordError.
+func (mr *MockSpanMockRecorder) RecordError(err interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordError", reflect.TypeOf((*MockSpan)(nil).RecordError), err)
+}
+
+// SetAttribute mocks base method.
+func (m *MockSpan) SetAttribute(key string, value interface{}) {
+        m.ctrl.T.Helper()
+        m.ctrl.Call(m, "SetAttribute", key, value)
+}
+
+// SetAttribute indicates an expected call of SetAttribute.
+func (mr *MockSpanMockRecorder) SetAttribute(key, value interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAttribute", reflect.TypeOf((*MockSpan)(nil).SetAttribute), key, value)
+}
+
+// MockTracerProvider is a mock of TracerProvider interface.
+type MockTracerProvider struct {
+        ctrl     *gomock.Controller
+        recorder *MockTracerProviderMockRecorder
+}
+
+// MockTracerProviderMockRecorder is the mock recorder for MockTracerProvider.
+type MockTracerProviderMockRecorder struct {
+        mock *MockTracerProvider
+}
+
+// NewMockTracerProvider creates a new mock instance.
+func NewMockTracerProvider(ctrl *gomock.Controller) *MockTracerProvider {
+        mock := &MockTracerProvider{ctrl: ctrl}
+        mock.recorder = &MockTracerProviderMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTracerProvider) EXPECT() *MockTracerProviderMockRecorder {
+        return m.recorder
+}
+
+// Start mocks base method.
+func (m *MockTracerProvider) Start(ctx context.Context, name string) (context.Context, providers.Span) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Start", ctx, name)
+        ret0, _ := ret[0].(context.Context)
+        ret1, _ := ret[1].(providers.Span)
+        return ret0, ret1
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockTracerProviderMockRecorder) Start(ctx, name interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockTracerProvider)(nil).Start), ctx, name)
+}
\ No newline at end of file
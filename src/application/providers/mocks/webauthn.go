@@ -0,0 +1,81 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/providers/webauthn.go
+
+// Package mock_providers is a generated GoMock package.
+package mock_providers
+
+import (
+        reflect "reflect"
+
+        providers "github.com/AndreyArthur/oganessone/src/application/providers"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockWebAuthnProvider is a mock of WebAuthnProvider interface.
+type MockWebAuthnProvider struct {
+        ctrl     *gomock.Controller
+        recorder *MockWebAuthnProviderMockRecorder
+}
+
+// MockWebAuthnProviderMockRecorder is the mock recorder for MockWebAuthnProvider.
+type MockWebAuthnProviderMockRecorder struct {
+        mock *MockWebAuthnProvider
+}
+
+// NewMockWebAuthnProvider creates a new mock instance.
+func NewMockWebAuthnProvider(ctrl *gomock.Controller) *MockWebAuthnProvider {
+        mock := &MockWebAuthnProvider{ctrl: ctrl}
+        mock.recorder = &MockWebAuthnProviderMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebAuthnProvider) EXPECT() *MockWebAuthnProviderMockRecorder {
+        return m.recorder
+}
+
+// ParseAuthenticatorData mocks base method.
+func (m *MockWebAuthnProvider) ParseAuthenticatorData(authenticatorData []byte) (*providers.WebAuthnAuthenticatorData, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "ParseAuthenticatorData", authenticatorData)
+        ret0, _ := ret[0].(*providers.WebAuthnAuthenticatorData)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// ParseAuthenticatorData indicates an expected call of ParseAuthenticatorData.
+func (mr *MockWebAuthnProviderMockRecorder) ParseAuthenticatorData(authenticatorData interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ParseAuthenticatorData", reflect.TypeOf((*MockWebAuthnProvider)(nil).ParseAuthenticatorData), authenticatorData)
+}
+
+// VerifyAttestation mocks base method.
+func (m *MockWebAuthnProvider) VerifyAttestation(attestationObject, clientDataHash []byte) (*providers.WebAuthnAuthenticatorData, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "VerifyAttestation", attestationObject, clientDataHash)
+        ret0, _ := ret[0].(*providers.WebAuthnAuthenticatorData)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// VerifyAttestation indicates an expected call of VerifyAttestation.
+func (mr *MockWebAuthnProviderMockRecorder) VerifyAttestation(attestationObject, clientDataHash interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyAttestation", reflect.TypeOf((*MockWebAuthnProvider)(nil).VerifyAttestation), attestationObject, clientDataHash)
+}
+
+// VerifySignature mocks base method.
+func (m *MockWebAuthnProvider) VerifySignature(publicKey, data, signature []byte) (bool, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "VerifySignature", publicKey, data, signature)
+        ret0, _ := ret[0].(bool)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// VerifySignature indicates an expected call of VerifySignature.
+func (mr *MockWebAuthnProviderMockRecorder) VerifySignature(publicKey, data, signature interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifySignature", reflect.TypeOf((*MockWebAuthnProvider)(nil).VerifySignature), publicKey, data, signature)
+}
\ No newline at end of file
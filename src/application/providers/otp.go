@@ -0,0 +1,10 @@
+package providers
+
+import "github.com/AndreyArthur/oganessone/src/core/shared"
+
+// Secret returns a base32 encoded seed and Code the RFC 6238 code of that
+// seed for the given time step.
+type OtpProvider interface {
+	Secret() (string, *shared.Error)
+	Code(secret string, step int64) (string, *shared.Error)
+}
@@ -0,0 +1,11 @@
+package providers
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type PasswordScreenerProvider interface {
+	IsBreached(ctx context.Context, password string) (bool, *shared.Error)
+}
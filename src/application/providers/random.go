@@ -0,0 +1,7 @@
+package providers
+
+import "github.com/AndreyArthur/oganessone/src/core/shared"
+
+type RandomProvider interface {
+	String(length int) (string, *shared.Error)
+}
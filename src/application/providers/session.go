@@ -5,9 +5,10 @@ import "github.com/AndreyArthur/oganessone/src/core/shared"
 type SessionData struct {
 	Key            string
 	UserId         string
+	OrganizationId string
 	ExpirationDate string
 }
 
 type SessionProvider interface {
-	Generate(userId string) (*SessionData, *shared.Error)
+	Generate(userId string, organizationId string) (*SessionData, *shared.Error)
 }
@@ -0,0 +1,7 @@
+package providers
+
+import "github.com/AndreyArthur/oganessone/src/core/shared"
+
+type TokenSignerProvider interface {
+	Sign(claims map[string]interface{}) (string, *shared.Error)
+}
@@ -0,0 +1,9 @@
+package providers
+
+import "github.com/AndreyArthur/oganessone/src/core/shared"
+
+// Verify returns nil claims when the token is malformed or its signature
+// doesn't match the given PEM encoded public key.
+type TokenVerifierProvider interface {
+	Verify(token string, publicKey string) (map[string]interface{}, *shared.Error)
+}
@@ -0,0 +1,19 @@
+package providers
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type Span interface {
+	SetAttribute(key string, value interface{})
+	RecordError(err *shared.Error)
+	End()
+}
+
+// The returned ctx carries the new span, spans started from it become its
+// children.
+type TracerProvider interface {
+	Start(ctx context.Context, name string) (context.Context, Span)
+}
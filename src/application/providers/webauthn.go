@@ -0,0 +1,23 @@
+package providers
+
+import "github.com/AndreyArthur/oganessone/src/core/shared"
+
+// Authenticator data as laid out by WebAuthn section 6.1, CredentialId and
+// the COSE encoded PublicKey are only set when attested credential data is
+// present, that is during registration.
+type WebAuthnAuthenticatorData struct {
+	RpIdHash     []byte
+	UserPresent  bool
+	UserVerified bool
+	SignCount    uint32
+	CredentialId []byte
+	PublicKey    []byte
+}
+
+// VerifyAttestation checks the attestation statement against the client data
+// hash, VerifySignature reports false for a well formed but wrong signature.
+type WebAuthnProvider interface {
+	VerifyAttestation(attestationObject []byte, clientDataHash []byte) (*WebAuthnAuthenticatorData, *shared.Error)
+	ParseAuthenticatorData(authenticatorData []byte) (*WebAuthnAuthenticatorData, *shared.Error)
+	VerifySignature(publicKey []byte, data []byte, signature []byte) (bool, *shared.Error)
+}
@@ -0,0 +1,18 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type ApiKeysRepository interface {
+	FindById(ctx context.Context, id string) (*entities.ApiKeyEntity, *shared.Error)
+	FindByPrefix(ctx context.Context, prefix string) (*entities.ApiKeyEntity, *shared.Error)
+	FindByUserId(ctx context.Context, userId string) ([]*entities.ApiKeyEntity, *shared.Error)
+	FindServiceAccountKeys(ctx context.Context, organizationId string) ([]*entities.ApiKeyEntity, *shared.Error)
+	Create(data *dtos.ApiKeyDTO) (*entities.ApiKeyEntity, *shared.Error)
+	Save(ctx context.Context, apiKey *entities.ApiKeyEntity) *shared.Error
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// Events are only ever added, there is no way to change or remove one.
+type AuditLogRepository interface {
+	Create(data *dtos.AuditEventDTO) (*entities.AuditEventEntity, *shared.Error)
+	Save(ctx context.Context, auditEvent *entities.AuditEventEntity) *shared.Error
+	Query(ctx context.Context, filter *dtos.AuditLogFilterDTO) ([]*entities.AuditEventEntity, *shared.Error)
+}
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// Consume returns the code and removes it in the same step, so a code can
+// only ever be exchanged once.
+type AuthorizationCodesRepository interface {
+	Create(data *dtos.AuthorizationCodeDTO) (*entities.AuthorizationCodeEntity, *shared.Error)
+	Save(ctx context.Context, authorizationCode *entities.AuthorizationCodeEntity) *shared.Error
+	Consume(ctx context.Context, code string) (*entities.AuthorizationCodeEntity, *shared.Error)
+}
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type MembershipsRepository interface {
+	FindByOrganizationIdAndUserId(ctx context.Context, organizationId string, userId string) (*entities.MembershipEntity, *shared.Error)
+	FindByOrganizationId(ctx context.Context, organizationId string) ([]*entities.MembershipEntity, *shared.Error)
+	Create(data *dtos.MembershipDTO) (*entities.MembershipEntity, *shared.Error)
+	Save(ctx context.Context, membership *entities.MembershipEntity) *shared.Error
+	Delete(ctx context.Context, id string) *shared.Error
+}
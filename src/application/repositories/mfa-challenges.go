@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type MfaChallengesRepository interface {
+	Create(data *dtos.MfaChallengeDTO) (*entities.MfaChallengeEntity, *shared.Error)
+	Save(ctx context.Context, mfaChallenge *entities.MfaChallengeEntity) *shared.Error
+	FindByToken(ctx context.Context, token string) (*entities.MfaChallengeEntity, *shared.Error)
+	Delete(ctx context.Context, token string) *shared.Error
+}
@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/repositories/api-keys.go
+
+// Package mock_repositories is a generated GoMock package.
+package mock_repositories
+
+import (
+        context "context"
+        reflect "reflect"
+
+        dtos "github.com/AndreyArthur/oganessone/src/core/dtos"
+        entities "github.com/AndreyArthur/oganessone/src/core/entities"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockApiKeysRepository is a mock of ApiKeysRepository interface.
+type MockApiKeysRepository struct {
+        ctrl     *gomock.Controller
+        recorder *MockApiKeysRepositoryMockRecorder
+}
+
+// MockApiKeysRepositoryMockRecorder is the mock recorder for MockApiKeysRepository.
+type MockApiKeysRepositoryMockRecorder struct {
+        mock *MockApiKeysRepository
+}
+
+// NewMockApiKeysRepository creates a new mock instance.
+func NewMockApiKeysRepository(ctrl *gomock.Controller) *MockApiKeysRepository {
+        mock := &MockApiKeysRepository{ctrl: ctrl}
+        mock.recorder = &MockApiKeysRepositoryMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApiKeysRepository) EXPECT() *MockApiKeysRepositoryMockRecorder {
+        return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockApiKeysRepository) Create(data *dtos.ApiKeyDTO) (*entities.ApiKeyEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Create", data)
+        ret0, _ := ret[0].(*entities.ApiKeyEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockApiKeysRepositoryMockRecorder) Create(data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockApiKeysRepository)(nil).Create), data)
+}
+
+// FindById mocks base method.
+func (m *MockApiKeysRepository) FindById(ctx context.Context, id string) (*entities.ApiKeyEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "FindById", ctx, id)
+        ret0, _ := ret[0].(*entities.ApiKeyEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockApiKeysRepositoryMockRecorder) FindById(ctx, id interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockApiKeysRepository)(nil).FindById), ctx, id)
+}
+
+// FindByPrefix mocks base method.
+func (m *MockApiKeysRepository) FindByPrefix(ctx context.Context, prefix string) (*entities.ApiKeyEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "FindByPrefix", ctx, prefix)
+        ret0, _ := ret[0].(*entities.ApiKeyEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// FindByPrefix indicates an expected call of FindByPrefix.
+func (mr *MockApiKeysRepositoryMockRecorder) FindByPrefix(ctx, prefix interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPrefix", reflect.TypeOf((*MockApiKeysRepository)(nil).FindByPrefix), ctx, prefix)
+}
+
+// FindByUserId mocks base method.
+func (m *MockApiKeysRepository) FindByUserId(ctx context.Context, userId string) ([]*entities.ApiKeyEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "FindByUserId", ctx, userId)
+        ret0, _ := ret[0].([]*entities.ApiKeyEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// FindByUserId indicates an expected call of FindByUserId.
+func (mr *MockApiKeysRepositoryMockRecorder) FindByUserId(ctx, userId interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserId", reflect.TypeOf((*MockApiKeysRepository)(nil).FindByUserId), ctx, userId)
+}
+
+// FindServiceAccountKeys mocks base method.
+func (m *MockApiKeysRepository) FindServiceAccountKeys(ctx context.Context, organizationId string) ([]*entities.ApiKeyEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "FindServiceAccountKeys", ctx, organizationId)
+        ret0, _ := ret[0].([]*entities.ApiKeyEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// FindServiceAccountKeys indicates an expected call of FindServiceAccountKeys.
+func (mr *MockApiKeysRepositoryMockRecorder) FindServiceAccountKeys(ctx, organizationId interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindServiceAccountKeys", reflect.TypeOf((*MockApiKeysRepository)(nil).FindServiceAccountKeys), ctx, organizationId)
+}
+
+// Save mocks base method.
+func (m *MockApiKeysRepository) Save(ctx context.Context, apiKey *entities.ApiKeyEntity) *shared.Error {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Save", ctx, apiKey)
+        ret0, _ := ret[0].(*shared.Error)
+        return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockApiKeysRepositoryMockRecorder) Save(ctx, apiKey interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockApiKeysRepository)(nil).Save), ctx, apiKey)
+}
\ No newline at end of file
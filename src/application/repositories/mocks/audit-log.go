@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/repositories/audit-log.go
+
+// Package mock_repositories is a generated GoMock package.
+package mock_repositories
+
+import (
+        context "context"
+        reflect "reflect"
+
+        dtos "github.com/AndreyArthur/oganessone/src/core/dtos"
+        entities "github.com/AndreyArthur/oganessone/src/core/entities"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuditLogRepository is a mock of AuditLogRepository interface.
+type MockAuditLogRepository struct {
+        ctrl     *gomock.Controller
+        recorder *MockAuditLogRepositoryMockRecorder
+}
+
+// MockAuditLogRepositoryMockRecorder is the mock recorder for MockAuditLogRepository.
+type MockAuditLogRepositoryMockRecorder struct {
+        mock *MockAuditLogRepository
+}
+
+// NewMockAuditLogRepository creates a new mock instance.
+func NewMockAuditLogRepository(ctrl *gomock.Controller) *MockAuditLogRepository {
+        mock := &MockAuditLogRepository{ctrl: ctrl}
+        mock.recorder = &MockAuditLogRepositoryMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditLogRepository) EXPECT() *MockAuditLogRepositoryMockRecorder {
+        return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockAuditLogRepository) Create(data *dtos.AuditEventDTO) (*entities.AuditEventEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Create", data)
+        ret0, _ := ret[0].(*entities.AuditEventEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAuditLogRepositoryMockRecorder) Create(data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuditLogRepository)(nil).Create), data)
+}
+
+// Query mocks base method.
+func (m *MockAuditLogRepository) Query(ctx context.Context, filter *dtos.AuditLogFilterDTO) ([]*entities.AuditEventEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Query", ctx, filter)
+        ret0, _ := ret[0].([]*entities.AuditEventEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockAuditLogRepositoryMockRecorder) Query(ctx, filter interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockAuditLogRepository)(nil).Query), ctx, filter)
+}
+
+// Save mocks base method.
+func (m *MockAuditLogRepository) Save(ctx context.Context, auditEvent *entities.AuditEventEntity) *shared.Error {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Save", ctx, auditEvent)
+        ret0, _ := ret[0].(*shared.Error)
+        return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockAuditLogRepositoryMockRecorder) Save(ctx, auditEvent interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAuditLogRepository)(nil).Save), ctx, auditEvent)
+}
\ No newline at end of file
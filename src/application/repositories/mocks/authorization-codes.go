@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/repositories/authorization-codes.go
+
+// Package mock_repositories is a generated GoMock package.
+package mock_repositories
+
+import (
+        context "context"
+        reflect "reflect"
+
+        dtos "github.com/AndreyArthur/oganessone/src/core/dtos"
+        entities "github.com/AndreyArthur/oganessone/src/core/entities"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockAuthorizationCodesRepository is a mock of AuthorizationCodesRepository interface.
+type MockAuthorizationCodesRepository struct {
+        ctrl     *gomock.Controller
+        recorder *MockAuthorizationCodesRepositoryMockRecorder
+}
+
+// MockAuthorizationCodesRepositoryMockRecorder is the mock recorder for MockAuthorizationCodesRepository.
+type MockAuthorizationCodesRepositoryMockRecorder struct {
+        mock *MockAuthorizationCodesRepository
+}
+
+// NewMockAuthorizationCodesRepository creates a new mock instance.
+func NewMockAuthorizationCodesRepository(ctrl *gomock.Controller) *MockAuthorizationCodesRepository {
+        mock := &MockAuthorizationCodesRepository{ctrl: ctrl}
+        mock.recorder = &MockAuthorizationCodesRepositoryMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizationCodesRepository) EXPECT() *MockAuthorizationCodesRepositoryMockRecorder {
+        return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockAuthorizationCodesRepository) Consume(ctx context.Context, code string) (*entities.AuthorizationCodeEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Consume", ctx, code)
+        ret0, _ := ret[0].(*entities.AuthorizationCodeEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockAuthorizationCodesRepositoryMockRecorder) Consume(ctx, code interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockAuthorizationCodesRepository)(nil).Consume), ctx, code)
+}
+
+// Create mocks base method.
+func (m *MockAuthorizationCodesRepository) Create(data *dtos.AuthorizationCodeDTO) (*entities.AuthorizationCodeEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Create", data)
+        ret0, _ := ret[0].(*entities.AuthorizationCodeEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockAuthorizationCodesRepositoryMockRecorder) Create(data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAuthorizationCodesRepository)(nil).Create), data)
+}
+
+// Save mocks base method.
+func (m *MockAuthorizationCodesRepository) Save(ctx context.Context, authorizationCode *entities.AuthorizationCodeEntity) *shared.Error {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Save", ctx, authorizationCode)
+        ret0, _ := ret[0].(*shared.Error)
+        return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockAuthorizationCodesRepositoryMockRecorder) Save(ctx, authorizationCode interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockAuthorizationCodesRepository)(nil).Save), ctx, authorizationCode)
+}
\ No newline at end of file
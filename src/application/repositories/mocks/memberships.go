@@ -0,0 +1,111 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/repositories/memberships.go
+
+// Package mock_repositories is a generated GoMock package.
+package mock_repositories
+
+import (
+        context "context"
+        reflect "reflect"
+
+        dtos "github.com/AndreyArthur/oganessone/src/core/dtos"
+        entities "github.com/AndreyArthur/oganessone/src/core/entities"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockMembershipsRepository is a mock of MembershipsRepository interface.
+type MockMembershipsRepository struct {
+        ctrl     *gomock.Controller
+        recorder *MockMembershipsRepositoryMockRecorder
+}
+
+// MockMembershipsRepositoryMockRecorder is the mock recorder for MockMembershipsRepository.
+type MockMembershipsRepositoryMockRecorder struct {
+        mock *MockMembershipsRepository
+}
+
+// NewMockMembershipsRepository creates a new mock instance.
+func NewMockMembershipsRepository(ctrl *gomock.Controller) *MockMembershipsRepository {
+        mock := &MockMembershipsRepository{ctrl: ctrl}
+        mock.recorder = &MockMembershipsRepositoryMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMembershipsRepository) EXPECT() *MockMembershipsRepositoryMockRecorder {
+        return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockMembershipsRepository) Create(data *dtos.MembershipDTO) (*entities.MembershipEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Create", data)
+        ret0, _ := ret[0].(*entities.MembershipEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockMembershipsRepositoryMockRecorder) Create(data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMembershipsRepository)(nil).Create), data)
+}
+
+// Delete mocks base method.
+func (m *MockMembershipsRepository) Delete(ctx context.Context, id string) *shared.Error {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Delete", ctx, id)
+        ret0, _ := ret[0].(*shared.Error)
+        return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMembershipsRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMembershipsRepository)(nil).Delete), ctx, id)
+}
+
+// FindByOrganizationId mocks base method.
+func (m *MockMembershipsRepository) FindByOrganizationId(ctx context.Context, organizationId string) ([]*entities.MembershipEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "FindByOrganizationId", ctx, organizationId)
+        ret0, _ := ret[0].([]*entities.MembershipEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// FindByOrganizationId indicates an expected call of FindByOrganizationId.
+func (mr *MockMembershipsRepositoryMockRecorder) FindByOrganizationId(ctx, organizationId interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOrganizationId", reflect.TypeOf((*MockMembershipsRepository)(nil).FindByOrganizationId), ctx, organizationId)
+}
+
+// FindByOrganizationIdAndUserId mocks base method.
+func (m *MockMembershipsRepository) FindByOrganizationIdAndUserId(ctx context.Context, organizationId, userId string) (*entities.MembershipEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "FindByOrganizationIdAndUserId", ctx, organizationId, userId)
+        ret0, _ := ret[0].(*entities.MembershipEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// FindByOrganizationIdAndUserId indicates an expected call of FindByOrganizationIdAndUserId.
+func (mr *MockMembershipsRepositoryMockRecorder) FindByOrganizationIdAndUserId(ctx, organizationId, userId interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByOrganizationIdAndUserId", reflect.TypeOf((*MockMembershipsRepository)(nil).FindByOrganizationIdAndUserId), ctx, organizationId, userId)
+}
+
+// Save mocks base method.
+func (m *MockMembershipsRepository) Save(ctx context.Context, membership *entities.MembershipEntity) *shared.Error {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Save", ctx, membership)
+        ret0, _ := ret[0].(*shared.Error)
+        return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockMembershipsRepositoryMockRecorder) Save(ctx, membership interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockMembershipsRepository)(nil).Save), ctx, membership)
+}
\ No newline at end of file
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/repositories/mfa-challenges.go
+
+// Package mock_repositories is a generated GoMock package.
+package mock_repositories
+
+import (
+        context "context"
+        reflect "reflect"
+
+        dtos "github.com/AndreyArthur/oganessone/src/core/dtos"
+        entities "github.com/AndreyArthur/oganessone/src/core/entities"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockMfaChallengesRepository is a mock of MfaChallengesRepository interface.
+type MockMfaChallengesRepository struct {
+        ctrl     *gomock.Controller
+        recorder *MockMfaChallengesRepositoryMockRecorder
+}
+
+// MockMfaChallengesRepositoryMockRecorder is the mock recorder for MockMfaChallengesRepository.
+type MockMfaChallengesRepositoryMockRecorder struct {
+        mock *MockMfaChallengesRepository
+}
+
+// NewMockMfaChallengesRepository creates a new mock instance.
+func NewMockMfaChallengesRepository(ctrl *gomock.Controller) *MockMfaChallengesRepository {
+        mock := &MockMfaChallengesRepository{ctrl: ctrl}
+        mock.recorder = &MockMfaChallengesRepositoryMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMfaChallengesRepository) EXPECT() *MockMfaChallengesRepositoryMockRecorder {
+        return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockMfaChallengesRepository) Create(data *dtos.MfaChallengeDTO) (*entities.MfaChallengeEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Create", data)
+        ret0, _ := ret[0].(*entities.MfaChallengeEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockMfaChallengesRepositoryMockRecorder) Create(data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMfaChallengesRepository)(nil).Create), data)
+}
+
+// Delete mocks base method.
+func (m *MockMfaChallengesRepository) Delete(ctx context.Context, token string) *shared.Error {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Delete", ctx, token)
+        ret0, _ := ret[0].(*shared.Error)
+        return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockMfaChallengesRepositoryMockRecorder) Delete(ctx, token interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockMfaChallengesRepository)(nil).Delete), ctx, token)
+}
+
+// FindByToken mocks base method.
+func (m *MockMfaChallengesRepository) FindByToken(ctx context.Context, token string) (*entities.MfaChallengeEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "FindByToken", ctx, token)
+        ret0, _ := ret[0].(*entities.MfaChallengeEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// FindByToken indicates an expected call of FindByToken.
+func (mr *MockMfaChallengesRepositoryMockRecorder) FindByToken(ctx, token interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByToken", reflect.TypeOf((*MockMfaChallengesRepository)(nil).FindByToken), ctx, token)
+}
+
+// Save mocks base method.
+func (m *MockMfaChallengesRepository) Save(ctx context.Context, mfaChallenge *entities.MfaChallengeEntity) *shared.Error {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Save", ctx, mfaChallenge)
+        ret0, _ := ret[0].(*shared.Error)
+        return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockMfaChallengesRepositoryMockRecorder) Save(ctx, mfaChallenge interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockMfaChallengesRepository)(nil).Save), ctx, mfaChallenge)
+}
\ No newline at end of file
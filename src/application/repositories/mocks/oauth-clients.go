@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/repositories/oauth-clients.go
+
+// Package mock_repositories is a generated GoMock package.
+package mock_repositories
+
+import (
+        context "context"
+        reflect "reflect"
+
+        dtos "github.com/AndreyArthur/oganessone/src/core/dtos"
+        entities "github.com/AndreyArthur/oganessone/src/core/entities"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockOAuthClientsRepository is a mock of OAuthClientsRepository interface.
+type MockOAuthClientsRepository struct {
+        ctrl     *gomock.Controller
+        recorder *MockOAuthClientsRepositoryMockRecorder
+}
+
+// MockOAuthClientsRepositoryMockRecorder is the mock recorder for MockOAuthClientsRepository.
+type MockOAuthClientsRepositoryMockRecorder struct {
+        mock *MockOAuthClientsRepository
+}
+
+// NewMockOAuthClientsRepository creates a new mock instance.
+func NewMockOAuthClientsRepository(ctrl *gomock.Controller) *MockOAuthClientsRepository {
+        mock := &MockOAuthClientsRepository{ctrl: ctrl}
+        mock.recorder = &MockOAuthClientsRepositoryMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOAuthClientsRepository) EXPECT() *MockOAuthClientsRepositoryMockRecorder {
+        return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockOAuthClientsRepository) Create(data *dtos.OAuthClientDTO) (*entities.OAuthClientEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Create", data)
+        ret0, _ := ret[0].(*entities.OAuthClientEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOAuthClientsRepositoryMockRecorder) Create(data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOAuthClientsRepository)(nil).Create), data)
+}
+
+// FindById mocks base method.
+func (m *MockOAuthClientsRepository) FindById(ctx context.Context, id string) (*entities.OAuthClientEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "FindById", ctx, id)
+        ret0, _ := ret[0].(*entities.OAuthClientEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockOAuthClientsRepositoryMockRecorder) FindById(ctx, id interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockOAuthClientsRepository)(nil).FindById), ctx, id)
+}
+
+// Save mocks base method.
+func (m *MockOAuthClientsRepository) Save(ctx context.Context, client *entities.OAuthClientEntity) *shared.Error {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Save", ctx, client)
+        ret0, _ := ret[0].(*shared.Error)
+        return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockOAuthClientsRepositoryMockRecorder) Save(ctx, client interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockOAuthClientsRepository)(nil).Save), ctx, client)
+}
\ No newline at end of file
@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/repositories/organizations.go
+
+// Package mock_repositories is a generated GoMock package.
+package mock_repositories
+
+import (
+        context "context"
+        reflect "reflect"
+
+        dtos "github.com/AndreyArthur/oganessone/src/core/dtos"
+        entities "github.com/AndreyArthur/oganessone/src/core/entities"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockOrganizationsRepository is a mock of OrganizationsRepository interface.
+type MockOrganizationsRepository struct {
+        ctrl     *gomock.Controller
+        recorder *MockOrganizationsRepositoryMockRecorder
+}
+
+// MockOrganizationsRepositoryMockRecorder is the mock recorder for MockOrganizationsRepository.
+type MockOrganizationsRepositoryMockRecorder struct {
+        mock *MockOrganizationsRepository
+}
+
+// NewMockOrganizationsRepository creates a new mock instance.
+func NewMockOrganizationsRepository(ctrl *gomock.Controller) *MockOrganizationsRepository {
+        mock := &MockOrganizationsRepository{ctrl: ctrl}
+        mock.recorder = &MockOrganizationsRepositoryMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOrganizationsRepository) EXPECT() *MockOrganizationsRepositoryMockRecorder {
+        return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockOrganizationsRepository) Create(data *dtos.OrganizationDTO) (*entities.OrganizationEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Create", data)
+        ret0, _ := ret[0].(*entities.OrganizationEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOrganizationsRepositoryMockRecorder) Create(data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOrganizationsRepository)(nil).Create), data)
+}
+
+// Delete mocks base method.
+func (m *MockOrganizationsRepository) Delete(ctx context.Context, id string) *shared.Error {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Delete", ctx, id)
+        ret0, _ := ret[0].(*shared.Error)
+        return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockOrganizationsRepositoryMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrganizationsRepository)(nil).Delete), ctx, id)
+}
+
+// FindById mocks base method.
+func (m *MockOrganizationsRepository) FindById(ctx context.Context, id string) (*entities.OrganizationEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "FindById", ctx, id)
+        ret0, _ := ret[0].(*entities.OrganizationEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockOrganizationsRepositoryMockRecorder) FindById(ctx, id interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockOrganizationsRepository)(nil).FindById), ctx, id)
+}
+
+// Save mocks base method.
+func (m *MockOrganizationsRepository) Save(ctx context.Context, organization *entities.OrganizationEntity) *shared.Error {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Save", ctx, organization)
+        ret0, _ := ret[0].(*shared.Error)
+        return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockOrganizationsRepositoryMockRecorder) Save(ctx, organization interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockOrganizationsRepository)(nil).Save), ctx, organization)
+}
\ No newline at end of file
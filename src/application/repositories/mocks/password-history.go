@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/repositories/password-history.go
+
+// Package mock_repositories is a generated GoMock package.
+package mock_repositories
+
+import (
+        context "context"
+        reflect "reflect"
+        time "time"
+
+        dtos "github.com/AndreyArthur/oganessone/src/core/dtos"
+        entities "github.com/AndreyArthur/oganessone/src/core/entities"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockPasswordHistoryRepository is a mock of PasswordHistoryRepository interface.
+type MockPasswordHistoryRepository struct {
+        ctrl     *gomock.Controller
+        recorder *MockPasswordHistoryRepositoryMockRecorder
+}
+
+// MockPasswordHistoryRepositoryMockRecorder is the mock recorder for MockPasswordHistoryRepository.
+type MockPasswordHistoryRepositoryMockRecorder struct {
+        mock *MockPasswordHistoryRepository
+}
+
+// NewMockPasswordHistoryRepository creates a new mock instance.
+func NewMockPasswordHistoryRepository(ctrl *gomock.Controller) *MockPasswordHistoryRepository {
+        mock := &MockPasswordHistoryRepository{ctrl: ctrl}
+        mock.recorder = &MockPasswordHistoryRepositoryMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordHistoryRepository) EXPECT() *MockPasswordHistoryRepositoryMockRecorder {
+        return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockPasswordHistoryRepository) Create(data *dtos.PasswordHistoryDTO) (*entities.PasswordHistoryEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Create", data)
+        ret0, _ := ret[0].(*entities.PasswordHistoryEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockPasswordHistoryRepositoryMockRecorder) Create(data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockPasswordHistoryRepository)(nil).Create), data)
+}
+
+// FindRecentByUserId mocks base method.
+func (m *MockPasswordHistoryRepository) FindRecentByUserId(ctx context.Context, userId string, limit int, since time.Time) ([]*entities.PasswordHistoryEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "FindRecentByUserId", ctx, userId, limit, since)
+        ret0, _ := ret[0].([]*entities.PasswordHistoryEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// FindRecentByUserId indicates an expected call of FindRecentByUserId.
+func (mr *MockPasswordHistoryRepositoryMockRecorder) FindRecentByUserId(ctx, userId, limit, since interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRecentByUserId", reflect.TypeOf((*MockPasswordHistoryRepository)(nil).FindRecentByUserId), ctx, userId, limit, since)
+}
+
+// Prune mocks base method.
+func (m *MockPasswordHistoryRepository) Prune(ctx context.Context, userId string, keep int, before time.Time) *shared.Error {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Prune", ctx, userId, keep, before)
+        ret0, _ := ret[0].(*shared.Error)
+        return ret0
+}
+
+// Prune indicates an expected call of Prune.
+func (mr *MockPasswordHistoryRepositoryMockRecorder) Prune(ctx, userId, keep, before interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Prune", reflect.TypeOf((*MockPasswordHistoryRepository)(nil).Prune), ctx, userId, keep, before)
+}
+
+// Save mocks base method.
+func (m *MockPasswordHistoryRepository) Save(ctx context.Context, passwordHistory *entities.PasswordHistoryEntity) *shared.Error {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Save", ctx, passwordHistory)
+        ret0, _ := ret[0].(*shared.Error)
+        return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockPasswordHistoryRepositoryMockRecorder) Save(ctx, passwordHistory interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockPasswordHistoryRepository)(nil).Save), ctx, passwordHistory)
+}
\ No newline at end of file
@@ -5,6 +5,7 @@
 package mock_repositories
 
 import (
+        context "context"
         reflect "reflect"
 
         dtos "github.com/AndreyArthur/oganessone/src/core/dtos"
@@ -36,6 +37,36 @@ func (m *MockUsersRepository) EXPECT() *MockUsersRepositoryMockRecorder {
         return m.recorder
 }
 
+// ClaimMfaStep mocks base method.
+func (m *MockUsersRepository) ClaimMfaStep(ctx context.Context, userId string, step int64) (bool, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "ClaimMfaStep", ctx, userId, step)
+        ret0, _ := ret[0].(bool)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// ClaimMfaStep indicates an expected call of ClaimMfaStep.
+func (mr *MockUsersRepositoryMockRecorder) ClaimMfaStep(ctx, userId, step interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimMfaStep", reflect.TypeOf((*MockUsersRepository)(nil).ClaimMfaStep), ctx, userId, step)
+}
+
+// ConsumeMfaRecoveryCode mocks base method.
+func (m *MockUsersRepository) ConsumeMfaRecoveryCode(ctx context.Context, userId, hash string) (bool, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "ConsumeMfaRecoveryCode", ctx, userId, hash)
+        ret0, _ := ret[0].(bool)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// ConsumeMfaRecoveryCode indicates an expected call of ConsumeMfaRecoveryCode.
+func (mr *MockUsersRepositoryMockRecorder) ConsumeMfaRecoveryCode(ctx, userId, hash interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeMfaRecoveryCode", reflect.TypeOf((*MockUsersRepository)(nil).ConsumeMfaRecoveryCode), ctx, userId, hash)
+}
+
 // Create mocks base method.
 func (m *MockUsersRepository) Create(data *dtos.UserDTO) (*entities.UserEntity, *shared.Error) {
         m.ctrl.T.Helper()
@@ -52,45 +83,60 @@ func (mr *MockUsersRepositoryMockRecorder) Create(data interface{}) *gomock.Call
 }
 
 // FindByEmail mocks base method.
-func (m *MockUsersRepository) FindByEmail(email string) (*entities.UserEntity, *shared.Error) {
+func (m *MockUsersRepository) FindByEmail(ctx context.Context, organizationId, email string) (*entities.UserEntity, *shared.Error) {
         m.ctrl.T.Helper()
-        ret := m.ctrl.Call(m, "FindByEmail", email)
+        ret := m.ctrl.Call(m, "FindByEmail", ctx, organizationId, email)
         ret0, _ := ret[0].(*entities.UserEntity)
         ret1, _ := ret[1].(*shared.Error)
         return ret0, ret1
 }
 
 // FindByEmail indicates an expected call of FindByEmail.
-func (mr *MockUsersRepositoryMockRecorder) FindByEmail(email interface{}) *gomock.Call {
+func (mr *MockUsersRepositoryMockRecorder) FindByEmail(ctx, organizationId, email interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockUsersRepository)(nil).FindByEmail), ctx, organizationId, email)
+}
+
+// FindById mocks base method.
+func (m *MockUsersRepository) FindById(ctx context.Context, id string) (*entities.UserEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "FindById", ctx, id)
+        ret0, _ := ret[0].(*entities.UserEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockUsersRepositoryMockRecorder) FindById(ctx, id interface{}) *gomock.Call {
         mr.mock.ctrl.T.Helper()
-        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmail", reflect.TypeOf((*MockUsersRepository)(nil).FindByEmail), email)
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockUsersRepository)(nil).FindById), ctx, id)
 }
 
 // FindByUsername mocks base method.
-func (m *MockUsersRepository) FindByUsername(username string, caseSensitive bool) (*entities.UserEntity, *shared.Error) {
+func (m *MockUsersRepository) FindByUsername(ctx context.Context, organizationId, username string, caseSensitive bool) (*entities.UserEntity, *shared.Error) {
         m.ctrl.T.Helper()
-        ret := m.ctrl.Call(m, "FindByUsername", username, caseSensitive)
+        ret := m.ctrl.Call(m, "FindByUsername", ctx, organizationId, username, caseSensitive)
         ret0, _ := ret[0].(*entities.UserEntity)
         ret1, _ := ret[1].(*shared.Error)
         return ret0, ret1
 }
 
 // FindByUsername indicates an expected call of FindByUsername.
-func (mr *MockUsersRepositoryMockRecorder) FindByUsername(username, caseSensitive interface{}) *gomock.Call {
+func (mr *MockUsersRepositoryMockRecorder) FindByUsername(ctx, organizationId, username, caseSensitive interface{}) *gomock.Call {
         mr.mock.ctrl.T.Helper()
-        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUsername", reflect.TypeOf((*MockUsersRepository)(nil).FindByUsername), username, caseSensitive)
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUsername", reflect.TypeOf((*MockUsersRepository)(nil).FindByUsername), ctx, organizationId, username, caseSensitive)
 }
 
 // Save mocks base method.
-func (m *MockUsersRepository) Save(arg0 *entities.UserEntity) *shared.Error {
+func (m *MockUsersRepository) Save(ctx context.Context, user *entities.UserEntity) *shared.Error {
         m.ctrl.T.Helper()
-        ret := m.ctrl.Call(m, "Save", arg0)
+        ret := m.ctrl.Call(m, "Save", ctx, user)
         ret0, _ := ret[0].(*shared.Error)
         return ret0
 }
 
 // Save indicates an expected call of Save.
-func (mr *MockUsersRepositoryMockRecorder) Save(arg0 interface{}) *gomock.Call {
+func (mr *MockUsersRepositoryMockRecorder) Save(ctx, user interface{}) *gomock.Call {
         mr.mock.ctrl.T.Helper()
-        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockUsersRepository)(nil).Save), arg0)
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockUsersRepository)(nil).Save), ctx, user)
 }
\ No newline at end of file
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/repositories/webauthn-challenges.go
+
+// Package mock_repositories is a generated GoMock package.
+package mock_repositories
+
+import (
+        context "context"
+        reflect "reflect"
+
+        dtos "github.com/AndreyArthur/oganessone/src/core/dtos"
+        entities "github.com/AndreyArthur/oganessone/src/core/entities"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockWebAuthnChallengesRepository is a mock of WebAuthnChallengesRepository interface.
+type MockWebAuthnChallengesRepository struct {
+        ctrl     *gomock.Controller
+        recorder *MockWebAuthnChallengesRepositoryMockRecorder
+}
+
+// MockWebAuthnChallengesRepositoryMockRecorder is the mock recorder for MockWebAuthnChallengesRepository.
+type MockWebAuthnChallengesRepositoryMockRecorder struct {
+        mock *MockWebAuthnChallengesRepository
+}
+
+// NewMockWebAuthnChallengesRepository creates a new mock instance.
+func NewMockWebAuthnChallengesRepository(ctrl *gomock.Controller) *MockWebAuthnChallengesRepository {
+        mock := &MockWebAuthnChallengesRepository{ctrl: ctrl}
+        mock.recorder = &MockWebAuthnChallengesRepositoryMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebAuthnChallengesRepository) EXPECT() *MockWebAuthnChallengesRepositoryMockRecorder {
+        return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockWebAuthnChallengesRepository) Consume(ctx context.Context, challenge string) (*entities.WebAuthnChallengeEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Consume", ctx, challenge)
+        ret0, _ := ret[0].(*entities.WebAuthnChallengeEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockWebAuthnChallengesRepositoryMockRecorder) Consume(ctx, challenge interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockWebAuthnChallengesRepository)(nil).Consume), ctx, challenge)
+}
+
+// Create mocks base method.
+func (m *MockWebAuthnChallengesRepository) Create(data *dtos.WebAuthnChallengeDTO) (*entities.WebAuthnChallengeEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Create", data)
+        ret0, _ := ret[0].(*entities.WebAuthnChallengeEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebAuthnChallengesRepositoryMockRecorder) Create(data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebAuthnChallengesRepository)(nil).Create), data)
+}
+
+// Save mocks base method.
+func (m *MockWebAuthnChallengesRepository) Save(ctx context.Context, webAuthnChallenge *entities.WebAuthnChallengeEntity) *shared.Error {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Save", ctx, webAuthnChallenge)
+        ret0, _ := ret[0].(*shared.Error)
+        return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockWebAuthnChallengesRepositoryMockRecorder) Save(ctx, webAuthnChallenge interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockWebAuthnChallengesRepository)(nil).Save), ctx, webAuthnChallenge)
+}
\ No newline at end of file
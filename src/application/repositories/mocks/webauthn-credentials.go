@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./src/application/repositories/webauthn-credentials.go
+
+// Package mock_repositories is a generated GoMock package.
+package mock_repositories
+
+import (
+        context "context"
+        reflect "reflect"
+
+        dtos "github.com/AndreyArthur/oganessone/src/core/dtos"
+        entities "github.com/AndreyArthur/oganessone/src/core/entities"
+        shared "github.com/AndreyArthur/oganessone/src/core/shared"
+        gomock "github.com/golang/mock/gomock"
+)
+
+// MockWebAuthnCredentialsRepository is a mock of WebAuthnCredentialsRepository interface.
+type MockWebAuthnCredentialsRepository struct {
+        ctrl     *gomock.Controller
+        recorder *MockWebAuthnCredentialsRepositoryMockRecorder
+}
+
+// MockWebAuthnCredentialsRepositoryMockRecorder is the mock recorder for MockWebAuthnCredentialsRepository.
+type MockWebAuthnCredentialsRepositoryMockRecorder struct {
+        mock *MockWebAuthnCredentialsRepository
+}
+
+// NewMockWebAuthnCredentialsRepository creates a new mock instance.
+func NewMockWebAuthnCredentialsRepository(ctrl *gomock.Controller) *MockWebAuthnCredentialsRepository {
+        mock := &MockWebAuthnCredentialsRepository{ctrl: ctrl}
+        mock.recorder = &MockWebAuthnCredentialsRepositoryMockRecorder{mock}
+        return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWebAuthnCredentialsRepository) EXPECT() *MockWebAuthnCredentialsRepositoryMockRecorder {
+        return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWebAuthnCredentialsRepository) Create(data *dtos.WebAuthnCredentialDTO) (*entities.WebAuthnCredentialEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Create", data)
+        ret0, _ := ret[0].(*entities.WebAuthnCredentialEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWebAuthnCredentialsRepositoryMockRecorder) Create(data interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWebAuthnCredentialsRepository)(nil).Create), data)
+}
+
+// FindByCredentialId mocks base method.
+func (m *MockWebAuthnCredentialsRepository) FindByCredentialId(ctx context.Context, credentialId string) (*entities.WebAuthnCredentialEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "FindByCredentialId", ctx, credentialId)
+        ret0, _ := ret[0].(*entities.WebAuthnCredentialEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// FindByCredentialId indicates an expected call of FindByCredentialId.
+func (mr *MockWebAuthnCredentialsRepositoryMockRecorder) FindByCredentialId(ctx, credentialId interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCredentialId", reflect.TypeOf((*MockWebAuthnCredentialsRepository)(nil).FindByCredentialId), ctx, credentialId)
+}
+
+// FindByUserId mocks base method.
+func (m *MockWebAuthnCredentialsRepository) FindByUserId(ctx context.Context, userId string) ([]*entities.WebAuthnCredentialEntity, *shared.Error) {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "FindByUserId", ctx, userId)
+        ret0, _ := ret[0].([]*entities.WebAuthnCredentialEntity)
+        ret1, _ := ret[1].(*shared.Error)
+        return ret0, ret1
+}
+
+// FindByUserId indicates an expected call of FindByUserId.
+func (mr *MockWebAuthnCredentialsRepositoryMockRecorder) FindByUserId(ctx, userId interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByUserId", reflect.TypeOf((*MockWebAuthnCredentialsRepository)(nil).FindByUserId), ctx, userId)
+}
+
+// Save mocks base method.
+func (m *MockWebAuthnCredentialsRepository) Save(ctx context.Context, webAuthnCredential *entities.WebAuthnCredentialEntity) *shared.Error {
+        m.ctrl.T.Helper()
+        ret := m.ctrl.Call(m, "Save", ctx, webAuthnCredential)
+        ret0, _ := ret[0].(*shared.Error)
+        return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockWebAuthnCredentialsRepositoryMockRecorder) Save(ctx, webAuthnCredential interface{}) *gomock.Call {
+        mr.mock.ctrl.T.Helper()
+        return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockWebAuthnCredentialsRepository)(nil).Save), ctx, webAuthnCredential)
+}
\ No newline at end of file
@@ -0,0 +1,15 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type OAuthClientsRepository interface {
+	FindById(ctx context.Context, id string) (*entities.OAuthClientEntity, *shared.Error)
+	Create(data *dtos.OAuthClientDTO) (*entities.OAuthClientEntity, *shared.Error)
+	Save(ctx context.Context, client *entities.OAuthClientEntity) *shared.Error
+}
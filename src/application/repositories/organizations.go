@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type OrganizationsRepository interface {
+	FindById(ctx context.Context, id string) (*entities.OrganizationEntity, *shared.Error)
+	Create(data *dtos.OrganizationDTO) (*entities.OrganizationEntity, *shared.Error)
+	Save(ctx context.Context, organization *entities.OrganizationEntity) *shared.Error
+	Delete(ctx context.Context, id string) *shared.Error
+}
@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type PasswordHistoryRepository interface {
+	Create(data *dtos.PasswordHistoryDTO) (*entities.PasswordHistoryEntity, *shared.Error)
+	Save(ctx context.Context, passwordHistory *entities.PasswordHistoryEntity) *shared.Error
+	FindRecentByUserId(ctx context.Context, userId string, limit int, since time.Time) ([]*entities.PasswordHistoryEntity, *shared.Error)
+	Prune(ctx context.Context, userId string, keep int, before time.Time) *shared.Error
+}
@@ -1,14 +1,26 @@
 package repositories
 
 import (
+	"context"
+
 	"github.com/AndreyArthur/oganessone/src/core/dtos"
 	"github.com/AndreyArthur/oganessone/src/core/entities"
 	"github.com/AndreyArthur/oganessone/src/core/shared"
 )
 
+// An empty organizationId searches across every tenant, a non-empty one
+// restricts the search to the users of that organization.
+//
+// ClaimMfaStep records the last accepted one-time password time step and
+// reports false when that step, or a later one, was already used.
+// ConsumeMfaRecoveryCode removes a recovery code hash and reports false when
+// it was already gone.
 type UsersRepository interface {
-	FindByUsername(username string, caseSensitive bool) (*entities.UserEntity, *shared.Error)
-	FindByEmail(email string) (*entities.UserEntity, *shared.Error)
+	FindById(ctx context.Context, id string) (*entities.UserEntity, *shared.Error)
+	FindByUsername(ctx context.Context, organizationId string, username string, caseSensitive bool) (*entities.UserEntity, *shared.Error)
+	FindByEmail(ctx context.Context, organizationId string, email string) (*entities.UserEntity, *shared.Error)
 	Create(data *dtos.UserDTO) (*entities.UserEntity, *shared.Error)
-	Save(*entities.UserEntity) *shared.Error
+	Save(ctx context.Context, user *entities.UserEntity) *shared.Error
+	ClaimMfaStep(ctx context.Context, userId string, step int64) (bool, *shared.Error)
+	ConsumeMfaRecoveryCode(ctx context.Context, userId string, hash string) (bool, *shared.Error)
 }
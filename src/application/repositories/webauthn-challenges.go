@@ -0,0 +1,17 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// Like authorization codes, a challenge is removed when it is read so every
+// ceremony can be completed at most once.
+type WebAuthnChallengesRepository interface {
+	Create(data *dtos.WebAuthnChallengeDTO) (*entities.WebAuthnChallengeEntity, *shared.Error)
+	Save(ctx context.Context, webAuthnChallenge *entities.WebAuthnChallengeEntity) *shared.Error
+	Consume(ctx context.Context, challenge string) (*entities.WebAuthnChallengeEntity, *shared.Error)
+}
@@ -0,0 +1,16 @@
+package repositories
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type WebAuthnCredentialsRepository interface {
+	FindByCredentialId(ctx context.Context, credentialId string) (*entities.WebAuthnCredentialEntity, *shared.Error)
+	FindByUserId(ctx context.Context, userId string) ([]*entities.WebAuthnCredentialEntity, *shared.Error)
+	Create(data *dtos.WebAuthnCredentialDTO) (*entities.WebAuthnCredentialEntity, *shared.Error)
+	Save(ctx context.Context, webAuthnCredential *entities.WebAuthnCredentialEntity) *shared.Error
+}
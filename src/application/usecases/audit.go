@@ -0,0 +1,20 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+func recordAuditEvent(
+	ctx context.Context,
+	auditLog repositories.AuditLogRepository, data *dtos.AuditEventDTO,
+) *shared.Error {
+	auditEvent, err := auditLog.Create(data)
+	if err != nil {
+		return err
+	}
+	return auditLog.Save(ctx, auditEvent)
+}
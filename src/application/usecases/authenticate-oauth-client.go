@@ -0,0 +1,131 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// Assertions are meant to be minted right before the request, a long lived
+// one is as good as a password sitting in a log file.
+const clientAssertionMaxLifetime = 5 * time.Minute
+
+func claimContains(claim interface{}, values ...string) bool {
+	var claimed []string
+	switch typed := claim.(type) {
+	case string:
+		claimed = []string{typed}
+	case []interface{}:
+		for _, item := range typed {
+			str, ok := item.(string)
+			if ok {
+				claimed = append(claimed, str)
+			}
+		}
+	}
+	for _, item := range claimed {
+		for _, value := range values {
+			if item == value {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type AuthenticateOAuthClientUseCase struct {
+	clients   repositories.OAuthClientsRepository
+	encrypter providers.EncrypterProvider
+	verifier  providers.TokenVerifierProvider
+	openId    *definitions.OpenIdOptions
+}
+
+func (authenticateOAuthClientUseCase *AuthenticateOAuthClientUseCase) verifyAssertion(
+	client *entities.OAuthClientEntity, data *definitions.AuthenticateOAuthClientDTO,
+) (bool, *shared.Error) {
+	if data.ClientAssertionType != definitions.ClientAssertionTypeJwtBearer {
+		return false, nil
+	}
+	claims, err := authenticateOAuthClientUseCase.verifier.
+		Verify(data.ClientAssertion, client.PublicKey)
+	if err != nil {
+		return false, err
+	}
+	if claims == nil {
+		return false, nil
+	}
+	issuer := authenticateOAuthClientUseCase.openId.Issuer
+	if !claimContains(claims["iss"], client.Id) ||
+		!claimContains(claims["sub"], client.Id) ||
+		!claimContains(claims["aud"], issuer, issuer+"/token") {
+		return false, nil
+	}
+	exp, ok := claims["exp"].(float64)
+	if !ok {
+		return false, nil
+	}
+	now := time.Now().UTC()
+	expiresAt := time.Unix(int64(exp), 0).UTC()
+	if !expiresAt.After(now) || expiresAt.Sub(now) > clientAssertionMaxLifetime {
+		return false, nil
+	}
+	return true, nil
+}
+
+func (authenticateOAuthClientUseCase *AuthenticateOAuthClientUseCase) Execute(
+	ctx context.Context,
+	data *definitions.AuthenticateOAuthClientDTO,
+) (*definitions.AuthenticateOAuthClientResult, *shared.Error) {
+	if data.ClientId == "" {
+		return nil, exceptions.NewOAuthInvalidClient()
+	}
+	client, err := authenticateOAuthClientUseCase.clients.FindById(ctx, data.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return nil, exceptions.NewOAuthInvalidClient()
+	}
+	authenticated := false
+	switch client.AuthMethod {
+	case entities.OAuthClientAuthMethodNone:
+		authenticated = data.ClientSecret == "" && data.ClientAssertion == ""
+	case entities.OAuthClientAuthMethodClientSecretBasic,
+		entities.OAuthClientAuthMethodClientSecretPost:
+		if data.ClientSecret != "" {
+			authenticated, err = authenticateOAuthClientUseCase.encrypter.
+				Compare(ctx, data.ClientSecret, client.SecretHash)
+		}
+	case entities.OAuthClientAuthMethodPrivateKeyJwt:
+		if data.ClientAssertion != "" {
+			authenticated, err = authenticateOAuthClientUseCase.verifyAssertion(client, data)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !authenticated {
+		return nil, exceptions.NewOAuthInvalidClient()
+	}
+	return client, nil
+}
+
+func NewAuthenticateOAuthClientUseCase(
+	clients repositories.OAuthClientsRepository,
+	encrypter providers.EncrypterProvider,
+	verifier providers.TokenVerifierProvider,
+	openId *definitions.OpenIdOptions,
+) (*AuthenticateOAuthClientUseCase, *shared.Error) {
+	return &AuthenticateOAuthClientUseCase{
+		clients:   clients,
+		encrypter: encrypter,
+		verifier:  verifier,
+		openId:    openId,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type AuthenticatePrincipalUseCase struct {
+	authenticateSession definitions.AuthenticateSession
+	memberships         repositories.MembershipsRepository
+}
+
+func (authenticatePrincipalUseCase *AuthenticatePrincipalUseCase) roles(
+	ctx context.Context,
+	userId string, organizationId string,
+) ([]string, *shared.Error) {
+	if userId == "" || organizationId == "" {
+		return []string{}, nil
+	}
+	membership, err := authenticatePrincipalUseCase.memberships.
+		FindByOrganizationIdAndUserId(ctx, organizationId, userId)
+	if err != nil {
+		return nil, err
+	}
+	if membership == nil {
+		return []string{}, nil
+	}
+	return []string{membership.Role}, nil
+}
+
+func hasAnyRole(roles []string, allowed []string) bool {
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, role := range roles {
+		for _, candidate := range allowed {
+			if role == candidate {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (authenticatePrincipalUseCase *AuthenticatePrincipalUseCase) Execute(
+	ctx context.Context,
+	data *definitions.AuthenticatePrincipalDTO,
+) (*definitions.AuthenticatePrincipalResult, *shared.Error) {
+	session, err := authenticatePrincipalUseCase.authenticateSession.
+		Execute(ctx, &definitions.AuthenticateSessionDTO{
+			SessionKey: data.SessionKey,
+			IpAddress:  data.IpAddress,
+		})
+	if err != nil {
+		return nil, err
+	}
+	roles, err := authenticatePrincipalUseCase.roles(ctx, session.UserId, session.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	if !hasAnyRole(roles, data.Roles) {
+		return nil, exceptions.NewPermissionDenied()
+	}
+	for _, scope := range data.Scopes {
+		if !hasScope(session.Scopes, scope) {
+			return nil, exceptions.NewPermissionDenied()
+		}
+	}
+	return &definitions.AuthenticatePrincipalResult{
+		SessionKey:     session.SessionKey,
+		UserId:         session.UserId,
+		OrganizationId: session.OrganizationId,
+		ApiKeyId:       session.ApiKeyId,
+		ClientId:       session.ClientId,
+		Roles:          roles,
+		Scopes:         session.Scopes,
+	}, nil
+}
+
+func NewAuthenticatePrincipalUseCase(
+	authenticateSession definitions.AuthenticateSession,
+	memberships repositories.MembershipsRepository,
+) (*AuthenticatePrincipalUseCase, *shared.Error) {
+	return &AuthenticatePrincipalUseCase{
+		authenticateSession: authenticateSession,
+		memberships:         memberships,
+	}, nil
+}
@@ -0,0 +1,149 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// Every key a session may have in the cache, they all go away together when
+// the session expires or is revoked.
+func sessionCacheKeys(sessionKey string, subject string) []string {
+	keys := []string{sessionKey}
+	for _, marker := range []string{"@", "#", "$", "%", "&"} {
+		keys = append(keys, strings.Join([]string{sessionKey, marker, subject}, ""))
+	}
+	return keys
+}
+
+type AuthenticateSessionUseCase struct {
+	cache   providers.CacheProvider
+	apiKeys repositories.ApiKeysRepository
+	digest  providers.DigestProvider
+}
+
+func (authenticateSessionUseCase *AuthenticateSessionUseCase) authenticateApiKey(
+	ctx context.Context,
+	data *definitions.AuthenticateSessionDTO, prefix string,
+) (*definitions.AuthenticateSessionResult, *shared.Error) {
+	apiKey, err := authenticateSessionUseCase.apiKeys.FindByPrefix(ctx, prefix)
+	if err != nil {
+		return nil, err
+	}
+	if apiKey == nil {
+		return nil, exceptions.NewSessionNotFound()
+	}
+	if !authenticateSessionUseCase.digest.Matches(data.SessionKey, apiKey.Hash) ||
+		apiKey.IsRevoked() ||
+		apiKey.IsExpired(time.Now().UTC()) ||
+		!apiKey.AllowsIp(data.IpAddress) {
+		return nil, exceptions.NewSessionNotFound()
+	}
+	scopes := apiKey.Scopes
+	if scopes == nil {
+		scopes = []string{}
+	}
+	return &definitions.AuthenticateSessionResult{
+		SessionKey:     data.SessionKey,
+		UserId:         apiKey.UserId,
+		OrganizationId: apiKey.OrganizationId,
+		ApiKeyId:       apiKey.Id,
+		Scopes:         scopes,
+		ExpiresAt:      apiKey.ExpiresAt,
+	}, nil
+}
+
+func (authenticateSessionUseCase *AuthenticateSessionUseCase) Execute(
+	ctx context.Context,
+	data *definitions.AuthenticateSessionDTO,
+) (*definitions.AuthenticateSessionResult, *shared.Error) {
+	if data.SessionKey == "" {
+		return nil, exceptions.NewSessionNotFound()
+	}
+	prefix, isApiKey := splitApiKey(data.SessionKey)
+	if isApiKey {
+		return authenticateSessionUseCase.authenticateApiKey(ctx, data, prefix)
+	}
+	cache := authenticateSessionUseCase.cache
+	userId, err := cache.Get(ctx, data.SessionKey)
+	if err != nil {
+		return nil, err
+	}
+	if userId == "" {
+		return nil, exceptions.NewSessionNotFound()
+	}
+	expirationKey := strings.Join([]string{data.SessionKey, "@", userId}, "")
+	organizationKey := strings.Join([]string{data.SessionKey, "#", userId}, "")
+	scopesKey := strings.Join([]string{data.SessionKey, "$", userId}, "")
+	clientKey := strings.Join([]string{data.SessionKey, "%", userId}, "")
+	audiencesKey := strings.Join([]string{data.SessionKey, "&", userId}, "")
+	expirationDate, err := cache.Get(ctx, expirationKey)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt, goerr := time.Parse(time.RFC3339, expirationDate)
+	if goerr != nil || time.Now().UTC().After(expiresAt) {
+		for _, key := range sessionCacheKeys(data.SessionKey, userId) {
+			err = cache.Delete(ctx, key)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, exceptions.NewSessionNotFound()
+	}
+	organizationId, err := cache.Get(ctx, organizationKey)
+	if err != nil {
+		return nil, err
+	}
+	grantedScopes, err := cache.Get(ctx, scopesKey)
+	if err != nil {
+		return nil, err
+	}
+	clientId, err := cache.Get(ctx, clientKey)
+	if err != nil {
+		return nil, err
+	}
+	// Sessions handed out through oauth only get the scopes they were
+	// granted, even none, sessions created directly are not restricted.
+	var scopes []string
+	if clientId != "" {
+		scopes = append([]string{}, strings.Fields(grantedScopes)...)
+	}
+	result := &definitions.AuthenticateSessionResult{
+		SessionKey:     data.SessionKey,
+		UserId:         userId,
+		OrganizationId: organizationId,
+		ClientId:       clientId,
+		Scopes:         scopes,
+		ExpiresAt:      expiresAt,
+	}
+	// Client credentials tokens have the client as subject, they act for no
+	// user and only get the scopes the client was granted, even none.
+	if clientId != "" && clientId == userId {
+		audiences, err := cache.Get(ctx, audiencesKey)
+		if err != nil {
+			return nil, err
+		}
+		result.UserId = ""
+		result.Audiences = strings.Fields(audiences)
+	}
+	return result, nil
+}
+
+func NewAuthenticateSessionUseCase(
+	cache providers.CacheProvider,
+	apiKeys repositories.ApiKeysRepository,
+	digest providers.DigestProvider,
+) (*AuthenticateSessionUseCase, *shared.Error) {
+	return &AuthenticateSessionUseCase{
+		cache:   cache,
+		apiKeys: apiKeys,
+		digest:  digest,
+	}, nil
+}
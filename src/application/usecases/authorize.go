@@ -0,0 +1,144 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+const authorizationCodeLifetime = time.Minute
+
+type AuthorizeUseCase struct {
+	validateAuthorizationRequest definitions.ValidateAuthorizationRequest
+	createSession                definitions.CreateSession
+	verifyMfa                    definitions.VerifyMfa
+	authorizationCodes           repositories.AuthorizationCodesRepository
+	random                       providers.RandomProvider
+	cache                        providers.CacheProvider
+	logger                       providers.LoggerProvider
+	tracer                       providers.TracerProvider
+}
+
+func (authorizeUseCase *AuthorizeUseCase) Execute(
+	ctx context.Context,
+	data *definitions.AuthorizeDTO,
+) (*definitions.AuthorizeResult, *shared.Error) {
+	ctx, span := authorizeUseCase.tracer.Start(ctx, "AuthorizeUseCase.Execute")
+	defer span.End()
+	result, err := authorizeUseCase.execute(ctx, data)
+	if err != nil {
+		span.RecordError(err)
+	}
+	return result, err
+}
+
+func (authorizeUseCase *AuthorizeUseCase) execute(
+	ctx context.Context,
+	data *definitions.AuthorizeDTO,
+) (*definitions.AuthorizeResult, *shared.Error) {
+	request, err := authorizeUseCase.validateAuthorizationRequest.Execute(ctx, data.Request)
+	if err != nil {
+		return nil, err
+	}
+	session, err := authorizeUseCase.createSession.Execute(ctx, &definitions.CreateSessionDTO{
+		Login:          data.Login,
+		Password:       data.Password,
+		OrganizationId: data.OrganizationId,
+		IpAddress:      data.IpAddress,
+		UserAgent:      data.UserAgent,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if session.MfaRequired {
+		if data.MfaCode == "" {
+			return nil, exceptions.NewMfaRequired()
+		}
+		session, err = authorizeUseCase.verifyMfa.Execute(ctx, &definitions.VerifyMfaDTO{
+			MfaToken:  session.MfaToken,
+			Code:      data.MfaCode,
+			IpAddress: data.IpAddress,
+			UserAgent: data.UserAgent,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sessionExpiresAt, goerr := time.Parse(time.RFC3339, session.ExpirationDate)
+	if goerr != nil {
+		authorizeUseCase.logger.Error(ctx, "session expiration date is not RFC 3339", providers.LogFields{
+			"error": goerr,
+		})
+		return nil, exceptions.NewInternalServerError()
+	}
+	code, err := authorizeUseCase.random.String(43)
+	if err != nil {
+		return nil, err
+	}
+	// Until the code is exchanged the session lives no longer than the code,
+	// the exchange gives it back the rest of its lifetime.
+	expiresAt := time.Now().UTC().Add(authorizationCodeLifetime)
+	err = cacheSession(ctx, authorizeUseCase.cache, &providers.SessionData{
+		Key:            session.SessionKey,
+		UserId:         session.User.Id,
+		OrganizationId: session.OrganizationId,
+		ExpirationDate: expiresAt.Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, err
+	}
+	authorizationCode, err := authorizeUseCase.authorizationCodes.Create(&dtos.AuthorizationCodeDTO{
+		Code:                code,
+		ClientId:            request.Client.Id,
+		UserId:              session.User.Id,
+		OrganizationId:      session.OrganizationId,
+		SessionKey:          session.SessionKey,
+		SessionExpiresAt:    sessionExpiresAt,
+		RedirectUri:         data.Request.RedirectUri,
+		Scopes:              request.Scopes,
+		Nonce:               data.Request.Nonce,
+		CodeChallenge:       data.Request.CodeChallenge,
+		CodeChallengeMethod: data.Request.CodeChallengeMethod,
+		ExpiresAt:           expiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = authorizeUseCase.authorizationCodes.Save(ctx, authorizationCode)
+	if err != nil {
+		return nil, err
+	}
+	return &definitions.AuthorizeResult{
+		RedirectUri: authorizationCode.RedirectUri,
+		Code:        authorizationCode.Code,
+		State:       data.Request.State,
+	}, nil
+}
+
+func NewAuthorizeUseCase(
+	validateAuthorizationRequest definitions.ValidateAuthorizationRequest,
+	createSession definitions.CreateSession,
+	verifyMfa definitions.VerifyMfa,
+	authorizationCodes repositories.AuthorizationCodesRepository,
+	random providers.RandomProvider,
+	cache providers.CacheProvider,
+	logger providers.LoggerProvider,
+	tracer providers.TracerProvider,
+) (*AuthorizeUseCase, *shared.Error) {
+	return &AuthorizeUseCase{
+		validateAuthorizationRequest: validateAuthorizationRequest,
+		createSession:                createSession,
+		verifyMfa:                    verifyMfa,
+		authorizationCodes:           authorizationCodes,
+		random:                       random,
+		cache:                        cache,
+		logger:                       logger,
+		tracer:                       tracer,
+	}, nil
+}
@@ -0,0 +1,95 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type BeginWebAuthnLoginUseCase struct {
+	users       repositories.UsersRepository
+	credentials repositories.WebAuthnCredentialsRepository
+	challenges  repositories.WebAuthnChallengesRepository
+	random      providers.RandomProvider
+	tenancy     *definitions.TenancyOptions
+	options     *definitions.WebAuthnOptions
+}
+
+// An unknown login still gets a challenge, just without credentials to pick
+// from, so the response doesn't tell whether the account exists.
+func (beginWebAuthnLoginUseCase *BeginWebAuthnLoginUseCase) allowCredentials(
+	ctx context.Context,
+	data *definitions.BeginWebAuthnLoginDTO,
+) (string, []string, *shared.Error) {
+	allowCredentials := []string{}
+	if data.Login == "" {
+		return "", allowCredentials, nil
+	}
+	user, err := findUserByLogin(
+		ctx,
+		beginWebAuthnLoginUseCase.users, beginWebAuthnLoginUseCase.tenancy,
+		data.OrganizationId, data.Login,
+	)
+	if err != nil {
+		return "", nil, err
+	}
+	if user == nil {
+		return "", allowCredentials, nil
+	}
+	credentials, err := beginWebAuthnLoginUseCase.credentials.FindByUserId(ctx, user.Id)
+	if err != nil {
+		return "", nil, err
+	}
+	for _, credential := range credentials {
+		allowCredentials = append(allowCredentials, credential.CredentialId)
+	}
+	return user.Id, allowCredentials, nil
+}
+
+func (beginWebAuthnLoginUseCase *BeginWebAuthnLoginUseCase) Execute(
+	ctx context.Context,
+	data *definitions.BeginWebAuthnLoginDTO,
+) (*definitions.BeginWebAuthnLoginResult, *shared.Error) {
+	userId, allowCredentials, err := beginWebAuthnLoginUseCase.allowCredentials(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	challenge, err := createWebAuthnChallenge(
+		ctx,
+		beginWebAuthnLoginUseCase.challenges,
+		beginWebAuthnLoginUseCase.random,
+		beginWebAuthnLoginUseCase.options,
+		entities.WebAuthnCeremonyAuthentication, userId, data.OrganizationId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &definitions.BeginWebAuthnLoginResult{
+		Challenge:        challenge,
+		RpId:             beginWebAuthnLoginUseCase.options.RpId,
+		AllowCredentials: allowCredentials,
+		Timeout:          beginWebAuthnLoginUseCase.options.Timeout,
+	}, nil
+}
+
+func NewBeginWebAuthnLoginUseCase(
+	users repositories.UsersRepository,
+	credentials repositories.WebAuthnCredentialsRepository,
+	challenges repositories.WebAuthnChallengesRepository,
+	random providers.RandomProvider,
+	tenancy *definitions.TenancyOptions,
+	options *definitions.WebAuthnOptions,
+) (*BeginWebAuthnLoginUseCase, *shared.Error) {
+	return &BeginWebAuthnLoginUseCase{
+		users:       users,
+		credentials: credentials,
+		challenges:  challenges,
+		random:      random,
+		tenancy:     tenancy,
+		options:     options,
+	}, nil
+}
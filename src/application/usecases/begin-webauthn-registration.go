@@ -0,0 +1,114 @@
+package usecases
+
+import (
+	"context"
+	"encoding/base64"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// The stored challenge is the random string itself, clients get its bytes
+// base64url encoded and send them back that way inside the client data.
+func createWebAuthnChallenge(
+	ctx context.Context,
+	challenges repositories.WebAuthnChallengesRepository,
+	random providers.RandomProvider,
+	options *definitions.WebAuthnOptions,
+	ceremony string,
+	userId string,
+	organizationId string,
+) (string, *shared.Error) {
+	raw, err := random.String(43)
+	if err != nil {
+		return "", err
+	}
+	challenge, err := challenges.Create(&dtos.WebAuthnChallengeDTO{
+		Challenge:      raw,
+		Ceremony:       ceremony,
+		UserId:         userId,
+		OrganizationId: organizationId,
+		ExpiresAt:      time.Now().UTC().Add(options.Timeout),
+	})
+	if err != nil {
+		return "", err
+	}
+	err = challenges.Save(ctx, challenge)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString([]byte(challenge.Challenge)), nil
+}
+
+type BeginWebAuthnRegistrationUseCase struct {
+	users       repositories.UsersRepository
+	credentials repositories.WebAuthnCredentialsRepository
+	challenges  repositories.WebAuthnChallengesRepository
+	random      providers.RandomProvider
+	options     *definitions.WebAuthnOptions
+}
+
+func (beginWebAuthnRegistrationUseCase *BeginWebAuthnRegistrationUseCase) Execute(
+	ctx context.Context,
+	data *definitions.BeginWebAuthnRegistrationDTO,
+) (*definitions.BeginWebAuthnRegistrationResult, *shared.Error) {
+	if data.ActorId == "" {
+		return nil, exceptions.NewUserNotFound()
+	}
+	user, err := beginWebAuthnRegistrationUseCase.users.FindById(ctx, data.ActorId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, exceptions.NewUserNotFound()
+	}
+	credentials, err := beginWebAuthnRegistrationUseCase.credentials.FindByUserId(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+	excludeCredentials := []string{}
+	for _, credential := range credentials {
+		excludeCredentials = append(excludeCredentials, credential.CredentialId)
+	}
+	challenge, err := createWebAuthnChallenge(
+		ctx,
+		beginWebAuthnRegistrationUseCase.challenges,
+		beginWebAuthnRegistrationUseCase.random,
+		beginWebAuthnRegistrationUseCase.options,
+		entities.WebAuthnCeremonyRegistration, user.Id, "",
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &definitions.BeginWebAuthnRegistrationResult{
+		Challenge:          challenge,
+		RpId:               beginWebAuthnRegistrationUseCase.options.RpId,
+		RpName:             beginWebAuthnRegistrationUseCase.options.RpName,
+		UserHandle:         base64.RawURLEncoding.EncodeToString([]byte(user.Id)),
+		UserName:           user.Username,
+		ExcludeCredentials: excludeCredentials,
+		Timeout:            beginWebAuthnRegistrationUseCase.options.Timeout,
+	}, nil
+}
+
+func NewBeginWebAuthnRegistrationUseCase(
+	users repositories.UsersRepository,
+	credentials repositories.WebAuthnCredentialsRepository,
+	challenges repositories.WebAuthnChallengesRepository,
+	random providers.RandomProvider,
+	options *definitions.WebAuthnOptions,
+) (*BeginWebAuthnRegistrationUseCase, *shared.Error) {
+	return &BeginWebAuthnRegistrationUseCase{
+		users:       users,
+		credentials: credentials,
+		challenges:  challenges,
+		random:      random,
+		options:     options,
+	}, nil
+}
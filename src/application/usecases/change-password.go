@@ -0,0 +1,173 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type ChangePasswordUseCase struct {
+	users     repositories.UsersRepository
+	history   repositories.PasswordHistoryRepository
+	encrypter providers.EncrypterProvider
+	screener  providers.PasswordScreenerProvider
+	policy    *entities.PasswordPolicyEntity
+	options   *definitions.PasswordHistoryOptions
+	auditLog  repositories.AuditLogRepository
+}
+
+func (changePasswordUseCase *ChangePasswordUseCase) historySince(now time.Time) time.Time {
+	if changePasswordUseCase.options.Retention <= 0 {
+		return time.Time{}
+	}
+	return now.Add(-changePasswordUseCase.options.Retention)
+}
+
+func (changePasswordUseCase *ChangePasswordUseCase) isReused(
+	ctx context.Context,
+	user *entities.UserEntity, password string, now time.Time,
+) (bool, *shared.Error) {
+	hashes := []string{user.Password}
+	if changePasswordUseCase.options.Size > 0 {
+		previous, err := changePasswordUseCase.history.FindRecentByUserId(
+			ctx,
+			user.Id, changePasswordUseCase.options.Size, changePasswordUseCase.historySince(now),
+		)
+		if err != nil {
+			return false, err
+		}
+		for _, entry := range previous {
+			hashes = append(hashes, entry.Password)
+		}
+	}
+	for _, hash := range hashes {
+		matches, err := changePasswordUseCase.encrypter.Compare(ctx, password, hash)
+		if err != nil {
+			return false, err
+		}
+		if matches {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (changePasswordUseCase *ChangePasswordUseCase) recordPrevious(
+	ctx context.Context,
+	user *entities.UserEntity, previousHash string, now time.Time,
+) *shared.Error {
+	if changePasswordUseCase.options.Size <= 0 {
+		return nil
+	}
+	entry, err := changePasswordUseCase.history.Create(&dtos.PasswordHistoryDTO{
+		UserId:    user.Id,
+		Password:  previousHash,
+		CreatedAt: now,
+	})
+	if err != nil {
+		return err
+	}
+	err = changePasswordUseCase.history.Save(ctx, entry)
+	if err != nil {
+		return err
+	}
+	return changePasswordUseCase.history.Prune(
+		ctx,
+		user.Id, changePasswordUseCase.options.Size, changePasswordUseCase.historySince(now),
+	)
+}
+
+func (changePasswordUseCase *ChangePasswordUseCase) Execute(
+	ctx context.Context,
+	data *definitions.ChangePasswordDTO,
+) *shared.Error {
+	if data.ActorId == "" {
+		return exceptions.NewUserNotFound()
+	}
+	newPassword := strings.TrimSpace(data.NewPassword)
+	user, err := changePasswordUseCase.users.FindById(ctx, data.ActorId)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return exceptions.NewUserNotFound()
+	}
+	passwordMatches, err := changePasswordUseCase.encrypter.
+		Compare(ctx, data.CurrentPassword, user.Password)
+	if err != nil {
+		return err
+	}
+	if !passwordMatches {
+		return exceptions.NewUserReauthenticationFailed()
+	}
+	err = user.IsPasswordValid(newPassword, changePasswordUseCase.policy)
+	if err != nil {
+		return err
+	}
+	breached, err := changePasswordUseCase.screener.IsBreached(ctx, newPassword)
+	if err != nil {
+		return err
+	}
+	if breached {
+		return exceptions.NewUserPasswordBreached()
+	}
+	now := time.Now().UTC()
+	reused, err := changePasswordUseCase.isReused(ctx, user, newPassword, now)
+	if err != nil {
+		return err
+	}
+	if reused {
+		return exceptions.NewUserPasswordReused()
+	}
+	hashedPassword, err := changePasswordUseCase.encrypter.Hash(ctx, newPassword)
+	if err != nil {
+		return err
+	}
+	previousHash := user.Password
+	user.Password = hashedPassword
+	user.UpdatedAt = now
+	err = changePasswordUseCase.users.Save(ctx, user)
+	if err != nil {
+		return err
+	}
+	err = changePasswordUseCase.recordPrevious(ctx, user, previousHash, now)
+	if err != nil {
+		return err
+	}
+	return recordAuditEvent(ctx, changePasswordUseCase.auditLog, &dtos.AuditEventDTO{
+		Type:           entities.AuditEventPasswordChanged,
+		ActorId:        user.Id,
+		TargetId:       user.Id,
+		OrganizationId: user.OrganizationId,
+		IpAddress:      data.IpAddress,
+		UserAgent:      data.UserAgent,
+	})
+}
+
+func NewChangePasswordUseCase(
+	users repositories.UsersRepository,
+	history repositories.PasswordHistoryRepository,
+	encrypter providers.EncrypterProvider,
+	screener providers.PasswordScreenerProvider,
+	policy *entities.PasswordPolicyEntity,
+	options *definitions.PasswordHistoryOptions,
+	auditLog repositories.AuditLogRepository,
+) (*ChangePasswordUseCase, *shared.Error) {
+	return &ChangePasswordUseCase{
+		users:     users,
+		history:   history,
+		encrypter: encrypter,
+		screener:  screener,
+		policy:    policy,
+		options:   options,
+		auditLog:  auditLog,
+	}, nil
+}
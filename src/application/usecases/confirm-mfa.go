@@ -0,0 +1,67 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type ConfirmMfaUseCase struct {
+	users  repositories.UsersRepository
+	cipher providers.CipherProvider
+	otp    providers.OtpProvider
+}
+
+func (confirmMfaUseCase *ConfirmMfaUseCase) Execute(
+	ctx context.Context,
+	data *definitions.ConfirmMfaDTO,
+) *shared.Error {
+	if data.ActorId == "" {
+		return exceptions.NewUserNotFound()
+	}
+	user, err := confirmMfaUseCase.users.FindById(ctx, data.ActorId)
+	if err != nil {
+		return err
+	}
+	if user == nil {
+		return exceptions.NewUserNotFound()
+	}
+	if user.MfaEnabled {
+		return exceptions.NewMfaAlreadyEnabled()
+	}
+	if user.MfaSecret == "" {
+		return exceptions.NewMfaNotEnrolled()
+	}
+	err = verifyTotp(
+		ctx,
+		confirmMfaUseCase.users, confirmMfaUseCase.cipher, confirmMfaUseCase.otp,
+		user, data.Code,
+	)
+	if err != nil {
+		return err
+	}
+	user.MfaEnabled = true
+	user.UpdatedAt = time.Now().UTC()
+	err = confirmMfaUseCase.users.Save(ctx, user)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewConfirmMfaUseCase(
+	users repositories.UsersRepository,
+	cipher providers.CipherProvider,
+	otp providers.OtpProvider,
+) (*ConfirmMfaUseCase, *shared.Error) {
+	return &ConfirmMfaUseCase{
+		users:  users,
+		cipher: cipher,
+		otp:    otp,
+	}, nil
+}
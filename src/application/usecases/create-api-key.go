@@ -0,0 +1,138 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+const apiKeyMarker = "og"
+
+// Api keys look like "og_<prefix>_<secret>", the prefix is stored in plain
+// text to find the key and the whole key is stored as a digest.
+func splitApiKey(key string) (string, bool) {
+	parts := strings.Split(key, "_")
+	if len(parts) != 3 || parts[0] != apiKeyMarker || parts[1] == "" || parts[2] == "" {
+		return "", false
+	}
+	return parts[1], true
+}
+
+// A nil scope list belongs to a plain session, which can see everything.
+func hasScope(scopes []string, scope string) bool {
+	if scopes == nil {
+		return true
+	}
+	for _, granted := range scopes {
+		if granted == scope {
+			return true
+		}
+	}
+	return false
+}
+
+type CreateApiKeyUseCase struct {
+	apiKeys     repositories.ApiKeysRepository
+	memberships repositories.MembershipsRepository
+	digest      providers.DigestProvider
+	random      providers.RandomProvider
+}
+
+func (createApiKeyUseCase *CreateApiKeyUseCase) authorize(
+	ctx context.Context,
+	data *definitions.CreateApiKeyDTO,
+) *shared.Error {
+	// A restricted credential could otherwise mint a key that can do more
+	// than itself.
+	for _, scope := range data.Scopes {
+		if !hasScope(data.ActorScopes, scope) {
+			return exceptions.NewApiKeyPermissionDenied()
+		}
+	}
+	if data.OrganizationId == "" {
+		if data.ServiceAccount {
+			return exceptions.NewInvalidApiKeyOwner()
+		}
+		return nil
+	}
+	membership, err := createApiKeyUseCase.memberships.
+		FindByOrganizationIdAndUserId(ctx, data.OrganizationId, data.ActorId)
+	if err != nil {
+		return err
+	}
+	if membership == nil {
+		return exceptions.NewOrganizationPermissionDenied()
+	}
+	if data.ServiceAccount && !membership.CanManageMembers() {
+		return exceptions.NewOrganizationPermissionDenied()
+	}
+	return nil
+}
+
+func (createApiKeyUseCase *CreateApiKeyUseCase) Execute(
+	ctx context.Context,
+	data *definitions.CreateApiKeyDTO,
+) (*definitions.CreateApiKeyResult, *shared.Error) {
+	if data.ExpiresAt != (time.Time{}) && !data.ExpiresAt.After(time.Now().UTC()) {
+		return nil, exceptions.NewInvalidApiKeyExpiration()
+	}
+	err := createApiKeyUseCase.authorize(ctx, data)
+	if err != nil {
+		return nil, err
+	}
+	prefix, err := createApiKeyUseCase.random.String(8)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := createApiKeyUseCase.random.String(32)
+	if err != nil {
+		return nil, err
+	}
+	key := strings.Join([]string{apiKeyMarker, prefix, secret}, "_")
+	userId := data.ActorId
+	if data.ServiceAccount {
+		userId = ""
+	}
+	apiKey, err := createApiKeyUseCase.apiKeys.Create(&dtos.ApiKeyDTO{
+		UserId:         userId,
+		OrganizationId: data.OrganizationId,
+		Name:           strings.TrimSpace(data.Name),
+		Prefix:         prefix,
+		Hash:           createApiKeyUseCase.digest.Digest(key),
+		Scopes:         data.Scopes,
+		AllowedIps:     data.AllowedIps,
+		ExpiresAt:      data.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = createApiKeyUseCase.apiKeys.Save(ctx, apiKey)
+	if err != nil {
+		return nil, err
+	}
+	return &definitions.CreateApiKeyResult{
+		ApiKey: apiKey,
+		Key:    key,
+	}, nil
+}
+
+func NewCreateApiKeyUseCase(
+	apiKeys repositories.ApiKeysRepository,
+	memberships repositories.MembershipsRepository,
+	digest providers.DigestProvider,
+	random providers.RandomProvider,
+) (*CreateApiKeyUseCase, *shared.Error) {
+	return &CreateApiKeyUseCase{
+		apiKeys:     apiKeys,
+		memberships: memberships,
+		digest:      digest,
+		random:      random,
+	}, nil
+}
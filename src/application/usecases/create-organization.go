@@ -0,0 +1,61 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type CreateOrganizationUseCase struct {
+	organizations repositories.OrganizationsRepository
+	memberships   repositories.MembershipsRepository
+}
+
+func (createOrganizationUseCase *CreateOrganizationUseCase) Execute(
+	ctx context.Context,
+	data *definitions.CreateOrganizationDTO,
+) (*definitions.CreateOrganizationResult, *shared.Error) {
+	organization, err := createOrganizationUseCase.organizations.
+		Create(&dtos.OrganizationDTO{
+			Name: strings.TrimSpace(data.Name),
+		})
+	if err != nil {
+		return nil, err
+	}
+	membership, err := createOrganizationUseCase.memberships.
+		Create(&dtos.MembershipDTO{
+			OrganizationId: organization.Id,
+			UserId:         data.ActorId,
+			Role:           entities.MembershipRoleOwner,
+		})
+	if err != nil {
+		return nil, err
+	}
+	err = createOrganizationUseCase.organizations.Save(ctx, organization)
+	if err != nil {
+		return nil, err
+	}
+	// There are no transactions across repositories, an organization left
+	// without its owner could never be managed, so it is taken back.
+	err = createOrganizationUseCase.memberships.Save(ctx, membership)
+	if err != nil {
+		createOrganizationUseCase.organizations.Delete(ctx, organization.Id)
+		return nil, err
+	}
+	return organization, nil
+}
+
+func NewCreateOrganizationUseCase(
+	organizations repositories.OrganizationsRepository,
+	memberships repositories.MembershipsRepository,
+) (*CreateOrganizationUseCase, *shared.Error) {
+	return &CreateOrganizationUseCase{
+		organizations: organizations,
+		memberships:   memberships,
+	}, nil
+}
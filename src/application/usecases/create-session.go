@@ -1,108 +1,309 @@
 package usecases
 
 import (
+	"context"
 	"strings"
+	"time"
 
 	"github.com/AndreyArthur/oganessone/src/application/definitions"
 	"github.com/AndreyArthur/oganessone/src/application/providers"
 	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
 	"github.com/AndreyArthur/oganessone/src/core/entities"
 	"github.com/AndreyArthur/oganessone/src/core/exceptions"
 	"github.com/AndreyArthur/oganessone/src/core/shared"
 )
 
+func cacheSession(ctx context.Context, cache providers.CacheProvider, sessionData *providers.SessionData) *shared.Error {
+	expiresAt, goerr := time.Parse(time.RFC3339, sessionData.ExpirationDate)
+	if goerr != nil {
+		return exceptions.NewInternalServerError()
+	}
+	err := cache.Set(ctx, sessionData.Key, sessionData.UserId, expiresAt)
+	if err != nil {
+		return err
+	}
+	err = cache.Set(
+		ctx,
+		strings.Join([]string{sessionData.Key, "@", sessionData.UserId}, ""),
+		sessionData.ExpirationDate,
+		expiresAt,
+	)
+	if err != nil {
+		return err
+	}
+	err = cache.Set(
+		ctx,
+		strings.Join([]string{sessionData.Key, "#", sessionData.UserId}, ""),
+		sessionData.OrganizationId,
+		expiresAt,
+	)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 type CreateSessionUseCase struct {
-	repository repositories.UsersRepository
-	encrypter  providers.EncrypterProvider
-	session    providers.SessionProvider
-	cache      providers.CacheProvider
+	repository    repositories.UsersRepository
+	memberships   repositories.MembershipsRepository
+	encrypter     providers.EncrypterProvider
+	session       providers.SessionProvider
+	cache         providers.CacheProvider
+	tenancy       *definitions.TenancyOptions
+	mfaChallenges repositories.MfaChallengesRepository
+	random        providers.RandomProvider
+	enumeration   *definitions.AntiEnumerationOptions
+	auditLog      repositories.AuditLogRepository
+	tracer        providers.TracerProvider
+}
+
+// The login failure is what the caller gets unless the event can't be stored.
+func (createSessionUseCase *CreateSessionUseCase) loginFailed(
+	ctx context.Context,
+	data *definitions.CreateSessionDTO, user *entities.UserEntity, reason string,
+) *shared.Error {
+	auditEvent := &dtos.AuditEventDTO{
+		Type:           entities.AuditEventLoginFailed,
+		OrganizationId: data.OrganizationId,
+		IpAddress:      data.IpAddress,
+		UserAgent:      data.UserAgent,
+		Detail:         reason,
+	}
+	if user != nil {
+		auditEvent.TargetId = user.Id
+	}
+	err := recordAuditEvent(ctx, createSessionUseCase.auditLog, auditEvent)
+	if err != nil {
+		return err
+	}
+	return exceptions.NewUserLoginFailed()
 }
 
-func (createSessionUseCase *CreateSessionUseCase) findUser(
+// Usernames are preferred over emails when the login matches both, which can
+// only happen across different users.
+func findUserByLogin(
+	ctx context.Context,
+	users repositories.UsersRepository,
+	tenancy *definitions.TenancyOptions,
+	organizationId string,
 	login string,
-) (*entities.UserEntity, *entities.UserEntity, *shared.Error) {
+) (*entities.UserEntity, *shared.Error) {
+	var usernameScope, emailScope string
+	if tenancy.ScopedUsernames {
+		usernameScope = organizationId
+	}
+	if tenancy.ScopedEmails {
+		emailScope = organizationId
+	}
 	foundByUsernameChannel, findByUsernameErrorChannel := make(chan *entities.UserEntity), make(chan *shared.Error)
 	foundByEmailChannel, findByEmailErrorChannel := make(chan *entities.UserEntity), make(chan *shared.Error)
 	go func() {
-		foundByUsername, err := createSessionUseCase.repository.FindByUsername(
-			login, true,
-		)
+		foundByUsername, err := users.FindByUsername(ctx, usernameScope, login, true)
 		foundByUsernameChannel <- foundByUsername
 		findByUsernameErrorChannel <- err
 	}()
 	go func() {
-		foundByEmail, err := createSessionUseCase.repository.FindByEmail(login)
+		foundByEmail, err := users.FindByEmail(ctx, emailScope, login)
 		foundByEmailChannel <- foundByEmail
 		findByEmailErrorChannel <- err
 	}()
 	foundByUsername, foundByEmail := <-foundByUsernameChannel, <-foundByEmailChannel
 	findByUsernameError, findByEmailError := <-findByUsernameErrorChannel, <-findByEmailErrorChannel
 	if findByUsernameError != nil {
-		return nil, nil, findByUsernameError
+		return nil, findByUsernameError
 	}
 	if findByEmailError != nil {
-		return nil, nil, findByEmailError
+		return nil, findByEmailError
+	}
+	if foundByUsername != nil {
+		return foundByUsername, nil
 	}
-	return foundByUsername, foundByEmail, nil
+	return foundByEmail, nil
 }
 
-func (createSessionUseCase *CreateSessionUseCase) Execute(
-	data *definitions.CreateSessionDTO,
+// The boolean is false when the user can't log into the requested organization.
+func findLoginOrganization(
+	ctx context.Context,
+	memberships repositories.MembershipsRepository,
+	user *entities.UserEntity,
+	organizationId string,
+) (string, bool, *shared.Error) {
+	if organizationId == "" || organizationId == user.OrganizationId {
+		return user.OrganizationId, true, nil
+	}
+	membership, err := memberships.FindByOrganizationIdAndUserId(ctx, organizationId, user.Id)
+	if err != nil {
+		return "", false, err
+	}
+	if membership == nil {
+		return "", false, nil
+	}
+	return membership.OrganizationId, true, nil
+}
+
+// Only a login knows the plain password, so it is the moment to move a hash
+// made with outdated parameters over to the current ones.
+func (createSessionUseCase *CreateSessionUseCase) rehashPassword(
+	ctx context.Context,
+	user *entities.UserEntity, password string,
+) *shared.Error {
+	needsRehash, err := createSessionUseCase.encrypter.NeedsRehash(user.Password)
+	if err != nil {
+		return err
+	}
+	if !needsRehash {
+		return nil
+	}
+	hash, err := createSessionUseCase.encrypter.Hash(ctx, password)
+	if err != nil {
+		return err
+	}
+	user.Password = hash
+	user.UpdatedAt = time.Now().UTC()
+	return createSessionUseCase.repository.Save(ctx, user)
+}
+
+// The password was right but a second factor is still owed, the returned
+// token is the only thing that allows to finish the login through VerifyMfa.
+func (createSessionUseCase *CreateSessionUseCase) challenge(
+	ctx context.Context,
+	user *entities.UserEntity, organizationId string,
 ) (*definitions.CreateSessionResult, *shared.Error) {
-	foundByUsername, foundByEmail, err := createSessionUseCase.findUser(data.Login)
+	token, err := createSessionUseCase.random.String(43)
 	if err != nil {
 		return nil, err
 	}
-	if foundByUsername == nil && foundByEmail == nil {
-		return nil, exceptions.NewUserLoginFailed()
+	challenge, err := createSessionUseCase.mfaChallenges.Create(&dtos.MfaChallengeDTO{
+		Token:          token,
+		UserId:         user.Id,
+		OrganizationId: organizationId,
+		ExpiresAt:      time.Now().UTC().Add(mfaChallengeLifetime),
+	})
+	if err != nil {
+		return nil, err
 	}
-	var user *entities.UserEntity
-	if foundByEmail != nil {
-		user = foundByEmail
+	err = createSessionUseCase.mfaChallenges.Save(ctx, challenge)
+	if err != nil {
+		return nil, err
 	}
-	if foundByUsername != nil {
-		user = foundByUsername
+	return &definitions.CreateSessionResult{
+		User:           user,
+		OrganizationId: organizationId,
+		MfaRequired:    true,
+		MfaToken:       challenge.Token,
+	}, nil
+}
+
+func (createSessionUseCase *CreateSessionUseCase) Execute(
+	ctx context.Context,
+	data *definitions.CreateSessionDTO,
+) (*definitions.CreateSessionResult, *shared.Error) {
+	ctx, span := createSessionUseCase.tracer.Start(ctx, "CreateSessionUseCase.Execute")
+	defer span.End()
+	result, err := createSessionUseCase.execute(ctx, data)
+	if err != nil {
+		span.RecordError(err)
+	}
+	return result, err
+}
+
+func (createSessionUseCase *CreateSessionUseCase) execute(
+	ctx context.Context,
+	data *definitions.CreateSessionDTO,
+) (*definitions.CreateSessionResult, *shared.Error) {
+	user, err := findUserByLogin(
+		ctx,
+		createSessionUseCase.repository, createSessionUseCase.tenancy,
+		data.OrganizationId, data.Login,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		if createSessionUseCase.enumeration.Enabled {
+			createSessionUseCase.encrypter.
+				Compare(ctx, data.Password, createSessionUseCase.enumeration.DummyPasswordHash)
+		}
+		return nil, createSessionUseCase.loginFailed(ctx, data, nil, entities.AuditReasonUnknownUser)
 	}
 	passwordMatches, err := createSessionUseCase.encrypter.
-		Compare(data.Password, user.Password)
+		Compare(ctx, data.Password, user.Password)
 	if err != nil {
 		return nil, err
 	}
 	if !passwordMatches {
-		return nil, exceptions.NewUserLoginFailed()
+		return nil, createSessionUseCase.loginFailed(ctx, data, user, entities.AuditReasonWrongPassword)
+	}
+	err = createSessionUseCase.rehashPassword(ctx, user, data.Password)
+	if err != nil {
+		return nil, err
+	}
+	organizationId, isMember, err := findLoginOrganization(
+		ctx,
+		createSessionUseCase.memberships, user, data.OrganizationId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, createSessionUseCase.loginFailed(ctx, data, user, entities.AuditReasonNotAMember)
+	}
+	if user.MfaEnabled {
+		return createSessionUseCase.challenge(ctx, user, organizationId)
 	}
-	sessionData, err := createSessionUseCase.session.Generate(user.Id)
+	sessionData, err := createSessionUseCase.session.
+		Generate(user.Id, organizationId)
 	if err != nil {
 		return nil, err
 	}
-	err = createSessionUseCase.cache.Set(sessionData.Key, sessionData.UserId)
+	err = cacheSession(ctx, createSessionUseCase.cache, sessionData)
 	if err != nil {
 		return nil, err
 	}
-	err = createSessionUseCase.cache.
-		Set(
-			strings.Join([]string{sessionData.Key, "@", sessionData.UserId}, ""),
-			sessionData.ExpirationDate,
-		)
+	err = recordAuditEvent(ctx, createSessionUseCase.auditLog, &dtos.AuditEventDTO{
+		Type:           entities.AuditEventLoginSucceeded,
+		ActorId:        user.Id,
+		TargetId:       user.Id,
+		OrganizationId: organizationId,
+		IpAddress:      data.IpAddress,
+		UserAgent:      data.UserAgent,
+	})
 	if err != nil {
 		return nil, err
 	}
 	return &definitions.CreateSessionResult{
-		User:       user,
-		SessionKey: sessionData.Key,
+		User:           user,
+		SessionKey:     sessionData.Key,
+		OrganizationId: sessionData.OrganizationId,
+		ExpirationDate: sessionData.ExpirationDate,
 	}, nil
 }
 
 func NewCreateSessionUseCase(
 	repository repositories.UsersRepository,
+	memberships repositories.MembershipsRepository,
 	encrypter providers.EncrypterProvider,
 	session providers.SessionProvider,
 	cache providers.CacheProvider,
+	tenancy *definitions.TenancyOptions,
+	mfaChallenges repositories.MfaChallengesRepository,
+	random providers.RandomProvider,
+	enumeration *definitions.AntiEnumerationOptions,
+	auditLog repositories.AuditLogRepository,
+	tracer providers.TracerProvider,
 ) (*CreateSessionUseCase, *shared.Error) {
 	return &CreateSessionUseCase{
-		repository: repository,
-		encrypter:  encrypter,
-		session:    session,
-		cache:      cache,
+		repository:    repository,
+		memberships:   memberships,
+		encrypter:     encrypter,
+		session:       session,
+		cache:         cache,
+		tenancy:       tenancy,
+		mfaChallenges: mfaChallenges,
+		random:        random,
+		enumeration:   enumeration,
+		auditLog:      auditLog,
+		tracer:        tracer,
 	}, nil
 }
@@ -1,6 +1,7 @@
 package usecases
 
 import (
+	"context"
 	"strings"
 
 	"github.com/AndreyArthur/oganessone/src/application/definitions"
@@ -13,8 +14,65 @@ import (
 )
 
 type CreateUserUseCase struct {
-	repository repositories.UsersRepository
-	encrypter  providers.EncrypterProvider
+	repository    repositories.UsersRepository
+	organizations repositories.OrganizationsRepository
+	memberships   repositories.MembershipsRepository
+	encrypter     providers.EncrypterProvider
+	screener      providers.PasswordScreenerProvider
+	mailer        providers.MailerProvider
+	policy        *entities.PasswordPolicyEntity
+	tenancy       *definitions.TenancyOptions
+	enumeration   *definitions.AntiEnumerationOptions
+	auditLog      repositories.AuditLogRepository
+	tracer        providers.TracerProvider
+}
+
+// Errors are left out on purpose, failing the request here would tell the
+// email is taken just like UserEmailAlreadyInUse does.
+func (createUserUseCase *CreateUserUseCase) notifyOwner(owner *entities.UserEntity) {
+	createUserUseCase.mailer.Send(
+		owner.Email,
+		"Sign up attempt with your email",
+		strings.Join([]string{
+			"Someone tried to create a new account with this email address, which already belongs to the account " + owner.Username + ".",
+			"If it was you, sign in with your existing account instead. Otherwise no action is needed, your account was not changed.",
+		}, "\n\n"),
+	)
+}
+
+// Stands in for the writes of a real signup with the same round trips, a
+// lookup where the user and the membership would be saved, so a taken email
+// answers as slowly as a free one without creating a second account.
+func (createUserUseCase *CreateUserUseCase) imitateSignup(
+	ctx context.Context,
+	user *entities.UserEntity, owner *entities.UserEntity, data *definitions.CreateUserDTO,
+) *shared.Error {
+	_, err := createUserUseCase.repository.FindById(ctx, user.Id)
+	if err != nil {
+		return err
+	}
+	if user.OrganizationId != "" {
+		_, err = createUserUseCase.memberships.Create(&dtos.MembershipDTO{
+			OrganizationId: user.OrganizationId,
+			UserId:         user.Id,
+			Role:           entities.MembershipRoleMember,
+		})
+		if err != nil {
+			return err
+		}
+		_, err = createUserUseCase.memberships.
+			FindByOrganizationIdAndUserId(ctx, user.OrganizationId, user.Id)
+		if err != nil {
+			return err
+		}
+	}
+	return recordAuditEvent(ctx, createUserUseCase.auditLog, &dtos.AuditEventDTO{
+		Type:           entities.AuditEventSignupEmailTaken,
+		TargetId:       owner.Id,
+		OrganizationId: user.OrganizationId,
+		IpAddress:      data.IpAddress,
+		UserAgent:      data.UserAgent,
+	})
 }
 
 func (createUserUseCase *CreateUserUseCase) sanitize(
@@ -26,19 +84,35 @@ func (createUserUseCase *CreateUserUseCase) sanitize(
 }
 
 func (createUserUseCase *CreateUserUseCase) findUser(
-	username string, email string,
+	ctx context.Context,
+	organizationId string, username string, email string,
 ) (*entities.UserEntity, *entities.UserEntity, *shared.Error) {
+	var usernameScope, emailScope string
+	if createUserUseCase.tenancy.ScopedUsernames {
+		usernameScope = organizationId
+	}
+	if createUserUseCase.tenancy.ScopedEmails {
+		emailScope = organizationId
+	}
 	foundByUsernameChannel, findByUsernameErrorChannel := make(chan *entities.UserEntity), make(chan *shared.Error)
 	foundByEmailChannel, findByEmailErrorChannel := make(chan *entities.UserEntity), make(chan *shared.Error)
 	go func() {
+		ctx, span := createUserUseCase.tracer.Start(ctx, "CreateUserUseCase.findUserByUsername")
+		defer span.End()
 		foundByUsername, err := createUserUseCase.repository.FindByUsername(
-			username, false,
+			ctx,
+			usernameScope, username, false,
 		)
 		foundByUsernameChannel <- foundByUsername
 		findByUsernameErrorChannel <- err
 	}()
 	go func() {
-		foundByEmail, err := createUserUseCase.repository.FindByEmail(email)
+		ctx, span := createUserUseCase.tracer.Start(ctx, "CreateUserUseCase.findUserByEmail")
+		defer span.End()
+		foundByEmail, err := createUserUseCase.repository.FindByEmail(
+			ctx,
+			emailScope, email,
+		)
 		foundByEmailChannel <- foundByEmail
 		findByEmailErrorChannel <- err
 	}()
@@ -54,37 +128,102 @@ func (createUserUseCase *CreateUserUseCase) findUser(
 }
 
 func (createUserUseCase *CreateUserUseCase) Execute(
+	ctx context.Context,
+	data *definitions.CreateUserDTO,
+) (*definitions.CreateUserResult, *shared.Error) {
+	ctx, span := createUserUseCase.tracer.Start(ctx, "CreateUserUseCase.Execute")
+	defer span.End()
+	user, err := createUserUseCase.execute(ctx, data)
+	if err != nil {
+		span.RecordError(err)
+	}
+	return user, err
+}
+
+func (createUserUseCase *CreateUserUseCase) execute(
+	ctx context.Context,
 	data *definitions.CreateUserDTO,
 ) (*definitions.CreateUserResult, *shared.Error) {
 	createUserUseCase.sanitize(&data.Username, &data.Email, &data.Password)
+	if data.OrganizationId != "" {
+		organization, err := createUserUseCase.organizations.
+			FindById(ctx, data.OrganizationId)
+		if err != nil {
+			return nil, err
+		}
+		if organization == nil {
+			return nil, exceptions.NewOrganizationNotFound()
+		}
+	}
 	foundByUsername, foundByEmail, err := createUserUseCase.
-		findUser(data.Username, data.Email)
+		findUser(ctx, data.OrganizationId, data.Username, data.Email)
 	if err != nil {
 		return nil, err
 	}
 	if foundByUsername != nil {
 		return nil, exceptions.NewUserUsernameAlreadyInUse()
 	}
-	if foundByEmail != nil {
+	if foundByEmail != nil && !createUserUseCase.enumeration.Enabled {
 		return nil, exceptions.NewUserEmailAlreadyInUse()
 	}
-	hashedPassword, err := createUserUseCase.encrypter.Hash(data.Password)
+	hashedPassword, err := createUserUseCase.encrypter.Hash(ctx, data.Password)
 	if err != nil {
 		return nil, exceptions.NewInternalServerError()
 	}
 	user, err := createUserUseCase.repository.Create(&dtos.UserDTO{
-		Username: data.Username,
-		Email:    data.Email,
-		Password: hashedPassword,
+		OrganizationId: data.OrganizationId,
+		Username:       data.Username,
+		Email:          data.Email,
+		Password:       hashedPassword,
 	})
 	if err != nil {
 		return nil, err
 	}
-	err = user.IsPasswordValid(data.Password)
+	err = user.IsPasswordValid(data.Password, createUserUseCase.policy)
 	if err != nil {
 		return nil, err
 	}
-	err = createUserUseCase.repository.Save(user)
+	breached, err := createUserUseCase.screener.IsBreached(ctx, data.Password)
+	if err != nil {
+		return nil, err
+	}
+	if breached {
+		return nil, exceptions.NewUserPasswordBreached()
+	}
+	if foundByEmail != nil {
+		err = createUserUseCase.imitateSignup(ctx, user, foundByEmail, data)
+		if err != nil {
+			return nil, err
+		}
+		createUserUseCase.notifyOwner(foundByEmail)
+		return user, nil
+	}
+	err = createUserUseCase.repository.Save(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if user.OrganizationId != "" {
+		membership, err := createUserUseCase.memberships.
+			Create(&dtos.MembershipDTO{
+				OrganizationId: user.OrganizationId,
+				UserId:         user.Id,
+				Role:           entities.MembershipRoleMember,
+			})
+		if err != nil {
+			return nil, err
+		}
+		err = createUserUseCase.memberships.Save(ctx, membership)
+		if err != nil {
+			return nil, err
+		}
+	}
+	err = recordAuditEvent(ctx, createUserUseCase.auditLog, &dtos.AuditEventDTO{
+		Type:           entities.AuditEventUserCreated,
+		TargetId:       user.Id,
+		OrganizationId: user.OrganizationId,
+		IpAddress:      data.IpAddress,
+		UserAgent:      data.UserAgent,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -93,11 +232,29 @@ func (createUserUseCase *CreateUserUseCase) Execute(
 
 func NewCreateUserUseCase(
 	repository repositories.UsersRepository,
+	organizations repositories.OrganizationsRepository,
+	memberships repositories.MembershipsRepository,
 	encrypter providers.EncrypterProvider,
+	screener providers.PasswordScreenerProvider,
+	mailer providers.MailerProvider,
+	policy *entities.PasswordPolicyEntity,
+	tenancy *definitions.TenancyOptions,
+	enumeration *definitions.AntiEnumerationOptions,
+	auditLog repositories.AuditLogRepository,
+	tracer providers.TracerProvider,
 ) (*CreateUserUseCase, *shared.Error) {
 	createUserUseCase := &CreateUserUseCase{
-		repository: repository,
-		encrypter:  encrypter,
+		repository:    repository,
+		organizations: organizations,
+		memberships:   memberships,
+		encrypter:     encrypter,
+		screener:      screener,
+		mailer:        mailer,
+		policy:        policy,
+		tenancy:       tenancy,
+		enumeration:   enumeration,
+		auditLog:      auditLog,
+		tracer:        tracer,
 	}
 	return createUserUseCase, nil
 }
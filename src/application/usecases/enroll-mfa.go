@@ -0,0 +1,105 @@
+package usecases
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type EnrollMfaUseCase struct {
+	users   repositories.UsersRepository
+	cipher  providers.CipherProvider
+	otp     providers.OtpProvider
+	digest  providers.DigestProvider
+	random  providers.RandomProvider
+	options *definitions.MfaOptions
+}
+
+// Key URI format understood by authenticator apps, the label is prefixed by
+// the issuer so accounts of different services don't collide.
+func (enrollMfaUseCase *EnrollMfaUseCase) uri(username string, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", enrollMfaUseCase.options.Issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", strconv.Itoa(totpDigits))
+	query.Set("period", strconv.Itoa(totpPeriod))
+	uri := &url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + strings.Join([]string{enrollMfaUseCase.options.Issuer, username}, ":"),
+		RawQuery: query.Encode(),
+	}
+	return uri.String()
+}
+
+func (enrollMfaUseCase *EnrollMfaUseCase) Execute(
+	ctx context.Context,
+	data *definitions.EnrollMfaDTO,
+) (*definitions.EnrollMfaResult, *shared.Error) {
+	if data.ActorId == "" {
+		return nil, exceptions.NewUserNotFound()
+	}
+	user, err := enrollMfaUseCase.users.FindById(ctx, data.ActorId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, exceptions.NewUserNotFound()
+	}
+	if user.MfaEnabled {
+		return nil, exceptions.NewMfaAlreadyEnabled()
+	}
+	secret, err := enrollMfaUseCase.otp.Secret()
+	if err != nil {
+		return nil, err
+	}
+	encryptedSecret, err := enrollMfaUseCase.cipher.Encrypt(secret)
+	if err != nil {
+		return nil, err
+	}
+	recoveryCodes, recoveryCodeHashes, err := generateRecoveryCodes(
+		enrollMfaUseCase.random, enrollMfaUseCase.digest,
+	)
+	if err != nil {
+		return nil, err
+	}
+	user.MfaSecret = encryptedSecret
+	user.MfaRecoveryCodes = recoveryCodeHashes
+	user.UpdatedAt = time.Now().UTC()
+	err = enrollMfaUseCase.users.Save(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	return &definitions.EnrollMfaResult{
+		Secret:        secret,
+		Uri:           enrollMfaUseCase.uri(user.Username, secret),
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+func NewEnrollMfaUseCase(
+	users repositories.UsersRepository,
+	cipher providers.CipherProvider,
+	otp providers.OtpProvider,
+	digest providers.DigestProvider,
+	random providers.RandomProvider,
+	options *definitions.MfaOptions,
+) (*EnrollMfaUseCase, *shared.Error) {
+	return &EnrollMfaUseCase{
+		users:   users,
+		cipher:  cipher,
+		otp:     otp,
+		digest:  digest,
+		random:  random,
+		options: options,
+	}, nil
+}
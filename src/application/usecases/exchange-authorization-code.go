@@ -0,0 +1,177 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type ExchangeAuthorizationCodeUseCase struct {
+	authenticateClient definitions.AuthenticateOAuthClient
+	authorizationCodes repositories.AuthorizationCodesRepository
+	users              repositories.UsersRepository
+	cache              providers.CacheProvider
+	signer             providers.TokenSignerProvider
+	openId             *definitions.OpenIdOptions
+	tracer             providers.TracerProvider
+}
+
+// The code is already consumed at this point, the session behind it is
+// dropped as well so a stolen code can't be retried against a guessed verifier.
+func (exchangeAuthorizationCodeUseCase *ExchangeAuthorizationCodeUseCase) reject(
+	ctx context.Context,
+	authorizationCode *entities.AuthorizationCodeEntity,
+) *shared.Error {
+	for _, key := range []string{
+		authorizationCode.SessionKey,
+		strings.Join([]string{authorizationCode.SessionKey, "@", authorizationCode.UserId}, ""),
+		strings.Join([]string{authorizationCode.SessionKey, "#", authorizationCode.UserId}, ""),
+	} {
+		err := exchangeAuthorizationCodeUseCase.cache.Delete(ctx, key)
+		if err != nil {
+			return err
+		}
+	}
+	return exceptions.NewOAuthInvalidGrant()
+}
+
+func (exchangeAuthorizationCodeUseCase *ExchangeAuthorizationCodeUseCase) issueIdToken(
+	ctx context.Context,
+	authorizationCode *entities.AuthorizationCodeEntity, now time.Time,
+) (string, *shared.Error) {
+	user, err := exchangeAuthorizationCodeUseCase.users.FindById(ctx, authorizationCode.UserId)
+	if err != nil {
+		return "", err
+	}
+	if user == nil {
+		return "", exceptions.NewOAuthInvalidGrant()
+	}
+	openId := exchangeAuthorizationCodeUseCase.openId
+	claims := userClaims(user, authorizationCode.Scopes)
+	claims["iss"] = openId.Issuer
+	claims["aud"] = authorizationCode.ClientId
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(openId.IdTokenLifetime).Unix()
+	if authorizationCode.Nonce != "" {
+		claims["nonce"] = authorizationCode.Nonce
+	}
+	return exchangeAuthorizationCodeUseCase.signer.Sign(claims)
+}
+
+func (exchangeAuthorizationCodeUseCase *ExchangeAuthorizationCodeUseCase) Execute(
+	ctx context.Context,
+	data *definitions.ExchangeAuthorizationCodeDTO,
+) (*definitions.ExchangeAuthorizationCodeResult, *shared.Error) {
+	ctx, span := exchangeAuthorizationCodeUseCase.tracer.Start(ctx, "ExchangeAuthorizationCodeUseCase.Execute")
+	defer span.End()
+	result, err := exchangeAuthorizationCodeUseCase.execute(ctx, data)
+	if err != nil {
+		span.RecordError(err)
+	}
+	return result, err
+}
+
+func (exchangeAuthorizationCodeUseCase *ExchangeAuthorizationCodeUseCase) execute(
+	ctx context.Context,
+	data *definitions.ExchangeAuthorizationCodeDTO,
+) (*definitions.ExchangeAuthorizationCodeResult, *shared.Error) {
+	if data.Client == nil {
+		return nil, exceptions.NewOAuthInvalidClient()
+	}
+	if data.Code == "" || data.CodeVerifier == "" {
+		return nil, exceptions.NewOAuthInvalidRequest()
+	}
+	client, err := exchangeAuthorizationCodeUseCase.authenticateClient.Execute(ctx, data.Client)
+	if err != nil {
+		return nil, err
+	}
+	authorizationCode, err := exchangeAuthorizationCodeUseCase.authorizationCodes.
+		Consume(ctx, data.Code)
+	if err != nil {
+		return nil, err
+	}
+	if authorizationCode == nil {
+		return nil, exceptions.NewOAuthInvalidGrant()
+	}
+	now := time.Now().UTC()
+	if authorizationCode.IsExpired(now) ||
+		authorizationCode.ClientId != client.Id ||
+		authorizationCode.RedirectUri != data.RedirectUri ||
+		!authorizationCode.VerifyCodeVerifier(data.CodeVerifier) {
+		return nil, exchangeAuthorizationCodeUseCase.reject(ctx, authorizationCode)
+	}
+	var idToken string
+	if hasScope(authorizationCode.Scopes, "openid") {
+		idToken, err = exchangeAuthorizationCodeUseCase.issueIdToken(ctx, authorizationCode, now)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// The client goes first, a session with a client and no scopes written
+	// allows nothing.
+	err = exchangeAuthorizationCodeUseCase.cache.Set(
+		ctx,
+		strings.Join([]string{authorizationCode.SessionKey, "%", authorizationCode.UserId}, ""),
+		client.Id,
+		authorizationCode.SessionExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	// Written even when no scope was granted, an empty value leaves the
+	// session without any permission.
+	err = exchangeAuthorizationCodeUseCase.cache.Set(
+		ctx,
+		strings.Join([]string{authorizationCode.SessionKey, "$", authorizationCode.UserId}, ""),
+		strings.Join(authorizationCode.Scopes, " "),
+		authorizationCode.SessionExpiresAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	// Last, the session only gets its full lifetime once the client and the
+	// scopes are in place.
+	err = cacheSession(ctx, exchangeAuthorizationCodeUseCase.cache, &providers.SessionData{
+		Key:            authorizationCode.SessionKey,
+		UserId:         authorizationCode.UserId,
+		OrganizationId: authorizationCode.OrganizationId,
+		ExpirationDate: authorizationCode.SessionExpiresAt.Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &definitions.ExchangeAuthorizationCodeResult{
+		AccessToken: authorizationCode.SessionKey,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(authorizationCode.SessionExpiresAt.Sub(now).Seconds()),
+		Scopes:      authorizationCode.Scopes,
+		IdToken:     idToken,
+	}, nil
+}
+
+func NewExchangeAuthorizationCodeUseCase(
+	authenticateClient definitions.AuthenticateOAuthClient,
+	authorizationCodes repositories.AuthorizationCodesRepository,
+	users repositories.UsersRepository,
+	cache providers.CacheProvider,
+	signer providers.TokenSignerProvider,
+	openId *definitions.OpenIdOptions,
+	tracer providers.TracerProvider,
+) (*ExchangeAuthorizationCodeUseCase, *shared.Error) {
+	return &ExchangeAuthorizationCodeUseCase{
+		authenticateClient: authenticateClient,
+		authorizationCodes: authorizationCodes,
+		users:              users,
+		cache:              cache,
+		signer:             signer,
+		openId:             openId,
+		tracer:             tracer,
+	}, nil
+}
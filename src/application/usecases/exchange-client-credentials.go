@@ -0,0 +1,140 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type ExchangeClientCredentialsUseCase struct {
+	authenticateClient definitions.AuthenticateOAuthClient
+	session            providers.SessionProvider
+	cache              providers.CacheProvider
+	tracer             providers.TracerProvider
+}
+
+func (exchangeClientCredentialsUseCase *ExchangeClientCredentialsUseCase) grantedScopes(
+	client *entities.OAuthClientEntity, scope string,
+) ([]string, *shared.Error) {
+	requested, ok := parseScopes(scope)
+	if !ok {
+		return nil, exceptions.NewOAuthInvalidScope()
+	}
+	if len(requested) == 0 {
+		return append([]string{}, client.Scopes...), nil
+	}
+	for _, scope := range requested {
+		if !client.HasScope(scope) {
+			return nil, exceptions.NewOAuthInvalidScope()
+		}
+	}
+	return requested, nil
+}
+
+func (exchangeClientCredentialsUseCase *ExchangeClientCredentialsUseCase) grantedAudiences(
+	client *entities.OAuthClientEntity, audiences []string,
+) ([]string, *shared.Error) {
+	if len(audiences) == 0 {
+		return append([]string{}, client.Audiences...), nil
+	}
+	granted := []string{}
+	seen := map[string]bool{}
+	for _, audience := range audiences {
+		if !client.HasAudience(audience) {
+			return nil, exceptions.NewOAuthInvalidTarget()
+		}
+		if !seen[audience] {
+			seen[audience] = true
+			granted = append(granted, audience)
+		}
+	}
+	return granted, nil
+}
+
+func (exchangeClientCredentialsUseCase *ExchangeClientCredentialsUseCase) Execute(
+	ctx context.Context,
+	data *definitions.ExchangeClientCredentialsDTO,
+) (*definitions.ExchangeClientCredentialsResult, *shared.Error) {
+	ctx, span := exchangeClientCredentialsUseCase.tracer.Start(ctx, "ExchangeClientCredentialsUseCase.Execute")
+	defer span.End()
+	result, err := exchangeClientCredentialsUseCase.execute(ctx, data)
+	if err != nil {
+		span.RecordError(err)
+	}
+	return result, err
+}
+
+func (exchangeClientCredentialsUseCase *ExchangeClientCredentialsUseCase) execute(
+	ctx context.Context,
+	data *definitions.ExchangeClientCredentialsDTO,
+) (*definitions.ExchangeClientCredentialsResult, *shared.Error) {
+	if data.Client == nil {
+		return nil, exceptions.NewOAuthInvalidClient()
+	}
+	client, err := exchangeClientCredentialsUseCase.authenticateClient.Execute(ctx, data.Client)
+	if err != nil {
+		return nil, err
+	}
+	if !client.IsConfidential() {
+		return nil, exceptions.NewOAuthUnauthorizedClient()
+	}
+	scopes, err := exchangeClientCredentialsUseCase.grantedScopes(client, data.Scope)
+	if err != nil {
+		return nil, err
+	}
+	audiences, err := exchangeClientCredentialsUseCase.grantedAudiences(client, data.Audiences)
+	if err != nil {
+		return nil, err
+	}
+	// The client is its own subject, the session layer can tell these tokens
+	// apart from user sessions because the subject is also the client id.
+	sessionData, err := exchangeClientCredentialsUseCase.session.
+		Generate(client.Id, client.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt, goerr := time.Parse(time.RFC3339, sessionData.ExpirationDate)
+	if goerr != nil {
+		return nil, exceptions.NewInternalServerError()
+	}
+	for _, entry := range [][2]string{
+		{sessionData.Key, sessionData.UserId},
+		{strings.Join([]string{sessionData.Key, "@", sessionData.UserId}, ""), sessionData.ExpirationDate},
+		{strings.Join([]string{sessionData.Key, "#", sessionData.UserId}, ""), sessionData.OrganizationId},
+		{strings.Join([]string{sessionData.Key, "$", sessionData.UserId}, ""), strings.Join(scopes, " ")},
+		{strings.Join([]string{sessionData.Key, "%", sessionData.UserId}, ""), client.Id},
+		{strings.Join([]string{sessionData.Key, "&", sessionData.UserId}, ""), strings.Join(audiences, " ")},
+	} {
+		err = exchangeClientCredentialsUseCase.cache.Set(ctx, entry[0], entry[1], expiresAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &definitions.ExchangeClientCredentialsResult{
+		AccessToken: sessionData.Key,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(time.Until(expiresAt).Seconds()),
+		Scopes:      scopes,
+		Audiences:   audiences,
+	}, nil
+}
+
+func NewExchangeClientCredentialsUseCase(
+	authenticateClient definitions.AuthenticateOAuthClient,
+	session providers.SessionProvider,
+	cache providers.CacheProvider,
+	tracer providers.TracerProvider,
+) (*ExchangeClientCredentialsUseCase, *shared.Error) {
+	return &ExchangeClientCredentialsUseCase{
+		authenticateClient: authenticateClient,
+		session:            session,
+		cache:              cache,
+		tracer:             tracer,
+	}, nil
+}
@@ -0,0 +1,203 @@
+package usecases
+
+import (
+	"context"
+	"crypto/sha256"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type FinishWebAuthnLoginUseCase struct {
+	users       repositories.UsersRepository
+	memberships repositories.MembershipsRepository
+	credentials repositories.WebAuthnCredentialsRepository
+	challenges  repositories.WebAuthnChallengesRepository
+	webAuthn    providers.WebAuthnProvider
+	session     providers.SessionProvider
+	cache       providers.CacheProvider
+	options     *definitions.WebAuthnOptions
+	auditLog    repositories.AuditLogRepository
+}
+
+func (finishWebAuthnLoginUseCase *FinishWebAuthnLoginUseCase) findCredential(
+	ctx context.Context,
+	data *definitions.FinishWebAuthnLoginDTO,
+	challenge *entities.WebAuthnChallengeEntity,
+) (*entities.WebAuthnCredentialEntity, *shared.Error) {
+	credential, err := finishWebAuthnLoginUseCase.credentials.
+		FindByCredentialId(ctx, data.CredentialId)
+	if err != nil {
+		return nil, err
+	}
+	if credential == nil {
+		return nil, exceptions.NewWebAuthnLoginFailed()
+	}
+	if challenge.UserId != "" && challenge.UserId != credential.UserId {
+		return nil, exceptions.NewWebAuthnLoginFailed()
+	}
+	if data.UserHandle != "" {
+		userHandle, err := decodeWebAuthnField(data.UserHandle)
+		if err != nil {
+			return nil, err
+		}
+		if string(userHandle) != credential.UserId {
+			return nil, exceptions.NewWebAuthnLoginFailed()
+		}
+	}
+	return credential, nil
+}
+
+// The signature covers the authenticator data followed by the hash of the
+// client data, WebAuthn section 7.2 step 20.
+func (finishWebAuthnLoginUseCase *FinishWebAuthnLoginUseCase) verifyAssertion(
+	credential *entities.WebAuthnCredentialEntity,
+	clientDataJson []byte,
+	authenticatorData []byte,
+	signature []byte,
+) (*providers.WebAuthnAuthenticatorData, *shared.Error) {
+	parsed, err := finishWebAuthnLoginUseCase.webAuthn.
+		ParseAuthenticatorData(authenticatorData)
+	if err != nil {
+		return nil, err
+	}
+	if !isWebAuthnAuthenticatorDataValid(finishWebAuthnLoginUseCase.options, parsed) {
+		return nil, exceptions.NewWebAuthnLoginFailed()
+	}
+	publicKey, err := decodeWebAuthnField(credential.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	clientDataHash := sha256.Sum256(clientDataJson)
+	signed := append(append([]byte{}, authenticatorData...), clientDataHash[:]...)
+	valid, err := finishWebAuthnLoginUseCase.webAuthn.
+		VerifySignature(publicKey, signed, signature)
+	if err != nil {
+		return nil, err
+	}
+	if !valid {
+		return nil, exceptions.NewWebAuthnLoginFailed()
+	}
+	return parsed, nil
+}
+
+// A verified passkey already proves possession and the user's presence, so
+// the login skips the one-time code even when it is enabled.
+func (finishWebAuthnLoginUseCase *FinishWebAuthnLoginUseCase) Execute(
+	ctx context.Context,
+	data *definitions.FinishWebAuthnLoginDTO,
+) (*definitions.FinishWebAuthnLoginResult, *shared.Error) {
+	clientDataJson, err := decodeWebAuthnField(data.ClientDataJson)
+	if err != nil {
+		return nil, err
+	}
+	authenticatorData, err := decodeWebAuthnField(data.AuthenticatorData)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := decodeWebAuthnField(data.Signature)
+	if err != nil {
+		return nil, err
+	}
+	challenge, err := verifyWebAuthnClientData(
+		ctx,
+		finishWebAuthnLoginUseCase.challenges,
+		finishWebAuthnLoginUseCase.options,
+		clientDataJson, entities.WebAuthnCeremonyAuthentication,
+	)
+	if err != nil {
+		return nil, err
+	}
+	credential, err := finishWebAuthnLoginUseCase.findCredential(ctx, data, challenge)
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := finishWebAuthnLoginUseCase.verifyAssertion(
+		credential, clientDataJson, authenticatorData, signature,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !credential.IsSignCountValid(int64(parsed.SignCount)) {
+		return nil, exceptions.NewWebAuthnLoginFailed()
+	}
+	credential.SignCount = int64(parsed.SignCount)
+	credential.UpdatedAt = time.Now().UTC()
+	err = finishWebAuthnLoginUseCase.credentials.Save(ctx, credential)
+	if err != nil {
+		return nil, err
+	}
+	user, err := finishWebAuthnLoginUseCase.users.FindById(ctx, credential.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, exceptions.NewWebAuthnLoginFailed()
+	}
+	organizationId, isMember, err := findLoginOrganization(
+		ctx,
+		finishWebAuthnLoginUseCase.memberships, user, challenge.OrganizationId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !isMember {
+		return nil, exceptions.NewUserLoginFailed()
+	}
+	sessionData, err := finishWebAuthnLoginUseCase.session.
+		Generate(user.Id, organizationId)
+	if err != nil {
+		return nil, err
+	}
+	err = cacheSession(ctx, finishWebAuthnLoginUseCase.cache, sessionData)
+	if err != nil {
+		return nil, err
+	}
+	err = recordAuditEvent(ctx, finishWebAuthnLoginUseCase.auditLog, &dtos.AuditEventDTO{
+		Type:           entities.AuditEventLoginSucceeded,
+		ActorId:        user.Id,
+		TargetId:       user.Id,
+		OrganizationId: sessionData.OrganizationId,
+		IpAddress:      data.IpAddress,
+		UserAgent:      data.UserAgent,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &definitions.FinishWebAuthnLoginResult{
+		User:           user,
+		SessionKey:     sessionData.Key,
+		OrganizationId: sessionData.OrganizationId,
+		ExpirationDate: sessionData.ExpirationDate,
+	}, nil
+}
+
+func NewFinishWebAuthnLoginUseCase(
+	users repositories.UsersRepository,
+	memberships repositories.MembershipsRepository,
+	credentials repositories.WebAuthnCredentialsRepository,
+	challenges repositories.WebAuthnChallengesRepository,
+	webAuthn providers.WebAuthnProvider,
+	session providers.SessionProvider,
+	cache providers.CacheProvider,
+	options *definitions.WebAuthnOptions,
+	auditLog repositories.AuditLogRepository,
+) (*FinishWebAuthnLoginUseCase, *shared.Error) {
+	return &FinishWebAuthnLoginUseCase{
+		users:       users,
+		memberships: memberships,
+		credentials: credentials,
+		challenges:  challenges,
+		webAuthn:    webAuthn,
+		session:     session,
+		cache:       cache,
+		options:     options,
+		auditLog:    auditLog,
+	}, nil
+}
@@ -0,0 +1,173 @@
+package usecases
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type webAuthnClientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+// Browsers omit the padding but some libraries keep it.
+func decodeWebAuthnField(field string) ([]byte, *shared.Error) {
+	decoded, goerr := base64.RawURLEncoding.DecodeString(strings.TrimRight(field, "="))
+	if goerr != nil || len(decoded) == 0 {
+		return nil, exceptions.NewInvalidWebAuthnResponse()
+	}
+	return decoded, nil
+}
+
+// WebAuthn sections 7.1 and 7.2, steps shared by both ceremonies. The
+// challenge is consumed before anything else is looked at so a response can't
+// be replayed even when it fails further down.
+func verifyWebAuthnClientData(
+	ctx context.Context,
+	challenges repositories.WebAuthnChallengesRepository,
+	options *definitions.WebAuthnOptions,
+	clientDataJson []byte,
+	ceremony string,
+) (*entities.WebAuthnChallengeEntity, *shared.Error) {
+	clientData := &webAuthnClientData{}
+	goerr := json.Unmarshal(clientDataJson, clientData)
+	if goerr != nil {
+		return nil, exceptions.NewInvalidWebAuthnResponse()
+	}
+	raw, err := decodeWebAuthnField(clientData.Challenge)
+	if err != nil {
+		return nil, exceptions.NewInvalidWebAuthnChallenge()
+	}
+	challenge, err := challenges.Consume(ctx, string(raw))
+	if err != nil {
+		return nil, err
+	}
+	if challenge == nil || challenge.Ceremony != ceremony ||
+		challenge.IsExpired(time.Now().UTC()) {
+		return nil, exceptions.NewInvalidWebAuthnChallenge()
+	}
+	expectedType := "webauthn.get"
+	if ceremony == entities.WebAuthnCeremonyRegistration {
+		expectedType = "webauthn.create"
+	}
+	if clientData.Type != expectedType || clientData.CrossOrigin {
+		return nil, exceptions.NewInvalidWebAuthnResponse()
+	}
+	for _, origin := range options.Origins {
+		if clientData.Origin == origin {
+			return challenge, nil
+		}
+	}
+	return nil, exceptions.NewInvalidWebAuthnResponse()
+}
+
+// Passkeys stand for both factors at once, so user verification is required
+// and not only user presence.
+func isWebAuthnAuthenticatorDataValid(
+	options *definitions.WebAuthnOptions,
+	authenticatorData *providers.WebAuthnAuthenticatorData,
+) bool {
+	rpIdHash := sha256.Sum256([]byte(options.RpId))
+	return subtle.ConstantTimeCompare(rpIdHash[:], authenticatorData.RpIdHash) == 1 &&
+		authenticatorData.UserPresent && authenticatorData.UserVerified
+}
+
+type FinishWebAuthnRegistrationUseCase struct {
+	credentials repositories.WebAuthnCredentialsRepository
+	challenges  repositories.WebAuthnChallengesRepository
+	webAuthn    providers.WebAuthnProvider
+	options     *definitions.WebAuthnOptions
+}
+
+func (finishWebAuthnRegistrationUseCase *FinishWebAuthnRegistrationUseCase) Execute(
+	ctx context.Context,
+	data *definitions.FinishWebAuthnRegistrationDTO,
+) (*definitions.FinishWebAuthnRegistrationResult, *shared.Error) {
+	if data.ActorId == "" {
+		return nil, exceptions.NewUserNotFound()
+	}
+	clientDataJson, err := decodeWebAuthnField(data.ClientDataJson)
+	if err != nil {
+		return nil, err
+	}
+	attestationObject, err := decodeWebAuthnField(data.AttestationObject)
+	if err != nil {
+		return nil, err
+	}
+	challenge, err := verifyWebAuthnClientData(
+		ctx,
+		finishWebAuthnRegistrationUseCase.challenges,
+		finishWebAuthnRegistrationUseCase.options,
+		clientDataJson, entities.WebAuthnCeremonyRegistration,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if challenge.UserId != data.ActorId {
+		return nil, exceptions.NewInvalidWebAuthnChallenge()
+	}
+	clientDataHash := sha256.Sum256(clientDataJson)
+	authenticatorData, err := finishWebAuthnRegistrationUseCase.webAuthn.
+		VerifyAttestation(attestationObject, clientDataHash[:])
+	if err != nil {
+		return nil, err
+	}
+	if !isWebAuthnAuthenticatorDataValid(
+		finishWebAuthnRegistrationUseCase.options, authenticatorData,
+	) || len(authenticatorData.CredentialId) == 0 {
+		return nil, exceptions.NewInvalidWebAuthnResponse()
+	}
+	credentialId := base64.RawURLEncoding.EncodeToString(authenticatorData.CredentialId)
+	existing, err := finishWebAuthnRegistrationUseCase.credentials.
+		FindByCredentialId(ctx, credentialId)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, exceptions.NewWebAuthnCredentialAlreadyRegistered()
+	}
+	credential, err := finishWebAuthnRegistrationUseCase.credentials.Create(&dtos.WebAuthnCredentialDTO{
+		UserId:       data.ActorId,
+		CredentialId: credentialId,
+		PublicKey:    base64.RawURLEncoding.EncodeToString(authenticatorData.PublicKey),
+		SignCount:    int64(authenticatorData.SignCount),
+		Transports:   data.Transports,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = finishWebAuthnRegistrationUseCase.credentials.Save(ctx, credential)
+	if err != nil {
+		return nil, err
+	}
+	return credential, nil
+}
+
+func NewFinishWebAuthnRegistrationUseCase(
+	credentials repositories.WebAuthnCredentialsRepository,
+	challenges repositories.WebAuthnChallengesRepository,
+	webAuthn providers.WebAuthnProvider,
+	options *definitions.WebAuthnOptions,
+) (*FinishWebAuthnRegistrationUseCase, *shared.Error) {
+	return &FinishWebAuthnRegistrationUseCase{
+		credentials: credentials,
+		challenges:  challenges,
+		webAuthn:    webAuthn,
+		options:     options,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type GetMfaStatusUseCase struct {
+	users repositories.UsersRepository
+}
+
+func (getMfaStatusUseCase *GetMfaStatusUseCase) Execute(
+	ctx context.Context,
+	data *definitions.GetMfaStatusDTO,
+) (*definitions.GetMfaStatusResult, *shared.Error) {
+	if data.ActorId == "" {
+		return nil, exceptions.NewUserNotFound()
+	}
+	user, err := getMfaStatusUseCase.users.FindById(ctx, data.ActorId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, exceptions.NewUserNotFound()
+	}
+	if !user.MfaEnabled {
+		return &definitions.GetMfaStatusResult{}, nil
+	}
+	return &definitions.GetMfaStatusResult{
+		Enabled:                true,
+		RecoveryCodesRemaining: len(user.MfaRecoveryCodes),
+	}, nil
+}
+
+func NewGetMfaStatusUseCase(
+	users repositories.UsersRepository,
+) (*GetMfaStatusUseCase, *shared.Error) {
+	return &GetMfaStatusUseCase{
+		users: users,
+	}, nil
+}
@@ -0,0 +1,36 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type GetPasswordPolicyUseCase struct {
+	policy *entities.PasswordPolicyEntity
+}
+
+func (getPasswordPolicyUseCase *GetPasswordPolicyUseCase) Execute(
+	ctx context.Context,
+	data *definitions.GetPasswordPolicyDTO,
+) (*definitions.GetPasswordPolicyResult, *shared.Error) {
+	policy := getPasswordPolicyUseCase.policy
+	return &definitions.GetPasswordPolicyResult{
+		MinLength:               policy.MinLength,
+		MaxLength:               policy.MaxLength,
+		RequiredClasses:         append([]string{}, policy.RequiredClasses...),
+		MaxRepeatedCharacters:   policy.MaxRepeatedCharacters,
+		DisallowUserInfo:        policy.DisallowUserInfo,
+		DisallowDictionaryWords: policy.HasDictionary(),
+	}, nil
+}
+
+func NewGetPasswordPolicyUseCase(
+	policy *entities.PasswordPolicyEntity,
+) (*GetPasswordPolicyUseCase, *shared.Error) {
+	return &GetPasswordPolicyUseCase{
+		policy: policy,
+	}, nil
+}
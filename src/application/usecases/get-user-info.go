@@ -0,0 +1,58 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+func userClaims(user *entities.UserEntity, scopes []string) map[string]interface{} {
+	claims := map[string]interface{}{
+		"sub": user.Id,
+	}
+	if hasScope(scopes, "profile") {
+		claims["preferred_username"] = user.Username
+		claims["updated_at"] = user.UpdatedAt.Unix()
+	}
+	if hasScope(scopes, "email") {
+		claims["email"] = user.Email
+		claims["email_verified"] = user.EmailVerified
+	}
+	return claims
+}
+
+type GetUserInfoUseCase struct {
+	users repositories.UsersRepository
+}
+
+func (getUserInfoUseCase *GetUserInfoUseCase) Execute(
+	ctx context.Context,
+	data *definitions.GetUserInfoDTO,
+) (definitions.GetUserInfoResult, *shared.Error) {
+	if !hasScope(data.Scopes, "openid") {
+		return nil, exceptions.NewOAuthInsufficientScope()
+	}
+	if data.UserId == "" {
+		return nil, exceptions.NewUserNotFound()
+	}
+	user, err := getUserInfoUseCase.users.FindById(ctx, data.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, exceptions.NewUserNotFound()
+	}
+	return userClaims(user, data.Scopes), nil
+}
+
+func NewGetUserInfoUseCase(
+	users repositories.UsersRepository,
+) (*GetUserInfoUseCase, *shared.Error) {
+	return &GetUserInfoUseCase{
+		users: users,
+	}, nil
+}
@@ -0,0 +1,80 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type IntrospectTokenUseCase struct {
+	authenticateClient  definitions.AuthenticateOAuthClient
+	authenticateSession definitions.AuthenticateSession
+}
+
+func (introspectTokenUseCase *IntrospectTokenUseCase) Execute(
+	ctx context.Context,
+	data *definitions.IntrospectTokenDTO,
+) (*definitions.IntrospectTokenResult, *shared.Error) {
+	if data.Client == nil {
+		return nil, exceptions.NewOAuthInvalidClient()
+	}
+	client, err := introspectTokenUseCase.authenticateClient.Execute(ctx, data.Client)
+	if err != nil {
+		return nil, err
+	}
+	// Introspection tells a lot about a token, only clients that can keep a
+	// secret, usually resource servers, are allowed to ask.
+	if !client.IsConfidential() {
+		return nil, exceptions.NewOAuthUnauthorizedClient()
+	}
+	if data.Token == "" {
+		return nil, exceptions.NewOAuthInvalidRequest()
+	}
+	inactive := &definitions.IntrospectTokenResult{Active: false}
+	session, err := introspectTokenUseCase.authenticateSession.
+		Execute(ctx, &definitions.AuthenticateSessionDTO{
+			SessionKey: data.Token,
+		})
+	if err != nil && err.Name == "SessionNotFound" {
+		return inactive, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	// Tokens of another organization are reported as inactive, a client must
+	// not be able to probe the tokens of other tenants. Sessions outside any
+	// organization are only seen by clients outside any organization too.
+	if session.OrganizationId != client.OrganizationId {
+		return inactive, nil
+	}
+	subject := session.UserId
+	if subject == "" {
+		subject = session.ClientId
+	}
+	var expiresAt int64
+	if session.ExpiresAt != (time.Time{}) {
+		expiresAt = session.ExpiresAt.Unix()
+	}
+	return &definitions.IntrospectTokenResult{
+		Active:         true,
+		Subject:        subject,
+		ClientId:       session.ClientId,
+		OrganizationId: session.OrganizationId,
+		Scopes:         session.Scopes,
+		Audiences:      session.Audiences,
+		ExpiresAt:      expiresAt,
+	}, nil
+}
+
+func NewIntrospectTokenUseCase(
+	authenticateClient definitions.AuthenticateOAuthClient,
+	authenticateSession definitions.AuthenticateSession,
+) (*IntrospectTokenUseCase, *shared.Error) {
+	return &IntrospectTokenUseCase{
+		authenticateClient:  authenticateClient,
+		authenticateSession: authenticateSession,
+	}, nil
+}
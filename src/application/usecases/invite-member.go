@@ -0,0 +1,92 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type InviteMemberUseCase struct {
+	users       repositories.UsersRepository
+	memberships repositories.MembershipsRepository
+	auditLog    repositories.AuditLogRepository
+}
+
+func (inviteMemberUseCase *InviteMemberUseCase) Execute(
+	ctx context.Context,
+	data *definitions.InviteMemberDTO,
+) (*definitions.InviteMemberResult, *shared.Error) {
+	role := data.Role
+	if role == "" {
+		role = entities.MembershipRoleMember
+	}
+	actor, err := inviteMemberUseCase.memberships.
+		FindByOrganizationIdAndUserId(ctx, data.OrganizationId, data.ActorId)
+	if err != nil {
+		return nil, err
+	}
+	if actor == nil || !actor.CanManageMembers() {
+		return nil, exceptions.NewOrganizationPermissionDenied()
+	}
+	if role == entities.MembershipRoleOwner && !actor.IsOwner() {
+		return nil, exceptions.NewOrganizationPermissionDenied()
+	}
+	user, err := inviteMemberUseCase.users.FindById(ctx, data.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, exceptions.NewUserNotFound()
+	}
+	found, err := inviteMemberUseCase.memberships.
+		FindByOrganizationIdAndUserId(ctx, data.OrganizationId, user.Id)
+	if err != nil {
+		return nil, err
+	}
+	if found != nil {
+		return nil, exceptions.NewMembershipAlreadyExists()
+	}
+	membership, err := inviteMemberUseCase.memberships.
+		Create(&dtos.MembershipDTO{
+			OrganizationId: data.OrganizationId,
+			UserId:         user.Id,
+			Role:           role,
+		})
+	if err != nil {
+		return nil, err
+	}
+	err = inviteMemberUseCase.memberships.Save(ctx, membership)
+	if err != nil {
+		return nil, err
+	}
+	err = recordAuditEvent(ctx, inviteMemberUseCase.auditLog, &dtos.AuditEventDTO{
+		Type:           entities.AuditEventRoleGranted,
+		ActorId:        data.ActorId,
+		TargetId:       user.Id,
+		OrganizationId: data.OrganizationId,
+		IpAddress:      data.IpAddress,
+		UserAgent:      data.UserAgent,
+		Detail:         role,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return membership, nil
+}
+
+func NewInviteMemberUseCase(
+	users repositories.UsersRepository,
+	memberships repositories.MembershipsRepository,
+	auditLog repositories.AuditLogRepository,
+) (*InviteMemberUseCase, *shared.Error) {
+	return &InviteMemberUseCase{
+		users:       users,
+		memberships: memberships,
+		auditLog:    auditLog,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type ListApiKeysUseCase struct {
+	apiKeys     repositories.ApiKeysRepository
+	memberships repositories.MembershipsRepository
+}
+
+func (listApiKeysUseCase *ListApiKeysUseCase) Execute(
+	ctx context.Context,
+	data *definitions.ListApiKeysDTO,
+) (definitions.ListApiKeysResult, *shared.Error) {
+	if data.OrganizationId == "" {
+		return listApiKeysUseCase.apiKeys.FindByUserId(ctx, data.ActorId)
+	}
+	membership, err := listApiKeysUseCase.memberships.
+		FindByOrganizationIdAndUserId(ctx, data.OrganizationId, data.ActorId)
+	if err != nil {
+		return nil, err
+	}
+	if membership == nil || !membership.CanManageMembers() {
+		return nil, exceptions.NewOrganizationPermissionDenied()
+	}
+	return listApiKeysUseCase.apiKeys.FindServiceAccountKeys(ctx, data.OrganizationId)
+}
+
+func NewListApiKeysUseCase(
+	apiKeys repositories.ApiKeysRepository,
+	memberships repositories.MembershipsRepository,
+) (*ListApiKeysUseCase, *shared.Error) {
+	return &ListApiKeysUseCase{
+		apiKeys:     apiKeys,
+		memberships: memberships,
+	}, nil
+}
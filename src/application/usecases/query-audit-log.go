@@ -0,0 +1,123 @@
+package usecases
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+const (
+	auditLogDefaultPageSize = 50
+	auditLogMaxPageSize     = 200
+)
+
+var auditLogPageTokenRegex = regexp.MustCompile("^[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}$")
+
+type QueryAuditLogUseCase struct {
+	auditLog    repositories.AuditLogRepository
+	memberships repositories.MembershipsRepository
+}
+
+func (queryAuditLogUseCase *QueryAuditLogUseCase) validate(
+	data *definitions.QueryAuditLogDTO,
+) *shared.Error {
+	if data.PageSize < 0 || data.PageSize > auditLogMaxPageSize {
+		return exceptions.NewInvalidAuditLogQuery()
+	}
+	if data.PageToken != "" && !auditLogPageTokenRegex.Match([]byte(data.PageToken)) {
+		return exceptions.NewInvalidAuditLogQuery()
+	}
+	if !data.Since.IsZero() && !data.Until.IsZero() && data.Since.After(data.Until) {
+		return exceptions.NewInvalidAuditLogQuery()
+	}
+	if data.Type == "" {
+		return nil
+	}
+	for _, eventType := range entities.AuditEventTypes {
+		if data.Type == eventType {
+			return nil
+		}
+	}
+	return exceptions.NewInvalidAuditEventType()
+}
+
+// Owners and admins see every event of the organization they are logged
+// into, anybody else only the events they did or were the target of.
+func (queryAuditLogUseCase *QueryAuditLogUseCase) scope(
+	ctx context.Context,
+	data *definitions.QueryAuditLogDTO, filter *dtos.AuditLogFilterDTO,
+) *shared.Error {
+	if data.OrganizationId != "" {
+		membership, err := queryAuditLogUseCase.memberships.
+			FindByOrganizationIdAndUserId(ctx, data.OrganizationId, data.ActorId)
+		if err != nil {
+			return err
+		}
+		if membership != nil && membership.CanManageMembers() {
+			if filter.OrganizationId != "" && filter.OrganizationId != data.OrganizationId {
+				return exceptions.NewOrganizationPermissionDenied()
+			}
+			filter.OrganizationId = data.OrganizationId
+			return nil
+		}
+	}
+	filter.Participant = data.ActorId
+	return nil
+}
+
+func (queryAuditLogUseCase *QueryAuditLogUseCase) Execute(
+	ctx context.Context,
+	data *definitions.QueryAuditLogDTO,
+) (*definitions.QueryAuditLogResult, *shared.Error) {
+	if data.ActorId == "" {
+		return nil, exceptions.NewUserNotFound()
+	}
+	err := queryAuditLogUseCase.validate(data)
+	if err != nil {
+		return nil, err
+	}
+	pageSize := data.PageSize
+	if pageSize == 0 {
+		pageSize = auditLogDefaultPageSize
+	}
+	filter := &dtos.AuditLogFilterDTO{
+		Type:           data.Type,
+		ActorId:        data.FilterActorId,
+		TargetId:       data.FilterTargetId,
+		OrganizationId: data.FilterOrganizationId,
+		Since:          data.Since,
+		Until:          data.Until,
+		Cursor:         data.PageToken,
+		Limit:          pageSize + 1,
+	}
+	err = queryAuditLogUseCase.scope(ctx, data, filter)
+	if err != nil {
+		return nil, err
+	}
+	events, err := queryAuditLogUseCase.auditLog.Query(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	result := &definitions.QueryAuditLogResult{Events: events}
+	if len(events) > pageSize {
+		result.Events = events[:pageSize]
+		result.NextPageToken = events[pageSize-1].Id
+	}
+	return result, nil
+}
+
+func NewQueryAuditLogUseCase(
+	auditLog repositories.AuditLogRepository,
+	memberships repositories.MembershipsRepository,
+) (*QueryAuditLogUseCase, *shared.Error) {
+	return &QueryAuditLogUseCase{
+		auditLog:    auditLog,
+		memberships: memberships,
+	}, nil
+}
@@ -0,0 +1,100 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+const recoveryCodesCount = 10
+
+// Codes are shown as two groups of five characters, the dash and any spaces
+// are ignored when one is typed back.
+func normalizeRecoveryCode(code string) string {
+	return strings.Join(strings.Fields(strings.ReplaceAll(code, "-", " ")), "")
+}
+
+func generateRecoveryCodes(
+	random providers.RandomProvider, digest providers.DigestProvider,
+) ([]string, []string, *shared.Error) {
+	codes := make([]string, 0, recoveryCodesCount)
+	hashes := make([]string, 0, recoveryCodesCount)
+	for len(codes) < recoveryCodesCount {
+		code, err := random.String(10)
+		if err != nil {
+			return nil, nil, err
+		}
+		codes = append(codes, code[:5]+"-"+code[5:])
+		hashes = append(hashes, digest.Digest(code))
+	}
+	return codes, hashes, nil
+}
+
+type RegenerateRecoveryCodesUseCase struct {
+	users     repositories.UsersRepository
+	encrypter providers.EncrypterProvider
+	digest    providers.DigestProvider
+	random    providers.RandomProvider
+}
+
+func (regenerateRecoveryCodesUseCase *RegenerateRecoveryCodesUseCase) Execute(
+	ctx context.Context,
+	data *definitions.RegenerateRecoveryCodesDTO,
+) (*definitions.RegenerateRecoveryCodesResult, *shared.Error) {
+	if data.ActorId == "" {
+		return nil, exceptions.NewUserNotFound()
+	}
+	user, err := regenerateRecoveryCodesUseCase.users.FindById(ctx, data.ActorId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, exceptions.NewUserNotFound()
+	}
+	passwordMatches, err := regenerateRecoveryCodesUseCase.encrypter.
+		Compare(ctx, data.Password, user.Password)
+	if err != nil {
+		return nil, err
+	}
+	if !passwordMatches {
+		return nil, exceptions.NewUserReauthenticationFailed()
+	}
+	if !user.MfaEnabled {
+		return nil, exceptions.NewMfaNotEnrolled()
+	}
+	codes, hashes, err := generateRecoveryCodes(
+		regenerateRecoveryCodesUseCase.random, regenerateRecoveryCodesUseCase.digest,
+	)
+	if err != nil {
+		return nil, err
+	}
+	user.MfaRecoveryCodes = hashes
+	user.UpdatedAt = time.Now().UTC()
+	err = regenerateRecoveryCodesUseCase.users.Save(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	return &definitions.RegenerateRecoveryCodesResult{
+		RecoveryCodes: codes,
+	}, nil
+}
+
+func NewRegenerateRecoveryCodesUseCase(
+	users repositories.UsersRepository,
+	encrypter providers.EncrypterProvider,
+	digest providers.DigestProvider,
+	random providers.RandomProvider,
+) (*RegenerateRecoveryCodesUseCase, *shared.Error) {
+	return &RegenerateRecoveryCodesUseCase{
+		users:     users,
+		encrypter: encrypter,
+		digest:    digest,
+		random:    random,
+	}, nil
+}
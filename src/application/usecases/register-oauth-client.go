@@ -0,0 +1,88 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+const oauthClientSecretLength = 48
+
+type RegisterOAuthClientUseCase struct {
+	clients     repositories.OAuthClientsRepository
+	memberships repositories.MembershipsRepository
+	encrypter   providers.EncrypterProvider
+	random      providers.RandomProvider
+}
+
+func (registerOAuthClientUseCase *RegisterOAuthClientUseCase) Execute(
+	ctx context.Context,
+	data *definitions.RegisterOAuthClientDTO,
+) (*definitions.RegisterOAuthClientResult, *shared.Error) {
+	membership, err := registerOAuthClientUseCase.memberships.
+		FindByOrganizationIdAndUserId(ctx, data.OrganizationId, data.ActorId)
+	if err != nil {
+		return nil, err
+	}
+	if membership == nil || !membership.CanManageMembers() {
+		return nil, exceptions.NewOrganizationPermissionDenied()
+	}
+	authMethod := data.AuthMethod
+	if authMethod == "" {
+		authMethod = entities.OAuthClientAuthMethodNone
+	}
+	var secret, secretHash string
+	if authMethod == entities.OAuthClientAuthMethodClientSecretBasic ||
+		authMethod == entities.OAuthClientAuthMethodClientSecretPost {
+		secret, err = registerOAuthClientUseCase.random.String(oauthClientSecretLength)
+		if err != nil {
+			return nil, err
+		}
+		secretHash, err = registerOAuthClientUseCase.encrypter.Hash(ctx, secret)
+		if err != nil {
+			return nil, err
+		}
+	}
+	client, err := registerOAuthClientUseCase.clients.Create(&dtos.OAuthClientDTO{
+		OrganizationId: data.OrganizationId,
+		Name:           strings.TrimSpace(data.Name),
+		RedirectUris:   data.RedirectUris,
+		AuthMethod:     authMethod,
+		SecretHash:     secretHash,
+		PublicKey:      strings.TrimSpace(data.PublicKey),
+		Scopes:         data.Scopes,
+		Audiences:      data.Audiences,
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = registerOAuthClientUseCase.clients.Save(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return &definitions.RegisterOAuthClientResult{
+		Client:       client,
+		ClientSecret: secret,
+	}, nil
+}
+
+func NewRegisterOAuthClientUseCase(
+	clients repositories.OAuthClientsRepository,
+	memberships repositories.MembershipsRepository,
+	encrypter providers.EncrypterProvider,
+	random providers.RandomProvider,
+) (*RegisterOAuthClientUseCase, *shared.Error) {
+	return &RegisterOAuthClientUseCase{
+		clients:     clients,
+		memberships: memberships,
+		encrypter:   encrypter,
+		random:      random,
+	}, nil
+}
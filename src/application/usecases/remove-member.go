@@ -0,0 +1,79 @@
+package usecases
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type RemoveMemberUseCase struct {
+	memberships repositories.MembershipsRepository
+}
+
+func (removeMemberUseCase *RemoveMemberUseCase) countOwners(
+	ctx context.Context,
+	organizationId string,
+) (int, *shared.Error) {
+	members, err := removeMemberUseCase.memberships.
+		FindByOrganizationId(ctx, organizationId)
+	if err != nil {
+		return 0, err
+	}
+	owners := 0
+	for _, member := range members {
+		if member.IsOwner() {
+			owners++
+		}
+	}
+	return owners, nil
+}
+
+func (removeMemberUseCase *RemoveMemberUseCase) Execute(
+	ctx context.Context,
+	data *definitions.RemoveMemberDTO,
+) *shared.Error {
+	actor, err := removeMemberUseCase.memberships.
+		FindByOrganizationIdAndUserId(ctx, data.OrganizationId, data.ActorId)
+	if err != nil {
+		return err
+	}
+	if actor == nil {
+		return exceptions.NewOrganizationPermissionDenied()
+	}
+	target, err := removeMemberUseCase.memberships.
+		FindByOrganizationIdAndUserId(ctx, data.OrganizationId, data.UserId)
+	if err != nil {
+		return err
+	}
+	if target == nil {
+		return exceptions.NewMembershipNotFound()
+	}
+	leaving := actor.Id == target.Id
+	if !leaving && !actor.CanManageMembers() {
+		return exceptions.NewOrganizationPermissionDenied()
+	}
+	if !leaving && target.IsOwner() && !actor.IsOwner() {
+		return exceptions.NewOrganizationPermissionDenied()
+	}
+	if target.IsOwner() {
+		owners, err := removeMemberUseCase.countOwners(ctx, data.OrganizationId)
+		if err != nil {
+			return err
+		}
+		if owners <= 1 {
+			return exceptions.NewMembershipLastOwner()
+		}
+	}
+	return removeMemberUseCase.memberships.Delete(ctx, target.Id)
+}
+
+func NewRemoveMemberUseCase(
+	memberships repositories.MembershipsRepository,
+) (*RemoveMemberUseCase, *shared.Error) {
+	return &RemoveMemberUseCase{
+		memberships: memberships,
+	}, nil
+}
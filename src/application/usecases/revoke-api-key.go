@@ -0,0 +1,77 @@
+package usecases
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type RevokeApiKeyUseCase struct {
+	apiKeys     repositories.ApiKeysRepository
+	memberships repositories.MembershipsRepository
+}
+
+func (revokeApiKeyUseCase *RevokeApiKeyUseCase) authorize(
+	ctx context.Context,
+	actorId string, organizationId string,
+) *shared.Error {
+	if organizationId == "" {
+		return exceptions.NewApiKeyPermissionDenied()
+	}
+	membership, err := revokeApiKeyUseCase.memberships.
+		FindByOrganizationIdAndUserId(ctx, organizationId, actorId)
+	if err != nil {
+		return err
+	}
+	if membership == nil || !membership.CanManageMembers() {
+		return exceptions.NewApiKeyPermissionDenied()
+	}
+	return nil
+}
+
+func (revokeApiKeyUseCase *RevokeApiKeyUseCase) Execute(
+	ctx context.Context,
+	data *definitions.RevokeApiKeyDTO,
+) (*definitions.RevokeApiKeyResult, *shared.Error) {
+	apiKey, err := revokeApiKeyUseCase.apiKeys.FindById(ctx, data.ApiKeyId)
+	if err != nil {
+		return nil, err
+	}
+	if apiKey == nil {
+		return nil, exceptions.NewApiKeyNotFound()
+	}
+	if apiKey.UserId != data.ActorId {
+		if !apiKey.IsServiceAccount() {
+			return nil, exceptions.NewApiKeyNotFound()
+		}
+		err = revokeApiKeyUseCase.authorize(ctx, data.ActorId, apiKey.OrganizationId)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if apiKey.IsRevoked() {
+		return apiKey, nil
+	}
+	now := time.Now().UTC()
+	apiKey.RevokedAt = now
+	apiKey.UpdatedAt = now
+	err = revokeApiKeyUseCase.apiKeys.Save(ctx, apiKey)
+	if err != nil {
+		return nil, err
+	}
+	return apiKey, nil
+}
+
+func NewRevokeApiKeyUseCase(
+	apiKeys repositories.ApiKeysRepository,
+	memberships repositories.MembershipsRepository,
+) (*RevokeApiKeyUseCase, *shared.Error) {
+	return &RevokeApiKeyUseCase{
+		apiKeys:     apiKeys,
+		memberships: memberships,
+	}, nil
+}
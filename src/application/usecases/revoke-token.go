@@ -0,0 +1,83 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type RevokeTokenUseCase struct {
+	authenticateClient definitions.AuthenticateOAuthClient
+	cache              providers.CacheProvider
+	auditLog           repositories.AuditLogRepository
+}
+
+// Following RFC 7009 section 2.2, unknown tokens and tokens issued to other
+// clients are answered like a successful revocation, the caller learns
+// nothing about them.
+func (revokeTokenUseCase *RevokeTokenUseCase) Execute(
+	ctx context.Context,
+	data *definitions.RevokeTokenDTO,
+) *shared.Error {
+	if data.Client == nil {
+		return exceptions.NewOAuthInvalidClient()
+	}
+	client, err := revokeTokenUseCase.authenticateClient.Execute(ctx, data.Client)
+	if err != nil {
+		return err
+	}
+	if data.Token == "" {
+		return exceptions.NewOAuthInvalidRequest()
+	}
+	_, isApiKey := splitApiKey(data.Token)
+	if isApiKey {
+		return exceptions.NewOAuthUnsupportedTokenType()
+	}
+	cache := revokeTokenUseCase.cache
+	subject, err := cache.Get(ctx, data.Token)
+	if err != nil {
+		return err
+	}
+	if subject == "" {
+		return nil
+	}
+	clientId, err := cache.Get(ctx, strings.Join([]string{data.Token, "%", subject}, ""))
+	if err != nil {
+		return err
+	}
+	if clientId != client.Id {
+		return nil
+	}
+	for _, key := range sessionCacheKeys(data.Token, subject) {
+		err = cache.Delete(ctx, key)
+		if err != nil {
+			return err
+		}
+	}
+	return recordAuditEvent(ctx, revokeTokenUseCase.auditLog, &dtos.AuditEventDTO{
+		Type:      entities.AuditEventSessionRevoked,
+		ActorId:   client.Id,
+		TargetId:  subject,
+		IpAddress: data.IpAddress,
+		UserAgent: data.UserAgent,
+	})
+}
+
+func NewRevokeTokenUseCase(
+	authenticateClient definitions.AuthenticateOAuthClient,
+	cache providers.CacheProvider,
+	auditLog repositories.AuditLogRepository,
+) (*RevokeTokenUseCase, *shared.Error) {
+	return &RevokeTokenUseCase{
+		authenticateClient: authenticateClient,
+		cache:              cache,
+		auditLog:           auditLog,
+	}, nil
+}
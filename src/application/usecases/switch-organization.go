@@ -0,0 +1,68 @@
+package usecases
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type SwitchOrganizationUseCase struct {
+	memberships repositories.MembershipsRepository
+	cache       providers.CacheProvider
+}
+
+func (switchOrganizationUseCase *SwitchOrganizationUseCase) Execute(
+	ctx context.Context,
+	data *definitions.SwitchOrganizationDTO,
+) (*definitions.SwitchOrganizationResult, *shared.Error) {
+	if data.OrganizationId != "" {
+		membership, err := switchOrganizationUseCase.memberships.
+			FindByOrganizationIdAndUserId(ctx, data.OrganizationId, data.UserId)
+		if err != nil {
+			return nil, err
+		}
+		if membership == nil {
+			return nil, exceptions.NewMembershipNotFound()
+		}
+	}
+	// The organization goes away together with the rest of the session.
+	expirationDate, err := switchOrganizationUseCase.cache.
+		Get(ctx, strings.Join([]string{data.SessionKey, "@", data.UserId}, ""))
+	if err != nil {
+		return nil, err
+	}
+	expiresAt, goerr := time.Parse(time.RFC3339, expirationDate)
+	if goerr != nil {
+		return nil, exceptions.NewSessionNotFound()
+	}
+	err = switchOrganizationUseCase.cache.
+		Set(
+			ctx,
+			strings.Join([]string{data.SessionKey, "#", data.UserId}, ""),
+			data.OrganizationId,
+			expiresAt,
+		)
+	if err != nil {
+		return nil, err
+	}
+	return &definitions.SwitchOrganizationResult{
+		SessionKey:     data.SessionKey,
+		OrganizationId: data.OrganizationId,
+	}, nil
+}
+
+func NewSwitchOrganizationUseCase(
+	memberships repositories.MembershipsRepository,
+	cache providers.CacheProvider,
+) (*SwitchOrganizationUseCase, *shared.Error) {
+	return &SwitchOrganizationUseCase{
+		memberships: memberships,
+		cache:       cache,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package usecases
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+func parseScopes(scope string) ([]string, bool) {
+	regex := regexp.MustCompile(`^[a-z][a-z0-9_.:-]{0,63}$`)
+	scopes := []string{}
+	seen := map[string]bool{}
+	for _, token := range strings.Fields(scope) {
+		if !regex.Match([]byte(token)) {
+			return nil, false
+		}
+		if !seen[token] {
+			seen[token] = true
+			scopes = append(scopes, token)
+		}
+	}
+	return scopes, true
+}
+
+type ValidateAuthorizationRequestUseCase struct {
+	clients repositories.OAuthClientsRepository
+}
+
+func (validateAuthorizationRequestUseCase *ValidateAuthorizationRequestUseCase) Execute(
+	ctx context.Context,
+	data *definitions.AuthorizationRequestDTO,
+) (*definitions.ValidateAuthorizationRequestResult, *shared.Error) {
+	if data.ClientId == "" {
+		return nil, exceptions.NewOAuthInvalidClient()
+	}
+	client, err := validateAuthorizationRequestUseCase.clients.FindById(ctx, data.ClientId)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil {
+		return nil, exceptions.NewOAuthInvalidClient()
+	}
+	if !client.HasRedirectUri(data.RedirectUri) {
+		return nil, exceptions.NewOAuthInvalidRedirectUri()
+	}
+	if data.ResponseType != "code" {
+		return nil, exceptions.NewOAuthUnsupportedResponseType()
+	}
+	if !entities.IsCodeChallengeValid(data.CodeChallengeMethod, data.CodeChallenge) {
+		return nil, exceptions.NewOAuthInvalidCodeChallenge()
+	}
+	scopes, ok := parseScopes(data.Scope)
+	if !ok {
+		return nil, exceptions.NewOAuthInvalidScope()
+	}
+	for _, scope := range scopes {
+		if !client.HasScope(scope) {
+			return nil, exceptions.NewOAuthInvalidScope()
+		}
+	}
+	return &definitions.ValidateAuthorizationRequestResult{
+		Client: client,
+		Scopes: scopes,
+	}, nil
+}
+
+func NewValidateAuthorizationRequestUseCase(
+	clients repositories.OAuthClientsRepository,
+) (*ValidateAuthorizationRequestUseCase, *shared.Error) {
+	return &ValidateAuthorizationRequestUseCase{
+		clients: clients,
+	}, nil
+}
@@ -0,0 +1,235 @@
+package usecases
+
+import (
+	"context"
+	"crypto/subtle"
+	"regexp"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/application/repositories"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+const (
+	totpPeriod           = 30
+	totpDigits           = 6
+	mfaChallengeLifetime = 5 * time.Minute
+)
+
+var totpCodeRegex = regexp.MustCompile(`^[0-9]{6}$`)
+
+// Accepts the current time step and its direct neighbours to tolerate clock
+// drift, every candidate is compared so the time taken doesn't tell which one
+// matched. The matched step is then claimed on the user, a code can therefore
+// be used only once and never after a newer one.
+func verifyTotp(
+	ctx context.Context,
+	users repositories.UsersRepository,
+	cipher providers.CipherProvider,
+	otp providers.OtpProvider,
+	user *entities.UserEntity,
+	code string,
+) *shared.Error {
+	if !totpCodeRegex.Match([]byte(code)) {
+		return exceptions.NewInvalidMfaCode()
+	}
+	secret, err := cipher.Decrypt(user.MfaSecret)
+	if err != nil {
+		return err
+	}
+	current := time.Now().UTC().Unix() / totpPeriod
+	var matchedStep int64 = -1
+	for step := current - 1; step <= current+1; step++ {
+		expected, err := otp.Code(secret, step)
+		if err != nil {
+			return err
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			matchedStep = step
+		}
+	}
+	if matchedStep < 0 {
+		return exceptions.NewInvalidMfaCode()
+	}
+	claimed, err := users.ClaimMfaStep(ctx, user.Id, matchedStep)
+	if err != nil {
+		return err
+	}
+	if !claimed {
+		return exceptions.NewInvalidMfaCode()
+	}
+	return nil
+}
+
+type VerifyMfaUseCase struct {
+	mfaChallenges repositories.MfaChallengesRepository
+	users         repositories.UsersRepository
+	cipher        providers.CipherProvider
+	otp           providers.OtpProvider
+	digest        providers.DigestProvider
+	session       providers.SessionProvider
+	cache         providers.CacheProvider
+	auditLog      repositories.AuditLogRepository
+}
+
+func (verifyMfaUseCase *VerifyMfaUseCase) findChallenge(
+	ctx context.Context,
+	token string,
+) (*entities.MfaChallengeEntity, *shared.Error) {
+	if token == "" {
+		return nil, exceptions.NewInvalidMfaChallenge()
+	}
+	challenge, err := verifyMfaUseCase.mfaChallenges.FindByToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+	if challenge == nil {
+		return nil, exceptions.NewInvalidMfaChallenge()
+	}
+	if challenge.IsExpired(time.Now().UTC()) || !challenge.HasAttemptsLeft() {
+		err = verifyMfaUseCase.mfaChallenges.Delete(ctx, challenge.Token)
+		if err != nil {
+			return nil, err
+		}
+		return nil, exceptions.NewInvalidMfaChallenge()
+	}
+	return challenge, nil
+}
+
+func (verifyMfaUseCase *VerifyMfaUseCase) verifyRecoveryCode(
+	ctx context.Context,
+	user *entities.UserEntity, code string,
+) *shared.Error {
+	normalized := normalizeRecoveryCode(code)
+	if len(normalized) != 10 {
+		return exceptions.NewInvalidMfaCode()
+	}
+	for _, hash := range user.MfaRecoveryCodes {
+		if !verifyMfaUseCase.digest.Matches(normalized, hash) {
+			continue
+		}
+		consumed, err := verifyMfaUseCase.users.ConsumeMfaRecoveryCode(ctx, user.Id, hash)
+		if err != nil {
+			return err
+		}
+		if !consumed {
+			return exceptions.NewInvalidMfaCode()
+		}
+		return nil
+	}
+	return exceptions.NewInvalidMfaCode()
+}
+
+// Six digits are a code from the authenticator app, anything else is taken
+// as one of the recovery codes.
+func (verifyMfaUseCase *VerifyMfaUseCase) verifyCode(
+	ctx context.Context,
+	user *entities.UserEntity, code string,
+) *shared.Error {
+	if totpCodeRegex.Match([]byte(code)) {
+		return verifyTotp(
+			ctx,
+			verifyMfaUseCase.users, verifyMfaUseCase.cipher, verifyMfaUseCase.otp,
+			user, code,
+		)
+	}
+	return verifyMfaUseCase.verifyRecoveryCode(ctx, user, code)
+}
+
+func (verifyMfaUseCase *VerifyMfaUseCase) Execute(
+	ctx context.Context,
+	data *definitions.VerifyMfaDTO,
+) (*definitions.VerifyMfaResult, *shared.Error) {
+	challenge, err := verifyMfaUseCase.findChallenge(ctx, data.MfaToken)
+	if err != nil {
+		return nil, err
+	}
+	user, err := verifyMfaUseCase.users.FindById(ctx, challenge.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || !user.MfaEnabled {
+		err = verifyMfaUseCase.mfaChallenges.Delete(ctx, challenge.Token)
+		if err != nil {
+			return nil, err
+		}
+		return nil, exceptions.NewInvalidMfaChallenge()
+	}
+	err = verifyMfaUseCase.verifyCode(ctx, user, data.Code)
+	if err != nil {
+		challenge.Attempts++
+		saveError := verifyMfaUseCase.mfaChallenges.Save(ctx, challenge)
+		if saveError != nil {
+			return nil, saveError
+		}
+		saveError = recordAuditEvent(ctx, verifyMfaUseCase.auditLog, &dtos.AuditEventDTO{
+			Type:           entities.AuditEventLoginFailed,
+			TargetId:       user.Id,
+			OrganizationId: challenge.OrganizationId,
+			IpAddress:      data.IpAddress,
+			UserAgent:      data.UserAgent,
+			Detail:         entities.AuditReasonWrongMfaCode,
+		})
+		if saveError != nil {
+			return nil, saveError
+		}
+		return nil, err
+	}
+	err = verifyMfaUseCase.mfaChallenges.Delete(ctx, challenge.Token)
+	if err != nil {
+		return nil, err
+	}
+	sessionData, err := verifyMfaUseCase.session.
+		Generate(user.Id, challenge.OrganizationId)
+	if err != nil {
+		return nil, err
+	}
+	err = cacheSession(ctx, verifyMfaUseCase.cache, sessionData)
+	if err != nil {
+		return nil, err
+	}
+	err = recordAuditEvent(ctx, verifyMfaUseCase.auditLog, &dtos.AuditEventDTO{
+		Type:           entities.AuditEventLoginSucceeded,
+		ActorId:        user.Id,
+		TargetId:       user.Id,
+		OrganizationId: sessionData.OrganizationId,
+		IpAddress:      data.IpAddress,
+		UserAgent:      data.UserAgent,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &definitions.VerifyMfaResult{
+		User:           user,
+		SessionKey:     sessionData.Key,
+		OrganizationId: sessionData.OrganizationId,
+		ExpirationDate: sessionData.ExpirationDate,
+	}, nil
+}
+
+func NewVerifyMfaUseCase(
+	mfaChallenges repositories.MfaChallengesRepository,
+	users repositories.UsersRepository,
+	cipher providers.CipherProvider,
+	otp providers.OtpProvider,
+	digest providers.DigestProvider,
+	session providers.SessionProvider,
+	cache providers.CacheProvider,
+	auditLog repositories.AuditLogRepository,
+) (*VerifyMfaUseCase, *shared.Error) {
+	return &VerifyMfaUseCase{
+		mfaChallenges: mfaChallenges,
+		users:         users,
+		cipher:        cipher,
+		otp:           otp,
+		digest:        digest,
+		session:       session,
+		cache:         cache,
+		auditLog:      auditLog,
+	}, nil
+}
@@ -0,0 +1,18 @@
+package dtos
+
+import "time"
+
+type ApiKeyDTO struct {
+	Id             string
+	UserId         string
+	OrganizationId string
+	Name           string
+	Prefix         string
+	Hash           string
+	Scopes         []string
+	AllowedIps     []string
+	ExpiresAt      time.Time
+	RevokedAt      time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
@@ -0,0 +1,29 @@
+package dtos
+
+import "time"
+
+type AuditEventDTO struct {
+	Id             string
+	Type           string
+	ActorId        string
+	TargetId       string
+	OrganizationId string
+	IpAddress      string
+	UserAgent      string
+	Detail         string
+	CreatedAt      time.Time
+}
+
+// Zero values leave a filter out, Cursor is the id of the last event of the
+// previous page.
+type AuditLogFilterDTO struct {
+	Type           string
+	ActorId        string
+	TargetId       string
+	OrganizationId string
+	Participant    string
+	Since          time.Time
+	Until          time.Time
+	Cursor         string
+	Limit          int
+}
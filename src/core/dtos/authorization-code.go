@@ -0,0 +1,18 @@
+package dtos
+
+import "time"
+
+type AuthorizationCodeDTO struct {
+	Code                string
+	ClientId            string
+	UserId              string
+	OrganizationId      string
+	SessionKey          string
+	SessionExpiresAt    time.Time
+	RedirectUri         string
+	Scopes              []string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	ExpiresAt           time.Time
+}
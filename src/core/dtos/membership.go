@@ -0,0 +1,12 @@
+package dtos
+
+import "time"
+
+type MembershipDTO struct {
+	Id             string
+	OrganizationId string
+	UserId         string
+	Role           string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
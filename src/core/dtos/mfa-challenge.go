@@ -0,0 +1,11 @@
+package dtos
+
+import "time"
+
+type MfaChallengeDTO struct {
+	Token          string
+	UserId         string
+	OrganizationId string
+	Attempts       int
+	ExpiresAt      time.Time
+}
@@ -0,0 +1,17 @@
+package dtos
+
+import "time"
+
+type OAuthClientDTO struct {
+	Id             string
+	OrganizationId string
+	Name           string
+	RedirectUris   []string
+	AuthMethod     string
+	SecretHash     string
+	PublicKey      string
+	Scopes         []string
+	Audiences      []string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
@@ -0,0 +1,10 @@
+package dtos
+
+import "time"
+
+type OrganizationDTO struct {
+	Id        string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
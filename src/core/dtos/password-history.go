@@ -0,0 +1,10 @@
+package dtos
+
+import "time"
+
+type PasswordHistoryDTO struct {
+	Id        string
+	UserId    string
+	Password  string
+	CreatedAt time.Time
+}
@@ -0,0 +1,10 @@
+package dtos
+
+type PasswordPolicyDTO struct {
+	MinLength             int
+	MaxLength             int
+	RequiredClasses       []string
+	MaxRepeatedCharacters int
+	DisallowUserInfo      bool
+	DictionaryWords       []string
+}
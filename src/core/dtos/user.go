@@ -3,10 +3,15 @@ package dtos
 import "time"
 
 type UserDTO struct {
-	Id        string
-	Username  string
-	Email     string
-	Password  string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	Id               string
+	OrganizationId   string
+	Username         string
+	Email            string
+	Password         string
+	EmailVerified    bool
+	MfaSecret        string
+	MfaEnabled       bool
+	MfaRecoveryCodes []string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
 }
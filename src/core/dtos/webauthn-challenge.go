@@ -0,0 +1,11 @@
+package dtos
+
+import "time"
+
+type WebAuthnChallengeDTO struct {
+	Challenge      string
+	Ceremony       string
+	UserId         string
+	OrganizationId string
+	ExpiresAt      time.Time
+}
@@ -0,0 +1,14 @@
+package dtos
+
+import "time"
+
+type WebAuthnCredentialDTO struct {
+	Id           string
+	UserId       string
+	CredentialId string
+	PublicKey    string
+	SignCount    int64
+	Transports   []string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
@@ -0,0 +1,154 @@
+package entities
+
+import (
+	"net"
+	"regexp"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type ApiKeyEntity struct {
+	Id             string
+	UserId         string
+	OrganizationId string
+	Name           string
+	Prefix         string
+	Hash           string
+	Scopes         []string
+	AllowedIps     []string
+	ExpiresAt      time.Time
+	RevokedAt      time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (apiKey *ApiKeyEntity) isIdValid() *shared.Error {
+	regex := regexp.MustCompile("^[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}$")
+	if !regex.Match([]byte(apiKey.Id)) {
+		return exceptions.NewInvalidApiKeyId()
+	}
+	return nil
+}
+
+func (apiKey *ApiKeyEntity) isOwnerValid() *shared.Error {
+	regex := regexp.MustCompile("^[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}$")
+	if apiKey.UserId == "" && apiKey.OrganizationId == "" {
+		return exceptions.NewInvalidApiKeyOwner()
+	}
+	if apiKey.UserId != "" && !regex.Match([]byte(apiKey.UserId)) {
+		return exceptions.NewInvalidUserId()
+	}
+	if apiKey.OrganizationId != "" && !regex.Match([]byte(apiKey.OrganizationId)) {
+		return exceptions.NewInvalidOrganizationId()
+	}
+	return nil
+}
+
+func (apiKey *ApiKeyEntity) isNameValid() *shared.Error {
+	nameLength := len(apiKey.Name)
+	if nameLength < 1 || nameLength > 64 {
+		return exceptions.NewInvalidApiKeyName()
+	}
+	return nil
+}
+
+func (apiKey *ApiKeyEntity) areScopesValid() *shared.Error {
+	regex := regexp.MustCompile(`^[a-z][a-z0-9_.:-]{0,63}$`)
+	for _, scope := range apiKey.Scopes {
+		if !regex.Match([]byte(scope)) {
+			return exceptions.NewInvalidApiKeyScope()
+		}
+	}
+	return nil
+}
+
+func (apiKey *ApiKeyEntity) areAllowedIpsValid() *shared.Error {
+	for _, allowed := range apiKey.AllowedIps {
+		_, _, goerr := net.ParseCIDR(allowed)
+		if goerr != nil && net.ParseIP(allowed) == nil {
+			return exceptions.NewInvalidApiKeyAllowedIp()
+		}
+	}
+	return nil
+}
+
+func (apiKey *ApiKeyEntity) IsValid() *shared.Error {
+	err := apiKey.isIdValid()
+	if err != nil {
+		return err
+	}
+	err = apiKey.isOwnerValid()
+	if err != nil {
+		return err
+	}
+	err = apiKey.isNameValid()
+	if err != nil {
+		return err
+	}
+	err = apiKey.areScopesValid()
+	if err != nil {
+		return err
+	}
+	err = apiKey.areAllowedIpsValid()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (apiKey *ApiKeyEntity) IsServiceAccount() bool {
+	return apiKey.UserId == ""
+}
+
+func (apiKey *ApiKeyEntity) IsRevoked() bool {
+	return apiKey.RevokedAt != (time.Time{})
+}
+
+func (apiKey *ApiKeyEntity) IsExpired(now time.Time) bool {
+	return apiKey.ExpiresAt != (time.Time{}) && !now.Before(apiKey.ExpiresAt)
+}
+
+func (apiKey *ApiKeyEntity) AllowsIp(address string) bool {
+	if len(apiKey.AllowedIps) == 0 {
+		return true
+	}
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	for _, allowed := range apiKey.AllowedIps {
+		_, network, goerr := net.ParseCIDR(allowed)
+		if goerr == nil && network.Contains(ip) {
+			return true
+		}
+		if goerr != nil && net.ParseIP(allowed).Equal(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func NewApiKeyEntity(data *dtos.ApiKeyDTO) (*ApiKeyEntity, *shared.Error) {
+	apiKey := &ApiKeyEntity{
+		Id:             data.Id,
+		UserId:         data.UserId,
+		OrganizationId: data.OrganizationId,
+		Name:           data.Name,
+		Prefix:         data.Prefix,
+		Hash:           data.Hash,
+		Scopes:         data.Scopes,
+		AllowedIps:     data.AllowedIps,
+		ExpiresAt:      data.ExpiresAt,
+		RevokedAt:      data.RevokedAt,
+		CreatedAt:      data.CreatedAt,
+		UpdatedAt:      data.UpdatedAt,
+	}
+	err := apiKey.IsValid()
+	if err != nil {
+		return nil, err
+	}
+	return apiKey, nil
+}
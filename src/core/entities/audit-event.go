@@ -0,0 +1,101 @@
+package entities
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+const (
+	AuditEventUserCreated     = "user.created"
+	AuditEventLoginSucceeded  = "login.succeeded"
+	AuditEventLoginFailed     = "login.failed"
+	AuditEventPasswordChanged = "password.changed"
+	AuditEventSessionRevoked  = "session.revoked"
+	AuditEventRoleGranted     = "role.granted"
+	// A signup with the email of the target, which got notified instead.
+	AuditEventSignupEmailTaken = "signup.email_taken"
+)
+
+var AuditEventTypes = []string{
+	AuditEventUserCreated, AuditEventLoginSucceeded, AuditEventLoginFailed,
+	AuditEventPasswordChanged, AuditEventSessionRevoked, AuditEventRoleGranted,
+	AuditEventSignupEmailTaken,
+}
+
+// Details of a failed login, a granted role has the role as detail.
+const (
+	AuditReasonUnknownUser   = "unknown_user"
+	AuditReasonWrongPassword = "wrong_password"
+	AuditReasonNotAMember    = "not_a_member"
+	AuditReasonWrongMfaCode  = "wrong_mfa_code"
+)
+
+// The actor is whoever did it, a user id or an oauth client id, and is empty
+// when nobody could be identified, like a login attempt for an unknown user.
+// The target is the user the event is about.
+type AuditEventEntity struct {
+	Id             string
+	Type           string
+	ActorId        string
+	TargetId       string
+	OrganizationId string
+	IpAddress      string
+	UserAgent      string
+	Detail         string
+	CreatedAt      time.Time
+}
+
+func (auditEvent *AuditEventEntity) isIdValid() *shared.Error {
+	regex := regexp.MustCompile("^[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}$")
+	if !regex.Match([]byte(auditEvent.Id)) {
+		return exceptions.NewInvalidAuditEventId()
+	}
+	if auditEvent.OrganizationId != "" && !regex.Match([]byte(auditEvent.OrganizationId)) {
+		return exceptions.NewInvalidOrganizationId()
+	}
+	return nil
+}
+
+func (auditEvent *AuditEventEntity) isTypeValid() *shared.Error {
+	for _, eventType := range AuditEventTypes {
+		if auditEvent.Type == eventType {
+			return nil
+		}
+	}
+	return exceptions.NewInvalidAuditEventType()
+}
+
+func (auditEvent *AuditEventEntity) IsValid() *shared.Error {
+	err := auditEvent.isIdValid()
+	if err != nil {
+		return err
+	}
+	err = auditEvent.isTypeValid()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewAuditEventEntity(data *dtos.AuditEventDTO) (*AuditEventEntity, *shared.Error) {
+	auditEvent := &AuditEventEntity{
+		Id:             data.Id,
+		Type:           data.Type,
+		ActorId:        data.ActorId,
+		TargetId:       data.TargetId,
+		OrganizationId: data.OrganizationId,
+		IpAddress:      data.IpAddress,
+		UserAgent:      data.UserAgent,
+		Detail:         data.Detail,
+		CreatedAt:      data.CreatedAt,
+	}
+	err := auditEvent.IsValid()
+	if err != nil {
+		return nil, err
+	}
+	return auditEvent, nil
+}
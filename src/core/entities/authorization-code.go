@@ -0,0 +1,124 @@
+package entities
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"regexp"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+const CodeChallengeMethodS256 = "S256"
+
+type AuthorizationCodeEntity struct {
+	Code                string
+	ClientId            string
+	UserId              string
+	OrganizationId      string
+	SessionKey          string
+	SessionExpiresAt    time.Time
+	RedirectUri         string
+	Scopes              []string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	ExpiresAt           time.Time
+}
+
+// RFC 7636 section 4.1, both the verifier and its S256 challenge are made of
+// unreserved characters only.
+var pkceRegex = regexp.MustCompile(`^[A-Za-z0-9\-._~]{43,128}$`)
+
+func IsCodeChallengeValid(method string, challenge string) bool {
+	return method == CodeChallengeMethodS256 && pkceRegex.Match([]byte(challenge))
+}
+
+func (authorizationCode *AuthorizationCodeEntity) isCodeValid() *shared.Error {
+	if authorizationCode.Code == "" || authorizationCode.SessionKey == "" {
+		return exceptions.NewInvalidAuthorizationCode()
+	}
+	return nil
+}
+
+func (authorizationCode *AuthorizationCodeEntity) areIdsValid() *shared.Error {
+	regex := regexp.MustCompile("^[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}$")
+	if !regex.Match([]byte(authorizationCode.ClientId)) {
+		return exceptions.NewInvalidOAuthClientId()
+	}
+	if !regex.Match([]byte(authorizationCode.UserId)) {
+		return exceptions.NewInvalidUserId()
+	}
+	if authorizationCode.OrganizationId != "" &&
+		!regex.Match([]byte(authorizationCode.OrganizationId)) {
+		return exceptions.NewInvalidOrganizationId()
+	}
+	return nil
+}
+
+func (authorizationCode *AuthorizationCodeEntity) isCodeChallengeValid() *shared.Error {
+	if !IsCodeChallengeValid(
+		authorizationCode.CodeChallengeMethod, authorizationCode.CodeChallenge,
+	) {
+		return exceptions.NewOAuthInvalidCodeChallenge()
+	}
+	return nil
+}
+
+func (authorizationCode *AuthorizationCodeEntity) IsValid() *shared.Error {
+	err := authorizationCode.isCodeValid()
+	if err != nil {
+		return err
+	}
+	err = authorizationCode.areIdsValid()
+	if err != nil {
+		return err
+	}
+	err = authorizationCode.isCodeChallengeValid()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (authorizationCode *AuthorizationCodeEntity) IsExpired(now time.Time) bool {
+	return !now.Before(authorizationCode.ExpiresAt)
+}
+
+func (authorizationCode *AuthorizationCodeEntity) VerifyCodeVerifier(verifier string) bool {
+	if !pkceRegex.Match([]byte(verifier)) {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	challenge := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare(
+		[]byte(challenge), []byte(authorizationCode.CodeChallenge),
+	) == 1
+}
+
+func NewAuthorizationCodeEntity(
+	data *dtos.AuthorizationCodeDTO,
+) (*AuthorizationCodeEntity, *shared.Error) {
+	authorizationCode := &AuthorizationCodeEntity{
+		Code:                data.Code,
+		ClientId:            data.ClientId,
+		UserId:              data.UserId,
+		OrganizationId:      data.OrganizationId,
+		SessionKey:          data.SessionKey,
+		SessionExpiresAt:    data.SessionExpiresAt,
+		RedirectUri:         data.RedirectUri,
+		Scopes:              data.Scopes,
+		Nonce:               data.Nonce,
+		CodeChallenge:       data.CodeChallenge,
+		CodeChallengeMethod: data.CodeChallengeMethod,
+		ExpiresAt:           data.ExpiresAt,
+	}
+	err := authorizationCode.IsValid()
+	if err != nil {
+		return nil, err
+	}
+	return authorizationCode, nil
+}
@@ -0,0 +1,84 @@
+package entities
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+const (
+	MembershipRoleOwner  = "owner"
+	MembershipRoleAdmin  = "admin"
+	MembershipRoleMember = "member"
+)
+
+type MembershipEntity struct {
+	Id             string
+	OrganizationId string
+	UserId         string
+	Role           string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (membership *MembershipEntity) isIdValid() *shared.Error {
+	regex := regexp.MustCompile("^[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}$")
+	if !regex.Match([]byte(membership.Id)) {
+		return exceptions.NewInvalidMembershipId()
+	}
+	if !regex.Match([]byte(membership.OrganizationId)) {
+		return exceptions.NewInvalidOrganizationId()
+	}
+	if !regex.Match([]byte(membership.UserId)) {
+		return exceptions.NewInvalidUserId()
+	}
+	return nil
+}
+
+func (membership *MembershipEntity) isRoleValid() *shared.Error {
+	switch membership.Role {
+	case MembershipRoleOwner, MembershipRoleAdmin, MembershipRoleMember:
+		return nil
+	}
+	return exceptions.NewInvalidMembershipRole()
+}
+
+func (membership *MembershipEntity) IsValid() *shared.Error {
+	err := membership.isIdValid()
+	if err != nil {
+		return err
+	}
+	err = membership.isRoleValid()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (membership *MembershipEntity) IsOwner() bool {
+	return membership.Role == MembershipRoleOwner
+}
+
+func (membership *MembershipEntity) CanManageMembers() bool {
+	return membership.Role == MembershipRoleOwner ||
+		membership.Role == MembershipRoleAdmin
+}
+
+func NewMembershipEntity(data *dtos.MembershipDTO) (*MembershipEntity, *shared.Error) {
+	membership := &MembershipEntity{
+		Id:             data.Id,
+		OrganizationId: data.OrganizationId,
+		UserId:         data.UserId,
+		Role:           data.Role,
+		CreatedAt:      data.CreatedAt,
+		UpdatedAt:      data.UpdatedAt,
+	}
+	err := membership.IsValid()
+	if err != nil {
+		return nil, err
+	}
+	return membership, nil
+}
@@ -0,0 +1,76 @@
+package entities
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+const MfaChallengeMaxAttempts = 5
+
+type MfaChallengeEntity struct {
+	Token          string
+	UserId         string
+	OrganizationId string
+	Attempts       int
+	ExpiresAt      time.Time
+}
+
+func (mfaChallenge *MfaChallengeEntity) isTokenValid() *shared.Error {
+	if mfaChallenge.Token == "" {
+		return exceptions.NewInvalidMfaChallenge()
+	}
+	return nil
+}
+
+func (mfaChallenge *MfaChallengeEntity) areIdsValid() *shared.Error {
+	regex := regexp.MustCompile("^[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}$")
+	if !regex.Match([]byte(mfaChallenge.UserId)) {
+		return exceptions.NewInvalidUserId()
+	}
+	if mfaChallenge.OrganizationId != "" &&
+		!regex.Match([]byte(mfaChallenge.OrganizationId)) {
+		return exceptions.NewInvalidOrganizationId()
+	}
+	return nil
+}
+
+func (mfaChallenge *MfaChallengeEntity) IsValid() *shared.Error {
+	err := mfaChallenge.isTokenValid()
+	if err != nil {
+		return err
+	}
+	err = mfaChallenge.areIdsValid()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (mfaChallenge *MfaChallengeEntity) IsExpired(now time.Time) bool {
+	return !now.Before(mfaChallenge.ExpiresAt)
+}
+
+func (mfaChallenge *MfaChallengeEntity) HasAttemptsLeft() bool {
+	return mfaChallenge.Attempts < MfaChallengeMaxAttempts
+}
+
+func NewMfaChallengeEntity(
+	data *dtos.MfaChallengeDTO,
+) (*MfaChallengeEntity, *shared.Error) {
+	mfaChallenge := &MfaChallengeEntity{
+		Token:          data.Token,
+		UserId:         data.UserId,
+		OrganizationId: data.OrganizationId,
+		Attempts:       data.Attempts,
+		ExpiresAt:      data.ExpiresAt,
+	}
+	err := mfaChallenge.IsValid()
+	if err != nil {
+		return nil, err
+	}
+	return mfaChallenge, nil
+}
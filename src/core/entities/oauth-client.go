@@ -0,0 +1,189 @@
+package entities
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+const (
+	OAuthClientAuthMethodNone              = "none"
+	OAuthClientAuthMethodClientSecretBasic = "client_secret_basic"
+	OAuthClientAuthMethodClientSecretPost  = "client_secret_post"
+	OAuthClientAuthMethodPrivateKeyJwt     = "private_key_jwt"
+)
+
+type OAuthClientEntity struct {
+	Id             string
+	OrganizationId string
+	Name           string
+	RedirectUris   []string
+	AuthMethod     string
+	SecretHash     string
+	PublicKey      string
+	Scopes         []string
+	Audiences      []string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+func (client *OAuthClientEntity) isIdValid() *shared.Error {
+	regex := regexp.MustCompile("^[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}$")
+	if !regex.Match([]byte(client.Id)) {
+		return exceptions.NewInvalidOAuthClientId()
+	}
+	if !regex.Match([]byte(client.OrganizationId)) {
+		return exceptions.NewInvalidOrganizationId()
+	}
+	return nil
+}
+
+func (client *OAuthClientEntity) isNameValid() *shared.Error {
+	nameLength := len(client.Name)
+	if nameLength < 1 || nameLength > 64 {
+		return exceptions.NewInvalidOAuthClientName()
+	}
+	return nil
+}
+
+func (client *OAuthClientEntity) isAuthMethodValid() *shared.Error {
+	switch client.AuthMethod {
+	case OAuthClientAuthMethodNone:
+		if client.SecretHash != "" || client.PublicKey != "" {
+			return exceptions.NewInvalidOAuthClientAuthMethod()
+		}
+	case OAuthClientAuthMethodClientSecretBasic, OAuthClientAuthMethodClientSecretPost:
+		if client.SecretHash == "" {
+			return exceptions.NewInvalidOAuthClientAuthMethod()
+		}
+	case OAuthClientAuthMethodPrivateKeyJwt:
+		if !strings.HasPrefix(strings.TrimSpace(client.PublicKey), "-----BEGIN PUBLIC KEY-----") {
+			return exceptions.NewInvalidOAuthClientPublicKey()
+		}
+	default:
+		return exceptions.NewInvalidOAuthClientAuthMethod()
+	}
+	return nil
+}
+
+// Public clients can only use the authorization code flow and need somewhere
+// to receive the code, confidential machine clients may have no redirect uri.
+func (client *OAuthClientEntity) areRedirectUrisValid() *shared.Error {
+	if len(client.RedirectUris) == 0 && !client.IsConfidential() {
+		return exceptions.NewInvalidOAuthClientRedirectUri()
+	}
+	for _, redirectUri := range client.RedirectUris {
+		parsed, goerr := url.Parse(redirectUri)
+		if goerr != nil || !parsed.IsAbs() || parsed.Fragment != "" {
+			return exceptions.NewInvalidOAuthClientRedirectUri()
+		}
+	}
+	return nil
+}
+
+func (client *OAuthClientEntity) areScopesValid() *shared.Error {
+	regex := regexp.MustCompile(`^[a-z][a-z0-9_.:-]{0,63}$`)
+	for _, scope := range client.Scopes {
+		if !regex.Match([]byte(scope)) {
+			return exceptions.NewInvalidOAuthClientScope()
+		}
+	}
+	return nil
+}
+
+func (client *OAuthClientEntity) areAudiencesValid() *shared.Error {
+	for _, audience := range client.Audiences {
+		parsed, goerr := url.Parse(audience)
+		if goerr != nil || !parsed.IsAbs() {
+			return exceptions.NewInvalidOAuthClientAudience()
+		}
+	}
+	return nil
+}
+
+func (client *OAuthClientEntity) IsValid() *shared.Error {
+	err := client.isIdValid()
+	if err != nil {
+		return err
+	}
+	err = client.isNameValid()
+	if err != nil {
+		return err
+	}
+	err = client.isAuthMethodValid()
+	if err != nil {
+		return err
+	}
+	err = client.areRedirectUrisValid()
+	if err != nil {
+		return err
+	}
+	err = client.areScopesValid()
+	if err != nil {
+		return err
+	}
+	err = client.areAudiencesValid()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (client *OAuthClientEntity) IsConfidential() bool {
+	return client.AuthMethod != OAuthClientAuthMethodNone
+}
+
+// Redirect uris are compared as plain strings, partial or prefix matches would
+// let an attacker bounce codes through an open redirect on the client domain.
+func (client *OAuthClientEntity) HasRedirectUri(redirectUri string) bool {
+	for _, registered := range client.RedirectUris {
+		if registered == redirectUri {
+			return true
+		}
+	}
+	return false
+}
+
+func (client *OAuthClientEntity) HasScope(scope string) bool {
+	for _, registered := range client.Scopes {
+		if registered == scope {
+			return true
+		}
+	}
+	return false
+}
+
+func (client *OAuthClientEntity) HasAudience(audience string) bool {
+	for _, registered := range client.Audiences {
+		if registered == audience {
+			return true
+		}
+	}
+	return false
+}
+
+func NewOAuthClientEntity(data *dtos.OAuthClientDTO) (*OAuthClientEntity, *shared.Error) {
+	client := &OAuthClientEntity{
+		Id:             data.Id,
+		OrganizationId: data.OrganizationId,
+		Name:           data.Name,
+		RedirectUris:   data.RedirectUris,
+		AuthMethod:     data.AuthMethod,
+		SecretHash:     data.SecretHash,
+		PublicKey:      data.PublicKey,
+		Scopes:         data.Scopes,
+		Audiences:      data.Audiences,
+		CreatedAt:      data.CreatedAt,
+		UpdatedAt:      data.UpdatedAt,
+	}
+	err := client.IsValid()
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
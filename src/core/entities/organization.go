@@ -0,0 +1,63 @@
+package entities
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type OrganizationEntity struct {
+	Id        string
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (organization *OrganizationEntity) isIdValid() *shared.Error {
+	regex := regexp.MustCompile("^[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}$")
+	if !regex.Match([]byte(organization.Id)) {
+		return exceptions.NewInvalidOrganizationId()
+	}
+	return nil
+}
+
+func (organization *OrganizationEntity) isNameValid() *shared.Error {
+	nameLength := len(organization.Name)
+	if nameLength < 2 || nameLength > 64 {
+		return exceptions.NewInvalidOrganizationName()
+	}
+	if strings.TrimSpace(organization.Name) != organization.Name {
+		return exceptions.NewInvalidOrganizationName()
+	}
+	return nil
+}
+
+func (organization *OrganizationEntity) IsValid() *shared.Error {
+	err := organization.isIdValid()
+	if err != nil {
+		return err
+	}
+	err = organization.isNameValid()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewOrganizationEntity(data *dtos.OrganizationDTO) (*OrganizationEntity, *shared.Error) {
+	organization := &OrganizationEntity{
+		Id:        data.Id,
+		Name:      data.Name,
+		CreatedAt: data.CreatedAt,
+		UpdatedAt: data.UpdatedAt,
+	}
+	err := organization.IsValid()
+	if err != nil {
+		return nil, err
+	}
+	return organization, nil
+}
@@ -0,0 +1,62 @@
+package entities
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// A password the user had before, Password holds its hash.
+type PasswordHistoryEntity struct {
+	Id        string
+	UserId    string
+	Password  string
+	CreatedAt time.Time
+}
+
+func (passwordHistory *PasswordHistoryEntity) areIdsValid() *shared.Error {
+	regex := regexp.MustCompile("^[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}$")
+	if !regex.Match([]byte(passwordHistory.Id)) {
+		return exceptions.NewInvalidPasswordHistoryId()
+	}
+	if !regex.Match([]byte(passwordHistory.UserId)) {
+		return exceptions.NewInvalidUserId()
+	}
+	return nil
+}
+
+func (passwordHistory *PasswordHistoryEntity) isPasswordValid() *shared.Error {
+	user := &UserEntity{Password: passwordHistory.Password}
+	return user.isPasswordHashValid()
+}
+
+func (passwordHistory *PasswordHistoryEntity) IsValid() *shared.Error {
+	err := passwordHistory.areIdsValid()
+	if err != nil {
+		return err
+	}
+	err = passwordHistory.isPasswordValid()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func NewPasswordHistoryEntity(
+	data *dtos.PasswordHistoryDTO,
+) (*PasswordHistoryEntity, *shared.Error) {
+	passwordHistory := &PasswordHistoryEntity{
+		Id:        data.Id,
+		UserId:    data.UserId,
+		Password:  data.Password,
+		CreatedAt: data.CreatedAt,
+	}
+	err := passwordHistory.IsValid()
+	if err != nil {
+		return nil, err
+	}
+	return passwordHistory, nil
+}
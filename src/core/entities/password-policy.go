@@ -0,0 +1,168 @@
+package entities
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+const (
+	PasswordClassLowercase = "lowercase"
+	PasswordClassUppercase = "uppercase"
+	PasswordClassLetter    = "letter"
+	PasswordClassDigit     = "digit"
+	PasswordClassSymbol    = "symbol"
+)
+
+var passwordClassMatchers = map[string]func(rune) bool{
+	PasswordClassLowercase: unicode.IsLower,
+	PasswordClassUppercase: unicode.IsUpper,
+	PasswordClassLetter:    unicode.IsLetter,
+	PasswordClassDigit:     unicode.IsDigit,
+	PasswordClassSymbol: func(char rune) bool {
+		return !unicode.IsLetter(char) && !unicode.IsDigit(char) && !unicode.IsSpace(char)
+	},
+}
+
+// Lengths count characters, not bytes. A MaxRepeatedCharacters of zero means
+// any run of the same character is accepted. Dictionary words are stored
+// lowercased and match the whole password once the digits and symbols around
+// it are stripped, so "Dragon123!" is rejected while a passphrase made of
+// several words is not. Leading or trailing whitespace is always rejected,
+// it is too easily lost when the password is typed or pasted again.
+type PasswordPolicyEntity struct {
+	MinLength             int
+	MaxLength             int
+	RequiredClasses       []string
+	MaxRepeatedCharacters int
+	DisallowUserInfo      bool
+	dictionary            map[string]bool
+}
+
+func (passwordPolicy *PasswordPolicyEntity) IsValid() *shared.Error {
+	if passwordPolicy.MinLength < 1 || passwordPolicy.MaxLength < passwordPolicy.MinLength ||
+		passwordPolicy.MaxRepeatedCharacters < 0 {
+		return exceptions.NewInvalidPasswordPolicy()
+	}
+	for _, class := range passwordPolicy.RequiredClasses {
+		if _, ok := passwordClassMatchers[class]; !ok {
+			return exceptions.NewInvalidPasswordPolicy()
+		}
+	}
+	return nil
+}
+
+func (passwordPolicy *PasswordPolicyEntity) HasDictionary() bool {
+	return len(passwordPolicy.dictionary) > 0
+}
+
+func (passwordPolicy *PasswordPolicyEntity) longestRun(password string) int {
+	longest, current := 0, 0
+	var previous rune
+	for index, char := range password {
+		if index > 0 && char == previous {
+			current++
+		} else {
+			current = 1
+		}
+		if current > longest {
+			longest = current
+		}
+		previous = char
+	}
+	return longest
+}
+
+func (passwordPolicy *PasswordPolicyEntity) containsUserInfo(
+	password string, username string, email string,
+) bool {
+	lowered := strings.ToLower(password)
+	candidates := []string{strings.ToLower(username)}
+	if at := strings.LastIndex(email, "@"); at > 0 {
+		candidates = append(candidates, strings.ToLower(email[:at]))
+	}
+	for _, candidate := range candidates {
+		if utf8.RuneCountInString(candidate) >= 3 && strings.Contains(lowered, candidate) {
+			return true
+		}
+	}
+	return false
+}
+
+func (passwordPolicy *PasswordPolicyEntity) isDictionaryWord(password string) bool {
+	word := strings.TrimFunc(strings.ToLower(password), func(char rune) bool {
+		return !unicode.IsLetter(char)
+	})
+	return passwordPolicy.dictionary[word]
+}
+
+// Returns one message per rule the password breaks, empty when it is accepted.
+func (passwordPolicy *PasswordPolicyEntity) Violations(
+	password string, username string, email string,
+) []string {
+	violations := []string{}
+	if strings.TrimSpace(password) != password {
+		violations = append(violations, "Must not start or end with whitespace.")
+	}
+	length := utf8.RuneCountInString(password)
+	if length < passwordPolicy.MinLength {
+		violations = append(violations, fmt.Sprintf(
+			"Must have at least %d characters.", passwordPolicy.MinLength,
+		))
+	}
+	if length > passwordPolicy.MaxLength {
+		violations = append(violations, fmt.Sprintf(
+			"Must have at most %d characters.", passwordPolicy.MaxLength,
+		))
+	}
+	for _, class := range passwordPolicy.RequiredClasses {
+		if strings.IndexFunc(password, passwordClassMatchers[class]) < 0 {
+			violations = append(violations, fmt.Sprintf(
+				"Must have at least one %s character.", class,
+			))
+		}
+	}
+	if passwordPolicy.MaxRepeatedCharacters > 0 &&
+		passwordPolicy.longestRun(password) > passwordPolicy.MaxRepeatedCharacters {
+		violations = append(violations, fmt.Sprintf(
+			"Must not repeat the same character more than %d times in a row.",
+			passwordPolicy.MaxRepeatedCharacters,
+		))
+	}
+	if passwordPolicy.DisallowUserInfo &&
+		passwordPolicy.containsUserInfo(password, username, email) {
+		violations = append(violations, "Must not contain the username or email.")
+	}
+	if passwordPolicy.isDictionaryWord(password) {
+		violations = append(violations, "Must not be a common word.")
+	}
+	return violations
+}
+
+func NewPasswordPolicyEntity(data *dtos.PasswordPolicyDTO) (*PasswordPolicyEntity, *shared.Error) {
+	dictionary := map[string]bool{}
+	for _, word := range data.DictionaryWords {
+		word = strings.ToLower(strings.TrimSpace(word))
+		if word != "" {
+			dictionary[word] = true
+		}
+	}
+	passwordPolicy := &PasswordPolicyEntity{
+		MinLength:             data.MinLength,
+		MaxLength:             data.MaxLength,
+		RequiredClasses:       data.RequiredClasses,
+		MaxRepeatedCharacters: data.MaxRepeatedCharacters,
+		DisallowUserInfo:      data.DisallowUserInfo,
+		dictionary:            dictionary,
+	}
+	err := passwordPolicy.IsValid()
+	if err != nil {
+		return nil, err
+	}
+	return passwordPolicy, nil
+}
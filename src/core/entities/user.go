@@ -11,12 +11,17 @@ import (
 )
 
 type UserEntity struct {
-	Id        string
-	Username  string
-	Email     string
-	Password  string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	Id               string
+	OrganizationId   string
+	Username         string
+	Email            string
+	Password         string
+	EmailVerified    bool
+	MfaSecret        string
+	MfaEnabled       bool
+	MfaRecoveryCodes []string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
 }
 
 func (user *UserEntity) isIdValid() *shared.Error {
@@ -27,6 +32,17 @@ func (user *UserEntity) isIdValid() *shared.Error {
 	return nil
 }
 
+func (user *UserEntity) isOrganizationIdValid() *shared.Error {
+	if user.OrganizationId == "" {
+		return nil
+	}
+	regex := regexp.MustCompile("^[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}$")
+	if !regex.Match([]byte(user.OrganizationId)) {
+		return exceptions.NewInvalidOrganizationId()
+	}
+	return nil
+}
+
 func (user *UserEntity) isUsernameValid() *shared.Error {
 	errorToReturn := exceptions.NewInvalidUserUsername()
 	usernameLength := len(user.Username)
@@ -51,8 +67,9 @@ func (user *UserEntity) isEmailValid() *shared.Error {
 }
 
 func (user *UserEntity) isPasswordHashValid() *shared.Error {
-	regex := regexp.MustCompile(`^\$2[aby]?\$\d{1,2}\$[.\/A-Za-z0-9]{53}$`)
-	if !regex.Match([]byte(user.Password)) {
+	bcryptRegex := regexp.MustCompile(`^\$2[aby]?\$\d{1,2}\$[.\/A-Za-z0-9]{53}$`)
+	argon2idRegex := regexp.MustCompile(`^\$argon2id\$v=19\$m=\d{1,10},t=\d{1,10},p=\d{1,3}\$[A-Za-z0-9+\/]{11,}\$[A-Za-z0-9+\/]{22,}$`)
+	if !bcryptRegex.Match([]byte(user.Password)) && !argon2idRegex.Match([]byte(user.Password)) {
 		return exceptions.NewInvalidUserPasswordHash()
 	}
 	return nil
@@ -63,6 +80,10 @@ func (user *UserEntity) IsValid() *shared.Error {
 	if err != nil {
 		return err
 	}
+	err = user.isOrganizationIdValid()
+	if err != nil {
+		return err
+	}
 	err = user.isUsernameValid()
 	if err != nil {
 		return err
@@ -78,37 +99,29 @@ func (user *UserEntity) IsValid() *shared.Error {
 	return nil
 }
 
-func (user *UserEntity) IsPasswordValid(password string) *shared.Error {
-	if strings.TrimSpace(password) != password {
-		return exceptions.NewInvalidUserPassword()
-	}
-	if len(password) < 8 || len(password) > 32 {
-		return exceptions.NewInvalidUserPassword()
-	}
-	hasLetter := func(text string) bool {
-		regex := regexp.MustCompile("^.*[a-zA-Z].*$")
-		result := regex.Match([]byte(text))
-		return result
-	}
-	hasDigit := func(text string) bool {
-		regex := regexp.MustCompile(`^.*\d.*$`)
-		result := regex.Match([]byte(text))
-		return result
-	}
-	if !hasLetter(password) || !hasDigit(password) {
-		return exceptions.NewInvalidUserPassword()
+func (user *UserEntity) IsPasswordValid(
+	password string, policy *PasswordPolicyEntity,
+) *shared.Error {
+	violations := policy.Violations(password, user.Username, user.Email)
+	if len(violations) > 0 {
+		return exceptions.NewInvalidUserPassword(violations...)
 	}
 	return nil
 }
 
 func NewUserEntity(data *dtos.UserDTO) (*UserEntity, *shared.Error) {
 	user := &UserEntity{
-		Id:        data.Id,
-		Username:  data.Username,
-		Email:     data.Email,
-		Password:  data.Password,
-		CreatedAt: data.CreatedAt,
-		UpdatedAt: data.UpdatedAt,
+		Id:               data.Id,
+		OrganizationId:   data.OrganizationId,
+		Username:         data.Username,
+		Email:            data.Email,
+		Password:         data.Password,
+		EmailVerified:    data.EmailVerified,
+		MfaSecret:        data.MfaSecret,
+		MfaEnabled:       data.MfaEnabled,
+		MfaRecoveryCodes: data.MfaRecoveryCodes,
+		CreatedAt:        data.CreatedAt,
+		UpdatedAt:        data.UpdatedAt,
 	}
 	err := user.IsValid()
 	if err != nil {
@@ -0,0 +1,84 @@
+package entities
+
+import (
+	"regexp"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+const (
+	WebAuthnCeremonyRegistration   = "registration"
+	WebAuthnCeremonyAuthentication = "authentication"
+)
+
+// A registration challenge always belongs to a user, an authentication one
+// has no user when the login is left to a discoverable credential.
+type WebAuthnChallengeEntity struct {
+	Challenge      string
+	Ceremony       string
+	UserId         string
+	OrganizationId string
+	ExpiresAt      time.Time
+}
+
+func (webAuthnChallenge *WebAuthnChallengeEntity) isChallengeValid() *shared.Error {
+	if webAuthnChallenge.Challenge == "" {
+		return exceptions.NewInvalidWebAuthnChallenge()
+	}
+	if webAuthnChallenge.Ceremony != WebAuthnCeremonyRegistration &&
+		webAuthnChallenge.Ceremony != WebAuthnCeremonyAuthentication {
+		return exceptions.NewInvalidWebAuthnChallenge()
+	}
+	return nil
+}
+
+func (webAuthnChallenge *WebAuthnChallengeEntity) areIdsValid() *shared.Error {
+	regex := regexp.MustCompile("^[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}$")
+	if webAuthnChallenge.Ceremony == WebAuthnCeremonyRegistration ||
+		webAuthnChallenge.UserId != "" {
+		if !regex.Match([]byte(webAuthnChallenge.UserId)) {
+			return exceptions.NewInvalidUserId()
+		}
+	}
+	if webAuthnChallenge.OrganizationId != "" &&
+		!regex.Match([]byte(webAuthnChallenge.OrganizationId)) {
+		return exceptions.NewInvalidOrganizationId()
+	}
+	return nil
+}
+
+func (webAuthnChallenge *WebAuthnChallengeEntity) IsValid() *shared.Error {
+	err := webAuthnChallenge.isChallengeValid()
+	if err != nil {
+		return err
+	}
+	err = webAuthnChallenge.areIdsValid()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (webAuthnChallenge *WebAuthnChallengeEntity) IsExpired(now time.Time) bool {
+	return !now.Before(webAuthnChallenge.ExpiresAt)
+}
+
+func NewWebAuthnChallengeEntity(
+	data *dtos.WebAuthnChallengeDTO,
+) (*WebAuthnChallengeEntity, *shared.Error) {
+	webAuthnChallenge := &WebAuthnChallengeEntity{
+		Challenge:      data.Challenge,
+		Ceremony:       data.Ceremony,
+		UserId:         data.UserId,
+		OrganizationId: data.OrganizationId,
+		ExpiresAt:      data.ExpiresAt,
+	}
+	err := webAuthnChallenge.IsValid()
+	if err != nil {
+		return nil, err
+	}
+	return webAuthnChallenge, nil
+}
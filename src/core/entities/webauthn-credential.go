@@ -0,0 +1,117 @@
+package entities
+
+import (
+	"encoding/base64"
+	"regexp"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// Transports reported by authenticators, as listed by the AuthenticatorTransport
+// enum of WebAuthn level 3.
+var WebAuthnTransports = []string{"usb", "nfc", "ble", "smart-card", "hybrid", "internal"}
+
+type WebAuthnCredentialEntity struct {
+	Id           string
+	UserId       string
+	CredentialId string
+	PublicKey    string
+	SignCount    int64
+	Transports   []string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (webAuthnCredential *WebAuthnCredentialEntity) areIdsValid() *shared.Error {
+	regex := regexp.MustCompile("^[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}$")
+	if !regex.Match([]byte(webAuthnCredential.Id)) {
+		return exceptions.NewInvalidWebAuthnCredentialId()
+	}
+	if !regex.Match([]byte(webAuthnCredential.UserId)) {
+		return exceptions.NewInvalidUserId()
+	}
+	return nil
+}
+
+func (webAuthnCredential *WebAuthnCredentialEntity) isCredentialIdValid() *shared.Error {
+	credentialId, goerr := base64.RawURLEncoding.DecodeString(webAuthnCredential.CredentialId)
+	if goerr != nil || len(credentialId) == 0 || len(credentialId) > 1023 {
+		return exceptions.NewInvalidWebAuthnCredentialRawId()
+	}
+	return nil
+}
+
+func (webAuthnCredential *WebAuthnCredentialEntity) isPublicKeyValid() *shared.Error {
+	publicKey, goerr := base64.RawURLEncoding.DecodeString(webAuthnCredential.PublicKey)
+	if goerr != nil || len(publicKey) == 0 {
+		return exceptions.NewInvalidWebAuthnPublicKey()
+	}
+	return nil
+}
+
+func (webAuthnCredential *WebAuthnCredentialEntity) areTransportsValid() *shared.Error {
+	for _, transport := range webAuthnCredential.Transports {
+		valid := false
+		for _, known := range WebAuthnTransports {
+			if transport == known {
+				valid = true
+			}
+		}
+		if !valid {
+			return exceptions.NewInvalidWebAuthnTransport()
+		}
+	}
+	return nil
+}
+
+func (webAuthnCredential *WebAuthnCredentialEntity) IsValid() *shared.Error {
+	err := webAuthnCredential.areIdsValid()
+	if err != nil {
+		return err
+	}
+	err = webAuthnCredential.isCredentialIdValid()
+	if err != nil {
+		return err
+	}
+	err = webAuthnCredential.isPublicKeyValid()
+	if err != nil {
+		return err
+	}
+	err = webAuthnCredential.areTransportsValid()
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// WebAuthn section 6.1.1, a counter that doesn't grow hints at a cloned
+// authenticator. Authenticators without a counter always report zero.
+func (webAuthnCredential *WebAuthnCredentialEntity) IsSignCountValid(signCount int64) bool {
+	if signCount == 0 && webAuthnCredential.SignCount == 0 {
+		return true
+	}
+	return signCount > webAuthnCredential.SignCount
+}
+
+func NewWebAuthnCredentialEntity(
+	data *dtos.WebAuthnCredentialDTO,
+) (*WebAuthnCredentialEntity, *shared.Error) {
+	webAuthnCredential := &WebAuthnCredentialEntity{
+		Id:           data.Id,
+		UserId:       data.UserId,
+		CredentialId: data.CredentialId,
+		PublicKey:    data.PublicKey,
+		SignCount:    data.SignCount,
+		Transports:   data.Transports,
+		CreatedAt:    data.CreatedAt,
+		UpdatedAt:    data.UpdatedAt,
+	}
+	err := webAuthnCredential.IsValid()
+	if err != nil {
+		return nil, err
+	}
+	return webAuthnCredential, nil
+}
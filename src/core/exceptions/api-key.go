@@ -0,0 +1,67 @@
+package exceptions
+
+import "github.com/AndreyArthur/oganessone/src/core/shared"
+
+func NewInvalidApiKeyId() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidApiKeyId",
+		"Invalid api key id, must be an uuid.",
+	)
+}
+
+func NewInvalidApiKeyOwner() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidApiKeyOwner",
+		"Invalid api key owner, must belong to an user or to an organization service account.",
+	)
+}
+
+func NewInvalidApiKeyName() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidApiKeyName",
+		"Invalid api key name, must have 1-64 characters.",
+	)
+}
+
+func NewInvalidApiKeyScope() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidApiKeyScope",
+		"Invalid api key scope, must be lowercase and only contain letters, digits and \"_.:-\".",
+	)
+}
+
+func NewInvalidApiKeyAllowedIp() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidApiKeyAllowedIp",
+		"Invalid api key allowed ip, must be an ip address or a cidr block.",
+	)
+}
+
+func NewInvalidApiKeyExpiration() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidApiKeyExpiration",
+		"Invalid api key expiration, must be a future ISO8601 date.",
+	)
+}
+
+func NewApiKeyNotFound() *shared.Error {
+	return shared.NewError(
+		notFound,
+		"ApiKeyNotFound",
+		"Api key not found.",
+	)
+}
+
+func NewApiKeyPermissionDenied() *shared.Error {
+	return shared.NewError(
+		authorization,
+		"ApiKeyPermissionDenied",
+		"You don't have permission to manage this api key.",
+	)
+}
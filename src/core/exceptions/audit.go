@@ -0,0 +1,27 @@
+package exceptions
+
+import "github.com/AndreyArthur/oganessone/src/core/shared"
+
+func NewInvalidAuditEventId() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidAuditEventId",
+		"Invalid audit event id, must be an uuid.",
+	)
+}
+
+func NewInvalidAuditEventType() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidAuditEventType",
+		"Invalid audit event type.",
+	)
+}
+
+func NewInvalidAuditLogQuery() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidAuditLogQuery",
+		"Invalid audit log query, check the page token, the page size of 1-200 and that since is not after until.",
+	)
+}
@@ -3,6 +3,7 @@ package exceptions
 import "github.com/AndreyArthur/oganessone/src/core/shared"
 
 const unexpected = "unexpected"
+const cancelled = "cancelled"
 
 func NewInternalServerError() *shared.Error {
 	return shared.NewError(
@@ -11,3 +12,35 @@ func NewInternalServerError() *shared.Error {
 		"An internal server error has occured, try again later.",
 	)
 }
+
+func NewInvalidRequestBody() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidRequestBody",
+		"The request body must be a json object of at most 1MB.",
+	)
+}
+
+func NewRouteNotFound() *shared.Error {
+	return shared.NewError(
+		notFound,
+		"RouteNotFound",
+		"There is no route for this path.",
+	)
+}
+
+func NewMethodNotAllowed() *shared.Error {
+	return shared.NewError(
+		validation,
+		"MethodNotAllowed",
+		"This method is not allowed for this path, check the Allow header.",
+	)
+}
+
+func NewRequestCancelled() *shared.Error {
+	return shared.NewError(
+		cancelled,
+		"RequestCancelled",
+		"The request was cancelled or ran out of time before it finished.",
+	)
+}
@@ -0,0 +1,43 @@
+package exceptions
+
+import "github.com/AndreyArthur/oganessone/src/core/shared"
+
+func NewMfaAlreadyEnabled() *shared.Error {
+	return shared.NewError(
+		conflict,
+		"MfaAlreadyEnabled",
+		"Multi-factor authentication is already enabled for this user.",
+	)
+}
+
+func NewMfaNotEnrolled() *shared.Error {
+	return shared.NewError(
+		validation,
+		"MfaNotEnrolled",
+		"Multi-factor authentication is not enrolled, enroll before confirming.",
+	)
+}
+
+func NewInvalidMfaCode() *shared.Error {
+	return shared.NewError(
+		authentication,
+		"InvalidMfaCode",
+		"Invalid or already used one-time code.",
+	)
+}
+
+func NewInvalidMfaChallenge() *shared.Error {
+	return shared.NewError(
+		authentication,
+		"InvalidMfaChallenge",
+		"Multi-factor challenge not found or expired, log in again.",
+	)
+}
+
+func NewMfaRequired() *shared.Error {
+	return shared.NewError(
+		authentication,
+		"MfaRequired",
+		"A one-time code from your authenticator app is required.",
+	)
+}
@@ -0,0 +1,189 @@
+package exceptions
+
+import "github.com/AndreyArthur/oganessone/src/core/shared"
+
+const oauth = "oauth"
+
+func NewInvalidOAuthClientId() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidOAuthClientId",
+		"Invalid oauth client id, must be an uuid.",
+	)
+}
+
+func NewInvalidOAuthClientName() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidOAuthClientName",
+		"Invalid oauth client name, must have 1-64 characters.",
+	)
+}
+
+func NewInvalidOAuthClientRedirectUri() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidOAuthClientRedirectUri",
+		"Invalid oauth client redirect uri, must be an absolute uri without fragment.",
+	)
+}
+
+func NewInvalidOAuthClientAuthMethod() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidOAuthClientAuthMethod",
+		"Invalid oauth client auth method, must be \"none\", \"client_secret_basic\", \"client_secret_post\" or \"private_key_jwt\".",
+	)
+}
+
+func NewInvalidOAuthClientPublicKey() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidOAuthClientPublicKey",
+		"Invalid oauth client public key, private_key_jwt clients must register a PEM encoded RSA public key.",
+	)
+}
+
+func NewInvalidOAuthClientScope() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidOAuthClientScope",
+		"Invalid oauth client scope, must be lowercase and only contain letters, digits and \"_.:-\".",
+	)
+}
+
+func NewInvalidOAuthClientAudience() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidOAuthClientAudience",
+		"Invalid oauth client audience, must be an absolute uri.",
+	)
+}
+
+func NewInvalidAuthorizationCode() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidAuthorizationCode",
+		"Invalid authorization code.",
+	)
+}
+
+func NewOAuthClientNotFound() *shared.Error {
+	return shared.NewError(
+		notFound,
+		"OAuthClientNotFound",
+		"OAuth client not found.",
+	)
+}
+
+func NewOAuthInvalidRequest() *shared.Error {
+	return shared.NewError(
+		oauth,
+		"OAuthInvalidRequest",
+		"The request is missing a required parameter or is otherwise malformed.",
+	)
+}
+
+func NewOAuthInvalidClient() *shared.Error {
+	return shared.NewError(
+		oauth,
+		"OAuthInvalidClient",
+		"Client authentication failed or the client is not registered.",
+	)
+}
+
+func NewOAuthInvalidRedirectUri() *shared.Error {
+	return shared.NewError(
+		oauth,
+		"OAuthInvalidRedirectUri",
+		"The redirect_uri is not registered for this client.",
+	)
+}
+
+func NewOAuthUnsupportedResponseType() *shared.Error {
+	return shared.NewError(
+		oauth,
+		"OAuthUnsupportedResponseType",
+		"Only the \"code\" response type is supported.",
+	)
+}
+
+func NewOAuthInvalidCodeChallenge() *shared.Error {
+	return shared.NewError(
+		oauth,
+		"OAuthInvalidCodeChallenge",
+		"A S256 code_challenge with 43-128 characters is required.",
+	)
+}
+
+func NewOAuthInvalidScope() *shared.Error {
+	return shared.NewError(
+		oauth,
+		"OAuthInvalidScope",
+		"The requested scope is invalid or malformed.",
+	)
+}
+
+func NewOAuthAccessDenied() *shared.Error {
+	return shared.NewError(
+		oauth,
+		"OAuthAccessDenied",
+		"The resource owner denied the request.",
+	)
+}
+
+func NewOAuthInvalidGrant() *shared.Error {
+	return shared.NewError(
+		oauth,
+		"OAuthInvalidGrant",
+		"The authorization code is invalid, expired, already used or was issued to another client.",
+	)
+}
+
+func NewOAuthUnsupportedGrantType() *shared.Error {
+	return shared.NewError(
+		oauth,
+		"OAuthUnsupportedGrantType",
+		"The grant type is not supported by this server.",
+	)
+}
+
+func NewOAuthInsufficientScope() *shared.Error {
+	return shared.NewError(
+		oauth,
+		"OAuthInsufficientScope",
+		"The access token was not granted the scope required by this request.",
+	)
+}
+
+func NewOAuthUnauthorizedClient() *shared.Error {
+	return shared.NewError(
+		oauth,
+		"OAuthUnauthorizedClient",
+		"The client is not allowed to use this grant type.",
+	)
+}
+
+func NewOAuthInvalidTarget() *shared.Error {
+	return shared.NewError(
+		oauth,
+		"OAuthInvalidTarget",
+		"The requested audience is not registered for this client.",
+	)
+}
+
+func NewOAuthUnsupportedTokenType() *shared.Error {
+	return shared.NewError(
+		oauth,
+		"OAuthUnsupportedTokenType",
+		"Api keys can't be revoked here, use the api keys service instead.",
+	)
+}
+
+func NewInvalidLoginForm() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidLoginForm",
+		"The sign in form has expired or was not sent from this page, reload it and try again.",
+	)
+}
@@ -0,0 +1,78 @@
+package exceptions
+
+import "github.com/AndreyArthur/oganessone/src/core/shared"
+
+const notFound = "not_found"
+const authorization = "authorization"
+
+func NewInvalidOrganizationId() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidOrganizationId",
+		"Invalid organization id, must be an uuid.",
+	)
+}
+
+func NewInvalidOrganizationName() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidOrganizationName",
+		"Invalid organization name, must have 2-64 characters.",
+	)
+}
+
+func NewOrganizationNotFound() *shared.Error {
+	return shared.NewError(
+		notFound,
+		"OrganizationNotFound",
+		"Organization not found.",
+	)
+}
+
+func NewInvalidMembershipId() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidMembershipId",
+		"Invalid membership id, must be an uuid.",
+	)
+}
+
+func NewInvalidMembershipRole() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidMembershipRole",
+		"Invalid membership role, must be owner, admin or member.",
+	)
+}
+
+func NewMembershipAlreadyExists() *shared.Error {
+	return shared.NewError(
+		conflict,
+		"MembershipAlreadyExists",
+		"User is already a member of this organization.",
+	)
+}
+
+func NewMembershipNotFound() *shared.Error {
+	return shared.NewError(
+		notFound,
+		"MembershipNotFound",
+		"User is not a member of this organization.",
+	)
+}
+
+func NewMembershipLastOwner() *shared.Error {
+	return shared.NewError(
+		conflict,
+		"MembershipLastOwner",
+		"The last owner of an organization can not be removed.",
+	)
+}
+
+func NewOrganizationPermissionDenied() *shared.Error {
+	return shared.NewError(
+		authorization,
+		"OrganizationPermissionDenied",
+		"You don't have permission to perform this action on the organization.",
+	)
+}
@@ -0,0 +1,19 @@
+package exceptions
+
+import "github.com/AndreyArthur/oganessone/src/core/shared"
+
+func NewSessionNotFound() *shared.Error {
+	return shared.NewError(
+		authentication,
+		"SessionNotFound",
+		"Session not found or expired, create a new one.",
+	)
+}
+
+func NewPermissionDenied() *shared.Error {
+	return shared.NewError(
+		authorization,
+		"PermissionDenied",
+		"You don't have the role or the scopes this action requires.",
+	)
+}
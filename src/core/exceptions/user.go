@@ -34,15 +34,49 @@ func NewInvalidUserPasswordHash() *shared.Error {
 	return shared.NewError(
 		validation,
 		"InvalidUserPasswordHash",
-		"Invalid user password hash, must be a bcrypt.",
+		"Invalid user password hash, must be a bcrypt or argon2id hash.",
 	)
 }
 
-func NewInvalidUserPassword() *shared.Error {
-	return shared.NewError(
+func NewInvalidUserPassword(violations ...string) *shared.Error {
+	err := shared.NewError(
 		validation,
 		"InvalidUserPassword",
-		"Invalid user password, must have ascii characters, numbers and 8-32 characters.",
+		"Invalid user password, it does not meet the password policy.",
+	)
+	err.Details = violations
+	return err
+}
+
+func NewInvalidPasswordPolicy() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidPasswordPolicy",
+		"Invalid password policy, lengths must be positive with the maximum not below the minimum and character classes must be lowercase, uppercase, letter, digit or symbol.",
+	)
+}
+
+func NewUserPasswordBreached() *shared.Error {
+	return shared.NewError(
+		validation,
+		"UserPasswordBreached",
+		"This password has appeared in a known data breach, choose another one.",
+	)
+}
+
+func NewUserPasswordReused() *shared.Error {
+	return shared.NewError(
+		validation,
+		"UserPasswordReused",
+		"The new password must not match the current or a recently used password.",
+	)
+}
+
+func NewInvalidPasswordHistoryId() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidPasswordHistoryId",
+		"Invalid password history id, must be an uuid.",
 	)
 }
 
@@ -69,3 +103,19 @@ func NewUserLoginFailed() *shared.Error {
 		"Login failed, invalid login/password combination.",
 	)
 }
+
+func NewUserNotFound() *shared.Error {
+	return shared.NewError(
+		notFound,
+		"UserNotFound",
+		"User not found.",
+	)
+}
+
+func NewUserReauthenticationFailed() *shared.Error {
+	return shared.NewError(
+		authentication,
+		"UserReauthenticationFailed",
+		"Re-authentication failed, invalid password.",
+	)
+}
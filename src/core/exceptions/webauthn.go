@@ -0,0 +1,67 @@
+package exceptions
+
+import "github.com/AndreyArthur/oganessone/src/core/shared"
+
+func NewInvalidWebAuthnCredentialId() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidWebAuthnCredentialId",
+		"Invalid webauthn credential id, must be an uuid.",
+	)
+}
+
+func NewInvalidWebAuthnCredentialRawId() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidWebAuthnCredentialRawId",
+		"Invalid webauthn credential raw id, must be base64url with up to 1023 bytes.",
+	)
+}
+
+func NewInvalidWebAuthnPublicKey() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidWebAuthnPublicKey",
+		"Invalid webauthn public key, must be a base64url encoded cose key.",
+	)
+}
+
+func NewInvalidWebAuthnTransport() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidWebAuthnTransport",
+		"Invalid webauthn transport, must be usb, nfc, ble, smart-card, hybrid or internal.",
+	)
+}
+
+func NewInvalidWebAuthnChallenge() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidWebAuthnChallenge",
+		"Webauthn challenge not found or expired, start the ceremony again.",
+	)
+}
+
+func NewInvalidWebAuthnResponse() *shared.Error {
+	return shared.NewError(
+		validation,
+		"InvalidWebAuthnResponse",
+		"Malformed or unsupported webauthn authenticator response.",
+	)
+}
+
+func NewWebAuthnCredentialAlreadyRegistered() *shared.Error {
+	return shared.NewError(
+		conflict,
+		"WebAuthnCredentialAlreadyRegistered",
+		"This authenticator is already registered.",
+	)
+}
+
+func NewWebAuthnLoginFailed() *shared.Error {
+	return shared.NewError(
+		authentication,
+		"WebAuthnLoginFailed",
+		"Login failed, the authenticator response could not be verified.",
+	)
+}
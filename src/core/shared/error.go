@@ -1,9 +1,12 @@
 package shared
 
+// Details lists every individual problem when a single error stands for
+// several of them, like each password policy rule a password breaks.
 type Error struct {
 	Type    string
 	Name    string
 	Message string
+	Details []string
 }
 
 func NewError(errorType string, name string, message string) *Error {
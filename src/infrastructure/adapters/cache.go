@@ -0,0 +1,89 @@
+package adapters
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+const cacheSweepInterval = time.Minute
+
+type cacheEntry struct {
+	value     string
+	expiresAt time.Time
+}
+
+// Expired entries read as missing right away, Set sweeps them out at most
+// once per interval so sessions nobody comes back for don't pile up.
+type CacheAdapter struct {
+	mutex     sync.RWMutex
+	entries   map[string]*cacheEntry
+	nextSweep time.Time
+}
+
+func (cacheAdapter *CacheAdapter) sweep(now time.Time) {
+	if now.Before(cacheAdapter.nextSweep) {
+		return
+	}
+	for key, entry := range cacheAdapter.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(cacheAdapter.entries, key)
+		}
+	}
+	cacheAdapter.nextSweep = now.Add(cacheSweepInterval)
+}
+
+func (cacheAdapter *CacheAdapter) Set(
+	ctx context.Context, key string, value string, expiresAt time.Time,
+) *shared.Error {
+	_, span := Tracer().Start(ctx, "cache.set")
+	defer span.End()
+	cacheAdapter.mutex.Lock()
+	defer cacheAdapter.mutex.Unlock()
+	cacheAdapter.sweep(time.Now())
+	cacheAdapter.entries[key] = &cacheEntry{value: value, expiresAt: expiresAt}
+	return nil
+}
+
+func (cacheAdapter *CacheAdapter) Get(ctx context.Context, key string) (string, *shared.Error) {
+	_, span := Tracer().Start(ctx, "cache.get")
+	defer span.End()
+	cacheAdapter.mutex.RLock()
+	defer cacheAdapter.mutex.RUnlock()
+	entry, ok := cacheAdapter.entries[key]
+	if !ok || !time.Now().Before(entry.expiresAt) {
+		return "", nil
+	}
+	return entry.value, nil
+}
+
+func (cacheAdapter *CacheAdapter) Delete(ctx context.Context, key string) *shared.Error {
+	_, span := Tracer().Start(ctx, "cache.delete")
+	defer span.End()
+	cacheAdapter.mutex.Lock()
+	defer cacheAdapter.mutex.Unlock()
+	delete(cacheAdapter.entries, key)
+	return nil
+}
+
+// For metrics read at scrape time, match runs with the cache locked.
+func (cacheAdapter *CacheAdapter) Count(match func(key string, value string) bool) int {
+	cacheAdapter.mutex.RLock()
+	defer cacheAdapter.mutex.RUnlock()
+	now := time.Now()
+	count := 0
+	for key, entry := range cacheAdapter.entries {
+		if now.Before(entry.expiresAt) && match(key, entry.value) {
+			count++
+		}
+	}
+	return count
+}
+
+func NewCacheAdapter() (*CacheAdapter, *shared.Error) {
+	return &CacheAdapter{
+		entries: map[string]*cacheEntry{},
+	}, nil
+}
@@ -0,0 +1,69 @@
+package adapters
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// AES-256-GCM, the random nonce is prepended to the sealed text and both are
+// base64 encoded together.
+type CipherAdapter struct {
+	aead cipher.AEAD
+}
+
+func (cipherAdapter *CipherAdapter) Encrypt(text string) (string, *shared.Error) {
+	nonce := make([]byte, cipherAdapter.aead.NonceSize())
+	_, goerr := rand.Read(nonce)
+	if goerr != nil {
+		LogError(context.Background(), goerr)
+		return "", exceptions.NewInternalServerError()
+	}
+	sealed := cipherAdapter.aead.Seal(nonce, nonce, []byte(text), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (cipherAdapter *CipherAdapter) Decrypt(ciphertext string) (string, *shared.Error) {
+	sealed, goerr := base64.StdEncoding.DecodeString(ciphertext)
+	if goerr != nil {
+		LogError(context.Background(), goerr)
+		return "", exceptions.NewInternalServerError()
+	}
+	nonceSize := cipherAdapter.aead.NonceSize()
+	if len(sealed) < nonceSize {
+		LogError(context.Background(), errors.New("ciphertext is shorter than the nonce"))
+		return "", exceptions.NewInternalServerError()
+	}
+	text, goerr := cipherAdapter.aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+	if goerr != nil {
+		LogError(context.Background(), goerr)
+		return "", exceptions.NewInternalServerError()
+	}
+	return string(text), nil
+}
+
+func NewCipherAdapter(key []byte) (*CipherAdapter, *shared.Error) {
+	if len(key) != 32 {
+		LogError(context.Background(), errors.New("cipher key must have 32 bytes"))
+		return nil, exceptions.NewInternalServerError()
+	}
+	block, goerr := aes.NewCipher(key)
+	if goerr != nil {
+		LogError(context.Background(), goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	aead, goerr := cipher.NewGCM(block)
+	if goerr != nil {
+		LogError(context.Background(), goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	return &CipherAdapter{
+		aead: aead,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package adapters
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type DigestAdapter struct{}
+
+func (digestAdapter *DigestAdapter) Digest(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func (digestAdapter *DigestAdapter) Matches(text string, digest string) bool {
+	return subtle.ConstantTimeCompare([]byte(digestAdapter.Digest(text)), []byte(digest)) == 1
+}
+
+func NewDigestAdapter() (*DigestAdapter, *shared.Error) {
+	return &DigestAdapter{}, nil
+}
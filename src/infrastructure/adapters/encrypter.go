@@ -1,26 +1,161 @@
 package adapters
 
 import (
-	"log"
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/AndreyArthur/oganessone/src/core/exceptions"
 	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"golang.org/x/crypto/argon2"
 	"golang.org/x/crypto/bcrypt"
 )
 
-type EncrypterAdapter struct{}
+const (
+	EncrypterAlgorithmArgon2id = "argon2id"
+	EncrypterAlgorithmBcrypt   = "bcrypt"
+)
+
+const (
+	argon2SaltLength = 16
+	argon2KeyLength  = 32
+)
+
+// Argon2Memory is in KiB. Hashes made with other parameters still compare,
+// NeedsRehash is what tells they should be replaced.
+type EncrypterParameters struct {
+	Algorithm         string
+	BcryptCost        int
+	Argon2Memory      uint32
+	Argon2Iterations  uint32
+	Argon2Parallelism uint8
+}
+
+type argon2Hash struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	salt        []byte
+	key         []byte
+}
+
+// PHC string format, $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<key>
+// with salt and key in unpadded standard base64.
+func parseArgon2Hash(hash string) (*argon2Hash, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != EncrypterAlgorithmArgon2id {
+		return nil, errors.New("not an argon2id hash")
+	}
+	var version int
+	_, goerr := fmt.Sscanf(parts[2], "v=%d", &version)
+	if goerr != nil || version != argon2.Version {
+		return nil, errors.New("unsupported argon2 version")
+	}
+	parsed := &argon2Hash{}
+	_, goerr = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &parsed.memory, &parsed.iterations, &parsed.parallelism)
+	if goerr != nil {
+		return nil, goerr
+	}
+	if parsed.memory == 0 || parsed.memory > 1<<21 || parsed.iterations == 0 ||
+		parsed.iterations > 64 || parsed.parallelism == 0 {
+		return nil, errors.New("argon2 parameters out of range")
+	}
+	parsed.salt, goerr = base64.RawStdEncoding.DecodeString(parts[4])
+	if goerr != nil {
+		return nil, goerr
+	}
+	parsed.key, goerr = base64.RawStdEncoding.DecodeString(parts[5])
+	if goerr != nil || len(parsed.key) == 0 {
+		return nil, errors.New("invalid argon2 key")
+	}
+	return parsed, nil
+}
+
+var passwordHashDuration = helpers.Metrics.NewHistogram(
+	"oganessone_password_hash_duration_seconds",
+	"Time spent hashing or comparing a password.",
+	[]float64{0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5},
+	"algorithm", "operation",
+)
 
-func (encrypterAdapter *EncrypterAdapter) Hash(text string) (string, *shared.Error) {
-	const BCRYPT_COST = 10
-	hash, goerr := bcrypt.GenerateFromPassword([]byte(text), BCRYPT_COST)
+func observePasswordHash(start time.Time, algorithm string, operation string) {
+	passwordHashDuration.Observe(time.Since(start).Seconds(), algorithm, operation)
+}
+
+type EncrypterAdapter struct {
+	parameters *EncrypterParameters
+}
+
+func (encrypterAdapter *EncrypterAdapter) hashArgon2id(text string) (string, *shared.Error) {
+	salt := make([]byte, argon2SaltLength)
+	_, goerr := rand.Read(salt)
 	if goerr != nil {
-		log.Println(goerr)
+		LogError(context.Background(), goerr)
+		return "", exceptions.NewInternalServerError()
+	}
+	parameters := encrypterAdapter.parameters
+	key := argon2.IDKey(
+		[]byte(text), salt, parameters.Argon2Iterations, parameters.Argon2Memory,
+		parameters.Argon2Parallelism, argon2KeyLength,
+	)
+	return fmt.Sprintf(
+		"$%s$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		EncrypterAlgorithmArgon2id, argon2.Version,
+		parameters.Argon2Memory, parameters.Argon2Iterations, parameters.Argon2Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// Hashing can not be interrupted once started, a request that is already
+// cancelled is refused before spending the time and memory on it.
+func (encrypterAdapter *EncrypterAdapter) Hash(ctx context.Context, text string) (string, *shared.Error) {
+	if ctx.Err() != nil {
+		return "", exceptions.NewRequestCancelled()
+	}
+	algorithm := encrypterAdapter.parameters.Algorithm
+	_, span := Tracer().Start(ctx, "password.hash")
+	span.SetAttribute("algorithm", algorithm)
+	defer span.End()
+	defer observePasswordHash(time.Now(), algorithm, "hash")
+	if algorithm == EncrypterAlgorithmArgon2id {
+		return encrypterAdapter.hashArgon2id(text)
+	}
+	hash, goerr := bcrypt.GenerateFromPassword([]byte(text), encrypterAdapter.parameters.BcryptCost)
+	if goerr != nil {
+		LogError(ctx, goerr)
 		return "", exceptions.NewInternalServerError()
 	}
 	return string(hash), nil
 }
 
-func (encrypterAdapter *EncrypterAdapter) Compare(text string, hash string) (bool, *shared.Error) {
+func (encrypterAdapter *EncrypterAdapter) Compare(ctx context.Context, text string, hash string) (bool, *shared.Error) {
+	if ctx.Err() != nil {
+		return false, exceptions.NewRequestCancelled()
+	}
+	_, span := Tracer().Start(ctx, "password.compare")
+	defer span.End()
+	if strings.HasPrefix(hash, "$"+EncrypterAlgorithmArgon2id+"$") {
+		span.SetAttribute("algorithm", EncrypterAlgorithmArgon2id)
+		defer observePasswordHash(time.Now(), EncrypterAlgorithmArgon2id, "compare")
+		parsed, goerr := parseArgon2Hash(hash)
+		if goerr != nil {
+			return false, nil
+		}
+		key := argon2.IDKey(
+			[]byte(text), parsed.salt, parsed.iterations, parsed.memory,
+			parsed.parallelism, uint32(len(parsed.key)),
+		)
+		return subtle.ConstantTimeCompare(key, parsed.key) == 1, nil
+	}
+	span.SetAttribute("algorithm", EncrypterAlgorithmBcrypt)
+	defer observePasswordHash(time.Now(), EncrypterAlgorithmBcrypt, "compare")
 	goerr := bcrypt.CompareHashAndPassword([]byte(hash), []byte(text))
 	if goerr != nil {
 		return false, nil
@@ -28,6 +163,43 @@ func (encrypterAdapter *EncrypterAdapter) Compare(text string, hash string) (boo
 	return true, nil
 }
 
-func NewEncrypterAdapter() (*EncrypterAdapter, *shared.Error) {
-	return &EncrypterAdapter{}, nil
+func (encrypterAdapter *EncrypterAdapter) NeedsRehash(hash string) (bool, *shared.Error) {
+	parameters := encrypterAdapter.parameters
+	if parameters.Algorithm == EncrypterAlgorithmArgon2id {
+		parsed, goerr := parseArgon2Hash(hash)
+		if goerr != nil {
+			return true, nil
+		}
+		return parsed.memory != parameters.Argon2Memory ||
+			parsed.iterations != parameters.Argon2Iterations ||
+			parsed.parallelism != parameters.Argon2Parallelism ||
+			len(parsed.key) != argon2KeyLength, nil
+	}
+	cost, goerr := bcrypt.Cost([]byte(hash))
+	if goerr != nil {
+		return true, nil
+	}
+	return cost != parameters.BcryptCost, nil
+}
+
+func NewEncrypterAdapter(parameters *EncrypterParameters) (*EncrypterAdapter, *shared.Error) {
+	switch parameters.Algorithm {
+	case EncrypterAlgorithmArgon2id:
+		if parameters.Argon2Memory < 8*uint32(parameters.Argon2Parallelism) ||
+			parameters.Argon2Iterations == 0 || parameters.Argon2Parallelism == 0 {
+			LogError(context.Background(), errors.New("invalid argon2id parameters"))
+			return nil, exceptions.NewInternalServerError()
+		}
+	case EncrypterAlgorithmBcrypt:
+		if parameters.BcryptCost < bcrypt.MinCost || parameters.BcryptCost > bcrypt.MaxCost {
+			LogError(context.Background(), errors.New("invalid bcrypt cost"))
+			return nil, exceptions.NewInternalServerError()
+		}
+	default:
+		LogError(context.Background(), errors.New("unknown password hashing algorithm"))
+		return nil, exceptions.NewInternalServerError()
+	}
+	return &EncrypterAdapter{
+		parameters: parameters,
+	}, nil
 }
@@ -0,0 +1,139 @@
+package adapters
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+)
+
+const (
+	LogLevelDebug = "debug"
+	LogLevelInfo  = "info"
+	LogLevelWarn  = "warn"
+	LogLevelError = "error"
+)
+
+var logLevels = map[string]int{
+	LogLevelDebug: 0,
+	LogLevelInfo:  1,
+	LogLevelWarn:  2,
+	LogLevelError: 3,
+}
+
+// Without an Output lines go to stderr.
+type LoggerParameters struct {
+	Level  string
+	Output io.Writer
+}
+
+// Every line is a single JSON object carrying the correlation id and the
+// trace of the request ctx belongs to, if any.
+type LoggerAdapter struct {
+	level  int
+	output io.Writer
+	mutex  sync.Mutex
+}
+
+func (loggerAdapter *LoggerAdapter) log(
+	ctx context.Context, level string, message string, fields providers.LogFields,
+) {
+	if logLevels[level] < loggerAdapter.level {
+		return
+	}
+	entry := map[string]interface{}{}
+	for key, value := range fields {
+		if goerr, ok := value.(error); ok {
+			value = goerr.Error()
+		}
+		entry[key] = value
+	}
+	entry["time"] = time.Now().UTC().Format(time.RFC3339Nano)
+	entry["level"] = level
+	entry["message"] = message
+	correlationId := helpers.CorrelationId(ctx)
+	if correlationId != "" {
+		entry["correlation_id"] = correlationId
+	}
+	span := SpanFromContext(ctx)
+	if span != nil {
+		entry["trace_id"] = hex.EncodeToString(span.context.TraceId[:])
+		entry["span_id"] = hex.EncodeToString(span.context.SpanId[:])
+	}
+	line, goerr := json.Marshal(entry)
+	if goerr != nil {
+		line, _ = json.Marshal(map[string]interface{}{
+			"time":           entry["time"],
+			"level":          level,
+			"message":        message,
+			"correlation_id": correlationId,
+			"error":          goerr.Error(),
+		})
+	}
+	loggerAdapter.mutex.Lock()
+	defer loggerAdapter.mutex.Unlock()
+	loggerAdapter.output.Write(append(line, '\n'))
+}
+
+func (loggerAdapter *LoggerAdapter) Debug(ctx context.Context, message string, fields providers.LogFields) {
+	loggerAdapter.log(ctx, LogLevelDebug, message, fields)
+}
+
+func (loggerAdapter *LoggerAdapter) Info(ctx context.Context, message string, fields providers.LogFields) {
+	loggerAdapter.log(ctx, LogLevelInfo, message, fields)
+}
+
+func (loggerAdapter *LoggerAdapter) Warn(ctx context.Context, message string, fields providers.LogFields) {
+	loggerAdapter.log(ctx, LogLevelWarn, message, fields)
+}
+
+func (loggerAdapter *LoggerAdapter) Error(ctx context.Context, message string, fields providers.LogFields) {
+	loggerAdapter.log(ctx, LogLevelError, message, fields)
+}
+
+func NewLoggerAdapter(parameters *LoggerParameters) (*LoggerAdapter, *shared.Error) {
+	level, ok := logLevels[parameters.Level]
+	if !ok {
+		LogError(context.Background(), errors.New("unknown log level "+parameters.Level))
+		return nil, exceptions.NewInternalServerError()
+	}
+	output := parameters.Output
+	if output == nil {
+		output = os.Stderr
+	}
+	return &LoggerAdapter{
+		level:  level,
+		output: output,
+	}, nil
+}
+
+var sharedLogger atomic.Value
+
+func init() {
+	sharedLogger.Store(&LoggerAdapter{level: logLevels[LogLevelInfo], output: os.Stderr})
+}
+
+// Repositories and adapters are not handed a logger, they all write through
+// this one, which main replaces with the configured logger at startup.
+func Logger() *LoggerAdapter {
+	return sharedLogger.Load().(*LoggerAdapter)
+}
+
+func SetLogger(loggerAdapter *LoggerAdapter) {
+	sharedLogger.Store(loggerAdapter)
+}
+
+// Shorthand for the common case of an error replaced by a generic one.
+func LogError(ctx context.Context, goerr error) {
+	Logger().Error(ctx, goerr.Error(), nil)
+}
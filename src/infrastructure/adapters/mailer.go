@@ -0,0 +1,104 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+const mailerQueueSize = 256
+
+// Without a Host messages are only logged, which is enough for development.
+type MailerParameters struct {
+	Host     string
+	Port     int
+	Username string
+	Password string
+	From     string
+}
+
+type mail struct {
+	to      string
+	subject string
+	body    string
+}
+
+// Messages are handed to a single background sender, so how long the smtp
+// server takes never shows up in the latency of the request that sent them.
+type MailerAdapter struct {
+	parameters *MailerParameters
+	queue      chan *mail
+}
+
+func (mailerAdapter *MailerAdapter) deliver(message *mail) error {
+	parameters := mailerAdapter.parameters
+	if parameters.Host == "" {
+		Logger().Info(context.Background(), "mail not sent, no smtp host", providers.LogFields{
+			"to":      message.to,
+			"subject": message.subject,
+			"body":    message.body,
+		})
+		return nil
+	}
+	var auth smtp.Auth
+	if parameters.Username != "" {
+		auth = smtp.PlainAuth("", parameters.Username, parameters.Password, parameters.Host)
+	}
+	content := strings.Join([]string{
+		"From: " + parameters.From,
+		"To: " + message.to,
+		"Subject: " + message.subject,
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=utf-8",
+		"",
+		message.body,
+	}, "\r\n")
+	return smtp.SendMail(
+		net.JoinHostPort(parameters.Host, strconv.Itoa(parameters.Port)),
+		auth, parameters.From, []string{message.to}, []byte(content),
+	)
+}
+
+func (mailerAdapter *MailerAdapter) work() {
+	for message := range mailerAdapter.queue {
+		goerr := mailerAdapter.deliver(message)
+		if goerr != nil {
+			LogError(context.Background(), goerr)
+		}
+	}
+}
+
+func (mailerAdapter *MailerAdapter) Send(to string, subject string, body string) *shared.Error {
+	if strings.ContainsAny(to+subject, "\r\n") {
+		LogError(context.Background(), errors.New("mail headers must not contain line breaks"))
+		return exceptions.NewInternalServerError()
+	}
+	select {
+	case mailerAdapter.queue <- &mail{to: to, subject: subject, body: body}:
+		return nil
+	default:
+		LogError(context.Background(), fmt.Errorf("mail queue is full, dropping mail to %s", to))
+		return exceptions.NewInternalServerError()
+	}
+}
+
+func NewMailerAdapter(parameters *MailerParameters) (*MailerAdapter, *shared.Error) {
+	if parameters.Host != "" && (parameters.Port <= 0 || parameters.From == "") {
+		LogError(context.Background(), errors.New("mailer needs a port and a from address"))
+		return nil, exceptions.NewInternalServerError()
+	}
+	mailerAdapter := &MailerAdapter{
+		parameters: parameters,
+		queue:      make(chan *mail, mailerQueueSize),
+	}
+	go mailerAdapter.work()
+	return mailerAdapter, nil
+}
@@ -0,0 +1,53 @@
+package adapters
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// RFC 6238 with the defaults every authenticator app supports, HMAC-SHA1 and
+// six digit codes. Secrets are 160 bits as recommended by RFC 4226.
+type OtpAdapter struct{}
+
+var otpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func (otpAdapter *OtpAdapter) Secret() (string, *shared.Error) {
+	secret := make([]byte, 20)
+	_, goerr := rand.Read(secret)
+	if goerr != nil {
+		LogError(context.Background(), goerr)
+		return "", exceptions.NewInternalServerError()
+	}
+	return otpEncoding.EncodeToString(secret), nil
+}
+
+func (otpAdapter *OtpAdapter) Code(secret string, step int64) (string, *shared.Error) {
+	key, goerr := otpEncoding.DecodeString(
+		strings.ToUpper(strings.TrimRight(secret, "=")),
+	)
+	if goerr != nil {
+		LogError(context.Background(), goerr)
+		return "", exceptions.NewInternalServerError()
+	}
+	counter := make([]byte, 8)
+	binary.BigEndian.PutUint64(counter, uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	truncated := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%06d", truncated%1000000), nil
+}
+
+func NewOtpAdapter() (*OtpAdapter, *shared.Error) {
+	return &OtpAdapter{}, nil
+}
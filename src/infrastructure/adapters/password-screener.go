@@ -0,0 +1,193 @@
+package adapters
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// The index starts with a magic number and a fanout table, entry i holding
+// how many hashes have a two byte prefix lower or equal to i. The remaining
+// 18 bytes of every hash follow, sorted, so a lookup reads the fanout table
+// once at startup and then binary searches a single prefix bucket.
+var passwordScreenerMagic = []byte("OGPWIDX\x01")
+
+const (
+	passwordScreenerFanoutSize   = 1 << 16
+	passwordScreenerHeaderSize   = 8 + passwordScreenerFanoutSize*8
+	passwordScreenerPrefixLength = 2
+	passwordScreenerSuffixLength = sha1.Size - passwordScreenerPrefixLength
+)
+
+// Reads HIBP style "<sha1 hex>:<count>" lines, which must already be sorted by
+// hash as in the ordered downloads, and writes the index. Hashes seen fewer
+// than minimumCount times are left out. Returns the number of hashes written.
+func WritePasswordScreenerIndex(
+	input io.Reader, output io.WriteSeeker, minimumCount int,
+) (int, error) {
+	writer := bufio.NewWriter(output)
+	_, goerr := writer.Write(make([]byte, passwordScreenerHeaderSize))
+	if goerr != nil {
+		return 0, goerr
+	}
+	fanout := make([]uint64, passwordScreenerFanoutSize)
+	scanner := bufio.NewScanner(input)
+	previous := []byte{}
+	written := 0
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		parts := strings.SplitN(text, ":", 2)
+		hash, goerr := hex.DecodeString(parts[0])
+		if goerr != nil || len(hash) != sha1.Size {
+			return 0, fmt.Errorf("line %d: invalid sha1 hash", line)
+		}
+		if len(parts) == 2 {
+			count, goerr := strconv.Atoi(strings.TrimSpace(parts[1]))
+			if goerr != nil {
+				return 0, fmt.Errorf("line %d: invalid count", line)
+			}
+			if count < minimumCount {
+				continue
+			}
+		}
+		comparison := bytes.Compare(hash, previous)
+		if comparison == 0 {
+			continue
+		}
+		if comparison < 0 {
+			return 0, fmt.Errorf("line %d: hashes are not sorted", line)
+		}
+		_, goerr = writer.Write(hash[passwordScreenerPrefixLength:])
+		if goerr != nil {
+			return 0, goerr
+		}
+		fanout[binary.BigEndian.Uint16(hash)]++
+		previous = hash
+		written++
+	}
+	goerr = scanner.Err()
+	if goerr != nil {
+		return 0, goerr
+	}
+	goerr = writer.Flush()
+	if goerr != nil {
+		return 0, goerr
+	}
+	header := make([]byte, passwordScreenerHeaderSize)
+	copy(header, passwordScreenerMagic)
+	var cumulative uint64
+	for i, count := range fanout {
+		cumulative += count
+		binary.BigEndian.PutUint64(header[8+i*8:], cumulative)
+	}
+	_, goerr = output.Seek(0, io.SeekStart)
+	if goerr != nil {
+		return 0, goerr
+	}
+	_, goerr = output.Write(header)
+	if goerr != nil {
+		return 0, goerr
+	}
+	return written, nil
+}
+
+// Without an index every password passes, screening is opt in.
+type PasswordScreenerAdapter struct {
+	index  io.ReaderAt
+	fanout []uint64
+}
+
+func (passwordScreenerAdapter *PasswordScreenerAdapter) IsBreached(
+	ctx context.Context,
+	password string,
+) (bool, *shared.Error) {
+	if passwordScreenerAdapter.index == nil {
+		return false, nil
+	}
+	if ctx.Err() != nil {
+		return false, exceptions.NewRequestCancelled()
+	}
+	hash := sha1.Sum([]byte(password))
+	prefix := binary.BigEndian.Uint16(hash[:])
+	var low uint64
+	if prefix > 0 {
+		low = passwordScreenerAdapter.fanout[prefix-1]
+	}
+	high := passwordScreenerAdapter.fanout[prefix]
+	suffix := hash[passwordScreenerPrefixLength:]
+	record := make([]byte, passwordScreenerSuffixLength)
+	for low < high {
+		middle := low + (high-low)/2
+		offset := int64(passwordScreenerHeaderSize) + int64(middle)*passwordScreenerSuffixLength
+		_, goerr := passwordScreenerAdapter.index.ReadAt(record, offset)
+		if goerr != nil {
+			LogError(ctx, goerr)
+			return false, exceptions.NewInternalServerError()
+		}
+		switch bytes.Compare(record, suffix) {
+		case 0:
+			return true, nil
+		case -1:
+			low = middle + 1
+		default:
+			high = middle
+		}
+	}
+	return false, nil
+}
+
+func NewPasswordScreenerAdapter(path string) (*PasswordScreenerAdapter, *shared.Error) {
+	if path == "" {
+		return &PasswordScreenerAdapter{}, nil
+	}
+	file, goerr := os.Open(path)
+	if goerr != nil {
+		LogError(context.Background(), goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	header := make([]byte, passwordScreenerHeaderSize)
+	_, goerr = io.ReadFull(file, header)
+	if goerr != nil || !bytes.Equal(header[:8], passwordScreenerMagic) {
+		file.Close()
+		LogError(context.Background(), errors.New("password screener index has an invalid header"))
+		return nil, exceptions.NewInternalServerError()
+	}
+	fanout := make([]uint64, passwordScreenerFanoutSize)
+	for i := range fanout {
+		fanout[i] = binary.BigEndian.Uint64(header[8+i*8:])
+		if i > 0 && fanout[i] < fanout[i-1] {
+			file.Close()
+			LogError(context.Background(), errors.New("password screener index has an invalid fanout table"))
+			return nil, exceptions.NewInternalServerError()
+		}
+	}
+	info, goerr := file.Stat()
+	expectedSize := int64(passwordScreenerHeaderSize) +
+		int64(fanout[passwordScreenerFanoutSize-1])*passwordScreenerSuffixLength
+	if goerr != nil || info.Size() != expectedSize {
+		file.Close()
+		LogError(context.Background(), errors.New("password screener index is truncated"))
+		return nil, exceptions.NewInternalServerError()
+	}
+	return &PasswordScreenerAdapter{
+		index:  file,
+		fanout: fanout,
+	}, nil
+}
@@ -0,0 +1,26 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+)
+
+type RandomAdapter struct{}
+
+func (randomAdapter *RandomAdapter) String(length int) (string, *shared.Error) {
+	const ALPHABET = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789"
+	str, _ := helpers.NewString()
+	result, goerr := str.Random(ALPHABET, length)
+	if goerr != nil {
+		LogError(context.Background(), goerr)
+		return "", exceptions.NewInternalServerError()
+	}
+	return result, nil
+}
+
+func NewRandomAdapter() (*RandomAdapter, *shared.Error) {
+	return &RandomAdapter{}, nil
+}
@@ -1,9 +1,11 @@
 package adapters
 
 import (
+	"context"
 	"time"
 
 	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
 	"github.com/AndreyArthur/oganessone/src/core/shared"
 	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
 )
@@ -11,16 +13,21 @@ import (
 type SessionAdapter struct{}
 
 func (*SessionAdapter) Generate(
-	userId string,
+	userId string, organizationId string,
 ) (*providers.SessionData, *shared.Error) {
 	ONE_DAY := time.Hour * 24
 	tomorrow := time.Now().UTC().Add(ONE_DAY)
 	expiresIn := tomorrow.Format(time.RFC3339)
 	str, _ := helpers.NewString()
 	chars := "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789(){}[]/~`!@#$%^&*;:?"
-	key := str.Random(chars, 32)
+	key, goerr := str.Random(chars, 32)
+	if goerr != nil {
+		LogError(context.Background(), goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
 	return &providers.SessionData{
 		UserId:         userId,
+		OrganizationId: organizationId,
 		Key:            key,
 		ExpirationDate: expiresIn,
 	}, nil
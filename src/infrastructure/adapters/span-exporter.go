@@ -0,0 +1,193 @@
+package adapters
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Spans are encoded as an OTLP/JSON ExportTraceServiceRequest, see
+// https://opentelemetry.io/docs/specs/otlp/#json-protobuf-encoding.
+type otlpValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+}
+
+type otlpAttribute struct {
+	Key   string    `json:"key"`
+	Value otlpValue `json:"value"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+type otlpSpan struct {
+	TraceId           string          `json:"traceId"`
+	SpanId            string          `json:"spanId"`
+	ParentSpanId      string          `json:"parentSpanId,omitempty"`
+	TraceState        string          `json:"traceState,omitempty"`
+	Name              string          `json:"name"`
+	Kind              int             `json:"kind"`
+	StartTimeUnixNano string          `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string          `json:"endTimeUnixNano"`
+	Attributes        []otlpAttribute `json:"attributes,omitempty"`
+	Status            otlpStatus      `json:"status"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpAttribute `json:"attributes"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+func newOtlpAttribute(key string, value interface{}) otlpAttribute {
+	attribute := otlpAttribute{Key: key}
+	switch typed := value.(type) {
+	case string:
+		attribute.Value.StringValue = &typed
+	case bool:
+		attribute.Value.BoolValue = &typed
+	case int:
+		integer := strconv.Itoa(typed)
+		attribute.Value.IntValue = &integer
+	case int64:
+		integer := strconv.FormatInt(typed, 10)
+		attribute.Value.IntValue = &integer
+	case float64:
+		attribute.Value.DoubleValue = &typed
+	default:
+		text := fmt.Sprint(typed)
+		attribute.Value.StringValue = &text
+	}
+	return attribute
+}
+
+func newOtlpSpan(span *SpanAdapter) otlpSpan {
+	span.mutex.Lock()
+	defer span.mutex.Unlock()
+	encoded := otlpSpan{
+		TraceId:           hex.EncodeToString(span.context.TraceId[:]),
+		SpanId:            hex.EncodeToString(span.context.SpanId[:]),
+		TraceState:        span.context.TraceState,
+		Name:              span.name,
+		Kind:              span.kind,
+		StartTimeUnixNano: strconv.FormatInt(span.start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(span.end.UnixNano(), 10),
+	}
+	if span.parentSpanId != [8]byte{} {
+		encoded.ParentSpanId = hex.EncodeToString(span.parentSpanId[:])
+	}
+	keys := []string{}
+	for key := range span.attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		encoded.Attributes = append(encoded.Attributes, newOtlpAttribute(key, span.attributes[key]))
+	}
+	if span.failed {
+		encoded.Status = otlpStatus{Code: 2, Message: span.message}
+	}
+	return encoded
+}
+
+func encodeSpans(serviceName string, spans []*SpanAdapter) ([]byte, error) {
+	encoded := []otlpSpan{}
+	for _, span := range spans {
+		encoded = append(encoded, newOtlpSpan(span))
+	}
+	return json.Marshal(&otlpRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: []otlpAttribute{newOtlpAttribute("service.name", serviceName)},
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: serviceName},
+				Spans: encoded,
+			}},
+		}},
+	})
+}
+
+// One request per line, the format the collector's otlpjsonfile receiver
+// reads back, so a file written in tests can still be looked at in a UI.
+type WriterSpanExporter struct {
+	serviceName string
+	output      io.Writer
+	mutex       sync.Mutex
+}
+
+func (exporter *WriterSpanExporter) Export(spans []*SpanAdapter) error {
+	line, goerr := encodeSpans(exporter.serviceName, spans)
+	if goerr != nil {
+		return goerr
+	}
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+	_, goerr = exporter.output.Write(append(line, '\n'))
+	return goerr
+}
+
+func NewWriterSpanExporter(serviceName string, output io.Writer) *WriterSpanExporter {
+	return &WriterSpanExporter{serviceName: serviceName, output: output}
+}
+
+// Sends to the OTLP/HTTP traces endpoint of a collector, usually
+// http://localhost:4318/v1/traces.
+type OtlpSpanExporter struct {
+	serviceName string
+	endpoint    string
+	client      *http.Client
+}
+
+func (exporter *OtlpSpanExporter) Export(spans []*SpanAdapter) error {
+	body, goerr := encodeSpans(exporter.serviceName, spans)
+	if goerr != nil {
+		return goerr
+	}
+	response, goerr := exporter.client.Post(exporter.endpoint, "application/json", bytes.NewReader(body))
+	if goerr != nil {
+		return goerr
+	}
+	defer response.Body.Close()
+	io.Copy(io.Discard, response.Body)
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return fmt.Errorf("otlp collector answered %s", response.Status)
+	}
+	return nil
+}
+
+func NewOtlpSpanExporter(serviceName string, endpoint string) *OtlpSpanExporter {
+	return &OtlpSpanExporter{
+		serviceName: serviceName,
+		endpoint:    endpoint,
+		client:      &http.Client{Timeout: 10 * time.Second},
+	}
+}
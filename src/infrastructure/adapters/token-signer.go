@@ -0,0 +1,92 @@
+package adapters
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type TokenSignerAdapter struct {
+	key   *rsa.PrivateKey
+	keyId string
+}
+
+func encodeSegment(value interface{}) (string, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+func (tokenSignerAdapter *TokenSignerAdapter) Sign(
+	claims map[string]interface{},
+) (string, *shared.Error) {
+	header, goerr := encodeSegment(map[string]string{
+		"alg": "RS256",
+		"typ": "JWT",
+		"kid": tokenSignerAdapter.keyId,
+	})
+	if goerr != nil {
+		LogError(context.Background(), goerr)
+		return "", exceptions.NewInternalServerError()
+	}
+	payload, goerr := encodeSegment(claims)
+	if goerr != nil {
+		LogError(context.Background(), goerr)
+		return "", exceptions.NewInternalServerError()
+	}
+	signingInput := strings.Join([]string{header, payload}, ".")
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, goerr := rsa.SignPKCS1v15(
+		rand.Reader, tokenSignerAdapter.key, crypto.SHA256, digest[:],
+	)
+	if goerr != nil {
+		LogError(context.Background(), goerr)
+		return "", exceptions.NewInternalServerError()
+	}
+	return strings.Join([]string{
+		signingInput, base64.RawURLEncoding.EncodeToString(signature),
+	}, "."), nil
+}
+
+// The public half of the signing key as a JWK, relying parties fetch it from
+// the jwks endpoint to verify id tokens.
+func (tokenSignerAdapter *TokenSignerAdapter) PublicJwk() map[string]string {
+	publicKey := tokenSignerAdapter.key.PublicKey
+	return map[string]string{
+		"kty": "RSA",
+		"use": "sig",
+		"alg": "RS256",
+		"kid": tokenSignerAdapter.keyId,
+		"n":   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+	}
+}
+
+func NewTokenSignerAdapter(key *rsa.PrivateKey) (*TokenSignerAdapter, *shared.Error) {
+	// RFC 7638 thumbprint, members in lexicographic order and no whitespace.
+	thumbprint, goerr := json.Marshal(map[string]string{
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.PublicKey.E)).Bytes()),
+		"kty": "RSA",
+		"n":   base64.RawURLEncoding.EncodeToString(key.PublicKey.N.Bytes()),
+	})
+	if goerr != nil {
+		LogError(context.Background(), goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	digest := sha256.Sum256(thumbprint)
+	return &TokenSignerAdapter{
+		key:   key,
+		keyId: base64.RawURLEncoding.EncodeToString(digest[:]),
+	}, nil
+}
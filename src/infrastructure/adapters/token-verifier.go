@@ -0,0 +1,69 @@
+package adapters
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"strings"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type TokenVerifierAdapter struct{}
+
+func decodeSegment(segment string, value interface{}) bool {
+	raw, goerr := base64.RawURLEncoding.DecodeString(segment)
+	if goerr != nil {
+		return false
+	}
+	return json.Unmarshal(raw, value) == nil
+}
+
+func (*TokenVerifierAdapter) Verify(
+	token string, publicKey string,
+) (map[string]interface{}, *shared.Error) {
+	block, _ := pem.Decode([]byte(publicKey))
+	if block == nil {
+		return nil, nil
+	}
+	parsed, goerr := x509.ParsePKIXPublicKey(block.Bytes)
+	if goerr != nil {
+		return nil, nil
+	}
+	key, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, nil
+	}
+	segments := strings.Split(token, ".")
+	if len(segments) != 3 {
+		return nil, nil
+	}
+	// The algorithm is pinned, a token announcing "none" or a hmac algorithm
+	// must not be checked any other way.
+	var header map[string]interface{}
+	if !decodeSegment(segments[0], &header) || header["alg"] != "RS256" {
+		return nil, nil
+	}
+	signature, goerr := base64.RawURLEncoding.DecodeString(segments[2])
+	if goerr != nil {
+		return nil, nil
+	}
+	digest := sha256.Sum256([]byte(segments[0] + "." + segments[1]))
+	goerr = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature)
+	if goerr != nil {
+		return nil, nil
+	}
+	var claims map[string]interface{}
+	if !decodeSegment(segments[1], &claims) {
+		return nil, nil
+	}
+	return claims, nil
+}
+
+func NewTokenVerifierAdapter() (*TokenVerifierAdapter, *shared.Error) {
+	return &TokenVerifierAdapter{}, nil
+}
@@ -0,0 +1,311 @@
+package adapters
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// Kinds as numbered by OTLP.
+const (
+	SpanKindInternal = 1
+	SpanKindServer   = 2
+	SpanKindClient   = 3
+)
+
+const (
+	tracerQueueSize     = 2048
+	tracerBatchSize     = 512
+	tracerFlushInterval = 5 * time.Second
+)
+
+type SpanContext struct {
+	TraceId    [16]byte
+	SpanId     [8]byte
+	Sampled    bool
+	TraceState string
+}
+
+func (spanContext SpanContext) IsValid() bool {
+	return spanContext.TraceId != [16]byte{} && spanContext.SpanId != [8]byte{}
+}
+
+// W3C trace context, version-traceid-parentid-flags like
+// 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01. An invalid header
+// gives an invalid SpanContext and the request starts a new trace.
+func ParseTraceParent(traceParent string, traceState string) SpanContext {
+	parts := strings.Split(strings.TrimSpace(traceParent), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" ||
+		len(parts[1]) != 32 || len(parts[2]) != 16 || len(parts[3]) != 2 ||
+		(parts[0] == "00" && len(parts) != 4) {
+		return SpanContext{}
+	}
+	var spanContext SpanContext
+	_, goerr := hex.Decode(spanContext.TraceId[:], []byte(parts[1]))
+	if goerr != nil || strings.ToLower(parts[1]) != parts[1] {
+		return SpanContext{}
+	}
+	_, goerr = hex.Decode(spanContext.SpanId[:], []byte(parts[2]))
+	if goerr != nil || strings.ToLower(parts[2]) != parts[2] {
+		return SpanContext{}
+	}
+	flags, goerr := hex.DecodeString(parts[3])
+	if goerr != nil {
+		return SpanContext{}
+	}
+	spanContext.Sampled = flags[0]&1 == 1
+	spanContext.TraceState = traceState
+	if !spanContext.IsValid() {
+		return SpanContext{}
+	}
+	return spanContext
+}
+
+func (spanContext SpanContext) TraceParent() string {
+	flags := "00"
+	if spanContext.Sampled {
+		flags = "01"
+	}
+	return "00-" + hex.EncodeToString(spanContext.TraceId[:]) + "-" +
+		hex.EncodeToString(spanContext.SpanId[:]) + "-" + flags
+}
+
+type SpanAdapter struct {
+	tracer       *TracerAdapter
+	name         string
+	kind         int
+	context      SpanContext
+	parentSpanId [8]byte
+	start        time.Time
+	mutex        sync.Mutex
+	end          time.Time
+	attributes   map[string]interface{}
+	failed       bool
+	message      string
+}
+
+func (span *SpanAdapter) Context() SpanContext {
+	if span == nil {
+		return SpanContext{}
+	}
+	return span.context
+}
+
+func (span *SpanAdapter) SetAttribute(key string, value interface{}) {
+	if span == nil || !span.context.Sampled {
+		return
+	}
+	span.mutex.Lock()
+	defer span.mutex.Unlock()
+	span.attributes[key] = value
+}
+
+func (span *SpanAdapter) Fail(message string) {
+	if span == nil || !span.context.Sampled {
+		return
+	}
+	span.mutex.Lock()
+	defer span.mutex.Unlock()
+	span.failed = true
+	span.message = message
+}
+
+func (span *SpanAdapter) RecordError(err *shared.Error) {
+	span.Fail(err.Name + ": " + err.Message)
+}
+
+// Only the first call counts, unsampled spans are never exported.
+func (span *SpanAdapter) End() {
+	if span == nil || !span.context.Sampled {
+		return
+	}
+	span.mutex.Lock()
+	if span.end != (time.Time{}) {
+		span.mutex.Unlock()
+		return
+	}
+	span.end = time.Now()
+	span.mutex.Unlock()
+	span.tracer.export(span)
+}
+
+type spanKey struct{}
+
+// Nil when ctx carries no span, every SpanAdapter method accepts a nil one.
+func SpanFromContext(ctx context.Context) *SpanAdapter {
+	span, _ := ctx.Value(spanKey{}).(*SpanAdapter)
+	return span
+}
+
+type SpanExporter interface {
+	Export(spans []*SpanAdapter) error
+}
+
+// Without an Exporter tracing is off, spans are neither created nor
+// propagated. SampleRatio only applies to new traces, a request that comes
+// with a trace context keeps its sampling decision.
+type TracerParameters struct {
+	ServiceName string
+	Exporter    SpanExporter
+	SampleRatio float64
+}
+
+// Finished spans are queued and handed to the exporter in batches by a
+// single background worker, like the mailer does with its messages.
+type TracerAdapter struct {
+	parameters *TracerParameters
+	queue      chan *SpanAdapter
+	done       chan struct{}
+	closeOnce  sync.Once
+}
+
+func (tracerAdapter *TracerAdapter) enabled() bool {
+	return tracerAdapter.parameters.Exporter != nil
+}
+
+func (tracerAdapter *TracerAdapter) sample(traceId [16]byte) bool {
+	ratio := tracerAdapter.parameters.SampleRatio
+	if ratio >= 1 {
+		return true
+	}
+	if ratio <= 0 {
+		return false
+	}
+	return float64(binary.BigEndian.Uint64(traceId[8:])) < ratio*(1<<64)
+}
+
+// A zero parent starts a new trace.
+func (tracerAdapter *TracerAdapter) StartSpan(
+	ctx context.Context, name string, kind int, parent SpanContext,
+) (context.Context, *SpanAdapter) {
+	if !tracerAdapter.enabled() {
+		return ctx, nil
+	}
+	span := &SpanAdapter{
+		tracer:     tracerAdapter,
+		name:       name,
+		kind:       kind,
+		start:      time.Now(),
+		attributes: map[string]interface{}{},
+	}
+	rand.Read(span.context.SpanId[:])
+	if parent.IsValid() {
+		span.context.TraceId = parent.TraceId
+		span.context.Sampled = parent.Sampled
+		span.context.TraceState = parent.TraceState
+		span.parentSpanId = parent.SpanId
+	} else {
+		rand.Read(span.context.TraceId[:])
+		span.context.Sampled = tracerAdapter.sample(span.context.TraceId)
+	}
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+func (tracerAdapter *TracerAdapter) Start(ctx context.Context, name string) (context.Context, providers.Span) {
+	ctx, span := tracerAdapter.StartSpan(ctx, name, SpanKindInternal, SpanFromContext(ctx).Context())
+	return ctx, span
+}
+
+func (tracerAdapter *TracerAdapter) export(span *SpanAdapter) {
+	defer func() {
+		// The queue is closed once Shutdown started, late spans are dropped.
+		recover()
+	}()
+	select {
+	case tracerAdapter.queue <- span:
+	default:
+		LogError(context.Background(), errors.New("span queue is full, dropping span "+span.name))
+	}
+}
+
+func (tracerAdapter *TracerAdapter) flush(batch []*SpanAdapter) []*SpanAdapter {
+	if len(batch) == 0 {
+		return batch
+	}
+	goerr := tracerAdapter.parameters.Exporter.Export(batch)
+	if goerr != nil {
+		LogError(context.Background(), goerr)
+	}
+	return batch[:0]
+}
+
+func (tracerAdapter *TracerAdapter) work() {
+	defer close(tracerAdapter.done)
+	ticker := time.NewTicker(tracerFlushInterval)
+	defer ticker.Stop()
+	batch := []*SpanAdapter{}
+	for {
+		select {
+		case span, ok := <-tracerAdapter.queue:
+			if !ok {
+				tracerAdapter.flush(batch)
+				return
+			}
+			batch = append(batch, span)
+			if len(batch) >= tracerBatchSize {
+				batch = tracerAdapter.flush(batch)
+			}
+		case <-ticker.C:
+			batch = tracerAdapter.flush(batch)
+		}
+	}
+}
+
+// Exports the spans still queued, giving up when ctx is done.
+func (tracerAdapter *TracerAdapter) Shutdown(ctx context.Context) *shared.Error {
+	if !tracerAdapter.enabled() {
+		return nil
+	}
+	tracerAdapter.closeOnce.Do(func() {
+		close(tracerAdapter.queue)
+	})
+	select {
+	case <-tracerAdapter.done:
+		return nil
+	case <-ctx.Done():
+		LogError(ctx, ctx.Err())
+		return exceptions.NewInternalServerError()
+	}
+}
+
+func NewTracerAdapter(parameters *TracerParameters) (*TracerAdapter, *shared.Error) {
+	if parameters.Exporter != nil && parameters.ServiceName == "" {
+		LogError(context.Background(), errors.New("tracing needs a service name"))
+		return nil, exceptions.NewInternalServerError()
+	}
+	tracerAdapter := &TracerAdapter{
+		parameters: parameters,
+		queue:      make(chan *SpanAdapter, tracerQueueSize),
+		done:       make(chan struct{}),
+	}
+	if tracerAdapter.enabled() {
+		go tracerAdapter.work()
+	}
+	return tracerAdapter, nil
+}
+
+var sharedTracer atomic.Value
+
+func init() {
+	sharedTracer.Store(&TracerAdapter{parameters: &TracerParameters{}})
+}
+
+// Like the shared logger, what the interceptors, the sql driver and adapters
+// trace with. It is disabled until main sets the configured one.
+func Tracer() *TracerAdapter {
+	return sharedTracer.Load().(*TracerAdapter)
+}
+
+func SetTracer(tracerAdapter *TracerAdapter) {
+	sharedTracer.Store(tracerAdapter)
+}
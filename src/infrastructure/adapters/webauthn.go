@@ -0,0 +1,391 @@
+package adapters
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/binary"
+	"errors"
+	"math"
+	"math/big"
+
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+const (
+	webAuthnFlagUserPresent            = 0x01
+	webAuthnFlagUserVerified           = 0x04
+	webAuthnFlagAttestedCredentialData = 0x40
+	webAuthnFlagExtensionData          = 0x80
+)
+
+const (
+	coseAlgorithmES256 = -7
+	coseAlgorithmRS256 = -257
+)
+
+var errMalformedCbor = errors.New("malformed cbor")
+
+// Decodes the subset of CBOR that authenticators produce, definite lengths
+// only and no floats or tags, RFC 8949.
+type cborDecoder struct {
+	data   []byte
+	offset int
+}
+
+func (decoder *cborDecoder) read(length uint64) ([]byte, error) {
+	if length > uint64(len(decoder.data)-decoder.offset) {
+		return nil, errMalformedCbor
+	}
+	start := decoder.offset
+	decoder.offset += int(length)
+	return decoder.data[start:decoder.offset], nil
+}
+
+func (decoder *cborDecoder) head() (byte, uint64, error) {
+	initial, err := decoder.read(1)
+	if err != nil {
+		return 0, 0, err
+	}
+	major, additional := initial[0]>>5, initial[0]&0x1f
+	if additional < 24 {
+		return major, uint64(additional), nil
+	}
+	if additional > 27 {
+		return 0, 0, errMalformedCbor
+	}
+	argument, err := decoder.read(1 << (additional - 24))
+	if err != nil {
+		return 0, 0, err
+	}
+	var value uint64
+	for _, b := range argument {
+		value = value<<8 | uint64(b)
+	}
+	return major, value, nil
+}
+
+// Maps keep their int64 or string keys, which is all COSE and attestation
+// objects use.
+func (decoder *cborDecoder) decode(depth int) (interface{}, error) {
+	if depth > 16 {
+		return nil, errMalformedCbor
+	}
+	major, argument, err := decoder.head()
+	if err != nil {
+		return nil, err
+	}
+	switch major {
+	case 0:
+		if argument > math.MaxInt64 {
+			return nil, errMalformedCbor
+		}
+		return int64(argument), nil
+	case 1:
+		if argument > math.MaxInt64 {
+			return nil, errMalformedCbor
+		}
+		return -1 - int64(argument), nil
+	case 2:
+		return decoder.read(argument)
+	case 3:
+		text, err := decoder.read(argument)
+		return string(text), err
+	case 4:
+		if argument > uint64(len(decoder.data)) {
+			return nil, errMalformedCbor
+		}
+		items := []interface{}{}
+		for i := uint64(0); i < argument; i++ {
+			item, err := decoder.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, item)
+		}
+		return items, nil
+	case 5:
+		if argument > uint64(len(decoder.data)) {
+			return nil, errMalformedCbor
+		}
+		entries := map[interface{}]interface{}{}
+		for i := uint64(0); i < argument; i++ {
+			key, err := decoder.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			switch key.(type) {
+			case int64, string:
+			default:
+				return nil, errMalformedCbor
+			}
+			value, err := decoder.decode(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			entries[key] = value
+		}
+		return entries, nil
+	case 7:
+		switch argument {
+		case 20:
+			return false, nil
+		case 21:
+			return true, nil
+		case 22:
+			return nil, nil
+		}
+	}
+	return nil, errMalformedCbor
+}
+
+func decodeCbor(data []byte) (interface{}, int, error) {
+	decoder := &cborDecoder{data: data}
+	value, err := decoder.decode(0)
+	if err != nil {
+		return nil, 0, err
+	}
+	return value, decoder.offset, nil
+}
+
+type coseKey struct {
+	algorithm int64
+	publicKey crypto.PublicKey
+}
+
+func coseInt(key map[interface{}]interface{}, label int64) (int64, bool) {
+	value, ok := key[label].(int64)
+	return value, ok
+}
+
+func coseBytes(key map[interface{}]interface{}, label int64) ([]byte, bool) {
+	value, ok := key[label].([]byte)
+	return value, ok
+}
+
+// Only ES256 on P-256 and RS256 are accepted, which covers the algorithms
+// browsers ask for by default, RFC 9053.
+func parseCoseKey(data []byte) (*coseKey, error) {
+	decoded, length, err := decodeCbor(data)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := decoded.(map[interface{}]interface{})
+	if !ok || length != len(data) {
+		return nil, errMalformedCbor
+	}
+	keyType, _ := coseInt(key, 1)
+	algorithm, _ := coseInt(key, 3)
+	switch {
+	case keyType == 2 && algorithm == coseAlgorithmES256:
+		curve, _ := coseInt(key, -1)
+		x, xOk := coseBytes(key, -2)
+		y, yOk := coseBytes(key, -3)
+		if curve != 1 || !xOk || !yOk || len(x) != 32 || len(y) != 32 {
+			return nil, errors.New("invalid ec2 cose key")
+		}
+		publicKey := &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(x),
+			Y:     new(big.Int).SetBytes(y),
+		}
+		if !publicKey.Curve.IsOnCurve(publicKey.X, publicKey.Y) {
+			return nil, errors.New("cose key point is not on the curve")
+		}
+		return &coseKey{algorithm: algorithm, publicKey: publicKey}, nil
+	case keyType == 3 && algorithm == coseAlgorithmRS256:
+		n, nOk := coseBytes(key, -1)
+		e, eOk := coseBytes(key, -2)
+		if !nOk || !eOk || len(e) == 0 || len(e) > 4 {
+			return nil, errors.New("invalid rsa cose key")
+		}
+		exponent := 0
+		for _, b := range e {
+			exponent = exponent<<8 | int(b)
+		}
+		publicKey := &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: exponent}
+		if publicKey.N.BitLen() < 2048 || exponent < 3 {
+			return nil, errors.New("weak rsa cose key")
+		}
+		return &coseKey{algorithm: algorithm, publicKey: publicKey}, nil
+	}
+	return nil, errors.New("unsupported cose key")
+}
+
+func (key *coseKey) verify(data []byte, signature []byte) bool {
+	digest := sha256.Sum256(data)
+	switch publicKey := key.publicKey.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(publicKey, digest[:], signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, digest[:], signature) == nil
+	}
+	return false
+}
+
+type WebAuthnAdapter struct{}
+
+func (webAuthnAdapter *WebAuthnAdapter) parseAuthenticatorData(
+	data []byte,
+) (*providers.WebAuthnAuthenticatorData, error) {
+	if len(data) < 37 {
+		return nil, errors.New("authenticator data is too short")
+	}
+	flags := data[32]
+	authenticatorData := &providers.WebAuthnAuthenticatorData{
+		RpIdHash:     data[:32],
+		UserPresent:  flags&webAuthnFlagUserPresent != 0,
+		UserVerified: flags&webAuthnFlagUserVerified != 0,
+		SignCount:    binary.BigEndian.Uint32(data[33:37]),
+	}
+	offset := 37
+	if flags&webAuthnFlagAttestedCredentialData != 0 {
+		if len(data) < offset+18 {
+			return nil, errors.New("attested credential data is too short")
+		}
+		credentialIdLength := int(binary.BigEndian.Uint16(data[offset+16 : offset+18]))
+		offset += 18
+		if credentialIdLength == 0 || len(data) < offset+credentialIdLength {
+			return nil, errors.New("invalid credential id length")
+		}
+		authenticatorData.CredentialId = data[offset : offset+credentialIdLength]
+		offset += credentialIdLength
+		_, length, err := decodeCbor(data[offset:])
+		if err != nil {
+			return nil, err
+		}
+		authenticatorData.PublicKey = data[offset : offset+length]
+		offset += length
+	}
+	if flags&webAuthnFlagExtensionData != 0 {
+		_, length, err := decodeCbor(data[offset:])
+		if err != nil {
+			return nil, err
+		}
+		offset += length
+	}
+	if offset != len(data) {
+		return nil, errors.New("trailing bytes after authenticator data")
+	}
+	return authenticatorData, nil
+}
+
+// Packed attestation, WebAuthn section 8.2. The certificate chain isn't
+// checked against any metadata service, so a full attestation proves no more
+// than self attestation and only its signature is verified.
+func (webAuthnAdapter *WebAuthnAdapter) verifyPackedStatement(
+	statement map[interface{}]interface{},
+	credentialKey *coseKey,
+	signed []byte,
+) error {
+	algorithm, ok := statement["alg"].(int64)
+	if !ok {
+		return errors.New("packed attestation without alg")
+	}
+	signature, ok := statement["sig"].([]byte)
+	if !ok {
+		return errors.New("packed attestation without sig")
+	}
+	chain, hasChain := statement["x5c"].([]interface{})
+	if !hasChain {
+		if algorithm != credentialKey.algorithm || !credentialKey.verify(signed, signature) {
+			return errors.New("invalid self attestation signature")
+		}
+		return nil
+	}
+	if len(chain) == 0 {
+		return errors.New("empty x5c")
+	}
+	leaf, ok := chain[0].([]byte)
+	if !ok {
+		return errors.New("invalid x5c")
+	}
+	certificate, goerr := x509.ParseCertificate(leaf)
+	if goerr != nil {
+		return goerr
+	}
+	if certificate.Version != 3 || certificate.IsCA {
+		return errors.New("invalid attestation certificate")
+	}
+	signatureAlgorithm := x509.UnknownSignatureAlgorithm
+	switch algorithm {
+	case coseAlgorithmES256:
+		signatureAlgorithm = x509.ECDSAWithSHA256
+	case coseAlgorithmRS256:
+		signatureAlgorithm = x509.SHA256WithRSA
+	}
+	return certificate.CheckSignature(signatureAlgorithm, signed, signature)
+}
+
+func (webAuthnAdapter *WebAuthnAdapter) VerifyAttestation(
+	attestationObject []byte, clientDataHash []byte,
+) (*providers.WebAuthnAuthenticatorData, *shared.Error) {
+	decoded, length, goerr := decodeCbor(attestationObject)
+	if goerr != nil || length != len(attestationObject) {
+		return nil, exceptions.NewInvalidWebAuthnResponse()
+	}
+	object, ok := decoded.(map[interface{}]interface{})
+	if !ok {
+		return nil, exceptions.NewInvalidWebAuthnResponse()
+	}
+	format, _ := object["fmt"].(string)
+	statement, statementOk := object["attStmt"].(map[interface{}]interface{})
+	data, dataOk := object["authData"].([]byte)
+	if !statementOk || !dataOk {
+		return nil, exceptions.NewInvalidWebAuthnResponse()
+	}
+	authenticatorData, goerr := webAuthnAdapter.parseAuthenticatorData(data)
+	if goerr != nil || authenticatorData.PublicKey == nil {
+		return nil, exceptions.NewInvalidWebAuthnResponse()
+	}
+	credentialKey, goerr := parseCoseKey(authenticatorData.PublicKey)
+	if goerr != nil {
+		return nil, exceptions.NewInvalidWebAuthnResponse()
+	}
+	switch format {
+	case "none":
+		if len(statement) != 0 {
+			return nil, exceptions.NewInvalidWebAuthnResponse()
+		}
+	case "packed":
+		signed := append(append([]byte{}, data...), clientDataHash...)
+		goerr = webAuthnAdapter.verifyPackedStatement(statement, credentialKey, signed)
+		if goerr != nil {
+			return nil, exceptions.NewInvalidWebAuthnResponse()
+		}
+	default:
+		return nil, exceptions.NewInvalidWebAuthnResponse()
+	}
+	return authenticatorData, nil
+}
+
+func (webAuthnAdapter *WebAuthnAdapter) ParseAuthenticatorData(
+	authenticatorData []byte,
+) (*providers.WebAuthnAuthenticatorData, *shared.Error) {
+	parsed, goerr := webAuthnAdapter.parseAuthenticatorData(authenticatorData)
+	if goerr != nil {
+		return nil, exceptions.NewInvalidWebAuthnResponse()
+	}
+	return parsed, nil
+}
+
+func (webAuthnAdapter *WebAuthnAdapter) VerifySignature(
+	publicKey []byte, data []byte, signature []byte,
+) (bool, *shared.Error) {
+	key, goerr := parseCoseKey(publicKey)
+	if goerr != nil {
+		LogError(context.Background(), goerr)
+		return false, exceptions.NewInternalServerError()
+	}
+	return key.verify(data, signature), nil
+}
+
+func NewWebAuthnAdapter() (*WebAuthnAdapter, *shared.Error) {
+	return &WebAuthnAdapter{}, nil
+}
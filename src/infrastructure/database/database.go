@@ -1,12 +1,12 @@
 package database
 
 import (
+	"context"
 	"database/sql"
-	"log"
 
 	"github.com/AndreyArthur/oganessone/src/core/exceptions"
 	"github.com/AndreyArthur/oganessone/src/core/shared"
-	_ "github.com/lib/pq"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
 )
 
 type Database struct{}
@@ -17,9 +17,9 @@ func (database *Database) Connect() (*sql.DB, *shared.Error) {
 		return nil, err
 	}
 	info := postgres.GenerateConnectionString()
-	connection, goerr := sql.Open("postgres", info)
+	connection, goerr := sql.Open(tracedDriverName, info)
 	if goerr != nil {
-		log.Println(goerr)
+		adapters.LogError(context.Background(), goerr)
 		return nil, exceptions.NewInternalServerError()
 	}
 	return connection, nil
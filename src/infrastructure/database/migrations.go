@@ -19,12 +19,118 @@ func (migrator *Migrator) Up() {
 		log.Fatal(goerr)
 		return
 	}
+	_, goerr = db.Query(`
+		CREATE TABLE IF NOT EXISTS organizations (
+			id UUID UNIQUE NOT NULL DEFAULT uuid_generate_v4(),
+			name VARCHAR(64) NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+		);
+	`)
+	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
 	_, goerr = db.Query(`
 		CREATE TABLE IF NOT EXISTS users (
 			id UUID UNIQUE NOT NULL DEFAULT uuid_generate_v4(),
-			username VARCHAR(16) UNIQUE NOT NULL,
-			email VARCHAR(255) UNIQUE NOT NULL,
-			password VARCHAR(60) UNIQUE NOT NULL,
+			organization_id UUID REFERENCES organizations (id) ON DELETE CASCADE,
+			username VARCHAR(16) NOT NULL,
+			email VARCHAR(255) NOT NULL,
+			password VARCHAR(255) UNIQUE NOT NULL,
+			email_verified BOOLEAN NOT NULL DEFAULT FALSE,
+			mfa_secret TEXT,
+			mfa_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+			mfa_last_step BIGINT,
+			mfa_recovery_codes TEXT[] NOT NULL DEFAULT '{}',
+			created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+		);
+	`)
+	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
+	// Tables made before organizations had global unique usernames and emails.
+	_, goerr = db.Query(`
+		ALTER TABLE users
+			ADD COLUMN IF NOT EXISTS organization_id UUID REFERENCES organizations (id) ON DELETE CASCADE,
+			DROP CONSTRAINT IF EXISTS users_username_key,
+			DROP CONSTRAINT IF EXISTS users_email_key,
+			ADD COLUMN IF NOT EXISTS email_verified BOOLEAN NOT NULL DEFAULT FALSE,
+			ADD COLUMN IF NOT EXISTS mfa_secret TEXT,
+			ADD COLUMN IF NOT EXISTS mfa_enabled BOOLEAN NOT NULL DEFAULT FALSE,
+			ADD COLUMN IF NOT EXISTS mfa_last_step BIGINT,
+			ADD COLUMN IF NOT EXISTS mfa_recovery_codes TEXT[] NOT NULL DEFAULT '{}',
+			ALTER COLUMN password TYPE VARCHAR(255);
+	`)
+	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
+	// A composite unique index sees two NULL organization ids as distinct,
+	// users without an organization need indexes of their own.
+	_, goerr = db.Query(`
+		CREATE UNIQUE INDEX IF NOT EXISTS users_organization_id_username
+			ON users (organization_id, username) WHERE organization_id IS NOT NULL;
+		CREATE UNIQUE INDEX IF NOT EXISTS users_organization_id_email
+			ON users (organization_id, email) WHERE organization_id IS NOT NULL;
+		CREATE UNIQUE INDEX IF NOT EXISTS users_username_without_organization
+			ON users (username) WHERE organization_id IS NULL;
+		CREATE UNIQUE INDEX IF NOT EXISTS users_email_without_organization
+			ON users (email) WHERE organization_id IS NULL;
+	`)
+	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
+	_, goerr = db.Query(`
+		CREATE TABLE IF NOT EXISTS memberships (
+			id UUID UNIQUE NOT NULL DEFAULT uuid_generate_v4(),
+			organization_id UUID NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+			user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+			role VARCHAR(16) NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+			UNIQUE (organization_id, user_id)
+		);
+	`)
+	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
+	_, goerr = db.Query(`
+		CREATE TABLE IF NOT EXISTS api_keys (
+			id UUID UNIQUE NOT NULL DEFAULT uuid_generate_v4(),
+			user_id UUID REFERENCES users (id) ON DELETE CASCADE,
+			organization_id UUID REFERENCES organizations (id) ON DELETE CASCADE,
+			name VARCHAR(64) NOT NULL,
+			prefix VARCHAR(16) UNIQUE NOT NULL,
+			hash VARCHAR(255) NOT NULL,
+			scopes TEXT[] NOT NULL DEFAULT '{}',
+			allowed_ips TEXT[] NOT NULL DEFAULT '{}',
+			expires_at TIMESTAMP,
+			revoked_at TIMESTAMP,
+			created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+			CHECK (user_id IS NOT NULL OR organization_id IS NOT NULL)
+		);
+	`)
+	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
+	_, goerr = db.Query(`
+		CREATE TABLE IF NOT EXISTS oauth_clients (
+			id UUID UNIQUE NOT NULL DEFAULT uuid_generate_v4(),
+			organization_id UUID NOT NULL REFERENCES organizations (id) ON DELETE CASCADE,
+			name VARCHAR(64) NOT NULL,
+			redirect_uris TEXT[] NOT NULL DEFAULT '{}',
+			auth_method VARCHAR(32) NOT NULL DEFAULT 'none',
+			secret_hash VARCHAR(255) NOT NULL DEFAULT '',
+			public_key TEXT NOT NULL DEFAULT '',
+			scopes TEXT[] NOT NULL DEFAULT '{}',
+			audiences TEXT[] NOT NULL DEFAULT '{}',
 			created_at TIMESTAMP NOT NULL DEFAULT NOW(),
 			updated_at TIMESTAMP NOT NULL DEFAULT NOW()
 		);
@@ -33,12 +139,129 @@ func (migrator *Migrator) Up() {
 		log.Fatal(goerr)
 		return
 	}
+	_, goerr = db.Query(`
+		ALTER TABLE oauth_clients
+			ADD COLUMN IF NOT EXISTS auth_method VARCHAR(32) NOT NULL DEFAULT 'none',
+			ADD COLUMN IF NOT EXISTS secret_hash VARCHAR(255) NOT NULL DEFAULT '',
+			ADD COLUMN IF NOT EXISTS public_key TEXT NOT NULL DEFAULT '',
+			ADD COLUMN IF NOT EXISTS scopes TEXT[] NOT NULL DEFAULT '{}',
+			ADD COLUMN IF NOT EXISTS audiences TEXT[] NOT NULL DEFAULT '{}',
+			ALTER COLUMN secret_hash TYPE VARCHAR(255);
+	`)
+	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
+	_, goerr = db.Query(`
+		CREATE TABLE IF NOT EXISTS webauthn_credentials (
+			id UUID UNIQUE NOT NULL DEFAULT uuid_generate_v4(),
+			user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+			credential_id TEXT UNIQUE NOT NULL,
+			public_key TEXT NOT NULL,
+			sign_count BIGINT NOT NULL DEFAULT 0,
+			transports TEXT[] NOT NULL DEFAULT '{}',
+			created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+			updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+		);
+	`)
+	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
+	_, goerr = db.Query(`
+		CREATE TABLE IF NOT EXISTS password_history (
+			id UUID UNIQUE NOT NULL DEFAULT uuid_generate_v4(),
+			user_id UUID NOT NULL REFERENCES users (id) ON DELETE CASCADE,
+			password VARCHAR(255) NOT NULL,
+			created_at TIMESTAMP NOT NULL DEFAULT NOW()
+		);
+		CREATE INDEX IF NOT EXISTS password_history_user_id_created_at
+			ON password_history (user_id, created_at);
+	`)
+	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
+	// Audit events have no foreign keys, they have to outlive the users and
+	// organizations they mention, and the trigger keeps the table append only.
+	_, goerr = db.Query(`
+		CREATE TABLE IF NOT EXISTS audit_events (
+			id UUID UNIQUE NOT NULL DEFAULT uuid_generate_v4(),
+			type VARCHAR(32) NOT NULL,
+			actor_id VARCHAR(255) NOT NULL DEFAULT '',
+			target_id VARCHAR(255) NOT NULL DEFAULT '',
+			organization_id UUID,
+			ip_address VARCHAR(64) NOT NULL DEFAULT '',
+			user_agent VARCHAR(512) NOT NULL DEFAULT '',
+			detail VARCHAR(255) NOT NULL DEFAULT '',
+			created_at TIMESTAMP NOT NULL DEFAULT NOW()
+		);
+		CREATE INDEX IF NOT EXISTS audit_events_created_at
+			ON audit_events (created_at, id);
+		CREATE INDEX IF NOT EXISTS audit_events_organization_id_created_at
+			ON audit_events (organization_id, created_at);
+		CREATE INDEX IF NOT EXISTS audit_events_actor_id_created_at
+			ON audit_events (actor_id, created_at);
+		CREATE INDEX IF NOT EXISTS audit_events_target_id_created_at
+			ON audit_events (target_id, created_at);
+		CREATE OR REPLACE FUNCTION audit_events_append_only() RETURNS TRIGGER AS $$
+		BEGIN
+			RAISE EXCEPTION 'audit_events is append only';
+		END;
+		$$ LANGUAGE plpgsql;
+		DROP TRIGGER IF EXISTS audit_events_append_only ON audit_events;
+		CREATE TRIGGER audit_events_append_only
+			BEFORE UPDATE OR DELETE OR TRUNCATE ON audit_events
+			FOR EACH STATEMENT EXECUTE PROCEDURE audit_events_append_only();
+	`)
+	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
 }
 
 func (migrator *Migrator) Down() {
 	db := migrator.db
 	defer db.Close()
-	_, goerr := db.Query("DROP TABLE IF EXISTS users;")
+	_, goerr := db.Query(`
+		DROP TABLE IF EXISTS audit_events;
+		DROP FUNCTION IF EXISTS audit_events_append_only;
+	`)
+	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
+	_, goerr = db.Query("DROP TABLE IF EXISTS password_history;")
+	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
+	_, goerr = db.Query("DROP TABLE IF EXISTS webauthn_credentials;")
+	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
+	_, goerr = db.Query("DROP TABLE IF EXISTS oauth_clients;")
+	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
+	_, goerr = db.Query("DROP TABLE IF EXISTS api_keys;")
+	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
+	_, goerr = db.Query("DROP TABLE IF EXISTS memberships;")
+	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
+	_, goerr = db.Query("DROP TABLE IF EXISTS users;")
+	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
+	_, goerr = db.Query("DROP TABLE IF EXISTS organizations;")
 	if goerr != nil {
 		log.Fatal(goerr)
 		return
@@ -0,0 +1,124 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"strings"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/lib/pq"
+)
+
+const tracedDriverName = "postgres+tracing"
+
+func init() {
+	sql.Register(tracedDriverName, &tracedDriver{driver: &pq.Driver{}})
+}
+
+// Statements only get a span when ctx already carries one, a query made
+// outside of a request would otherwise start a trace of its own.
+func startStatementSpan(ctx context.Context, operation string, query string) *adapters.SpanAdapter {
+	parent := adapters.SpanFromContext(ctx)
+	if parent == nil {
+		return nil
+	}
+	_, span := adapters.Tracer().StartSpan(ctx, "sql."+operation, adapters.SpanKindClient, parent.Context())
+	span.SetAttribute("db.system", "postgresql")
+	span.SetAttribute("db.statement", strings.Join(strings.Fields(query), " "))
+	return span
+}
+
+func endStatementSpan(span *adapters.SpanAdapter, goerr error) {
+	if goerr != nil && goerr != driver.ErrSkip {
+		span.Fail(goerr.Error())
+	}
+	span.End()
+}
+
+type tracedDriver struct {
+	driver driver.Driver
+}
+
+// What lib/pq connections implement, anything else is used untraced.
+type tracedConnTarget interface {
+	driver.Conn
+	driver.ConnPrepareContext
+	driver.ConnBeginTx
+	driver.QueryerContext
+	driver.ExecerContext
+	driver.Pinger
+}
+
+func (tracedDriver *tracedDriver) Open(name string) (driver.Conn, error) {
+	conn, goerr := tracedDriver.driver.Open(name)
+	if goerr != nil {
+		return nil, goerr
+	}
+	target, ok := conn.(tracedConnTarget)
+	if !ok {
+		return conn, nil
+	}
+	return &tracedConn{tracedConnTarget: target}, nil
+}
+
+type tracedConn struct {
+	tracedConnTarget
+}
+
+func (conn *tracedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	span := startStatementSpan(ctx, "prepare", query)
+	stmt, goerr := conn.tracedConnTarget.PrepareContext(ctx, query)
+	endStatementSpan(span, goerr)
+	if goerr != nil {
+		return nil, goerr
+	}
+	target, ok := stmt.(tracedStmtTarget)
+	if !ok {
+		return stmt, nil
+	}
+	return &tracedStmt{tracedStmtTarget: target, query: query}, nil
+}
+
+func (conn *tracedConn) QueryContext(
+	ctx context.Context, query string, args []driver.NamedValue,
+) (driver.Rows, error) {
+	span := startStatementSpan(ctx, "query", query)
+	rows, goerr := conn.tracedConnTarget.QueryContext(ctx, query, args)
+	endStatementSpan(span, goerr)
+	return rows, goerr
+}
+
+func (conn *tracedConn) ExecContext(
+	ctx context.Context, query string, args []driver.NamedValue,
+) (driver.Result, error) {
+	span := startStatementSpan(ctx, "exec", query)
+	result, goerr := conn.tracedConnTarget.ExecContext(ctx, query, args)
+	endStatementSpan(span, goerr)
+	return result, goerr
+}
+
+type tracedStmtTarget interface {
+	driver.Stmt
+	driver.StmtQueryContext
+	driver.StmtExecContext
+}
+
+type tracedStmt struct {
+	tracedStmtTarget
+	query string
+}
+
+func (stmt *tracedStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	span := startStatementSpan(ctx, "query", stmt.query)
+	rows, goerr := stmt.tracedStmtTarget.QueryContext(ctx, args)
+	endStatementSpan(span, goerr)
+	return rows, goerr
+}
+
+func (stmt *tracedStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	span := startStatementSpan(ctx, "exec", stmt.query)
+	result, goerr := stmt.tracedStmtTarget.ExecContext(ctx, args)
+	endStatementSpan(span, goerr)
+	return result, goerr
+}
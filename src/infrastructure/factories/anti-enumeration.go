@@ -0,0 +1,58 @@
+package factories
+
+import (
+	"context"
+	"os"
+	"sync"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+)
+
+var antiEnumerationOptions *definitions.AntiEnumerationOptions
+var antiEnumerationOptionsOnce sync.Once
+
+// The dummy hash is made once per process, hashing it on every request would
+// cost more than the comparison it stands in for.
+func MakeAntiEnumerationOptions() (*definitions.AntiEnumerationOptions, *shared.Error) {
+	var err *shared.Error
+	antiEnumerationOptionsOnce.Do(func() {
+		if os.Getenv("ANTI_ENUMERATION") != "true" {
+			antiEnumerationOptions = &definitions.AntiEnumerationOptions{}
+			return
+		}
+		encrypter, encrypterError := MakeEncrypterAdapter()
+		if encrypterError != nil {
+			err = encrypterError
+			return
+		}
+		random, randomError := adapters.NewRandomAdapter()
+		if randomError != nil {
+			err = randomError
+			return
+		}
+		password, randomError := random.String(32)
+		if randomError != nil {
+			err = randomError
+			return
+		}
+		hash, hashError := encrypter.Hash(context.Background(), password)
+		if hashError != nil {
+			err = hashError
+			return
+		}
+		antiEnumerationOptions = &definitions.AntiEnumerationOptions{
+			Enabled:           true,
+			DummyPasswordHash: hash,
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+	if antiEnumerationOptions == nil {
+		return nil, exceptions.NewInternalServerError()
+	}
+	return antiEnumerationOptions, nil
+}
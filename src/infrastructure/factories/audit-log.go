@@ -0,0 +1,14 @@
+package factories
+
+import (
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+)
+
+func MakeAuditLogRepository() (*repositories.AuditLogRepositoryPostgres, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	return repositories.NewAuditLogRepositoryPostgres(sql)
+}
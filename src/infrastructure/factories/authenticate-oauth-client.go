@@ -0,0 +1,38 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+)
+
+func MakeAuthenticateOAuthClientUseCase() (*usecases.AuthenticateOAuthClientUseCase, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	clients, err := repositories.NewOAuthClientsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	encrypter, err := MakeEncrypterAdapter()
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := adapters.NewTokenVerifierAdapter()
+	if err != nil {
+		return nil, err
+	}
+	openId, err := MakeOpenIdOptions()
+	if err != nil {
+		return nil, err
+	}
+	authenticateOAuthClient, err := usecases.NewAuthenticateOAuthClientUseCase(
+		clients, encrypter, verifier, openId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return authenticateOAuthClient, nil
+}
@@ -0,0 +1,27 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+)
+
+func MakeAuthenticatePrincipalUseCase() (*usecases.AuthenticatePrincipalUseCase, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	authenticateSession, err := MakeAuthenticateSessionUseCase()
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := repositories.NewMembershipsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	authenticatePrincipal, err := usecases.NewAuthenticatePrincipalUseCase(authenticateSession, memberships)
+	if err != nil {
+		return nil, err
+	}
+	return authenticatePrincipal, nil
+}
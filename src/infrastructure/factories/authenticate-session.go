@@ -0,0 +1,32 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+)
+
+func MakeAuthenticateSessionUseCase() (*usecases.AuthenticateSessionUseCase, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	cache, err := MakeCacheAdapter()
+	if err != nil {
+		return nil, err
+	}
+	apiKeys, err := repositories.NewApiKeysRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	digest, err := adapters.NewDigestAdapter()
+	if err != nil {
+		return nil, err
+	}
+	authenticateSession, err := usecases.NewAuthenticateSessionUseCase(cache, apiKeys, digest)
+	if err != nil {
+		return nil, err
+	}
+	return authenticateSession, nil
+}
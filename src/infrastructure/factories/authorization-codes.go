@@ -0,0 +1,22 @@
+package factories
+
+import (
+	"sync"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+)
+
+var authorizationCodesRepository *repositories.AuthorizationCodesRepositoryMemory
+var authorizationCodesRepositoryOnce sync.Once
+
+func MakeAuthorizationCodesRepository() (*repositories.AuthorizationCodesRepositoryMemory, *shared.Error) {
+	var err *shared.Error
+	authorizationCodesRepositoryOnce.Do(func() {
+		authorizationCodesRepository, err = repositories.NewAuthorizationCodesRepositoryMemory()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return authorizationCodesRepository, nil
+}
@@ -0,0 +1,47 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeAuthorizePresenter() (*presenters.AuthorizePresenter, *shared.Error) {
+	validateAuthorizationRequest, err := MakeValidateAuthorizationRequestUseCase()
+	if err != nil {
+		return nil, err
+	}
+	createSession, err := MakeCreateSessionUseCase()
+	if err != nil {
+		return nil, err
+	}
+	verifyMfa, err := MakeVerifyMfaUseCase()
+	if err != nil {
+		return nil, err
+	}
+	authorizationCodes, err := MakeAuthorizationCodesRepository()
+	if err != nil {
+		return nil, err
+	}
+	random, err := adapters.NewRandomAdapter()
+	if err != nil {
+		return nil, err
+	}
+	cache, err := MakeCacheAdapter()
+	if err != nil {
+		return nil, err
+	}
+	authorize, err := usecases.NewAuthorizeUseCase(
+		validateAuthorizationRequest, createSession, verifyMfa, authorizationCodes, random, cache,
+		adapters.Logger(), adapters.Tracer(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	authorizePresenter, err := presenters.NewAuthorizePresenter(authorize)
+	if err != nil {
+		return nil, err
+	}
+	return authorizePresenter, nil
+}
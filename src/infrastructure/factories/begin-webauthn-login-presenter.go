@@ -0,0 +1,52 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeBeginWebAuthnLoginPresenter() (*presenters.BeginWebAuthnLoginPresenter, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	users, err := repositories.NewUsersRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := repositories.NewWebAuthnCredentialsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	challenges, err := MakeWebAuthnChallengesRepository()
+	if err != nil {
+		return nil, err
+	}
+	random, err := adapters.NewRandomAdapter()
+	if err != nil {
+		return nil, err
+	}
+	tenancy, err := MakeTenancyOptions()
+	if err != nil {
+		return nil, err
+	}
+	options, err := MakeWebAuthnOptions()
+	if err != nil {
+		return nil, err
+	}
+	beginWebAuthnLogin, err := usecases.NewBeginWebAuthnLoginUseCase(
+		users, credentials, challenges, random, tenancy, options,
+	)
+	if err != nil {
+		return nil, err
+	}
+	beginWebAuthnLoginPresenter, err := presenters.
+		NewBeginWebAuthnLoginPresenter(beginWebAuthnLogin)
+	if err != nil {
+		return nil, err
+	}
+	return beginWebAuthnLoginPresenter, nil
+}
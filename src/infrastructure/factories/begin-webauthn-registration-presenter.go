@@ -0,0 +1,48 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeBeginWebAuthnRegistrationPresenter() (*presenters.BeginWebAuthnRegistrationPresenter, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	users, err := repositories.NewUsersRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := repositories.NewWebAuthnCredentialsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	challenges, err := MakeWebAuthnChallengesRepository()
+	if err != nil {
+		return nil, err
+	}
+	random, err := adapters.NewRandomAdapter()
+	if err != nil {
+		return nil, err
+	}
+	options, err := MakeWebAuthnOptions()
+	if err != nil {
+		return nil, err
+	}
+	beginWebAuthnRegistration, err := usecases.NewBeginWebAuthnRegistrationUseCase(
+		users, credentials, challenges, random, options,
+	)
+	if err != nil {
+		return nil, err
+	}
+	beginWebAuthnRegistrationPresenter, err := presenters.
+		NewBeginWebAuthnRegistrationPresenter(beginWebAuthnRegistration)
+	if err != nil {
+		return nil, err
+	}
+	return beginWebAuthnRegistrationPresenter, nil
+}
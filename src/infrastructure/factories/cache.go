@@ -0,0 +1,22 @@
+package factories
+
+import (
+	"sync"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+)
+
+var cacheAdapter *adapters.CacheAdapter
+var cacheAdapterOnce sync.Once
+
+func MakeCacheAdapter() (*adapters.CacheAdapter, *shared.Error) {
+	var err *shared.Error
+	cacheAdapterOnce.Do(func() {
+		cacheAdapter, err = adapters.NewCacheAdapter()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return cacheAdapter, nil
+}
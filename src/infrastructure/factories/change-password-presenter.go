@@ -0,0 +1,55 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeChangePasswordPresenter() (*presenters.ChangePasswordPresenter, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	users, err := repositories.NewUsersRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	history, err := repositories.NewPasswordHistoryRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	encrypter, err := MakeEncrypterAdapter()
+	if err != nil {
+		return nil, err
+	}
+	screener, err := MakePasswordScreenerAdapter()
+	if err != nil {
+		return nil, err
+	}
+	policy, err := MakePasswordPolicy()
+	if err != nil {
+		return nil, err
+	}
+	options, err := MakePasswordHistoryOptions()
+	if err != nil {
+		return nil, err
+	}
+	auditLog, err := repositories.NewAuditLogRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	changePassword, err := usecases.NewChangePasswordUseCase(
+		users, history, encrypter, screener, policy, options, auditLog,
+	)
+	if err != nil {
+		return nil, err
+	}
+	changePasswordPresenter, err := presenters.
+		NewChangePasswordPresenter(changePassword)
+	if err != nil {
+		return nil, err
+	}
+	return changePasswordPresenter, nil
+}
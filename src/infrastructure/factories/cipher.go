@@ -0,0 +1,44 @@
+package factories
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"os"
+	"sync"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+)
+
+var cipherAdapter *adapters.CipherAdapter
+var cipherAdapterOnce sync.Once
+
+// There is no ephemeral fallback like for the signing key, secrets encrypted
+// with a key that is lost on restart could never be read again.
+func MakeCipherAdapter() (*adapters.CipherAdapter, *shared.Error) {
+	var err *shared.Error
+	cipherAdapterOnce.Do(func() {
+		encoded := os.Getenv("MFA_ENCRYPTION_KEY")
+		if encoded == "" {
+			adapters.LogError(context.Background(), errors.New("MFA_ENCRYPTION_KEY is not set"))
+			err = exceptions.NewInternalServerError()
+			return
+		}
+		key, goerr := base64.StdEncoding.DecodeString(encoded)
+		if goerr != nil {
+			adapters.LogError(context.Background(), goerr)
+			err = exceptions.NewInternalServerError()
+			return
+		}
+		cipherAdapter, err = adapters.NewCipherAdapter(key)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if cipherAdapter == nil {
+		return nil, exceptions.NewInternalServerError()
+	}
+	return cipherAdapter, nil
+}
@@ -0,0 +1,38 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeConfirmMfaPresenter() (*presenters.ConfirmMfaPresenter, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	users, err := repositories.NewUsersRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	cipher, err := MakeCipherAdapter()
+	if err != nil {
+		return nil, err
+	}
+	otp, err := adapters.NewOtpAdapter()
+	if err != nil {
+		return nil, err
+	}
+	confirmMfa, err := usecases.NewConfirmMfaUseCase(users, cipher, otp)
+	if err != nil {
+		return nil, err
+	}
+	confirmMfaPresenter, err := presenters.
+		NewConfirmMfaPresenter(confirmMfa)
+	if err != nil {
+		return nil, err
+	}
+	return confirmMfaPresenter, nil
+}
@@ -0,0 +1,42 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeCreateApiKeyPresenter() (*presenters.CreateApiKeyPresenter, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	apiKeys, err := repositories.NewApiKeysRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := repositories.NewMembershipsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	digest, err := adapters.NewDigestAdapter()
+	if err != nil {
+		return nil, err
+	}
+	random, err := adapters.NewRandomAdapter()
+	if err != nil {
+		return nil, err
+	}
+	createApiKey, err := usecases.NewCreateApiKeyUseCase(apiKeys, memberships, digest, random)
+	if err != nil {
+		return nil, err
+	}
+	createApiKeyPresenter, err := presenters.
+		NewCreateApiKeyPresenter(createApiKey)
+	if err != nil {
+		return nil, err
+	}
+	return createApiKeyPresenter, nil
+}
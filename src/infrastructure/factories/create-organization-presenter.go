@@ -0,0 +1,33 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeCreateOrganizationPresenter() (*presenters.CreateOrganizationPresenter, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	organizations, err := repositories.NewOrganizationsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := repositories.NewMembershipsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	createOrganization, err := usecases.NewCreateOrganizationUseCase(organizations, memberships)
+	if err != nil {
+		return nil, err
+	}
+	createOrganizationPresenter, err := presenters.
+		NewCreateOrganizationPresenter(createOrganization)
+	if err != nil {
+		return nil, err
+	}
+	return createOrganizationPresenter, nil
+}
@@ -0,0 +1,18 @@
+package factories
+
+import (
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeCreateSessionPresenter() (*presenters.CreateSessionPresenter, *shared.Error) {
+	createSession, err := MakeCreateSessionUseCase()
+	if err != nil {
+		return nil, err
+	}
+	createSessionPresenter, err := presenters.NewCreateSessionPresenter(createSession)
+	if err != nil {
+		return nil, err
+	}
+	return createSessionPresenter, nil
+}
@@ -0,0 +1,63 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+)
+
+func MakeCreateSessionUseCase() (*usecases.CreateSessionUseCase, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	repo, err := repositories.NewUsersRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := repositories.NewMembershipsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	encrypter, err := MakeEncrypterAdapter()
+	if err != nil {
+		return nil, err
+	}
+	session, err := adapters.NewSessionAdapter()
+	if err != nil {
+		return nil, err
+	}
+	cache, err := MakeCacheAdapter()
+	if err != nil {
+		return nil, err
+	}
+	tenancy, err := MakeTenancyOptions()
+	if err != nil {
+		return nil, err
+	}
+	mfaChallenges, err := MakeMfaChallengesRepository()
+	if err != nil {
+		return nil, err
+	}
+	random, err := adapters.NewRandomAdapter()
+	if err != nil {
+		return nil, err
+	}
+	enumeration, err := MakeAntiEnumerationOptions()
+	if err != nil {
+		return nil, err
+	}
+	auditLog, err := repositories.NewAuditLogRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	createSession, err := usecases.NewCreateSessionUseCase(
+		repo, memberships, encrypter, session, cache, tenancy, mfaChallenges, random,
+		enumeration, auditLog, adapters.Tracer(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return createSession, nil
+}
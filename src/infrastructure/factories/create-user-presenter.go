@@ -4,33 +4,63 @@ import (
 	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
 	"github.com/AndreyArthur/oganessone/src/core/shared"
 	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
-	"github.com/AndreyArthur/oganessone/src/infrastructure/database"
 	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
 	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
 )
 
 func MakeCreateUserPresenter() (*presenters.CreateUserPresenter, *shared.Error) {
-	db, err := database.NewDatabase()
+	sql, err := MakeDatabaseConnection()
 	if err != nil {
 		return nil, err
 	}
-	sql, err := db.Connect()
+	repo, err := repositories.NewUsersRepositoryPostgres(sql)
 	if err != nil {
 		return nil, err
 	}
-	repo, err := repositories.NewUsersRepositoryPostgres(sql)
+	organizations, err := repositories.NewOrganizationsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := repositories.NewMembershipsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	encrypter, err := MakeEncrypterAdapter()
+	if err != nil {
+		return nil, err
+	}
+	screener, err := MakePasswordScreenerAdapter()
+	if err != nil {
+		return nil, err
+	}
+	mailer, err := MakeMailerAdapter()
+	if err != nil {
+		return nil, err
+	}
+	policy, err := MakePasswordPolicy()
+	if err != nil {
+		return nil, err
+	}
+	tenancy, err := MakeTenancyOptions()
+	if err != nil {
+		return nil, err
+	}
+	enumeration, err := MakeAntiEnumerationOptions()
 	if err != nil {
 		return nil, err
 	}
-	encrypter, err := adapters.NewEncrypterAdapter()
+	auditLog, err := repositories.NewAuditLogRepositoryPostgres(sql)
 	if err != nil {
 		return nil, err
 	}
-	createUser, err := usecases.NewCreateUserUseCase(repo, encrypter)
+	createUser, err := usecases.NewCreateUserUseCase(
+		repo, organizations, memberships, encrypter, screener, mailer, policy,
+		tenancy, enumeration, auditLog, adapters.Tracer(),
+	)
 	if err != nil {
 		return nil, err
 	}
-	createUserPresenter, err := presenters.NewCreateUserPresenter(createUser)
+	createUserPresenter, err := presenters.NewCreateUserPresenter(createUser, adapters.Tracer())
 	if err != nil {
 		return nil, err
 	}
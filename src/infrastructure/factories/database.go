@@ -0,0 +1,42 @@
+package factories
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/database"
+)
+
+var databaseConnection *sql.DB
+var databaseConnectionOnce sync.Once
+
+// Every factory shares one pool, database/sql already multiplexes it across
+// requests and a single pool is something shutdown can close.
+func MakeDatabaseConnection() (*sql.DB, *shared.Error) {
+	var err *shared.Error
+	databaseConnectionOnce.Do(func() {
+		var db *database.Database
+		db, err = database.NewDatabase()
+		if err != nil {
+			return
+		}
+		databaseConnection, err = db.Connect()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return databaseConnection, nil
+}
+
+func CloseDatabaseConnection() {
+	if databaseConnection == nil {
+		return
+	}
+	goerr := databaseConnection.Close()
+	if goerr != nil {
+		adapters.LogError(context.Background(), goerr)
+	}
+}
@@ -0,0 +1,98 @@
+package factories
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+)
+
+var encrypterAdapter *adapters.EncrypterAdapter
+var encrypterAdapterOnce sync.Once
+
+func envUint(name string, fallback uint64, bits int) (uint64, *shared.Error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback, nil
+	}
+	parsed, goerr := strconv.ParseUint(value, 10, bits)
+	if goerr != nil {
+		adapters.LogError(context.Background(), goerr)
+		return 0, exceptions.NewInternalServerError()
+	}
+	return parsed, nil
+}
+
+func envDuration(name string, fallback time.Duration) (time.Duration, *shared.Error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return fallback, nil
+	}
+	parsed, goerr := time.ParseDuration(value)
+	if goerr != nil {
+		adapters.LogError(context.Background(), goerr)
+		return 0, exceptions.NewInternalServerError()
+	}
+	if parsed <= 0 {
+		adapters.LogError(context.Background(), fmt.Errorf("%s must be positive", name))
+		return 0, exceptions.NewInternalServerError()
+	}
+	return parsed, nil
+}
+
+// Defaults follow the OWASP recommendation for argon2id, bcrypt keeps the
+// cost every existing hash was made with.
+func makeEncrypterParameters() (*adapters.EncrypterParameters, *shared.Error) {
+	algorithm := os.Getenv("PASSWORD_HASH_ALGORITHM")
+	if algorithm == "" {
+		algorithm = adapters.EncrypterAlgorithmArgon2id
+	}
+	bcryptCost, err := envUint("PASSWORD_BCRYPT_COST", 10, 8)
+	if err != nil {
+		return nil, err
+	}
+	argon2Memory, err := envUint("PASSWORD_ARGON2_MEMORY", 19456, 32)
+	if err != nil {
+		return nil, err
+	}
+	argon2Iterations, err := envUint("PASSWORD_ARGON2_ITERATIONS", 2, 32)
+	if err != nil {
+		return nil, err
+	}
+	argon2Parallelism, err := envUint("PASSWORD_ARGON2_PARALLELISM", 1, 8)
+	if err != nil {
+		return nil, err
+	}
+	return &adapters.EncrypterParameters{
+		Algorithm:         algorithm,
+		BcryptCost:        int(bcryptCost),
+		Argon2Memory:      uint32(argon2Memory),
+		Argon2Iterations:  uint32(argon2Iterations),
+		Argon2Parallelism: uint8(argon2Parallelism),
+	}, nil
+}
+
+func MakeEncrypterAdapter() (*adapters.EncrypterAdapter, *shared.Error) {
+	var err *shared.Error
+	encrypterAdapterOnce.Do(func() {
+		parameters, parametersError := makeEncrypterParameters()
+		if parametersError != nil {
+			err = parametersError
+			return
+		}
+		encrypterAdapter, err = adapters.NewEncrypterAdapter(parameters)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if encrypterAdapter == nil {
+		return nil, exceptions.NewInternalServerError()
+	}
+	return encrypterAdapter, nil
+}
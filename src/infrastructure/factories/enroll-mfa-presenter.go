@@ -0,0 +1,52 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeEnrollMfaPresenter() (*presenters.EnrollMfaPresenter, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	users, err := repositories.NewUsersRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	cipher, err := MakeCipherAdapter()
+	if err != nil {
+		return nil, err
+	}
+	otp, err := adapters.NewOtpAdapter()
+	if err != nil {
+		return nil, err
+	}
+	digest, err := adapters.NewDigestAdapter()
+	if err != nil {
+		return nil, err
+	}
+	random, err := adapters.NewRandomAdapter()
+	if err != nil {
+		return nil, err
+	}
+	options, err := MakeMfaOptions()
+	if err != nil {
+		return nil, err
+	}
+	enrollMfa, err := usecases.NewEnrollMfaUseCase(
+		users, cipher, otp, digest, random, options,
+	)
+	if err != nil {
+		return nil, err
+	}
+	enrollMfaPresenter, err := presenters.
+		NewEnrollMfaPresenter(enrollMfa)
+	if err != nil {
+		return nil, err
+	}
+	return enrollMfaPresenter, nil
+}
@@ -0,0 +1,53 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeExchangeAuthorizationCodePresenter() (*presenters.ExchangeAuthorizationCodePresenter, *shared.Error) {
+	authenticateClient, err := MakeAuthenticateOAuthClientUseCase()
+	if err != nil {
+		return nil, err
+	}
+	authorizationCodes, err := MakeAuthorizationCodesRepository()
+	if err != nil {
+		return nil, err
+	}
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	users, err := repositories.NewUsersRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	cache, err := MakeCacheAdapter()
+	if err != nil {
+		return nil, err
+	}
+	signer, err := MakeTokenSignerAdapter()
+	if err != nil {
+		return nil, err
+	}
+	openId, err := MakeOpenIdOptions()
+	if err != nil {
+		return nil, err
+	}
+	exchangeAuthorizationCode, err := usecases.NewExchangeAuthorizationCodeUseCase(
+		authenticateClient, authorizationCodes, users, cache, signer, openId,
+		adapters.Tracer(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	exchangeAuthorizationCodePresenter, err := presenters.
+		NewExchangeAuthorizationCodePresenter(exchangeAuthorizationCode)
+	if err != nil {
+		return nil, err
+	}
+	return exchangeAuthorizationCodePresenter, nil
+}
@@ -0,0 +1,36 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeExchangeClientCredentialsPresenter() (*presenters.ExchangeClientCredentialsPresenter, *shared.Error) {
+	authenticateClient, err := MakeAuthenticateOAuthClientUseCase()
+	if err != nil {
+		return nil, err
+	}
+	session, err := adapters.NewSessionAdapter()
+	if err != nil {
+		return nil, err
+	}
+	cache, err := MakeCacheAdapter()
+	if err != nil {
+		return nil, err
+	}
+	exchangeClientCredentials, err := usecases.
+		NewExchangeClientCredentialsUseCase(
+			authenticateClient, session, cache, adapters.Tracer(),
+		)
+	if err != nil {
+		return nil, err
+	}
+	exchangeClientCredentialsPresenter, err := presenters.
+		NewExchangeClientCredentialsPresenter(exchangeClientCredentials)
+	if err != nil {
+		return nil, err
+	}
+	return exchangeClientCredentialsPresenter, nil
+}
@@ -0,0 +1,65 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeFinishWebAuthnLoginPresenter() (*presenters.FinishWebAuthnLoginPresenter, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	users, err := repositories.NewUsersRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := repositories.NewMembershipsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := repositories.NewWebAuthnCredentialsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	challenges, err := MakeWebAuthnChallengesRepository()
+	if err != nil {
+		return nil, err
+	}
+	webAuthn, err := adapters.NewWebAuthnAdapter()
+	if err != nil {
+		return nil, err
+	}
+	session, err := adapters.NewSessionAdapter()
+	if err != nil {
+		return nil, err
+	}
+	cache, err := MakeCacheAdapter()
+	if err != nil {
+		return nil, err
+	}
+	options, err := MakeWebAuthnOptions()
+	if err != nil {
+		return nil, err
+	}
+	auditLog, err := repositories.NewAuditLogRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	finishWebAuthnLogin, err := usecases.NewFinishWebAuthnLoginUseCase(
+		users, memberships, credentials, challenges, webAuthn, session, cache, options,
+		auditLog,
+	)
+	if err != nil {
+		return nil, err
+	}
+	finishWebAuthnLoginPresenter, err := presenters.
+		NewFinishWebAuthnLoginPresenter(finishWebAuthnLogin)
+	if err != nil {
+		return nil, err
+	}
+	return finishWebAuthnLoginPresenter, nil
+}
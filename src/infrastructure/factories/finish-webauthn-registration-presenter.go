@@ -0,0 +1,44 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeFinishWebAuthnRegistrationPresenter() (*presenters.FinishWebAuthnRegistrationPresenter, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := repositories.NewWebAuthnCredentialsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	challenges, err := MakeWebAuthnChallengesRepository()
+	if err != nil {
+		return nil, err
+	}
+	webAuthn, err := adapters.NewWebAuthnAdapter()
+	if err != nil {
+		return nil, err
+	}
+	options, err := MakeWebAuthnOptions()
+	if err != nil {
+		return nil, err
+	}
+	finishWebAuthnRegistration, err := usecases.NewFinishWebAuthnRegistrationUseCase(
+		credentials, challenges, webAuthn, options,
+	)
+	if err != nil {
+		return nil, err
+	}
+	finishWebAuthnRegistrationPresenter, err := presenters.
+		NewFinishWebAuthnRegistrationPresenter(finishWebAuthnRegistration)
+	if err != nil {
+		return nil, err
+	}
+	return finishWebAuthnRegistrationPresenter, nil
+}
@@ -0,0 +1,29 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeGetMfaStatusPresenter() (*presenters.GetMfaStatusPresenter, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	users, err := repositories.NewUsersRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	getMfaStatus, err := usecases.NewGetMfaStatusUseCase(users)
+	if err != nil {
+		return nil, err
+	}
+	getMfaStatusPresenter, err := presenters.
+		NewGetMfaStatusPresenter(getMfaStatus)
+	if err != nil {
+		return nil, err
+	}
+	return getMfaStatusPresenter, nil
+}
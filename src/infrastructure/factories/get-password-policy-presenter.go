@@ -0,0 +1,24 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeGetPasswordPolicyPresenter() (*presenters.GetPasswordPolicyPresenter, *shared.Error) {
+	policy, err := MakePasswordPolicy()
+	if err != nil {
+		return nil, err
+	}
+	getPasswordPolicy, err := usecases.NewGetPasswordPolicyUseCase(policy)
+	if err != nil {
+		return nil, err
+	}
+	getPasswordPolicyPresenter, err := presenters.
+		NewGetPasswordPolicyPresenter(getPasswordPolicy)
+	if err != nil {
+		return nil, err
+	}
+	return getPasswordPolicyPresenter, nil
+}
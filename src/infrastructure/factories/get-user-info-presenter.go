@@ -0,0 +1,29 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeGetUserInfoPresenter() (*presenters.GetUserInfoPresenter, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	users, err := repositories.NewUsersRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	getUserInfo, err := usecases.NewGetUserInfoUseCase(users)
+	if err != nil {
+		return nil, err
+	}
+	getUserInfoPresenter, err := presenters.
+		NewGetUserInfoPresenter(getUserInfo)
+	if err != nil {
+		return nil, err
+	}
+	return getUserInfoPresenter, nil
+}
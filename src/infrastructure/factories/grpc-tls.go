@@ -0,0 +1,97 @@
+package factories
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+)
+
+var tlsVersions = map[string]uint16{
+	"1.2": tls.VersionTLS12,
+	"1.3": tls.VersionTLS13,
+}
+
+var tlsClientAuths = map[string]tls.ClientAuthType{
+	"require":  tls.RequireAndVerifyClientCert,
+	"optional": tls.VerifyClientCertIfGiven,
+}
+
+// Only the suites Go considers secure can be named, TLS 1.3 suites are not
+// configurable and ignore the list.
+func parseCipherSuites(value string) ([]uint16, *shared.Error) {
+	if value == "" {
+		return nil, nil
+	}
+	known := map[string]uint16{}
+	for _, suite := range tls.CipherSuites() {
+		known[suite.Name] = suite.ID
+	}
+	suites := []uint16{}
+	for _, name := range strings.Split(value, ",") {
+		id, ok := known[strings.TrimSpace(name)]
+		if !ok {
+			adapters.LogError(context.Background(), fmt.Errorf("unknown or insecure cipher suite %q", name))
+			return nil, exceptions.NewInternalServerError()
+		}
+		suites = append(suites, id)
+	}
+	return suites, nil
+}
+
+// No GRPC_TLS_CERT_FILE keeps the listener in plaintext and returns nil.
+// GRPC_TLS_CLIENT_CA_FILE turns on client certificates, required unless
+// GRPC_TLS_CLIENT_AUTH is optional.
+func MakeGrpcTlsConfig() (*tls.Config, *shared.Error) {
+	certFile := os.Getenv("GRPC_TLS_CERT_FILE")
+	if certFile == "" {
+		return nil, nil
+	}
+	minVersion := os.Getenv("GRPC_TLS_MIN_VERSION")
+	if minVersion == "" {
+		minVersion = "1.2"
+	}
+	version, ok := tlsVersions[minVersion]
+	if !ok {
+		adapters.LogError(context.Background(), fmt.Errorf("unsupported minimum tls version %q", minVersion))
+		return nil, exceptions.NewInternalServerError()
+	}
+	suites, err := parseCipherSuites(os.Getenv("GRPC_TLS_CIPHER_SUITES"))
+	if err != nil {
+		return nil, err
+	}
+	caFile := os.Getenv("GRPC_TLS_CLIENT_CA_FILE")
+	clientAuth := tls.NoClientCert
+	if caFile != "" {
+		mode := os.Getenv("GRPC_TLS_CLIENT_AUTH")
+		if mode == "" {
+			mode = "require"
+		}
+		clientAuth, ok = tlsClientAuths[mode]
+		if !ok {
+			adapters.LogError(context.Background(), fmt.Errorf("unsupported client auth mode %q", mode))
+			return nil, exceptions.NewInternalServerError()
+		}
+	}
+	certificates, err := helpers.NewCertificates(
+		certFile, os.Getenv("GRPC_TLS_KEY_FILE"), caFile,
+		func(goerr error) { adapters.LogError(context.Background(), goerr) },
+	)
+	if err != nil {
+		return nil, err
+	}
+	// The per handshake config replaces the one grpc adds h2 to, so ALPN has
+	// to be set here.
+	return certificates.Config(&tls.Config{
+		MinVersion:   version,
+		CipherSuites: suites,
+		ClientAuth:   clientAuth,
+		NextProtos:   []string{"h2"},
+	}), nil
+}
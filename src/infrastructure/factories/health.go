@@ -0,0 +1,41 @@
+package factories
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+)
+
+type HealthCheck func(ctx context.Context) *shared.Error
+
+// The cache lives in process, so Postgres is the only dependency a replica
+// can lose while still running.
+func MakeHealthChecks() ([]HealthCheck, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	postgres := func(ctx context.Context) *shared.Error {
+		goerr := sql.PingContext(ctx)
+		if goerr != nil {
+			adapters.LogError(ctx, goerr)
+			return exceptions.NewInternalServerError()
+		}
+		return nil
+	}
+	return []HealthCheck{postgres}, nil
+}
+
+// Interval takes a Go duration like 30s, empty checks every 10 seconds.
+func MakeHealthCheckInterval() (time.Duration, *shared.Error) {
+	return envDuration("HEALTH_CHECK_INTERVAL", 10*time.Second)
+}
+
+// Timeout is how long shutdown waits for in-flight calls before cutting
+// them off, empty waits 30 seconds.
+func MakeShutdownTimeout() (time.Duration, *shared.Error) {
+	return envDuration("SHUTDOWN_TIMEOUT", 30*time.Second)
+}
@@ -0,0 +1,28 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeIntrospectTokenPresenter() (*presenters.IntrospectTokenPresenter, *shared.Error) {
+	authenticateClient, err := MakeAuthenticateOAuthClientUseCase()
+	if err != nil {
+		return nil, err
+	}
+	authenticateSession, err := MakeAuthenticateSessionUseCase()
+	if err != nil {
+		return nil, err
+	}
+	introspectToken, err := usecases.
+		NewIntrospectTokenUseCase(authenticateClient, authenticateSession)
+	if err != nil {
+		return nil, err
+	}
+	introspectTokenPresenter, err := presenters.NewIntrospectTokenPresenter(introspectToken)
+	if err != nil {
+		return nil, err
+	}
+	return introspectTokenPresenter, nil
+}
@@ -0,0 +1,37 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeInviteMemberPresenter() (*presenters.InviteMemberPresenter, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	users, err := repositories.NewUsersRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := repositories.NewMembershipsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	auditLog, err := repositories.NewAuditLogRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	inviteMember, err := usecases.NewInviteMemberUseCase(users, memberships, auditLog)
+	if err != nil {
+		return nil, err
+	}
+	inviteMemberPresenter, err := presenters.
+		NewInviteMemberPresenter(inviteMember)
+	if err != nil {
+		return nil, err
+	}
+	return inviteMemberPresenter, nil
+}
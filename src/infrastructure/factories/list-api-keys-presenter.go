@@ -0,0 +1,33 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeListApiKeysPresenter() (*presenters.ListApiKeysPresenter, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	apiKeys, err := repositories.NewApiKeysRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := repositories.NewMembershipsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	listApiKeys, err := usecases.NewListApiKeysUseCase(apiKeys, memberships)
+	if err != nil {
+		return nil, err
+	}
+	listApiKeysPresenter, err := presenters.
+		NewListApiKeysPresenter(listApiKeys)
+	if err != nil {
+		return nil, err
+	}
+	return listApiKeysPresenter, nil
+}
@@ -0,0 +1,24 @@
+package factories
+
+import (
+	"os"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+)
+
+// The configured logger also becomes the one repositories and adapters use.
+func MakeLogger() (*adapters.LoggerAdapter, *shared.Error) {
+	level := os.Getenv("LOG_LEVEL")
+	if level == "" {
+		level = adapters.LogLevelInfo
+	}
+	logger, err := adapters.NewLoggerAdapter(&adapters.LoggerParameters{
+		Level: level,
+	})
+	if err != nil {
+		return nil, err
+	}
+	adapters.SetLogger(logger)
+	return logger, nil
+}
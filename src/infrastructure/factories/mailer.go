@@ -0,0 +1,38 @@
+package factories
+
+import (
+	"os"
+	"sync"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+)
+
+var mailerAdapter *adapters.MailerAdapter
+var mailerAdapterOnce sync.Once
+
+func MakeMailerAdapter() (*adapters.MailerAdapter, *shared.Error) {
+	var err *shared.Error
+	mailerAdapterOnce.Do(func() {
+		port, portError := envUint("SMTP_PORT", 587, 16)
+		if portError != nil {
+			err = portError
+			return
+		}
+		mailerAdapter, err = adapters.NewMailerAdapter(&adapters.MailerParameters{
+			Host:     os.Getenv("SMTP_HOST"),
+			Port:     int(port),
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     os.Getenv("MAIL_FROM"),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	if mailerAdapter == nil {
+		return nil, exceptions.NewInternalServerError()
+	}
+	return mailerAdapter, nil
+}
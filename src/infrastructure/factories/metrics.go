@@ -0,0 +1,69 @@
+package factories
+
+import (
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+)
+
+// Every session caches its expiration date once, session keys may hold any
+// of the markers used to build the other entries so the value is what tells
+// them apart.
+func countActiveSessions() float64 {
+	cache, err := MakeCacheAdapter()
+	if err != nil {
+		return 0
+	}
+	now := time.Now().UTC()
+	return float64(cache.Count(func(key string, value string) bool {
+		expiresAt, goerr := time.Parse(time.RFC3339, value)
+		return goerr == nil && expiresAt.After(now)
+	}))
+}
+
+// Registers the metrics that are read at scrape time, the rest are recorded
+// where they happen.
+func MakeMetrics() (*helpers.MetricsRegistry, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	metrics := helpers.Metrics
+	metrics.NewGaugeFunc(
+		"oganessone_sessions_active", "Sessions that have not expired yet.", countActiveSessions,
+	)
+	metrics.NewGaugeFunc(
+		"oganessone_db_max_open_connections", "Maximum number of open connections to the database.",
+		func() float64 { return float64(sql.Stats().MaxOpenConnections) },
+	)
+	metrics.NewGaugeFunc(
+		"oganessone_db_open_connections", "Open connections to the database, in use or idle.",
+		func() float64 { return float64(sql.Stats().OpenConnections) },
+	)
+	metrics.NewGaugeFunc(
+		"oganessone_db_in_use_connections", "Connections currently in use.",
+		func() float64 { return float64(sql.Stats().InUse) },
+	)
+	metrics.NewGaugeFunc(
+		"oganessone_db_idle_connections", "Idle connections.",
+		func() float64 { return float64(sql.Stats().Idle) },
+	)
+	metrics.NewCounterFunc(
+		"oganessone_db_wait_count_total", "Connections waited for.",
+		func() float64 { return float64(sql.Stats().WaitCount) },
+	)
+	metrics.NewCounterFunc(
+		"oganessone_db_wait_duration_seconds_total", "Time spent waiting for a connection.",
+		func() float64 { return sql.Stats().WaitDuration.Seconds() },
+	)
+	metrics.NewCounterFunc(
+		"oganessone_db_max_idle_closed_total", "Connections closed because of the idle pool limit.",
+		func() float64 { return float64(sql.Stats().MaxIdleClosed) },
+	)
+	metrics.NewCounterFunc(
+		"oganessone_db_max_lifetime_closed_total", "Connections closed because of their maximum lifetime.",
+		func() float64 { return float64(sql.Stats().MaxLifetimeClosed) },
+	)
+	return metrics, nil
+}
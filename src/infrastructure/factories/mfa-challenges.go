@@ -0,0 +1,22 @@
+package factories
+
+import (
+	"sync"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+)
+
+var mfaChallengesRepository *repositories.MfaChallengesRepositoryMemory
+var mfaChallengesRepositoryOnce sync.Once
+
+func MakeMfaChallengesRepository() (*repositories.MfaChallengesRepositoryMemory, *shared.Error) {
+	var err *shared.Error
+	mfaChallengesRepositoryOnce.Do(func() {
+		mfaChallengesRepository, err = repositories.NewMfaChallengesRepositoryMemory()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mfaChallengesRepository, nil
+}
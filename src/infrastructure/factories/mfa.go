@@ -0,0 +1,18 @@
+package factories
+
+import (
+	"os"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+func MakeMfaOptions() (*definitions.MfaOptions, *shared.Error) {
+	issuer := os.Getenv("MFA_ISSUER")
+	if issuer == "" {
+		issuer = "Oganessone"
+	}
+	return &definitions.MfaOptions{
+		Issuer: issuer,
+	}, nil
+}
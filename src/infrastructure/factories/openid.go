@@ -0,0 +1,17 @@
+package factories
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+func MakeOpenIdOptions() (*definitions.OpenIdOptions, *shared.Error) {
+	return &definitions.OpenIdOptions{
+		Issuer:          strings.TrimSuffix(os.Getenv("OIDC_ISSUER"), "/"),
+		IdTokenLifetime: time.Hour,
+	}, nil
+}
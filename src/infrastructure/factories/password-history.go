@@ -0,0 +1,38 @@
+package factories
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+)
+
+// Retention takes a Go duration like 8760h, empty keeps entries forever.
+func MakePasswordHistoryOptions() (*definitions.PasswordHistoryOptions, *shared.Error) {
+	size, err := envUint("PASSWORD_HISTORY_SIZE", 5, 16)
+	if err != nil {
+		return nil, err
+	}
+	var retention time.Duration
+	if value := os.Getenv("PASSWORD_HISTORY_RETENTION"); value != "" {
+		parsed, goerr := time.ParseDuration(value)
+		if goerr != nil {
+			adapters.LogError(context.Background(), goerr)
+			return nil, exceptions.NewInternalServerError()
+		}
+		if parsed < 0 {
+			adapters.LogError(context.Background(), errors.New("PASSWORD_HISTORY_RETENTION must not be negative"))
+			return nil, exceptions.NewInternalServerError()
+		}
+		retention = parsed
+	}
+	return &definitions.PasswordHistoryOptions{
+		Size:      int(size),
+		Retention: retention,
+	}, nil
+}
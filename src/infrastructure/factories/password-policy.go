@@ -0,0 +1,79 @@
+package factories
+
+import (
+	"context"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+)
+
+var passwordPolicy *entities.PasswordPolicyEntity
+var passwordPolicyOnce sync.Once
+
+// The dictionary is a plain text file with one word per line.
+func makePasswordPolicyDTO() (*dtos.PasswordPolicyDTO, *shared.Error) {
+	minLength, err := envUint("PASSWORD_POLICY_MIN_LENGTH", 8, 16)
+	if err != nil {
+		return nil, err
+	}
+	maxLength, err := envUint("PASSWORD_POLICY_MAX_LENGTH", 128, 16)
+	if err != nil {
+		return nil, err
+	}
+	maxRepeated, err := envUint("PASSWORD_POLICY_MAX_REPEATED_CHARACTERS", 0, 16)
+	if err != nil {
+		return nil, err
+	}
+	requiredClasses := []string{entities.PasswordClassLetter, entities.PasswordClassDigit}
+	if classes, ok := os.LookupEnv("PASSWORD_POLICY_REQUIRED_CLASSES"); ok {
+		requiredClasses = []string{}
+		for _, class := range strings.Split(classes, ",") {
+			class = strings.TrimSpace(class)
+			if class != "" {
+				requiredClasses = append(requiredClasses, class)
+			}
+		}
+	}
+	dictionaryWords := []string{}
+	if path := os.Getenv("PASSWORD_POLICY_DICTIONARY"); path != "" {
+		content, goerr := os.ReadFile(path)
+		if goerr != nil {
+			adapters.LogError(context.Background(), goerr)
+			return nil, exceptions.NewInternalServerError()
+		}
+		dictionaryWords = strings.Split(string(content), "\n")
+	}
+	return &dtos.PasswordPolicyDTO{
+		MinLength:             int(minLength),
+		MaxLength:             int(maxLength),
+		RequiredClasses:       requiredClasses,
+		MaxRepeatedCharacters: int(maxRepeated),
+		DisallowUserInfo:      os.Getenv("PASSWORD_POLICY_DISALLOW_USER_INFO") != "false",
+		DictionaryWords:       dictionaryWords,
+	}, nil
+}
+
+func MakePasswordPolicy() (*entities.PasswordPolicyEntity, *shared.Error) {
+	var err *shared.Error
+	passwordPolicyOnce.Do(func() {
+		data, dataError := makePasswordPolicyDTO()
+		if dataError != nil {
+			err = dataError
+			return
+		}
+		passwordPolicy, err = entities.NewPasswordPolicyEntity(data)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if passwordPolicy == nil {
+		return nil, exceptions.NewInternalServerError()
+	}
+	return passwordPolicy, nil
+}
@@ -0,0 +1,29 @@
+package factories
+
+import (
+	"os"
+	"sync"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+)
+
+var passwordScreenerAdapter *adapters.PasswordScreenerAdapter
+var passwordScreenerAdapterOnce sync.Once
+
+func MakePasswordScreenerAdapter() (*adapters.PasswordScreenerAdapter, *shared.Error) {
+	var err *shared.Error
+	passwordScreenerAdapterOnce.Do(func() {
+		passwordScreenerAdapter, err = adapters.NewPasswordScreenerAdapter(
+			os.Getenv("PASSWORD_BREACH_INDEX"),
+		)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if passwordScreenerAdapter == nil {
+		return nil, exceptions.NewInternalServerError()
+	}
+	return passwordScreenerAdapter, nil
+}
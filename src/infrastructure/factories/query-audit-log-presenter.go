@@ -0,0 +1,33 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeQueryAuditLogPresenter() (*presenters.QueryAuditLogPresenter, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	auditLog, err := repositories.NewAuditLogRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := repositories.NewMembershipsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	queryAuditLog, err := usecases.NewQueryAuditLogUseCase(auditLog, memberships)
+	if err != nil {
+		return nil, err
+	}
+	queryAuditLogPresenter, err := presenters.
+		NewQueryAuditLogPresenter(queryAuditLog)
+	if err != nil {
+		return nil, err
+	}
+	return queryAuditLogPresenter, nil
+}
@@ -0,0 +1,43 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeRegenerateRecoveryCodesPresenter() (*presenters.RegenerateRecoveryCodesPresenter, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	users, err := repositories.NewUsersRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	encrypter, err := MakeEncrypterAdapter()
+	if err != nil {
+		return nil, err
+	}
+	digest, err := adapters.NewDigestAdapter()
+	if err != nil {
+		return nil, err
+	}
+	random, err := adapters.NewRandomAdapter()
+	if err != nil {
+		return nil, err
+	}
+	regenerateRecoveryCodes, err := usecases.
+		NewRegenerateRecoveryCodesUseCase(users, encrypter, digest, random)
+	if err != nil {
+		return nil, err
+	}
+	regenerateRecoveryCodesPresenter, err := presenters.
+		NewRegenerateRecoveryCodesPresenter(regenerateRecoveryCodes)
+	if err != nil {
+		return nil, err
+	}
+	return regenerateRecoveryCodesPresenter, nil
+}
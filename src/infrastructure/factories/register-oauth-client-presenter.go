@@ -0,0 +1,43 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeRegisterOAuthClientPresenter() (*presenters.RegisterOAuthClientPresenter, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	clients, err := repositories.NewOAuthClientsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := repositories.NewMembershipsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	encrypter, err := MakeEncrypterAdapter()
+	if err != nil {
+		return nil, err
+	}
+	random, err := adapters.NewRandomAdapter()
+	if err != nil {
+		return nil, err
+	}
+	registerOAuthClient, err := usecases.
+		NewRegisterOAuthClientUseCase(clients, memberships, encrypter, random)
+	if err != nil {
+		return nil, err
+	}
+	registerOAuthClientPresenter, err := presenters.
+		NewRegisterOAuthClientPresenter(registerOAuthClient)
+	if err != nil {
+		return nil, err
+	}
+	return registerOAuthClientPresenter, nil
+}
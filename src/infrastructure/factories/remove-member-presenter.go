@@ -0,0 +1,29 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeRemoveMemberPresenter() (*presenters.RemoveMemberPresenter, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := repositories.NewMembershipsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	removeMember, err := usecases.NewRemoveMemberUseCase(memberships)
+	if err != nil {
+		return nil, err
+	}
+	removeMemberPresenter, err := presenters.
+		NewRemoveMemberPresenter(removeMember)
+	if err != nil {
+		return nil, err
+	}
+	return removeMemberPresenter, nil
+}
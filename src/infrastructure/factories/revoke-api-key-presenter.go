@@ -0,0 +1,33 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeRevokeApiKeyPresenter() (*presenters.RevokeApiKeyPresenter, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	apiKeys, err := repositories.NewApiKeysRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := repositories.NewMembershipsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	revokeApiKey, err := usecases.NewRevokeApiKeyUseCase(apiKeys, memberships)
+	if err != nil {
+		return nil, err
+	}
+	revokeApiKeyPresenter, err := presenters.
+		NewRevokeApiKeyPresenter(revokeApiKey)
+	if err != nil {
+		return nil, err
+	}
+	return revokeApiKeyPresenter, nil
+}
@@ -0,0 +1,31 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeRevokeTokenPresenter() (*presenters.RevokeTokenPresenter, *shared.Error) {
+	authenticateClient, err := MakeAuthenticateOAuthClientUseCase()
+	if err != nil {
+		return nil, err
+	}
+	cache, err := MakeCacheAdapter()
+	if err != nil {
+		return nil, err
+	}
+	auditLog, err := MakeAuditLogRepository()
+	if err != nil {
+		return nil, err
+	}
+	revokeToken, err := usecases.NewRevokeTokenUseCase(authenticateClient, cache, auditLog)
+	if err != nil {
+		return nil, err
+	}
+	revokeTokenPresenter, err := presenters.NewRevokeTokenPresenter(revokeToken)
+	if err != nil {
+		return nil, err
+	}
+	return revokeTokenPresenter, nil
+}
@@ -0,0 +1,33 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeSwitchOrganizationPresenter() (*presenters.SwitchOrganizationPresenter, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := repositories.NewMembershipsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	cache, err := MakeCacheAdapter()
+	if err != nil {
+		return nil, err
+	}
+	switchOrganization, err := usecases.NewSwitchOrganizationUseCase(memberships, cache)
+	if err != nil {
+		return nil, err
+	}
+	switchOrganizationPresenter, err := presenters.
+		NewSwitchOrganizationPresenter(switchOrganization)
+	if err != nil {
+		return nil, err
+	}
+	return switchOrganizationPresenter, nil
+}
@@ -0,0 +1,15 @@
+package factories
+
+import (
+	"os"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+func MakeTenancyOptions() (*definitions.TenancyOptions, *shared.Error) {
+	return &definitions.TenancyOptions{
+		ScopedUsernames: os.Getenv("TENANCY_SCOPED_USERNAMES") == "true",
+		ScopedEmails:    os.Getenv("TENANCY_SCOPED_EMAILS") == "true",
+	}, nil
+}
@@ -0,0 +1,66 @@
+package factories
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"os"
+	"sync"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+)
+
+var tokenSignerAdapter *adapters.TokenSignerAdapter
+var tokenSignerAdapterOnce sync.Once
+
+func loadSigningKey(filename string) (*rsa.PrivateKey, error) {
+	if filename == "" {
+		adapters.Logger().Warn(context.Background(), "OIDC_SIGNING_KEY_FILE is not set, using an ephemeral signing key", nil)
+		return rsa.GenerateKey(rand.Reader, 2048)
+	}
+	contents, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(contents)
+	if block == nil {
+		return nil, errors.New("signing key file has no pem block")
+	}
+	if block.Type == "RSA PRIVATE KEY" {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("signing key must be a rsa private key")
+	}
+	return key, nil
+}
+
+func MakeTokenSignerAdapter() (*adapters.TokenSignerAdapter, *shared.Error) {
+	var err *shared.Error
+	tokenSignerAdapterOnce.Do(func() {
+		key, goerr := loadSigningKey(os.Getenv("OIDC_SIGNING_KEY_FILE"))
+		if goerr != nil {
+			adapters.LogError(context.Background(), goerr)
+			err = exceptions.NewInternalServerError()
+			return
+		}
+		tokenSignerAdapter, err = adapters.NewTokenSignerAdapter(key)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if tokenSignerAdapter == nil {
+		return nil, exceptions.NewInternalServerError()
+	}
+	return tokenSignerAdapter, nil
+}
@@ -0,0 +1,84 @@
+package factories
+
+import (
+	"context"
+	"errors"
+	"os"
+	"strconv"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+)
+
+var traceFile *os.File
+
+func makeSpanExporter(serviceName string) (adapters.SpanExporter, *shared.Error) {
+	switch os.Getenv("TRACING_EXPORTER") {
+	case "":
+		return nil, nil
+	case "stdout":
+		return adapters.NewWriterSpanExporter(serviceName, os.Stdout), nil
+	case "file":
+		var goerr error
+		traceFile, goerr = os.OpenFile(os.Getenv("TRACING_FILE"), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+		if goerr != nil {
+			adapters.LogError(context.Background(), goerr)
+			return nil, exceptions.NewInternalServerError()
+		}
+		return adapters.NewWriterSpanExporter(serviceName, traceFile), nil
+	case "otlp":
+		endpoint := os.Getenv("TRACING_OTLP_ENDPOINT")
+		if endpoint == "" {
+			endpoint = "http://localhost:4318/v1/traces"
+		}
+		return adapters.NewOtlpSpanExporter(serviceName, endpoint), nil
+	default:
+		adapters.LogError(context.Background(), errors.New("TRACING_EXPORTER must be stdout, file or otlp"))
+		return nil, exceptions.NewInternalServerError()
+	}
+}
+
+// Without TRACING_EXPORTER tracing stays off. The configured tracer also
+// becomes the one the interceptors, the sql driver and adapters use.
+func MakeTracer() (*adapters.TracerAdapter, *shared.Error) {
+	serviceName := os.Getenv("TRACING_SERVICE_NAME")
+	if serviceName == "" {
+		serviceName = "oganessone"
+	}
+	sampleRatio := 1.0
+	if value := os.Getenv("TRACING_SAMPLE_RATIO"); value != "" {
+		var goerr error
+		sampleRatio, goerr = strconv.ParseFloat(value, 64)
+		if goerr != nil || sampleRatio < 0 || sampleRatio > 1 {
+			adapters.LogError(context.Background(), errors.New("TRACING_SAMPLE_RATIO must be between 0 and 1"))
+			return nil, exceptions.NewInternalServerError()
+		}
+	}
+	exporter, err := makeSpanExporter(serviceName)
+	if err != nil {
+		return nil, err
+	}
+	tracer, err := adapters.NewTracerAdapter(&adapters.TracerParameters{
+		ServiceName: serviceName,
+		Exporter:    exporter,
+		SampleRatio: sampleRatio,
+	})
+	if err != nil {
+		return nil, err
+	}
+	adapters.SetTracer(tracer)
+	return tracer, nil
+}
+
+// Exports the spans still queued, the trace file is closed afterwards.
+func CloseTracer(ctx context.Context) {
+	adapters.Tracer().Shutdown(ctx)
+	if traceFile == nil {
+		return
+	}
+	goerr := traceFile.Close()
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+	}
+}
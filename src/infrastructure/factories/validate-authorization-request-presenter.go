@@ -0,0 +1,19 @@
+package factories
+
+import (
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeValidateAuthorizationRequestPresenter() (*presenters.ValidateAuthorizationRequestPresenter, *shared.Error) {
+	validateAuthorizationRequest, err := MakeValidateAuthorizationRequestUseCase()
+	if err != nil {
+		return nil, err
+	}
+	validateAuthorizationRequestPresenter, err := presenters.
+		NewValidateAuthorizationRequestPresenter(validateAuthorizationRequest)
+	if err != nil {
+		return nil, err
+	}
+	return validateAuthorizationRequestPresenter, nil
+}
@@ -0,0 +1,23 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+)
+
+func MakeValidateAuthorizationRequestUseCase() (*usecases.ValidateAuthorizationRequestUseCase, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	clients, err := repositories.NewOAuthClientsRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	validateAuthorizationRequest, err := usecases.NewValidateAuthorizationRequestUseCase(clients)
+	if err != nil {
+		return nil, err
+	}
+	return validateAuthorizationRequest, nil
+}
@@ -0,0 +1,18 @@
+package factories
+
+import (
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/presenters"
+)
+
+func MakeVerifyMfaPresenter() (*presenters.VerifyMfaPresenter, *shared.Error) {
+	verifyMfa, err := MakeVerifyMfaUseCase()
+	if err != nil {
+		return nil, err
+	}
+	verifyMfaPresenter, err := presenters.NewVerifyMfaPresenter(verifyMfa)
+	if err != nil {
+		return nil, err
+	}
+	return verifyMfaPresenter, nil
+}
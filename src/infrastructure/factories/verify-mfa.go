@@ -0,0 +1,54 @@
+package factories
+
+import (
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+)
+
+func MakeVerifyMfaUseCase() (*usecases.VerifyMfaUseCase, *shared.Error) {
+	sql, err := MakeDatabaseConnection()
+	if err != nil {
+		return nil, err
+	}
+	users, err := repositories.NewUsersRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	mfaChallenges, err := MakeMfaChallengesRepository()
+	if err != nil {
+		return nil, err
+	}
+	cipher, err := MakeCipherAdapter()
+	if err != nil {
+		return nil, err
+	}
+	otp, err := adapters.NewOtpAdapter()
+	if err != nil {
+		return nil, err
+	}
+	digest, err := adapters.NewDigestAdapter()
+	if err != nil {
+		return nil, err
+	}
+	session, err := adapters.NewSessionAdapter()
+	if err != nil {
+		return nil, err
+	}
+	cache, err := MakeCacheAdapter()
+	if err != nil {
+		return nil, err
+	}
+	auditLog, err := repositories.NewAuditLogRepositoryPostgres(sql)
+	if err != nil {
+		return nil, err
+	}
+	verifyMfa, err := usecases.NewVerifyMfaUseCase(
+		mfaChallenges, users, cipher, otp, digest, session, cache, auditLog,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return verifyMfa, nil
+}
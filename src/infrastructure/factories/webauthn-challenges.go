@@ -0,0 +1,22 @@
+package factories
+
+import (
+	"sync"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+)
+
+var webAuthnChallengesRepository *repositories.WebAuthnChallengesRepositoryMemory
+var webAuthnChallengesRepositoryOnce sync.Once
+
+func MakeWebAuthnChallengesRepository() (*repositories.WebAuthnChallengesRepositoryMemory, *shared.Error) {
+	var err *shared.Error
+	webAuthnChallengesRepositoryOnce.Do(func() {
+		webAuthnChallengesRepository, err = repositories.NewWebAuthnChallengesRepositoryMemory()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return webAuthnChallengesRepository, nil
+}
@@ -0,0 +1,44 @@
+package factories
+
+import (
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// Without explicit settings the relying party is the issuer itself, which is
+// where the login page is served from.
+func MakeWebAuthnOptions() (*definitions.WebAuthnOptions, *shared.Error) {
+	issuer := strings.TrimSuffix(os.Getenv("OIDC_ISSUER"), "/")
+	rpId := os.Getenv("WEBAUTHN_RP_ID")
+	if rpId == "" {
+		parsed, goerr := url.Parse(issuer)
+		if goerr == nil {
+			rpId = parsed.Hostname()
+		}
+	}
+	rpName := os.Getenv("WEBAUTHN_RP_NAME")
+	if rpName == "" {
+		rpName = "Oganessone"
+	}
+	origins := []string{}
+	for _, origin := range strings.Split(os.Getenv("WEBAUTHN_ORIGINS"), ",") {
+		origin = strings.TrimSuffix(strings.TrimSpace(origin), "/")
+		if origin != "" {
+			origins = append(origins, origin)
+		}
+	}
+	if len(origins) == 0 && issuer != "" {
+		origins = append(origins, issuer)
+	}
+	return &definitions.WebAuthnOptions{
+		RpId:    rpId,
+		RpName:  rpName,
+		Origins: origins,
+		Timeout: 5 * time.Minute,
+	}, nil
+}
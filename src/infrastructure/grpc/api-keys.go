@@ -0,0 +1,127 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/grpc/protobuf"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+func newProtobufApiKey(view *views.ApiKeyView) *protobuf.ApiKey {
+	return &protobuf.ApiKey{
+		Id:             view.Id,
+		UserId:         view.UserId,
+		OrganizationId: view.OrganizationId,
+		Name:           view.Name,
+		Prefix:         view.Prefix,
+		Scopes:         view.Scopes,
+		AllowedIps:     view.AllowedIps,
+		ExpiresAt:      view.ExpiresAt,
+		RevokedAt:      view.RevokedAt,
+		CreatedAt:      view.CreatedAt,
+		UpdatedAt:      view.UpdatedAt,
+	}
+}
+
+func (*server) CreateApiKey(
+	ctx context.Context, request *protobuf.CreateApiKeyRequest,
+) (*protobuf.CreateApiKeyResponse, error) {
+	createApiKeyPresenter, err := factories.MakeCreateApiKeyPresenter()
+	if err != nil {
+		return &protobuf.CreateApiKeyResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	response, err := createApiKeyPresenter.
+		Handle(ctx, &contracts.CreateApiKeyPresenterRequest{
+			Headers: headersFromContext(ctx),
+			Body: &contracts.CreateApiKeyPresenterRequestBody{
+				OrganizationId: request.GetOrganizationId(),
+				ServiceAccount: request.GetServiceAccount(),
+				Name:           request.GetName(),
+				Scopes:         request.GetScopes(),
+				AllowedIps:     request.GetAllowedIps(),
+				ExpiresAt:      request.GetExpiresAt(),
+			},
+		})
+	if err != nil {
+		return &protobuf.CreateApiKeyResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	return &protobuf.CreateApiKeyResponse{
+		Data: &protobuf.CreatedApiKey{
+			Key:    response.Body.Key,
+			ApiKey: newProtobufApiKey(response.Body.ApiKey),
+		},
+		Error: nil,
+	}, nil
+}
+
+func (*server) ListApiKeys(
+	ctx context.Context, request *protobuf.ListApiKeysRequest,
+) (*protobuf.ListApiKeysResponse, error) {
+	listApiKeysPresenter, err := factories.MakeListApiKeysPresenter()
+	if err != nil {
+		return &protobuf.ListApiKeysResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	response, err := listApiKeysPresenter.
+		Handle(ctx, &contracts.ListApiKeysPresenterRequest{
+			Headers: headersFromContext(ctx),
+			Body: &contracts.ListApiKeysPresenterRequestBody{
+				OrganizationId: request.GetOrganizationId(),
+			},
+		})
+	if err != nil {
+		return &protobuf.ListApiKeysResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	apiKeys := []*protobuf.ApiKey{}
+	for _, view := range response.Body {
+		apiKeys = append(apiKeys, newProtobufApiKey(view))
+	}
+	return &protobuf.ListApiKeysResponse{
+		Data: &protobuf.ApiKeys{
+			ApiKeys: apiKeys,
+		},
+		Error: nil,
+	}, nil
+}
+
+func (*server) RevokeApiKey(
+	ctx context.Context, request *protobuf.RevokeApiKeyRequest,
+) (*protobuf.RevokeApiKeyResponse, error) {
+	revokeApiKeyPresenter, err := factories.MakeRevokeApiKeyPresenter()
+	if err != nil {
+		return &protobuf.RevokeApiKeyResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	response, err := revokeApiKeyPresenter.
+		Handle(ctx, &contracts.RevokeApiKeyPresenterRequest{
+			Headers: headersFromContext(ctx),
+			Body: &contracts.RevokeApiKeyPresenterRequestBody{
+				ApiKeyId: request.GetApiKeyId(),
+			},
+		})
+	if err != nil {
+		return &protobuf.RevokeApiKeyResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	return &protobuf.RevokeApiKeyResponse{
+		Data:  newProtobufApiKey(response.Body),
+		Error: nil,
+	}, nil
+}
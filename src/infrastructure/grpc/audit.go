@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/grpc/protobuf"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+func newProtobufAuditEvent(view *views.AuditEventView) *protobuf.AuditEvent {
+	return &protobuf.AuditEvent{
+		Id:             view.Id,
+		Type:           view.Type,
+		ActorId:        view.ActorId,
+		TargetId:       view.TargetId,
+		OrganizationId: view.OrganizationId,
+		IpAddress:      view.IpAddress,
+		UserAgent:      view.UserAgent,
+		Detail:         view.Detail,
+		CreatedAt:      view.CreatedAt,
+	}
+}
+
+func (*server) QueryAuditLog(
+	ctx context.Context, request *protobuf.QueryAuditLogRequest,
+) (*protobuf.QueryAuditLogResponse, error) {
+	queryAuditLogPresenter, err := factories.MakeQueryAuditLogPresenter()
+	if err != nil {
+		return &protobuf.QueryAuditLogResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	response, err := queryAuditLogPresenter.
+		Handle(ctx, &contracts.QueryAuditLogPresenterRequest{
+			Headers: headersFromContext(ctx),
+			Body: &contracts.QueryAuditLogPresenterRequestBody{
+				Type:           request.GetType(),
+				ActorId:        request.GetActorId(),
+				TargetId:       request.GetTargetId(),
+				OrganizationId: request.GetOrganizationId(),
+				Since:          request.GetSince(),
+				Until:          request.GetUntil(),
+				PageSize:       int(request.GetPageSize()),
+				PageToken:      request.GetPageToken(),
+			},
+		})
+	if err != nil {
+		return &protobuf.QueryAuditLogResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	auditEvents := []*protobuf.AuditEvent{}
+	for _, view := range response.Body.Events {
+		auditEvents = append(auditEvents, newProtobufAuditEvent(view))
+	}
+	return &protobuf.QueryAuditLogResponse{
+		Data: &protobuf.AuditEvents{
+			AuditEvents:   auditEvents,
+			NextPageToken: response.Body.NextPageToken,
+		},
+		Error: nil,
+	}, nil
+}
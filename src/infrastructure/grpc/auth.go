@@ -0,0 +1,180 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"google.golang.org/grpc"
+)
+
+type access int
+
+const (
+	accessPublic access = iota
+	accessAuthenticated
+	accessPermitted
+)
+
+// Permitted methods list the roles, the scopes or both a caller needs, see
+// definitions.AuthenticatePrincipalDTO.
+type methodPolicy struct {
+	access access
+	roles  []string
+	scopes []string
+}
+
+var (
+	public        = &methodPolicy{access: accessPublic}
+	authenticated = &methodPolicy{access: accessAuthenticated}
+)
+
+func permitted(roles []string, scopes []string) *methodPolicy {
+	return &methodPolicy{access: accessPermitted, roles: roles, scopes: scopes}
+}
+
+const (
+	scopeUsersWrite         = "users:write"
+	scopeSessionsWrite      = "sessions:write"
+	scopeOrganizationsWrite = "organizations:write"
+	scopeMembersWrite       = "members:write"
+	scopeApiKeysRead        = "api_keys:read"
+	scopeApiKeysWrite       = "api_keys:write"
+	scopeOAuthClientsWrite  = "oauth_clients:write"
+	scopeMfaRead            = "mfa:read"
+	scopeMfaWrite           = "mfa:write"
+	scopeWebAuthnWrite      = "webauthn:write"
+	scopeAuditRead          = "audit:read"
+)
+
+// Managing an organization takes a session logged into it as an owner or an
+// admin, the use cases still check the organization named in the request.
+var managers = []string{entities.MembershipRoleOwner, entities.MembershipRoleAdmin}
+
+// Methods that take a client or an mfa token in the request authenticate it
+// themselves and are public here. Anything missing from the table, a new
+// method included, requires a session. REST routes are looked up by the
+// method they mirror, /userinfo by its path.
+var methodPolicies = map[string]*methodPolicy{
+	"/protobuf.UsersService/CreateUser":                    public,
+	"/protobuf.UsersService/GetPasswordPolicy":             public,
+	"/protobuf.UsersService/ChangePassword":                permitted(nil, []string{scopeUsersWrite}),
+	"/protobuf.SessionsService/CreateSession":              public,
+	"/protobuf.SessionsService/SwitchOrganization":         permitted(nil, []string{scopeSessionsWrite}),
+	"/protobuf.OrganizationsService/CreateOrganization":    permitted(nil, []string{scopeOrganizationsWrite}),
+	"/protobuf.OrganizationsService/InviteMember":          permitted(managers, []string{scopeMembersWrite}),
+	"/protobuf.OrganizationsService/RemoveMember":          permitted(managers, []string{scopeMembersWrite}),
+	"/protobuf.ApiKeysService/CreateApiKey":                permitted(nil, []string{scopeApiKeysWrite}),
+	"/protobuf.ApiKeysService/ListApiKeys":                 permitted(nil, []string{scopeApiKeysRead}),
+	"/protobuf.ApiKeysService/RevokeApiKey":                permitted(nil, []string{scopeApiKeysWrite}),
+	"/protobuf.OAuthClientsService/RegisterOAuthClient":    permitted(managers, []string{scopeOAuthClientsWrite}),
+	"/protobuf.TokensService/IntrospectToken":              public,
+	"/protobuf.TokensService/RevokeToken":                  public,
+	"/protobuf.MfaService/EnrollMfa":                       permitted(nil, []string{scopeMfaWrite}),
+	"/protobuf.MfaService/ConfirmMfa":                      permitted(nil, []string{scopeMfaWrite}),
+	"/protobuf.MfaService/VerifyMfa":                       public,
+	"/protobuf.MfaService/GetMfaStatus":                    permitted(nil, []string{scopeMfaRead}),
+	"/protobuf.MfaService/RegenerateRecoveryCodes":         permitted(nil, []string{scopeMfaWrite}),
+	"/protobuf.WebAuthnService/BeginWebAuthnRegistration":  permitted(nil, []string{scopeWebAuthnWrite}),
+	"/protobuf.WebAuthnService/FinishWebAuthnRegistration": permitted(nil, []string{scopeWebAuthnWrite}),
+	"/protobuf.WebAuthnService/BeginWebAuthnLogin":         public,
+	"/protobuf.WebAuthnService/FinishWebAuthnLogin":        public,
+	"/protobuf.AuditService/QueryAuditLog":                 permitted(nil, []string{scopeAuditRead}),
+	// The use case asks for the openid scope itself, so that a client
+	// missing it gets the insufficient_scope error OpenID Connect defines.
+	"/userinfo": authenticated,
+}
+
+// Probes and tooling have no credentials, every method of these services is
+// public.
+var publicServices = []string{
+	"/grpc.health.v1.Health/",
+	"/grpc.reflection.v1alpha.ServerReflection/",
+}
+
+func policyFor(fullMethod string) *methodPolicy {
+	for _, service := range publicServices {
+		if strings.HasPrefix(fullMethod, service) {
+			return public
+		}
+	}
+	policy, ok := methodPolicies[fullMethod]
+	if !ok {
+		return authenticated
+	}
+	return policy
+}
+
+type AuthInterceptor struct {
+	authenticatePrincipal definitions.AuthenticatePrincipal
+}
+
+// Puts the principal in the context returned, see
+// definitions.PrincipalFromContext. The REST routes call it too, with the
+// method they mirror.
+func (authInterceptor *AuthInterceptor) Authenticate(
+	ctx context.Context, fullMethod string, sessionKey string, ipAddress string,
+) (context.Context, *shared.Error) {
+	policy := policyFor(fullMethod)
+	if policy.access == accessPublic {
+		return ctx, nil
+	}
+	principal, err := authInterceptor.authenticatePrincipal.
+		Execute(ctx, &definitions.AuthenticatePrincipalDTO{
+			SessionKey: sessionKey,
+			IpAddress:  ipAddress,
+			Roles:      policy.roles,
+			Scopes:     policy.scopes,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return definitions.WithPrincipal(ctx, principal), nil
+}
+
+// Always a status, GRPC_LEGACY_ERRORS included: the handler never runs, so
+// there is no response whose Error field could carry the failure.
+func (authInterceptor *AuthInterceptor) authenticate(ctx context.Context, fullMethod string) (context.Context, error) {
+	ctx, err := authInterceptor.Authenticate(ctx, fullMethod, sessionKeyFromContext(ctx), ipAddressFromContext(ctx))
+	if err != nil {
+		return nil, newStatusError(err)
+	}
+	return ctx, nil
+}
+
+func (authInterceptor *AuthInterceptor) Unary(
+	ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	ctx, goerr := authInterceptor.authenticate(ctx, info.FullMethod)
+	if goerr != nil {
+		return nil, goerr
+	}
+	return handler(ctx, request)
+}
+
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *contextStream) Context() context.Context {
+	return stream.ctx
+}
+
+func (authInterceptor *AuthInterceptor) Stream(
+	server interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	ctx, goerr := authInterceptor.authenticate(stream.Context(), info.FullMethod)
+	if goerr != nil {
+		return goerr
+	}
+	return handler(server, &contextStream{ServerStream: stream, ctx: ctx})
+}
+
+func NewAuthInterceptor(authenticatePrincipal definitions.AuthenticatePrincipal) (*AuthInterceptor, *shared.Error) {
+	return &AuthInterceptor{
+		authenticatePrincipal: authenticatePrincipal,
+	}, nil
+}
@@ -0,0 +1,94 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const correlationIdHeader = "x-correlation-id"
+
+func correlate(ctx context.Context) context.Context {
+	var received string
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok && len(md.Get(correlationIdHeader)) > 0 {
+		received = md.Get(correlationIdHeader)[0]
+	}
+	correlationId := helpers.NewCorrelationId(received)
+	grpc.SetHeader(ctx, metadata.Pairs(correlationIdHeader, correlationId))
+	adapters.SpanFromContext(ctx).SetAttribute("correlation_id", correlationId)
+	return helpers.WithCorrelationId(ctx, correlationId)
+}
+
+// Internal errors say nothing about their cause, the correlation id in their
+// ErrorInfo is what ties them to the log lines that do.
+func withCorrelationId(ctx context.Context, goerr error) error {
+	grpcStatus, ok := status.FromError(goerr)
+	if !ok || grpcStatus.Code() != codes.Internal {
+		return goerr
+	}
+	details := []proto.Message{}
+	for _, detail := range grpcStatus.Details() {
+		errorInfo, ok := detail.(*errdetails.ErrorInfo)
+		if ok {
+			if errorInfo.Metadata == nil {
+				errorInfo.Metadata = map[string]string{}
+			}
+			errorInfo.Metadata["correlation_id"] = helpers.CorrelationId(ctx)
+		}
+		message, ok := detail.(proto.Message)
+		if ok {
+			details = append(details, message)
+		}
+	}
+	detailed, err := status.New(codes.Internal, grpcStatus.Message()).WithDetails(details...)
+	if err != nil {
+		return goerr
+	}
+	return detailed.Err()
+}
+
+func logCall(ctx context.Context, fullMethod string, start time.Time, goerr error) {
+	code := status.Code(goerr)
+	fields := providers.LogFields{
+		"method":      fullMethod,
+		"code":        code.String(),
+		"duration_ms": time.Since(start).Milliseconds(),
+	}
+	if code == codes.Internal || code == codes.Unknown {
+		adapters.Logger().Error(ctx, "grpc call failed", fields)
+		return
+	}
+	adapters.Logger().Info(ctx, "grpc call", fields)
+}
+
+func UnaryCorrelationInterceptor(
+	ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	start := time.Now()
+	ctx = correlate(ctx)
+	response, goerr := handler(ctx, request)
+	goerr = withCorrelationId(ctx, goerr)
+	logCall(ctx, info.FullMethod, start, goerr)
+	return response, goerr
+}
+
+func StreamCorrelationInterceptor(
+	server interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	start := time.Now()
+	ctx := correlate(stream.Context())
+	goerr := handler(server, &contextStream{ServerStream: stream, ctx: ctx})
+	goerr = withCorrelationId(ctx, goerr)
+	logCall(ctx, info.FullMethod, start, goerr)
+	return goerr
+}
@@ -0,0 +1,76 @@
+package grpc
+
+import (
+	"os"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const errorDomain = "oganessone"
+
+var errorCodes = map[string]codes.Code{
+	"validation":     codes.InvalidArgument,
+	"oauth":          codes.InvalidArgument,
+	"conflict":       codes.AlreadyExists,
+	"authentication": codes.Unauthenticated,
+	"authorization":  codes.PermissionDenied,
+	"not_found":      codes.NotFound,
+	"unexpected":     codes.Internal,
+	"cancelled":      codes.Canceled,
+}
+
+func errorCode(err *shared.Error) codes.Code {
+	code, ok := errorCodes[err.Type]
+	if !ok {
+		return codes.Internal
+	}
+	return code
+}
+
+func newStatusError(err *shared.Error) error {
+	grpcStatus := status.New(errorCode(err), err.Message)
+	detailed, goerr := grpcStatus.WithDetails(newErrorDetails(err)...)
+	if goerr != nil {
+		return grpcStatus.Err()
+	}
+	return detailed.Err()
+}
+
+// Handlers still fill the Error field of their responses, with
+// GRPC_LEGACY_ERRORS=true that payload is all clients get and the call itself
+// succeeds, as it did before errors became statuses. Failed authentication
+// has no response to fill and stays a status, see AuthInterceptor.
+func newGrpcError(err *shared.Error) error {
+	if os.Getenv("GRPC_LEGACY_ERRORS") == "true" {
+		return nil
+	}
+	return newStatusError(err)
+}
+
+func newErrorDetails(err *shared.Error) []proto.Message {
+	details := []proto.Message{
+		&errdetails.ErrorInfo{
+			Reason:   err.Name,
+			Domain:   errorDomain,
+			Metadata: map[string]string{"type": err.Type},
+		},
+	}
+	if err.Type != "validation" {
+		return details
+	}
+	violations := err.Details
+	if len(violations) == 0 {
+		violations = []string{err.Message}
+	}
+	badRequest := &errdetails.BadRequest{}
+	for _, detail := range violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Description: detail,
+		})
+	}
+	return append(details, badRequest)
+}
@@ -0,0 +1,80 @@
+package grpc
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type healthReporter struct {
+	server   *health.Server
+	services []string
+	checks   []factories.HealthCheck
+	interval time.Duration
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// Every service shares one status since they all sit on the same
+// dependencies, the empty name is the server as a whole.
+func (reporter *healthReporter) report() {
+	status := grpc_health_v1.HealthCheckResponse_SERVING
+	for _, check := range reporter.checks {
+		ctx, cancel := context.WithTimeout(context.Background(), reporter.interval)
+		err := check(ctx)
+		cancel()
+		if err != nil {
+			status = grpc_health_v1.HealthCheckResponse_NOT_SERVING
+			break
+		}
+	}
+	reporter.server.SetServingStatus("", status)
+	for _, service := range reporter.services {
+		reporter.server.SetServingStatus(service, status)
+	}
+}
+
+func (reporter *healthReporter) run() {
+	ticker := time.NewTicker(reporter.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			reporter.report()
+		case <-reporter.done:
+			return
+		}
+	}
+}
+
+// Shutdown pins every service to NOT_SERVING and ignores later reports, so
+// the flip is visible before the server starts draining.
+func (reporter *healthReporter) stop() {
+	reporter.stopOnce.Do(func() {
+		reporter.server.Shutdown()
+		close(reporter.done)
+	})
+}
+
+func newHealthReporter(server *health.Server, services []string) (*healthReporter, *shared.Error) {
+	checks, err := factories.MakeHealthChecks()
+	if err != nil {
+		return nil, err
+	}
+	interval, err := factories.MakeHealthCheckInterval()
+	if err != nil {
+		return nil, err
+	}
+	return &healthReporter{
+		server:   server,
+		services: services,
+		checks:   checks,
+		interval: interval,
+		done:     make(chan struct{}),
+	}, nil
+}
@@ -0,0 +1,66 @@
+package grpc
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/grpc/protobuf"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+func sessionKeyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return ""
+	}
+	return strings.TrimPrefix(values[0], "Bearer ")
+}
+
+func userAgentFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get("user-agent")
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func ipAddressFromContext(ctx context.Context) string {
+	remote, ok := peer.FromContext(ctx)
+	if !ok || remote.Addr == nil {
+		return ""
+	}
+	host, _, goerr := net.SplitHostPort(remote.Addr.String())
+	if goerr != nil {
+		return remote.Addr.String()
+	}
+	return host
+}
+
+func headersFromContext(ctx context.Context) *contracts.PresenterRequestHeaders {
+	return &contracts.PresenterRequestHeaders{
+		SessionKey: sessionKeyFromContext(ctx),
+		IpAddress:  ipAddressFromContext(ctx),
+		UserAgent:  userAgentFromContext(ctx),
+	}
+}
+
+func newProtobufError(err *shared.Error) *protobuf.Error {
+	return &protobuf.Error{
+		Type:    err.Type,
+		Name:    err.Name,
+		Message: err.Message,
+		Details: err.Details,
+	}
+}
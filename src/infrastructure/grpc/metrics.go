@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	rpcRequests = helpers.Metrics.NewCounter(
+		"oganessone_grpc_requests_total", "gRPC calls handled, by method and status code.",
+		"method", "code",
+	)
+	rpcDuration = helpers.Metrics.NewHistogram(
+		"oganessone_grpc_request_duration_seconds", "Time spent handling gRPC calls.",
+		helpers.DefaultLatencyBuckets, "method",
+	)
+)
+
+func observeCall(fullMethod string, start time.Time, goerr error) {
+	rpcRequests.Inc(fullMethod, status.Code(goerr).String())
+	rpcDuration.Observe(time.Since(start).Seconds(), fullMethod)
+}
+
+func UnaryMetricsInterceptor(
+	ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	start := time.Now()
+	response, goerr := handler(ctx, request)
+	observeCall(info.FullMethod, start, goerr)
+	return response, goerr
+}
+
+func StreamMetricsInterceptor(
+	server interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	start := time.Now()
+	goerr := handler(server, stream)
+	observeCall(info.FullMethod, start, goerr)
+	return goerr
+}
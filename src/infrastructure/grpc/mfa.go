@@ -0,0 +1,151 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/grpc/protobuf"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+func (*server) EnrollMfa(
+	ctx context.Context, request *protobuf.EnrollMfaRequest,
+) (*protobuf.EnrollMfaResponse, error) {
+	enrollMfaPresenter, err := factories.MakeEnrollMfaPresenter()
+	if err != nil {
+		return &protobuf.EnrollMfaResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	response, err := enrollMfaPresenter.
+		Handle(ctx, &contracts.EnrollMfaPresenterRequest{
+			Headers: headersFromContext(ctx),
+		})
+	if err != nil {
+		return &protobuf.EnrollMfaResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	return &protobuf.EnrollMfaResponse{
+		Data: &protobuf.MfaEnrollment{
+			Secret:        response.Body.Secret,
+			Uri:           response.Body.Uri,
+			RecoveryCodes: response.Body.RecoveryCodes,
+		},
+		Error: nil,
+	}, nil
+}
+
+func (*server) ConfirmMfa(
+	ctx context.Context, request *protobuf.ConfirmMfaRequest,
+) (*protobuf.ConfirmMfaResponse, error) {
+	confirmMfaPresenter, err := factories.MakeConfirmMfaPresenter()
+	if err != nil {
+		return &protobuf.ConfirmMfaResponse{
+			Error: newProtobufError(err),
+		}, newGrpcError(err)
+	}
+	_, err = confirmMfaPresenter.
+		Handle(ctx, &contracts.ConfirmMfaPresenterRequest{
+			Headers: headersFromContext(ctx),
+			Body: &contracts.ConfirmMfaPresenterRequestBody{
+				Code: request.GetCode(),
+			},
+		})
+	if err != nil {
+		return &protobuf.ConfirmMfaResponse{
+			Error: newProtobufError(err),
+		}, newGrpcError(err)
+	}
+	return &protobuf.ConfirmMfaResponse{
+		Error: nil,
+	}, nil
+}
+
+func (*server) VerifyMfa(
+	ctx context.Context, request *protobuf.VerifyMfaRequest,
+) (*protobuf.VerifyMfaResponse, error) {
+	verifyMfaPresenter, err := factories.MakeVerifyMfaPresenter()
+	if err != nil {
+		return &protobuf.VerifyMfaResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	response, err := verifyMfaPresenter.
+		Handle(ctx, &contracts.VerifyMfaPresenterRequest{
+			Headers: headersFromContext(ctx),
+			Body: &contracts.VerifyMfaPresenterRequestBody{
+				MfaToken: request.GetMfaToken(),
+				Code:     request.GetCode(),
+			},
+		})
+	if err != nil {
+		return &protobuf.VerifyMfaResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	return &protobuf.VerifyMfaResponse{
+		Data:  newProtobufSession(response.Body),
+		Error: nil,
+	}, nil
+}
+
+func (*server) GetMfaStatus(
+	ctx context.Context, request *protobuf.GetMfaStatusRequest,
+) (*protobuf.GetMfaStatusResponse, error) {
+	getMfaStatusPresenter, err := factories.MakeGetMfaStatusPresenter()
+	if err != nil {
+		return &protobuf.GetMfaStatusResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	response, err := getMfaStatusPresenter.
+		Handle(ctx, &contracts.GetMfaStatusPresenterRequest{
+			Headers: headersFromContext(ctx),
+		})
+	if err != nil {
+		return &protobuf.GetMfaStatusResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	return &protobuf.GetMfaStatusResponse{
+		Data: &protobuf.MfaStatus{
+			Enabled:                response.Body.Enabled,
+			RecoveryCodesRemaining: int32(response.Body.RecoveryCodesRemaining),
+		},
+		Error: nil,
+	}, nil
+}
+
+func (*server) RegenerateRecoveryCodes(
+	ctx context.Context, request *protobuf.RegenerateRecoveryCodesRequest,
+) (*protobuf.RegenerateRecoveryCodesResponse, error) {
+	regenerateRecoveryCodesPresenter, err := factories.MakeRegenerateRecoveryCodesPresenter()
+	if err != nil {
+		return &protobuf.RegenerateRecoveryCodesResponse{
+			Error: newProtobufError(err),
+		}, newGrpcError(err)
+	}
+	response, err := regenerateRecoveryCodesPresenter.
+		Handle(ctx, &contracts.RegenerateRecoveryCodesPresenterRequest{
+			Headers: headersFromContext(ctx),
+			Body: &contracts.RegenerateRecoveryCodesPresenterRequestBody{
+				Password: request.GetPassword(),
+			},
+		})
+	if err != nil {
+		return &protobuf.RegenerateRecoveryCodesResponse{
+			Error: newProtobufError(err),
+		}, newGrpcError(err)
+	}
+	return &protobuf.RegenerateRecoveryCodesResponse{
+		RecoveryCodes: response.Body.RecoveryCodes,
+		Error:         nil,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/grpc/protobuf"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+func (*server) RegisterOAuthClient(
+	ctx context.Context, request *protobuf.RegisterOAuthClientRequest,
+) (*protobuf.RegisterOAuthClientResponse, error) {
+	registerOAuthClientPresenter, err := factories.MakeRegisterOAuthClientPresenter()
+	if err != nil {
+		return &protobuf.RegisterOAuthClientResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	response, err := registerOAuthClientPresenter.
+		Handle(ctx, &contracts.RegisterOAuthClientPresenterRequest{
+			Headers: headersFromContext(ctx),
+			Body: &contracts.RegisterOAuthClientPresenterRequestBody{
+				OrganizationId: request.GetOrganizationId(),
+				Name:           request.GetName(),
+				RedirectUris:   request.GetRedirectUris(),
+				AuthMethod:     request.GetAuthMethod(),
+				PublicKey:      request.GetPublicKey(),
+				Scopes:         request.GetScopes(),
+				Audiences:      request.GetAudiences(),
+			},
+		})
+	if err != nil {
+		return &protobuf.RegisterOAuthClientResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	client := response.Body.Client
+	return &protobuf.RegisterOAuthClientResponse{
+		Data: &protobuf.RegisteredOAuthClient{
+			ClientSecret: response.Body.ClientSecret,
+			Client: &protobuf.OAuthClient{
+				Id:             client.Id,
+				OrganizationId: client.OrganizationId,
+				Name:           client.Name,
+				RedirectUris:   client.RedirectUris,
+				AuthMethod:     client.AuthMethod,
+				Scopes:         client.Scopes,
+				Audiences:      client.Audiences,
+				CreatedAt:      client.CreatedAt,
+				UpdatedAt:      client.UpdatedAt,
+			},
+		},
+		Error: nil,
+	}, nil
+}
@@ -0,0 +1,108 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/grpc/protobuf"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+func (*server) CreateOrganization(
+	ctx context.Context, request *protobuf.CreateOrganizationRequest,
+) (*protobuf.CreateOrganizationResponse, error) {
+	createOrganizationPresenter, err := factories.MakeCreateOrganizationPresenter()
+	if err != nil {
+		return &protobuf.CreateOrganizationResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	response, err := createOrganizationPresenter.
+		Handle(ctx, &contracts.CreateOrganizationPresenterRequest{
+			Headers: headersFromContext(ctx),
+			Body: &contracts.CreateOrganizationPresenterRequestBody{
+				Name: request.GetName(),
+			},
+		})
+	if err != nil {
+		return &protobuf.CreateOrganizationResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	return &protobuf.CreateOrganizationResponse{
+		Data: &protobuf.Organization{
+			Id:        response.Body.Id,
+			Name:      response.Body.Name,
+			CreatedAt: response.Body.CreatedAt,
+			UpdatedAt: response.Body.UpdatedAt,
+		},
+		Error: nil,
+	}, nil
+}
+
+func (*server) InviteMember(
+	ctx context.Context, request *protobuf.InviteMemberRequest,
+) (*protobuf.InviteMemberResponse, error) {
+	inviteMemberPresenter, err := factories.MakeInviteMemberPresenter()
+	if err != nil {
+		return &protobuf.InviteMemberResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	response, err := inviteMemberPresenter.
+		Handle(ctx, &contracts.InviteMemberPresenterRequest{
+			Headers: headersFromContext(ctx),
+			Body: &contracts.InviteMemberPresenterRequestBody{
+				OrganizationId: request.GetOrganizationId(),
+				UserId:         request.GetUserId(),
+				Role:           request.GetRole(),
+			},
+		})
+	if err != nil {
+		return &protobuf.InviteMemberResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	return &protobuf.InviteMemberResponse{
+		Data: &protobuf.Membership{
+			Id:             response.Body.Id,
+			OrganizationId: response.Body.OrganizationId,
+			UserId:         response.Body.UserId,
+			Role:           response.Body.Role,
+			CreatedAt:      response.Body.CreatedAt,
+			UpdatedAt:      response.Body.UpdatedAt,
+		},
+		Error: nil,
+	}, nil
+}
+
+func (*server) RemoveMember(
+	ctx context.Context, request *protobuf.RemoveMemberRequest,
+) (*protobuf.RemoveMemberResponse, error) {
+	removeMemberPresenter, err := factories.MakeRemoveMemberPresenter()
+	if err != nil {
+		return &protobuf.RemoveMemberResponse{
+			Error: newProtobufError(err),
+		}, newGrpcError(err)
+	}
+	_, err = removeMemberPresenter.
+		Handle(ctx, &contracts.RemoveMemberPresenterRequest{
+			Headers: headersFromContext(ctx),
+			Body: &contracts.RemoveMemberPresenterRequestBody{
+				OrganizationId: request.GetOrganizationId(),
+				UserId:         request.GetUserId(),
+			},
+		})
+	if err != nil {
+		return &protobuf.RemoveMemberResponse{
+			Error: newProtobufError(err),
+		}, newGrpcError(err)
+	}
+	return &protobuf.RemoveMemberResponse{
+		Error: nil,
+	}, nil
+}
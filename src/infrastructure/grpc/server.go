@@ -4,16 +4,28 @@ import (
 	"context"
 	"log"
 	"net"
+	"os"
 
 	"github.com/AndreyArthur/oganessone/src/core/shared"
 	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
 	"github.com/AndreyArthur/oganessone/src/infrastructure/grpc/protobuf"
 	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 type server struct {
 	protobuf.UnimplementedUsersServiceServer
+	protobuf.UnimplementedSessionsServiceServer
+	protobuf.UnimplementedOrganizationsServiceServer
+	protobuf.UnimplementedApiKeysServiceServer
+	protobuf.UnimplementedOAuthClientsServiceServer
+	protobuf.UnimplementedTokensServiceServer
+	protobuf.UnimplementedMfaServiceServer
+	protobuf.UnimplementedWebAuthnServiceServer
+	protobuf.UnimplementedAuditServiceServer
 }
 
 func (*server) CreateUser(
@@ -26,63 +38,157 @@ func (*server) CreateUser(
 	createUserPresenter, err := factories.MakeCreateUserPresenter()
 	if err != nil {
 		return &protobuf.CreateUserResponse{
-			Error: &protobuf.Error{
-				Type:    err.Type,
-				Name:    err.Name,
-				Message: err.Message,
-			},
-			Data: nil,
-		}, nil
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
 	}
 	response, err := createUserPresenter.
-		Handle(&contracts.CreateUserPresenterRequest{
+		Handle(ctx, &contracts.CreateUserPresenterRequest{
+			Headers: headersFromContext(ctx),
 			Body: &contracts.CreateUserPresenterRequestBody{
-				Username: username,
-				Email:    email,
-				Password: password,
+				Username:       username,
+				Email:          email,
+				Password:       password,
+				OrganizationId: request.GetOrganizationId(),
 			},
 		})
 	if err != nil {
 		return &protobuf.CreateUserResponse{
-			Error: &protobuf.Error{
-				Type:    err.Type,
-				Name:    err.Name,
-				Message: err.Message,
-			},
-			Data: nil,
-		}, nil
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
 	}
 	return &protobuf.CreateUserResponse{
 		Data: &protobuf.User{
-			Id:        response.Body.Id,
-			Username:  response.Body.Username,
-			Email:     response.Body.Email,
-			CreatedAt: response.Body.CreatedAt,
-			UpdatedAt: response.Body.UpdatedAt,
+			Id:             response.Body.Id,
+			OrganizationId: response.Body.OrganizationId,
+			Username:       response.Body.Username,
+			Email:          response.Body.Email,
+			CreatedAt:      response.Body.CreatedAt,
+			UpdatedAt:      response.Body.UpdatedAt,
 		},
 		Error: nil,
 	}, nil
 }
 
+func (*server) GetPasswordPolicy(
+	ctx context.Context, request *protobuf.GetPasswordPolicyRequest,
+) (*protobuf.GetPasswordPolicyResponse, error) {
+	getPasswordPolicyPresenter, err := factories.MakeGetPasswordPolicyPresenter()
+	if err != nil {
+		return &protobuf.GetPasswordPolicyResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	response, err := getPasswordPolicyPresenter.
+		Handle(ctx, &contracts.GetPasswordPolicyPresenterRequest{})
+	if err != nil {
+		return &protobuf.GetPasswordPolicyResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	return &protobuf.GetPasswordPolicyResponse{
+		Data: &protobuf.PasswordPolicy{
+			MinLength:               int32(response.Body.MinLength),
+			MaxLength:               int32(response.Body.MaxLength),
+			RequiredClasses:         response.Body.RequiredClasses,
+			MaxRepeatedCharacters:   int32(response.Body.MaxRepeatedCharacters),
+			DisallowUserInfo:        response.Body.DisallowUserInfo,
+			DisallowDictionaryWords: response.Body.DisallowDictionaryWords,
+		},
+		Error: nil,
+	}, nil
+}
+
+func (*server) ChangePassword(
+	ctx context.Context, request *protobuf.ChangePasswordRequest,
+) (*protobuf.ChangePasswordResponse, error) {
+	changePasswordPresenter, err := factories.MakeChangePasswordPresenter()
+	if err != nil {
+		return &protobuf.ChangePasswordResponse{
+			Error: newProtobufError(err),
+		}, newGrpcError(err)
+	}
+	_, err = changePasswordPresenter.
+		Handle(ctx, &contracts.ChangePasswordPresenterRequest{
+			Headers: headersFromContext(ctx),
+			Body: &contracts.ChangePasswordPresenterRequestBody{
+				CurrentPassword: request.GetCurrentPassword(),
+				NewPassword:     request.GetNewPassword(),
+			},
+		})
+	if err != nil {
+		return &protobuf.ChangePasswordResponse{
+			Error: newProtobufError(err),
+		}, newGrpcError(err)
+	}
+	return &protobuf.ChangePasswordResponse{
+		Error: nil,
+	}, nil
+}
+
 type GrpcServer struct {
 	googleGrpcServer *grpc.Server
-	protoServer      protobuf.UsersServiceServer
+	protoServer      *server
+	health           *healthReporter
 }
 
 func (gs *GrpcServer) Start(lis net.Listener) {
-	protobuf.RegisterUsersServiceServer(gs.googleGrpcServer, gs.protoServer)
-	err := gs.googleGrpcServer.Serve(lis)
+	gs.health.report()
+	go gs.health.run()
+	goerr := gs.googleGrpcServer.Serve(lis)
 	gs.googleGrpcServer.Stop()
-	if err != nil {
-		log.Fatal(err)
+	if goerr != nil {
+		log.Fatal(goerr)
 		return
 	}
 }
 
+// Health flips to NOT_SERVING first, then in-flight calls get until ctx is
+// done to finish before the rest are cut off.
+func (gs *GrpcServer) Shutdown(ctx context.Context) {
+	gs.health.stop()
+	stopped := make(chan struct{})
+	go func() {
+		gs.googleGrpcServer.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		gs.googleGrpcServer.Stop()
+	}
+}
+
 func NewGrpcServer(googleGrpcServer *grpc.Server) (*GrpcServer, *shared.Error) {
 	server := &server{}
+	protobuf.RegisterUsersServiceServer(googleGrpcServer, server)
+	protobuf.RegisterSessionsServiceServer(googleGrpcServer, server)
+	protobuf.RegisterOrganizationsServiceServer(googleGrpcServer, server)
+	protobuf.RegisterApiKeysServiceServer(googleGrpcServer, server)
+	protobuf.RegisterOAuthClientsServiceServer(googleGrpcServer, server)
+	protobuf.RegisterTokensServiceServer(googleGrpcServer, server)
+	protobuf.RegisterMfaServiceServer(googleGrpcServer, server)
+	protobuf.RegisterWebAuthnServiceServer(googleGrpcServer, server)
+	protobuf.RegisterAuditServiceServer(googleGrpcServer, server)
+	services := []string{}
+	for service := range googleGrpcServer.GetServiceInfo() {
+		services = append(services, service)
+	}
+	healthServer := health.NewServer()
+	grpc_health_v1.RegisterHealthServer(googleGrpcServer, healthServer)
+	reporter, err := newHealthReporter(healthServer, services)
+	if err != nil {
+		return nil, err
+	}
+	if os.Getenv("GRPC_REFLECTION") == "true" {
+		reflection.Register(googleGrpcServer)
+	}
 	return &GrpcServer{
 		googleGrpcServer: googleGrpcServer,
 		protoServer:      server,
+		health:           reporter,
 	}, nil
 }
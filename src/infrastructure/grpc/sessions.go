@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/grpc/protobuf"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+func newProtobufSession(session *views.SessionView) *protobuf.Session {
+	user := session.User
+	return &protobuf.Session{
+		SessionKey:     session.SessionKey,
+		OrganizationId: session.OrganizationId,
+		User: &protobuf.User{
+			Id:             user.Id,
+			OrganizationId: user.OrganizationId,
+			Username:       user.Username,
+			Email:          user.Email,
+			CreatedAt:      user.CreatedAt,
+			UpdatedAt:      user.UpdatedAt,
+		},
+		MfaRequired: session.MfaRequired,
+		MfaToken:    session.MfaToken,
+	}
+}
+
+func (*server) CreateSession(
+	ctx context.Context, request *protobuf.CreateSessionRequest,
+) (*protobuf.CreateSessionResponse, error) {
+	createSessionPresenter, err := factories.MakeCreateSessionPresenter()
+	if err != nil {
+		return &protobuf.CreateSessionResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	response, err := createSessionPresenter.
+		Handle(ctx, &contracts.CreateSessionPresenterRequest{
+			Headers: headersFromContext(ctx),
+			Body: &contracts.CreateSessionPresenterRequestBody{
+				Login:          request.GetLogin(),
+				Password:       request.GetPassword(),
+				OrganizationId: request.GetOrganizationId(),
+			},
+		})
+	if err != nil {
+		return &protobuf.CreateSessionResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	return &protobuf.CreateSessionResponse{
+		Data:  newProtobufSession(response.Body),
+		Error: nil,
+	}, nil
+}
+
+func (*server) SwitchOrganization(
+	ctx context.Context, request *protobuf.SwitchOrganizationRequest,
+) (*protobuf.SwitchOrganizationResponse, error) {
+	switchOrganizationPresenter, err := factories.MakeSwitchOrganizationPresenter()
+	if err != nil {
+		return &protobuf.SwitchOrganizationResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	response, err := switchOrganizationPresenter.
+		Handle(ctx, &contracts.SwitchOrganizationPresenterRequest{
+			Headers: headersFromContext(ctx),
+			Body: &contracts.SwitchOrganizationPresenterRequestBody{
+				OrganizationId: request.GetOrganizationId(),
+			},
+		})
+	if err != nil {
+		return &protobuf.SwitchOrganizationResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	return &protobuf.SwitchOrganizationResponse{
+		Data: &protobuf.Session{
+			SessionKey:     response.Body.SessionKey,
+			OrganizationId: response.Body.OrganizationId,
+		},
+		Error: nil,
+	}, nil
+}
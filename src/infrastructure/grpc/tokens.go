@@ -0,0 +1,87 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/grpc/protobuf"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+func clientCredentialsBody(
+	client *protobuf.ClientCredentials,
+) *contracts.ClientCredentialsBody {
+	return &contracts.ClientCredentialsBody{
+		ClientId:            client.GetClientId(),
+		ClientSecret:        client.GetClientSecret(),
+		ClientAssertionType: client.GetClientAssertionType(),
+		ClientAssertion:     client.GetClientAssertion(),
+	}
+}
+
+func (*server) IntrospectToken(
+	ctx context.Context, request *protobuf.IntrospectTokenRequest,
+) (*protobuf.IntrospectTokenResponse, error) {
+	introspectTokenPresenter, err := factories.MakeIntrospectTokenPresenter()
+	if err != nil {
+		return &protobuf.IntrospectTokenResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	response, err := introspectTokenPresenter.
+		Handle(ctx, &contracts.IntrospectTokenPresenterRequest{
+			Body: &contracts.IntrospectTokenPresenterRequestBody{
+				Client:        clientCredentialsBody(request.GetClient()),
+				Token:         request.GetToken(),
+				TokenTypeHint: request.GetTokenTypeHint(),
+			},
+		})
+	if err != nil {
+		return &protobuf.IntrospectTokenResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	return &protobuf.IntrospectTokenResponse{
+		Data: &protobuf.TokenIntrospection{
+			Active:         response.Body.Active,
+			Sub:            response.Body.Subject,
+			ClientId:       response.Body.ClientId,
+			OrganizationId: response.Body.OrganizationId,
+			Scope:          response.Body.Scope,
+			Audiences:      response.Body.Audiences,
+			Exp:            response.Body.ExpiresAt,
+			TokenType:      response.Body.TokenType,
+		},
+		Error: nil,
+	}, nil
+}
+
+func (*server) RevokeToken(
+	ctx context.Context, request *protobuf.RevokeTokenRequest,
+) (*protobuf.RevokeTokenResponse, error) {
+	revokeTokenPresenter, err := factories.MakeRevokeTokenPresenter()
+	if err != nil {
+		return &protobuf.RevokeTokenResponse{
+			Error: newProtobufError(err),
+		}, newGrpcError(err)
+	}
+	_, err = revokeTokenPresenter.
+		Handle(ctx, &contracts.RevokeTokenPresenterRequest{
+			Headers: headersFromContext(ctx),
+			Body: &contracts.RevokeTokenPresenterRequestBody{
+				Client:        clientCredentialsBody(request.GetClient()),
+				Token:         request.GetToken(),
+				TokenTypeHint: request.GetTokenTypeHint(),
+			},
+		})
+	if err != nil {
+		return &protobuf.RevokeTokenResponse{
+			Error: newProtobufError(err),
+		}, newGrpcError(err)
+	}
+	return &protobuf.RevokeTokenResponse{
+		Error: nil,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func firstMetadata(md metadata.MD, key string) string {
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// The caller's traceparent and tracestate metadata, as in W3C trace context,
+// make the call span a child of the caller's.
+func startCallSpan(ctx context.Context, fullMethod string) (context.Context, *adapters.SpanAdapter) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	parent := adapters.ParseTraceParent(firstMetadata(md, "traceparent"), firstMetadata(md, "tracestate"))
+	ctx, span := adapters.Tracer().StartSpan(ctx, fullMethod, adapters.SpanKindServer, parent)
+	span.SetAttribute("rpc.system", "grpc")
+	span.SetAttribute("rpc.method", fullMethod)
+	return ctx, span
+}
+
+func endCallSpan(span *adapters.SpanAdapter, goerr error) {
+	grpcStatus := status.Convert(goerr)
+	span.SetAttribute("rpc.grpc.status_code", int(grpcStatus.Code()))
+	if grpcStatus.Code() != codes.OK {
+		span.Fail(grpcStatus.Message())
+	}
+	span.End()
+}
+
+func UnaryTracingInterceptor(
+	ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+) (interface{}, error) {
+	ctx, span := startCallSpan(ctx, info.FullMethod)
+	response, goerr := handler(ctx, request)
+	endCallSpan(span, goerr)
+	return response, goerr
+}
+
+func StreamTracingInterceptor(
+	server interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler,
+) error {
+	ctx, span := startCallSpan(stream.Context(), info.FullMethod)
+	goerr := handler(server, &contextStream{ServerStream: stream, ctx: ctx})
+	endCallSpan(span, goerr)
+	return goerr
+}
@@ -0,0 +1,148 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/grpc/protobuf"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+func (*server) BeginWebAuthnRegistration(
+	ctx context.Context, request *protobuf.BeginWebAuthnRegistrationRequest,
+) (*protobuf.BeginWebAuthnRegistrationResponse, error) {
+	beginWebAuthnRegistrationPresenter, err := factories.MakeBeginWebAuthnRegistrationPresenter()
+	if err != nil {
+		return &protobuf.BeginWebAuthnRegistrationResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	response, err := beginWebAuthnRegistrationPresenter.
+		Handle(ctx, &contracts.BeginWebAuthnRegistrationPresenterRequest{
+			Headers: headersFromContext(ctx),
+		})
+	if err != nil {
+		return &protobuf.BeginWebAuthnRegistrationResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	return &protobuf.BeginWebAuthnRegistrationResponse{
+		Data: &protobuf.WebAuthnRegistrationOptions{
+			Challenge:          response.Body.Challenge,
+			RpId:               response.Body.RpId,
+			RpName:             response.Body.RpName,
+			UserHandle:         response.Body.UserHandle,
+			UserName:           response.Body.UserName,
+			ExcludeCredentials: response.Body.ExcludeCredentials,
+			Timeout:            response.Body.Timeout,
+		},
+		Error: nil,
+	}, nil
+}
+
+func (*server) FinishWebAuthnRegistration(
+	ctx context.Context, request *protobuf.FinishWebAuthnRegistrationRequest,
+) (*protobuf.FinishWebAuthnRegistrationResponse, error) {
+	finishWebAuthnRegistrationPresenter, err := factories.MakeFinishWebAuthnRegistrationPresenter()
+	if err != nil {
+		return &protobuf.FinishWebAuthnRegistrationResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	response, err := finishWebAuthnRegistrationPresenter.
+		Handle(ctx, &contracts.FinishWebAuthnRegistrationPresenterRequest{
+			Headers: headersFromContext(ctx),
+			Body: &contracts.FinishWebAuthnRegistrationPresenterRequestBody{
+				ClientDataJson:    request.GetClientDataJson(),
+				AttestationObject: request.GetAttestationObject(),
+				Transports:        request.GetTransports(),
+			},
+		})
+	if err != nil {
+		return &protobuf.FinishWebAuthnRegistrationResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	return &protobuf.FinishWebAuthnRegistrationResponse{
+		Data: &protobuf.WebAuthnCredential{
+			Id:           response.Body.Id,
+			UserId:       response.Body.UserId,
+			CredentialId: response.Body.CredentialId,
+			Transports:   response.Body.Transports,
+			CreatedAt:    response.Body.CreatedAt,
+			UpdatedAt:    response.Body.UpdatedAt,
+		},
+		Error: nil,
+	}, nil
+}
+
+func (*server) BeginWebAuthnLogin(
+	ctx context.Context, request *protobuf.BeginWebAuthnLoginRequest,
+) (*protobuf.BeginWebAuthnLoginResponse, error) {
+	beginWebAuthnLoginPresenter, err := factories.MakeBeginWebAuthnLoginPresenter()
+	if err != nil {
+		return &protobuf.BeginWebAuthnLoginResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	response, err := beginWebAuthnLoginPresenter.
+		Handle(ctx, &contracts.BeginWebAuthnLoginPresenterRequest{
+			Body: &contracts.BeginWebAuthnLoginPresenterRequestBody{
+				Login:          request.GetLogin(),
+				OrganizationId: request.GetOrganizationId(),
+			},
+		})
+	if err != nil {
+		return &protobuf.BeginWebAuthnLoginResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	return &protobuf.BeginWebAuthnLoginResponse{
+		Data: &protobuf.WebAuthnLoginOptions{
+			Challenge:        response.Body.Challenge,
+			RpId:             response.Body.RpId,
+			AllowCredentials: response.Body.AllowCredentials,
+			Timeout:          response.Body.Timeout,
+		},
+		Error: nil,
+	}, nil
+}
+
+func (*server) FinishWebAuthnLogin(
+	ctx context.Context, request *protobuf.FinishWebAuthnLoginRequest,
+) (*protobuf.FinishWebAuthnLoginResponse, error) {
+	finishWebAuthnLoginPresenter, err := factories.MakeFinishWebAuthnLoginPresenter()
+	if err != nil {
+		return &protobuf.FinishWebAuthnLoginResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	response, err := finishWebAuthnLoginPresenter.
+		Handle(ctx, &contracts.FinishWebAuthnLoginPresenterRequest{
+			Headers: headersFromContext(ctx),
+			Body: &contracts.FinishWebAuthnLoginPresenterRequestBody{
+				CredentialId:      request.GetCredentialId(),
+				ClientDataJson:    request.GetClientDataJson(),
+				AuthenticatorData: request.GetAuthenticatorData(),
+				Signature:         request.GetSignature(),
+				UserHandle:        request.GetUserHandle(),
+			},
+		})
+	if err != nil {
+		return &protobuf.FinishWebAuthnLoginResponse{
+			Error: newProtobufError(err),
+			Data:  nil,
+		}, newGrpcError(err)
+	}
+	return &protobuf.FinishWebAuthnLoginResponse{
+		Data:  newProtobufSession(response.Body),
+		Error: nil,
+	}, nil
+}
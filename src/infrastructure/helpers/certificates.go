@@ -0,0 +1,122 @@
+package helpers
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// Certificates rereads the key pair and the client CA bundle whenever one of
+// the files changes on disk, so rotated certificates apply on the next
+// handshake. A failed reload keeps the previous files in use, rotation tools
+// rarely write the certificate and the key at the same instant. Helpers
+// cannot reach the shared logger, so failures go to logError.
+type Certificates struct {
+	certFile    string
+	keyFile     string
+	caFile      string
+	logError    func(error)
+	mutex       sync.Mutex
+	modTimes    []time.Time
+	certificate *tls.Certificate
+	clientCAs   *x509.CertPool
+}
+
+func (certificates *Certificates) files() []string {
+	files := []string{certificates.certFile, certificates.keyFile}
+	if certificates.caFile != "" {
+		files = append(files, certificates.caFile)
+	}
+	return files
+}
+
+func (certificates *Certificates) changed() ([]time.Time, bool) {
+	modTimes := []time.Time{}
+	changed := len(certificates.modTimes) == 0
+	for index, file := range certificates.files() {
+		info, goerr := os.Stat(file)
+		if goerr != nil {
+			certificates.logError(goerr)
+			return nil, false
+		}
+		modTimes = append(modTimes, info.ModTime())
+		if !changed && !info.ModTime().Equal(certificates.modTimes[index]) {
+			changed = true
+		}
+	}
+	return modTimes, changed
+}
+
+func (certificates *Certificates) load(modTimes []time.Time) error {
+	certificate, goerr := tls.LoadX509KeyPair(certificates.certFile, certificates.keyFile)
+	if goerr != nil {
+		return goerr
+	}
+	var clientCAs *x509.CertPool
+	if certificates.caFile != "" {
+		contents, goerr := os.ReadFile(certificates.caFile)
+		if goerr != nil {
+			return goerr
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(contents) {
+			return errors.New("client ca file has no pem certificates")
+		}
+	}
+	certificates.certificate = &certificate
+	certificates.clientCAs = clientCAs
+	certificates.modTimes = modTimes
+	return nil
+}
+
+func (certificates *Certificates) current() (*tls.Certificate, *x509.CertPool) {
+	certificates.mutex.Lock()
+	defer certificates.mutex.Unlock()
+	modTimes, changed := certificates.changed()
+	if changed {
+		goerr := certificates.load(modTimes)
+		if goerr != nil {
+			certificates.logError(goerr)
+		}
+	}
+	return certificates.certificate, certificates.clientCAs
+}
+
+// Config hands out base with the current certificate and CAs on every
+// handshake, base keeps the versions, cipher suites and client auth mode.
+func (certificates *Certificates) Config(base *tls.Config) *tls.Config {
+	config := base.Clone()
+	config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		certificate, clientCAs := certificates.current()
+		handshake := base.Clone()
+		handshake.Certificates = []tls.Certificate{*certificate}
+		handshake.ClientCAs = clientCAs
+		return handshake, nil
+	}
+	return config
+}
+
+func NewCertificates(certFile string, keyFile string, caFile string, logError func(error)) (*Certificates, *shared.Error) {
+	certificates := &Certificates{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+		logError: logError,
+	}
+	modTimes, _ := certificates.changed()
+	if modTimes == nil {
+		return nil, exceptions.NewInternalServerError()
+	}
+	goerr := certificates.load(modTimes)
+	if goerr != nil {
+		certificates.logError(goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	return certificates, nil
+}
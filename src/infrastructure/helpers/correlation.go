@@ -0,0 +1,30 @@
+package helpers
+
+import (
+	"context"
+	"regexp"
+)
+
+var correlationIdPattern = regexp.MustCompile(`^[A-Za-z0-9._-]{1,64}$`)
+
+type correlationIdKey struct{}
+
+func WithCorrelationId(ctx context.Context, correlationId string) context.Context {
+	return context.WithValue(ctx, correlationIdKey{}, correlationId)
+}
+
+// Empty when ctx does not belong to a request.
+func CorrelationId(ctx context.Context) string {
+	correlationId, _ := ctx.Value(correlationIdKey{}).(string)
+	return correlationId
+}
+
+// An id sent by the caller is kept so a request can be followed across
+// services, as long as it is safe to put in logs and headers.
+func NewCorrelationId(received string) string {
+	if correlationIdPattern.MatchString(received) {
+		return received
+	}
+	uuid, _ := NewUuid()
+	return uuid.Generate()
+}
@@ -0,0 +1,252 @@
+package helpers
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Buckets in seconds, from one millisecond to ten seconds.
+var DefaultLatencyBuckets = []float64{
+	0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10,
+}
+
+type metric interface {
+	write(writer *bufio.Writer)
+}
+
+type series struct {
+	labelValues []string
+	value       float64
+	buckets     []uint64
+	count       uint64
+}
+
+func seriesKey(labelValues []string) string {
+	return strings.Join(labelValues, "\xff")
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func formatLabels(names []string, values []string, extra ...string) string {
+	pairs := []string{}
+	for index, name := range names {
+		pairs = append(pairs, name+`="`+labelValueEscaper.Replace(values[index])+`"`)
+	}
+	for index := 0; index+1 < len(extra); index += 2 {
+		pairs = append(pairs, extra[index]+`="`+extra[index+1]+`"`)
+	}
+	if len(pairs) == 0 {
+		return ""
+	}
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func formatValue(value float64) string {
+	if math.IsInf(value, 1) {
+		return "+Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+func writeHeader(writer *bufio.Writer, name string, help string, kind string) {
+	writer.WriteString("# HELP " + name + " " + help + "\n")
+	writer.WriteString("# TYPE " + name + " " + kind + "\n")
+}
+
+type labeledMetric struct {
+	name   string
+	help   string
+	labels []string
+	mutex  sync.Mutex
+	series map[string]*series
+}
+
+// Label values must be given in the order the labels were declared, a call
+// with the wrong number of them is dropped.
+func (labeled *labeledMetric) get(labelValues []string) *series {
+	if len(labelValues) != len(labeled.labels) {
+		return nil
+	}
+	key := seriesKey(labelValues)
+	found, ok := labeled.series[key]
+	if !ok {
+		found = &series{labelValues: append([]string{}, labelValues...)}
+		labeled.series[key] = found
+	}
+	return found
+}
+
+func (labeled *labeledMetric) sorted() []*series {
+	keys := []string{}
+	for key := range labeled.series {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	sorted := []*series{}
+	for _, key := range keys {
+		sorted = append(sorted, labeled.series[key])
+	}
+	return sorted
+}
+
+type Counter struct {
+	labeledMetric
+}
+
+func (counter *Counter) Add(value float64, labelValues ...string) {
+	if value < 0 {
+		return
+	}
+	counter.mutex.Lock()
+	defer counter.mutex.Unlock()
+	found := counter.get(labelValues)
+	if found != nil {
+		found.value += value
+	}
+}
+
+func (counter *Counter) Inc(labelValues ...string) {
+	counter.Add(1, labelValues...)
+}
+
+func (counter *Counter) write(writer *bufio.Writer) {
+	counter.mutex.Lock()
+	defer counter.mutex.Unlock()
+	writeHeader(writer, counter.name, counter.help, "counter")
+	for _, found := range counter.sorted() {
+		writer.WriteString(counter.name + formatLabels(counter.labels, found.labelValues) +
+			" " + formatValue(found.value) + "\n")
+	}
+}
+
+type Histogram struct {
+	labeledMetric
+	buckets []float64
+}
+
+func (histogram *Histogram) Observe(value float64, labelValues ...string) {
+	histogram.mutex.Lock()
+	defer histogram.mutex.Unlock()
+	found := histogram.get(labelValues)
+	if found == nil {
+		return
+	}
+	if found.buckets == nil {
+		found.buckets = make([]uint64, len(histogram.buckets))
+	}
+	for index, bound := range histogram.buckets {
+		if value <= bound {
+			found.buckets[index]++
+		}
+	}
+	found.value += value
+	found.count++
+}
+
+func (histogram *Histogram) write(writer *bufio.Writer) {
+	histogram.mutex.Lock()
+	defer histogram.mutex.Unlock()
+	writeHeader(writer, histogram.name, histogram.help, "histogram")
+	for _, found := range histogram.sorted() {
+		for index, bound := range histogram.buckets {
+			writer.WriteString(histogram.name + "_bucket" +
+				formatLabels(histogram.labels, found.labelValues, "le", formatValue(bound)) +
+				" " + strconv.FormatUint(found.buckets[index], 10) + "\n")
+		}
+		writer.WriteString(histogram.name + "_bucket" +
+			formatLabels(histogram.labels, found.labelValues, "le", "+Inf") +
+			" " + strconv.FormatUint(found.count, 10) + "\n")
+		labels := formatLabels(histogram.labels, found.labelValues)
+		writer.WriteString(histogram.name + "_sum" + labels + " " + formatValue(found.value) + "\n")
+		writer.WriteString(histogram.name + "_count" + labels + " " + strconv.FormatUint(found.count, 10) + "\n")
+	}
+}
+
+// Values read at scrape time from something that already keeps them, like
+// the statistics of a connection pool.
+type collectedMetric struct {
+	name    string
+	help    string
+	kind    string
+	collect func() float64
+}
+
+func (collected *collectedMetric) write(writer *bufio.Writer) {
+	writeHeader(writer, collected.name, collected.help, collected.kind)
+	writer.WriteString(collected.name + " " + formatValue(collected.collect()) + "\n")
+}
+
+// Metrics are written in the Prometheus text format, in the order they were
+// registered.
+type MetricsRegistry struct {
+	mutex   sync.Mutex
+	metrics []metric
+	names   map[string]metric
+}
+
+// Registering a name twice returns what was registered first, so callers
+// that may run more than once need no guard of their own.
+func (registry *MetricsRegistry) register(name string, create func() metric) metric {
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+	existing, ok := registry.names[name]
+	if ok {
+		return existing
+	}
+	created := create()
+	registry.names[name] = created
+	registry.metrics = append(registry.metrics, created)
+	return created
+}
+
+func (registry *MetricsRegistry) NewCounter(name string, help string, labels ...string) *Counter {
+	return registry.register(name, func() metric {
+		return &Counter{labeledMetric{name: name, help: help, labels: labels, series: map[string]*series{}}}
+	}).(*Counter)
+}
+
+func (registry *MetricsRegistry) NewHistogram(
+	name string, help string, buckets []float64, labels ...string,
+) *Histogram {
+	return registry.register(name, func() metric {
+		return &Histogram{
+			labeledMetric: labeledMetric{name: name, help: help, labels: labels, series: map[string]*series{}},
+			buckets:       buckets,
+		}
+	}).(*Histogram)
+}
+
+func (registry *MetricsRegistry) NewGaugeFunc(name string, help string, collect func() float64) {
+	registry.register(name, func() metric {
+		return &collectedMetric{name: name, help: help, kind: "gauge", collect: collect}
+	})
+}
+
+func (registry *MetricsRegistry) NewCounterFunc(name string, help string, collect func() float64) {
+	registry.register(name, func() metric {
+		return &collectedMetric{name: name, help: help, kind: "counter", collect: collect}
+	})
+}
+
+func (registry *MetricsRegistry) WriteText(output io.Writer) error {
+	registry.mutex.Lock()
+	metrics := append([]metric{}, registry.metrics...)
+	registry.mutex.Unlock()
+	writer := bufio.NewWriter(output)
+	for _, registered := range metrics {
+		registered.write(writer)
+	}
+	return writer.Flush()
+}
+
+func NewMetricsRegistry() *MetricsRegistry {
+	return &MetricsRegistry{names: map[string]metric{}}
+}
+
+// Shared by everything that records metrics and served on /metrics.
+var Metrics = NewMetricsRegistry()
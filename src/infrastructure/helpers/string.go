@@ -1,20 +1,27 @@
 package helpers
 
 import (
-	"math/rand"
+	"crypto/rand"
+	"math/big"
 
 	"github.com/AndreyArthur/oganessone/src/core/shared"
 )
 
 type String struct{}
 
-func (*String) Random(chars string, length int) string {
+// Reads from crypto/rand, what it makes ends up in session keys and api keys.
+func (*String) Random(chars string, length int) (string, error) {
 	letterRunes := []rune(chars)
+	max := big.NewInt(int64(len(letterRunes)))
 	b := make([]rune, length)
 	for i := range b {
-		b[i] = letterRunes[rand.Intn(len(letterRunes))]
+		position, goerr := rand.Int(rand.Reader, max)
+		if goerr != nil {
+			return "", goerr
+		}
+		b[i] = letterRunes[position.Int64()]
 	}
-	return string(b)
+	return string(b), nil
 }
 
 func NewString() (*String, *shared.Error) {
@@ -0,0 +1,47 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+)
+
+const correlationIdHeader = "X-Correlation-Id"
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (recorder *statusRecorder) WriteHeader(status int) {
+	recorder.status = status
+	recorder.ResponseWriter.WriteHeader(status)
+}
+
+// The id is set on the response before any handler runs, so every answer,
+// errors included, carries it.
+func withCorrelationId(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		correlationId := helpers.NewCorrelationId(r.Header.Get(correlationIdHeader))
+		w.Header().Set(correlationIdHeader, correlationId)
+		adapters.SpanFromContext(r.Context()).SetAttribute("correlation_id", correlationId)
+		ctx := helpers.WithCorrelationId(r.Context(), correlationId)
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+		fields := providers.LogFields{
+			"method":      r.Method,
+			"path":        r.URL.Path,
+			"status":      recorder.status,
+			"duration_ms": time.Since(start).Milliseconds(),
+		}
+		if recorder.status >= http.StatusInternalServerError {
+			adapters.Logger().Error(ctx, "http request failed", fields)
+			return
+		}
+		adapters.Logger().Info(ctx, "http request", fields)
+	})
+}
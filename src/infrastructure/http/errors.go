@@ -0,0 +1,183 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// Error codes from RFC 6749 sections 4.1.2.1 and 5.2, RFC 6750 section 3.1,
+// RFC 7009 section 2.2.1 and RFC 8707 section 2.
+var oauthErrorCodes = map[string]string{
+	"OAuthInvalidRequest":          "invalid_request",
+	"OAuthInvalidClient":           "invalid_client",
+	"OAuthInvalidRedirectUri":      "invalid_request",
+	"OAuthUnsupportedResponseType": "unsupported_response_type",
+	"OAuthInvalidCodeChallenge":    "invalid_request",
+	"OAuthInvalidScope":            "invalid_scope",
+	"OAuthAccessDenied":            "access_denied",
+	"OAuthInvalidGrant":            "invalid_grant",
+	"OAuthUnsupportedGrantType":    "unsupported_grant_type",
+	"OAuthInsufficientScope":       "insufficient_scope",
+	"OAuthUnauthorizedClient":      "unauthorized_client",
+	"OAuthInvalidTarget":           "invalid_target",
+	"OAuthUnsupportedTokenType":    "unsupported_token_type",
+}
+
+func oauthErrorCode(err *shared.Error) string {
+	code, ok := oauthErrorCodes[err.Name]
+	if ok {
+		return code
+	}
+	switch err.Type {
+	case "unexpected":
+		return "server_error"
+	case "authentication", "authorization":
+		return "access_denied"
+	default:
+		return "invalid_request"
+	}
+}
+
+// An invalid client or redirect uri must never be redirected to, the user
+// agent would be handing the error, and the state, to an unverified party.
+func canRedirectError(err *shared.Error) bool {
+	return err.Type == "oauth" &&
+		err.Name != "OAuthInvalidClient" &&
+		err.Name != "OAuthInvalidRedirectUri"
+}
+
+func redirectTo(
+	w http.ResponseWriter, r *http.Request, redirectUri string, params url.Values,
+) {
+	target, goerr := url.Parse(redirectUri)
+	if goerr != nil {
+		http.Error(w, "invalid redirect_uri", http.StatusBadRequest)
+		return
+	}
+	query := target.Query()
+	for key, values := range params {
+		for _, value := range values {
+			if value != "" {
+				query.Add(key, value)
+			}
+		}
+	}
+	target.RawQuery = query.Encode()
+	w.Header().Set("Cache-Control", "no-store")
+	http.Redirect(w, r, target.String(), http.StatusFound)
+}
+
+func redirectError(
+	w http.ResponseWriter, r *http.Request, redirectUri string, state string, err *shared.Error,
+) {
+	redirectTo(w, r, redirectUri, url.Values{
+		"error":             {oauthErrorCode(err)},
+		"error_description": {err.Message},
+		"state":             {state},
+	})
+}
+
+type oauthErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+func writeJson(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(body)
+}
+
+func writeOAuthError(w http.ResponseWriter, err *shared.Error) {
+	code := oauthErrorCode(err)
+	status := http.StatusBadRequest
+	switch code {
+	case "invalid_client":
+		status = http.StatusUnauthorized
+		w.Header().Set("WWW-Authenticate", "Basic")
+	case "server_error":
+		status = http.StatusInternalServerError
+	}
+	writeJson(w, status, &oauthErrorResponse{
+		Error:            code,
+		ErrorDescription: err.Message,
+	})
+}
+
+// Errors from resource endpoints follow RFC 6750 section 3, the error is
+// carried by the WWW-Authenticate header as well as the body.
+func writeBearerError(w http.ResponseWriter, err *shared.Error) {
+	code := "invalid_token"
+	status := http.StatusUnauthorized
+	switch {
+	case err.Name == "OAuthInsufficientScope":
+		code = "insufficient_scope"
+		status = http.StatusForbidden
+	case err.Type == "unexpected":
+		writeOAuthError(w, err)
+		return
+	case err.Type != "authentication":
+		code = "invalid_request"
+		status = http.StatusBadRequest
+	}
+	w.Header().Set("WWW-Authenticate", `Bearer error="`+code+`"`)
+	writeJson(w, status, &oauthErrorResponse{
+		Error:            code,
+		ErrorDescription: err.Message,
+	})
+}
+
+type restError struct {
+	Type    string   `json:"type"`
+	Name    string   `json:"name"`
+	Message string   `json:"message"`
+	Details []string `json:"details,omitempty"`
+	// Only set on unexpected errors, to look up what went wrong in the logs.
+	CorrelationId string `json:"correlation_id,omitempty"`
+}
+
+type restErrorResponse struct {
+	Error *restError `json:"error"`
+}
+
+var restErrorStatuses = map[string]int{
+	"validation":     http.StatusBadRequest,
+	"oauth":          http.StatusBadRequest,
+	"authentication": http.StatusUnauthorized,
+	"authorization":  http.StatusForbidden,
+	"not_found":      http.StatusNotFound,
+	"conflict":       http.StatusConflict,
+	"cancelled":      http.StatusRequestTimeout,
+}
+
+func restErrorStatus(err *shared.Error) int {
+	status, ok := restErrorStatuses[err.Type]
+	if !ok {
+		return http.StatusInternalServerError
+	}
+	return status
+}
+
+func writeRestErrorWithStatus(w http.ResponseWriter, status int, err *shared.Error) {
+	body := &restError{
+		Type:    err.Type,
+		Name:    err.Name,
+		Message: err.Message,
+		Details: err.Details,
+	}
+	if status == http.StatusInternalServerError {
+		body.CorrelationId = w.Header().Get(correlationIdHeader)
+	}
+	writeJson(w, status, &restErrorResponse{Error: body})
+}
+
+// Every REST endpoint answers errors with the same body, the status comes
+// from the error type.
+func writeRestError(w http.ResponseWriter, err *shared.Error) {
+	writeRestErrorWithStatus(w, restErrorStatus(err), err)
+}
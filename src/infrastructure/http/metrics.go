@@ -0,0 +1,39 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+)
+
+func metrics(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeRestErrorWithStatus(w, http.StatusMethodNotAllowed, exceptions.NewMethodNotAllowed())
+		return
+	}
+	registry, err := factories.MakeMetrics()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	goerr := registry.WriteText(w)
+	if goerr != nil {
+		adapters.LogError(r.Context(), goerr)
+	}
+}
+
+// Metrics are served by a server of their own, on an address that can be
+// kept off the public network.
+func NewMetricsHttpServer(netHttpServer *http.Server) (*HttpServer, *shared.Error) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", metrics)
+	return &HttpServer{
+		netHttpServer: netHttpServer,
+		handler:       mux,
+	}, nil
+}
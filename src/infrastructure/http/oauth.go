@@ -0,0 +1,305 @@
+package http
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"net/url"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+const csrfCookie = "authorize_csrf"
+
+type loginPage struct {
+	Request   *views.AuthorizationRequestView
+	CsrfToken string
+	Message   string
+}
+
+type tokenResponse struct {
+	AccessToken string   `json:"access_token"`
+	TokenType   string   `json:"token_type"`
+	ExpiresIn   int64    `json:"expires_in"`
+	Scope       string   `json:"scope,omitempty"`
+	IdToken     string   `json:"id_token,omitempty"`
+	Audience    []string `json:"aud,omitempty"`
+}
+
+// Clients authenticate with http basic, with form fields or with a signed
+// assertion (RFC 6749 section 2.3.1, RFC 7523), using two at once is an error.
+func clientCredentials(r *http.Request) (*contracts.ClientCredentialsBody, *shared.Error) {
+	client := &contracts.ClientCredentialsBody{
+		ClientId:            r.PostForm.Get("client_id"),
+		ClientSecret:        r.PostForm.Get("client_secret"),
+		ClientAssertionType: r.PostForm.Get("client_assertion_type"),
+		ClientAssertion:     r.PostForm.Get("client_assertion"),
+	}
+	username, password, ok := r.BasicAuth()
+	if !ok {
+		return client, nil
+	}
+	if client.ClientSecret != "" || client.ClientAssertion != "" {
+		return nil, exceptions.NewOAuthInvalidRequest()
+	}
+	clientId, idErr := url.QueryUnescape(username)
+	clientSecret, secretErr := url.QueryUnescape(password)
+	if idErr != nil || secretErr != nil ||
+		(client.ClientId != "" && client.ClientId != clientId) {
+		return nil, exceptions.NewOAuthInvalidClient()
+	}
+	client.ClientId, client.ClientSecret = clientId, clientSecret
+	return client, nil
+}
+
+func authorizationRequestBody(values url.Values) *contracts.AuthorizationRequestBody {
+	return &contracts.AuthorizationRequestBody{
+		ResponseType:        values.Get("response_type"),
+		ClientId:            values.Get("client_id"),
+		RedirectUri:         values.Get("redirect_uri"),
+		Scope:               values.Get("scope"),
+		State:               values.Get("state"),
+		Nonce:               values.Get("nonce"),
+		CodeChallenge:       values.Get("code_challenge"),
+		CodeChallengeMethod: values.Get("code_challenge_method"),
+	}
+}
+
+// The login form carries the same token as a cookie only this server can
+// set, so a form posted from another site is refused (double submit).
+func setCsrfToken(w http.ResponseWriter, r *http.Request) (string, *shared.Error) {
+	random, err := adapters.NewRandomAdapter()
+	if err != nil {
+		return "", err
+	}
+	csrfToken, err := random.String(32)
+	if err != nil {
+		return "", err
+	}
+	http.SetCookie(w, &http.Cookie{
+		Name:     csrfCookie,
+		Value:    csrfToken,
+		Path:     "/authorize",
+		HttpOnly: true,
+		Secure:   r.TLS != nil,
+		SameSite: http.SameSiteStrictMode,
+	})
+	return csrfToken, nil
+}
+
+func validCsrfToken(r *http.Request) bool {
+	cookie, goerr := r.Cookie(csrfCookie)
+	csrfToken := r.PostForm.Get("csrf_token")
+	return goerr == nil && csrfToken != "" &&
+		subtle.ConstantTimeCompare([]byte(cookie.Value), []byte(csrfToken)) == 1
+}
+
+func renderPage(w http.ResponseWriter, status int, render func(w http.ResponseWriter) error) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.WriteHeader(status)
+	render(w)
+}
+
+func renderAuthorizationError(
+	w http.ResponseWriter, r *http.Request, body *contracts.AuthorizationRequestBody, err *shared.Error,
+) {
+	if canRedirectError(err) {
+		redirectError(w, r, body.RedirectUri, body.State, err)
+		return
+	}
+	status := http.StatusBadRequest
+	if err.Type == "unexpected" {
+		status = http.StatusInternalServerError
+	}
+	renderPage(w, status, func(w http.ResponseWriter) error {
+		return errorTemplate.Execute(w, err.Message)
+	})
+}
+
+func validateAuthorizationRequest(
+	w http.ResponseWriter, r *http.Request, body *contracts.AuthorizationRequestBody,
+) *views.AuthorizationRequestView {
+	validateAuthorizationRequestPresenter, err := factories.MakeValidateAuthorizationRequestPresenter()
+	if err != nil {
+		renderAuthorizationError(w, r, body, err)
+		return nil
+	}
+	response, err := validateAuthorizationRequestPresenter.
+		Handle(r.Context(), &contracts.ValidateAuthorizationRequestPresenterRequest{
+			Body: body,
+		})
+	if err != nil {
+		renderAuthorizationError(w, r, body, err)
+		return nil
+	}
+	return response.Body
+}
+
+func authorize(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		body := authorizationRequestBody(r.URL.Query())
+		request := validateAuthorizationRequest(w, r, body)
+		if request == nil {
+			return
+		}
+		csrfToken, err := setCsrfToken(w, r)
+		if err != nil {
+			renderAuthorizationError(w, r, body, err)
+			return
+		}
+		renderPage(w, http.StatusOK, func(w http.ResponseWriter) error {
+			return loginTemplate.Execute(w, &loginPage{Request: request, CsrfToken: csrfToken})
+		})
+	case http.MethodPost:
+		goerr := r.ParseForm()
+		if goerr != nil {
+			http.Error(w, "malformed form", http.StatusBadRequest)
+			return
+		}
+		if !validCsrfToken(r) {
+			renderPage(w, http.StatusForbidden, func(w http.ResponseWriter) error {
+				return errorTemplate.Execute(w, exceptions.NewInvalidLoginForm().Message)
+			})
+			return
+		}
+		body := authorizationRequestBody(r.PostForm)
+		request := validateAuthorizationRequest(w, r, body)
+		if request == nil {
+			return
+		}
+		authorizePresenter, err := factories.MakeAuthorizePresenter()
+		if err != nil {
+			renderAuthorizationError(w, r, body, err)
+			return
+		}
+		response, err := authorizePresenter.Handle(r.Context(), &contracts.AuthorizePresenterRequest{
+			Headers: &contracts.PresenterRequestHeaders{
+				IpAddress: remoteIpAddress(r),
+				UserAgent: r.UserAgent(),
+			},
+			Body: &contracts.AuthorizePresenterRequestBody{
+				Request:        body,
+				Login:          r.PostForm.Get("login"),
+				Password:       r.PostForm.Get("password"),
+				OrganizationId: r.PostForm.Get("organization_id"),
+				MfaCode:        r.PostForm.Get("mfa_code"),
+			},
+		})
+		if err != nil && err.Type == "authentication" {
+			renderPage(w, http.StatusUnauthorized, func(w http.ResponseWriter) error {
+				return loginTemplate.Execute(w, &loginPage{
+					Request:   request,
+					CsrfToken: r.PostForm.Get("csrf_token"),
+					Message:   err.Message,
+				})
+			})
+			return
+		}
+		if err != nil {
+			renderAuthorizationError(w, r, body, err)
+			return
+		}
+		redirectTo(w, r, response.Body.RedirectUri, url.Values{
+			"code":  {response.Body.Code},
+			"state": {response.Body.State},
+		})
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func exchangeAuthorizationCode(w http.ResponseWriter, r *http.Request) {
+	client, err := clientCredentials(r)
+	if err != nil {
+		writeOAuthError(w, err)
+		return
+	}
+	exchangeAuthorizationCodePresenter, err := factories.MakeExchangeAuthorizationCodePresenter()
+	if err != nil {
+		writeOAuthError(w, err)
+		return
+	}
+	response, err := exchangeAuthorizationCodePresenter.
+		Handle(r.Context(), &contracts.ExchangeAuthorizationCodePresenterRequest{
+			Body: &contracts.ExchangeAuthorizationCodePresenterRequestBody{
+				Code:         r.PostForm.Get("code"),
+				RedirectUri:  r.PostForm.Get("redirect_uri"),
+				Client:       client,
+				CodeVerifier: r.PostForm.Get("code_verifier"),
+			},
+		})
+	if err != nil {
+		writeOAuthError(w, err)
+		return
+	}
+	writeJson(w, http.StatusOK, &tokenResponse{
+		AccessToken: response.Body.AccessToken,
+		TokenType:   response.Body.TokenType,
+		ExpiresIn:   response.Body.ExpiresIn,
+		Scope:       response.Body.Scope,
+		IdToken:     response.Body.IdToken,
+	})
+}
+
+func exchangeClientCredentials(w http.ResponseWriter, r *http.Request) {
+	client, err := clientCredentials(r)
+	if err != nil {
+		writeOAuthError(w, err)
+		return
+	}
+	exchangeClientCredentialsPresenter, err := factories.MakeExchangeClientCredentialsPresenter()
+	if err != nil {
+		writeOAuthError(w, err)
+		return
+	}
+	response, err := exchangeClientCredentialsPresenter.
+		Handle(r.Context(), &contracts.ExchangeClientCredentialsPresenterRequest{
+			Body: &contracts.ExchangeClientCredentialsPresenterRequestBody{
+				Client:    client,
+				Scope:     r.PostForm.Get("scope"),
+				Audiences: r.PostForm["audience"],
+			},
+		})
+	if err != nil {
+		writeOAuthError(w, err)
+		return
+	}
+	writeJson(w, http.StatusOK, &tokenResponse{
+		AccessToken: response.Body.AccessToken,
+		TokenType:   response.Body.TokenType,
+		ExpiresIn:   response.Body.ExpiresIn,
+		Scope:       response.Body.Scope,
+		Audience:    response.Body.Audiences,
+	})
+}
+
+func token(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	goerr := r.ParseForm()
+	if goerr != nil {
+		writeOAuthError(w, exceptions.NewOAuthInvalidRequest())
+		return
+	}
+	switch r.PostForm.Get("grant_type") {
+	case "authorization_code":
+		exchangeAuthorizationCode(w, r)
+	case "client_credentials":
+		exchangeClientCredentials(w, r)
+	case "":
+		writeOAuthError(w, exceptions.NewOAuthInvalidRequest())
+	default:
+		writeOAuthError(w, exceptions.NewOAuthUnsupportedGrantType())
+	}
+}
@@ -0,0 +1,130 @@
+package http
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+type openIdConfigurationResponse struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	IntrospectionEndpoint             string   `json:"introspection_endpoint"`
+	RevocationEndpoint                string   `json:"revocation_endpoint"`
+	JwksUri                           string   `json:"jwks_uri"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IdTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	TokenEndpointAuthSigningAlgValues []string `json:"token_endpoint_auth_signing_alg_values_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+type jwksResponse struct {
+	Keys []map[string]string `json:"keys"`
+}
+
+func bearerToken(r *http.Request) string {
+	authorization := r.Header.Get("Authorization")
+	if len(authorization) > 7 && strings.EqualFold(authorization[:7], "Bearer ") {
+		return strings.TrimSpace(authorization[7:])
+	}
+	return ""
+}
+
+func remoteIpAddress(r *http.Request) string {
+	host, _, goerr := net.SplitHostPort(r.RemoteAddr)
+	if goerr != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// Read through the authenticator rather than a REST route, errors have to
+// come back as bearer token errors.
+func userInfo(authenticator Authenticator) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodPost {
+			w.Header().Set("Allow", "GET, POST")
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+		sessionKey := bearerToken(r)
+		if sessionKey == "" {
+			writeBearerError(w, exceptions.NewSessionNotFound())
+			return
+		}
+		ctx, err := authenticator.Authenticate(r.Context(), "/userinfo", sessionKey, remoteIpAddress(r))
+		if err != nil {
+			writeBearerError(w, err)
+			return
+		}
+		getUserInfoPresenter, err := factories.MakeGetUserInfoPresenter()
+		if err != nil {
+			writeBearerError(w, err)
+			return
+		}
+		response, err := getUserInfoPresenter.Handle(ctx, &contracts.GetUserInfoPresenterRequest{
+			Headers: &contracts.PresenterRequestHeaders{
+				SessionKey: sessionKey,
+				IpAddress:  remoteIpAddress(r),
+				UserAgent:  r.UserAgent(),
+			},
+		})
+		if err != nil {
+			writeBearerError(w, err)
+			return
+		}
+		writeJson(w, http.StatusOK, response.Body)
+	}
+}
+
+func openIdConfiguration(w http.ResponseWriter, r *http.Request) {
+	openId, err := factories.MakeOpenIdOptions()
+	if err != nil {
+		writeOAuthError(w, err)
+		return
+	}
+	issuer := openId.Issuer
+	writeJson(w, http.StatusOK, &openIdConfigurationResponse{
+		Issuer:                           issuer,
+		AuthorizationEndpoint:            issuer + "/authorize",
+		TokenEndpoint:                    issuer + "/token",
+		UserInfoEndpoint:                 issuer + "/userinfo",
+		JwksUri:                          issuer + "/.well-known/jwks.json",
+		ResponseTypesSupported:           []string{"code"},
+		SubjectTypesSupported:            []string{"public"},
+		IdTokenSigningAlgValuesSupported: []string{"RS256"},
+		ScopesSupported:                  []string{"openid", "profile", "email"},
+		TokenEndpointAuthMethodsSupported: []string{
+			"none", "client_secret_basic", "client_secret_post", "private_key_jwt",
+		},
+		TokenEndpointAuthSigningAlgValues: []string{"RS256"},
+		CodeChallengeMethodsSupported:     []string{"S256"},
+		GrantTypesSupported:               []string{"authorization_code", "client_credentials"},
+		ClaimsSupported: []string{
+			"iss", "sub", "aud", "exp", "iat", "nonce",
+			"preferred_username", "updated_at", "email", "email_verified",
+		},
+	})
+}
+
+func jwks(w http.ResponseWriter, r *http.Request) {
+	signer, err := factories.MakeTokenSignerAdapter()
+	if err != nil {
+		writeOAuthError(w, err)
+		return
+	}
+	writeJson(w, http.StatusOK, &jwksResponse{
+		Keys: []map[string]string{signer.PublicJwk()},
+	})
+}
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+func createApiKey(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body := &contracts.CreateApiKeyPresenterRequestBody{}
+	if !decodeJson(w, r, body) {
+		return
+	}
+	createApiKeyPresenter, err := factories.MakeCreateApiKeyPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	response, err := createApiKeyPresenter.Handle(r.Context(), &contracts.CreateApiKeyPresenterRequest{
+		Headers: headersFromRequest(r),
+		Body:    body,
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	writeJson(w, http.StatusCreated, response.Body)
+}
+
+func listApiKeys(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	listApiKeysPresenter, err := factories.MakeListApiKeysPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	response, err := listApiKeysPresenter.Handle(r.Context(), &contracts.ListApiKeysPresenterRequest{
+		Headers: headersFromRequest(r),
+		Body: &contracts.ListApiKeysPresenterRequestBody{
+			OrganizationId: r.URL.Query().Get("organizationId"),
+		},
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	writeJson(w, http.StatusOK, response.Body)
+}
+
+func revokeApiKey(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	revokeApiKeyPresenter, err := factories.MakeRevokeApiKeyPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	response, err := revokeApiKeyPresenter.Handle(r.Context(), &contracts.RevokeApiKeyPresenterRequest{
+		Headers: headersFromRequest(r),
+		Body: &contracts.RevokeApiKeyPresenterRequestBody{
+			ApiKeyId: params["apiKeyId"],
+		},
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	writeJson(w, http.StatusOK, response.Body)
+}
@@ -0,0 +1,46 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+func queryAuditLog(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	query := r.URL.Query()
+	pageSize := 0
+	if query.Get("pageSize") != "" {
+		parsed, goerr := strconv.Atoi(query.Get("pageSize"))
+		if goerr != nil {
+			writeRestError(w, exceptions.NewInvalidAuditLogQuery())
+			return
+		}
+		pageSize = parsed
+	}
+	queryAuditLogPresenter, err := factories.MakeQueryAuditLogPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	response, err := queryAuditLogPresenter.Handle(r.Context(), &contracts.QueryAuditLogPresenterRequest{
+		Headers: headersFromRequest(r),
+		Body: &contracts.QueryAuditLogPresenterRequestBody{
+			Type:           query.Get("type"),
+			ActorId:        query.Get("actorId"),
+			TargetId:       query.Get("targetId"),
+			OrganizationId: query.Get("organizationId"),
+			Since:          query.Get("since"),
+			Until:          query.Get("until"),
+			PageSize:       pageSize,
+			PageToken:      query.Get("pageToken"),
+		},
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	writeJson(w, http.StatusOK, response.Body)
+}
@@ -0,0 +1,103 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+func getMfaStatus(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	getMfaStatusPresenter, err := factories.MakeGetMfaStatusPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	response, err := getMfaStatusPresenter.Handle(r.Context(), &contracts.GetMfaStatusPresenterRequest{
+		Headers: headersFromRequest(r),
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	writeJson(w, http.StatusOK, response.Body)
+}
+
+func enrollMfa(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	enrollMfaPresenter, err := factories.MakeEnrollMfaPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	response, err := enrollMfaPresenter.Handle(r.Context(), &contracts.EnrollMfaPresenterRequest{
+		Headers: headersFromRequest(r),
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	writeJson(w, http.StatusCreated, response.Body)
+}
+
+func confirmMfa(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body := &contracts.ConfirmMfaPresenterRequestBody{}
+	if !decodeJson(w, r, body) {
+		return
+	}
+	confirmMfaPresenter, err := factories.MakeConfirmMfaPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	_, err = confirmMfaPresenter.Handle(r.Context(), &contracts.ConfirmMfaPresenterRequest{
+		Headers: headersFromRequest(r),
+		Body:    body,
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func verifyMfa(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body := &contracts.VerifyMfaPresenterRequestBody{}
+	if !decodeJson(w, r, body) {
+		return
+	}
+	verifyMfaPresenter, err := factories.MakeVerifyMfaPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	response, err := verifyMfaPresenter.Handle(r.Context(), &contracts.VerifyMfaPresenterRequest{
+		Headers: headersFromRequest(r),
+		Body:    body,
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	writeJson(w, http.StatusCreated, response.Body)
+}
+
+func regenerateRecoveryCodes(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body := &contracts.RegenerateRecoveryCodesPresenterRequestBody{}
+	if !decodeJson(w, r, body) {
+		return
+	}
+	regenerateRecoveryCodesPresenter, err := factories.MakeRegenerateRecoveryCodesPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	response, err := regenerateRecoveryCodesPresenter.Handle(r.Context(), &contracts.RegenerateRecoveryCodesPresenterRequest{
+		Headers: headersFromRequest(r),
+		Body:    body,
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	writeJson(w, http.StatusCreated, response.Body)
+}
@@ -0,0 +1,29 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+func registerOAuthClient(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body := &contracts.RegisterOAuthClientPresenterRequestBody{}
+	if !decodeJson(w, r, body) {
+		return
+	}
+	registerOAuthClientPresenter, err := factories.MakeRegisterOAuthClientPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	response, err := registerOAuthClientPresenter.Handle(r.Context(), &contracts.RegisterOAuthClientPresenterRequest{
+		Headers: headersFromRequest(r),
+		Body:    body,
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	writeJson(w, http.StatusCreated, response.Body)
+}
@@ -0,0 +1,71 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+func createOrganization(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body := &contracts.CreateOrganizationPresenterRequestBody{}
+	if !decodeJson(w, r, body) {
+		return
+	}
+	createOrganizationPresenter, err := factories.MakeCreateOrganizationPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	response, err := createOrganizationPresenter.Handle(r.Context(), &contracts.CreateOrganizationPresenterRequest{
+		Headers: headersFromRequest(r),
+		Body:    body,
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	writeJson(w, http.StatusCreated, response.Body)
+}
+
+func inviteMember(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body := &contracts.InviteMemberPresenterRequestBody{}
+	if !decodeJson(w, r, body) {
+		return
+	}
+	body.OrganizationId = params["organizationId"]
+	inviteMemberPresenter, err := factories.MakeInviteMemberPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	response, err := inviteMemberPresenter.Handle(r.Context(), &contracts.InviteMemberPresenterRequest{
+		Headers: headersFromRequest(r),
+		Body:    body,
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	writeJson(w, http.StatusCreated, response.Body)
+}
+
+func removeMember(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	removeMemberPresenter, err := factories.MakeRemoveMemberPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	_, err = removeMemberPresenter.Handle(r.Context(), &contracts.RemoveMemberPresenterRequest{
+		Headers: headersFromRequest(r),
+		Body: &contracts.RemoveMemberPresenterRequestBody{
+			OrganizationId: params["organizationId"],
+			UserId:         params["userId"],
+		},
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,50 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+func createSession(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body := &contracts.CreateSessionPresenterRequestBody{}
+	if !decodeJson(w, r, body) {
+		return
+	}
+	createSessionPresenter, err := factories.MakeCreateSessionPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	response, err := createSessionPresenter.Handle(r.Context(), &contracts.CreateSessionPresenterRequest{
+		Headers: headersFromRequest(r),
+		Body:    body,
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	writeJson(w, http.StatusCreated, response.Body)
+}
+
+func switchOrganization(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body := &contracts.SwitchOrganizationPresenterRequestBody{}
+	if !decodeJson(w, r, body) {
+		return
+	}
+	switchOrganizationPresenter, err := factories.MakeSwitchOrganizationPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	response, err := switchOrganizationPresenter.Handle(r.Context(), &contracts.SwitchOrganizationPresenterRequest{
+		Headers: headersFromRequest(r),
+		Body:    body,
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	writeJson(w, http.StatusOK, response.Body)
+}
@@ -0,0 +1,64 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+func createUser(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body := &contracts.CreateUserPresenterRequestBody{}
+	if !decodeJson(w, r, body) {
+		return
+	}
+	createUserPresenter, err := factories.MakeCreateUserPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	response, err := createUserPresenter.Handle(r.Context(), &contracts.CreateUserPresenterRequest{
+		Headers: headersFromRequest(r),
+		Body:    body,
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	writeJson(w, http.StatusCreated, response.Body)
+}
+
+func getPasswordPolicy(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	getPasswordPolicyPresenter, err := factories.MakeGetPasswordPolicyPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	response, err := getPasswordPolicyPresenter.Handle(r.Context(), &contracts.GetPasswordPolicyPresenterRequest{})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	writeJson(w, http.StatusOK, response.Body)
+}
+
+func changePassword(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body := &contracts.ChangePasswordPresenterRequestBody{}
+	if !decodeJson(w, r, body) {
+		return
+	}
+	changePasswordPresenter, err := factories.MakeChangePasswordPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	_, err = changePasswordPresenter.Handle(r.Context(), &contracts.ChangePasswordPresenterRequest{
+		Headers: headersFromRequest(r),
+		Body:    body,
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
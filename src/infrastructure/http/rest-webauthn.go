@@ -0,0 +1,86 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+func beginWebAuthnRegistration(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	beginWebAuthnRegistrationPresenter, err := factories.MakeBeginWebAuthnRegistrationPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	response, err := beginWebAuthnRegistrationPresenter.Handle(r.Context(), &contracts.BeginWebAuthnRegistrationPresenterRequest{
+		Headers: headersFromRequest(r),
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	writeJson(w, http.StatusOK, response.Body)
+}
+
+func finishWebAuthnRegistration(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body := &contracts.FinishWebAuthnRegistrationPresenterRequestBody{}
+	if !decodeJson(w, r, body) {
+		return
+	}
+	finishWebAuthnRegistrationPresenter, err := factories.MakeFinishWebAuthnRegistrationPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	response, err := finishWebAuthnRegistrationPresenter.Handle(r.Context(), &contracts.FinishWebAuthnRegistrationPresenterRequest{
+		Headers: headersFromRequest(r),
+		Body:    body,
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	writeJson(w, http.StatusCreated, response.Body)
+}
+
+func beginWebAuthnLogin(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body := &contracts.BeginWebAuthnLoginPresenterRequestBody{}
+	if !decodeJson(w, r, body) {
+		return
+	}
+	beginWebAuthnLoginPresenter, err := factories.MakeBeginWebAuthnLoginPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	response, err := beginWebAuthnLoginPresenter.Handle(r.Context(), &contracts.BeginWebAuthnLoginPresenterRequest{
+		Body: body,
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	writeJson(w, http.StatusOK, response.Body)
+}
+
+func finishWebAuthnLogin(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body := &contracts.FinishWebAuthnLoginPresenterRequestBody{}
+	if !decodeJson(w, r, body) {
+		return
+	}
+	finishWebAuthnLoginPresenter, err := factories.MakeFinishWebAuthnLoginPresenter()
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	response, err := finishWebAuthnLoginPresenter.Handle(r.Context(), &contracts.FinishWebAuthnLoginPresenterRequest{
+		Headers: headersFromRequest(r),
+		Body:    body,
+	})
+	if err != nil {
+		writeRestError(w, err)
+		return
+	}
+	writeJson(w, http.StatusCreated, response.Body)
+}
@@ -0,0 +1,158 @@
+package http
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+const restMaxBodySize = 1 << 20
+
+type restHandler func(w http.ResponseWriter, r *http.Request, params map[string]string)
+
+type restRoute struct {
+	method    string
+	segments  []string
+	operation string
+	handle    restHandler
+}
+
+// Segments written as {name} match anything and are handed to the handler
+// under that name, net/http has no path parameters of its own. Each route
+// names the gRPC method it mirrors, whose policy the caller is checked
+// against before the handler runs.
+type restRouter struct {
+	authenticator Authenticator
+	routes        []*restRoute
+}
+
+func (router *restRouter) add(method string, pattern string, operation string, handle restHandler) {
+	router.routes = append(router.routes, &restRoute{
+		method:    method,
+		segments:  strings.Split(strings.Trim(pattern, "/"), "/"),
+		operation: operation,
+		handle:    handle,
+	})
+}
+
+func (route *restRoute) match(segments []string) (map[string]string, bool) {
+	if len(segments) != len(route.segments) {
+		return nil, false
+	}
+	params := map[string]string{}
+	for index, segment := range route.segments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if segments[index] == "" {
+				return nil, false
+			}
+			params[segment[1:len(segment)-1]] = segments[index]
+			continue
+		}
+		if segment != segments[index] {
+			return nil, false
+		}
+	}
+	return params, true
+}
+
+func (router *restRouter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	allowed := []string{}
+	for _, route := range router.routes {
+		params, ok := route.match(segments)
+		if !ok {
+			continue
+		}
+		if route.method == r.Method {
+			ctx, err := router.authenticator.
+				Authenticate(r.Context(), route.operation, bearerToken(r), remoteIpAddress(r))
+			if err != nil {
+				writeRestError(w, err)
+				return
+			}
+			route.handle(w, r.WithContext(ctx), params)
+			return
+		}
+		allowed = append(allowed, route.method)
+	}
+	if len(allowed) == 0 {
+		writeRestErrorWithStatus(w, http.StatusNotFound, exceptions.NewRouteNotFound())
+		return
+	}
+	w.Header().Set("Allow", strings.Join(allowed, ", "))
+	writeRestErrorWithStatus(w, http.StatusMethodNotAllowed, exceptions.NewMethodNotAllowed())
+}
+
+func headersFromRequest(r *http.Request) *contracts.PresenterRequestHeaders {
+	return &contracts.PresenterRequestHeaders{
+		SessionKey: bearerToken(r),
+		IpAddress:  remoteIpAddress(r),
+		UserAgent:  r.UserAgent(),
+	}
+}
+
+// An empty body decodes to the zero value, so endpoints whose fields are all
+// optional can be called without one.
+func decodeJson(w http.ResponseWriter, r *http.Request, body interface{}) bool {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, restMaxBodySize))
+	goerr := decoder.Decode(body)
+	if goerr != nil && !errors.Is(goerr, io.EOF) {
+		writeRestError(w, exceptions.NewInvalidRequestBody())
+		return false
+	}
+	return true
+}
+
+func newRestRouter(authenticator Authenticator) *restRouter {
+	router := &restRouter{authenticator: authenticator}
+	router.add(http.MethodPost, "/users",
+		"/protobuf.UsersService/CreateUser", createUser)
+	router.add(http.MethodGet, "/users/password-policy",
+		"/protobuf.UsersService/GetPasswordPolicy", getPasswordPolicy)
+	router.add(http.MethodPut, "/users/me/password",
+		"/protobuf.UsersService/ChangePassword", changePassword)
+	router.add(http.MethodPost, "/sessions",
+		"/protobuf.SessionsService/CreateSession", createSession)
+	router.add(http.MethodPut, "/sessions/organization",
+		"/protobuf.SessionsService/SwitchOrganization", switchOrganization)
+	router.add(http.MethodPost, "/organizations",
+		"/protobuf.OrganizationsService/CreateOrganization", createOrganization)
+	router.add(http.MethodPost, "/organizations/{organizationId}/members",
+		"/protobuf.OrganizationsService/InviteMember", inviteMember)
+	router.add(http.MethodDelete, "/organizations/{organizationId}/members/{userId}",
+		"/protobuf.OrganizationsService/RemoveMember", removeMember)
+	router.add(http.MethodPost, "/api-keys",
+		"/protobuf.ApiKeysService/CreateApiKey", createApiKey)
+	router.add(http.MethodGet, "/api-keys",
+		"/protobuf.ApiKeysService/ListApiKeys", listApiKeys)
+	router.add(http.MethodDelete, "/api-keys/{apiKeyId}",
+		"/protobuf.ApiKeysService/RevokeApiKey", revokeApiKey)
+	router.add(http.MethodPost, "/oauth-clients",
+		"/protobuf.OAuthClientsService/RegisterOAuthClient", registerOAuthClient)
+	router.add(http.MethodGet, "/mfa",
+		"/protobuf.MfaService/GetMfaStatus", getMfaStatus)
+	router.add(http.MethodPost, "/mfa/enrollment",
+		"/protobuf.MfaService/EnrollMfa", enrollMfa)
+	router.add(http.MethodPost, "/mfa/confirmation",
+		"/protobuf.MfaService/ConfirmMfa", confirmMfa)
+	router.add(http.MethodPost, "/mfa/verification",
+		"/protobuf.MfaService/VerifyMfa", verifyMfa)
+	router.add(http.MethodPost, "/mfa/recovery-codes",
+		"/protobuf.MfaService/RegenerateRecoveryCodes", regenerateRecoveryCodes)
+	router.add(http.MethodPost, "/webauthn/registration/begin",
+		"/protobuf.WebAuthnService/BeginWebAuthnRegistration", beginWebAuthnRegistration)
+	router.add(http.MethodPost, "/webauthn/registration/finish",
+		"/protobuf.WebAuthnService/FinishWebAuthnRegistration", finishWebAuthnRegistration)
+	router.add(http.MethodPost, "/webauthn/login/begin",
+		"/protobuf.WebAuthnService/BeginWebAuthnLogin", beginWebAuthnLogin)
+	router.add(http.MethodPost, "/webauthn/login/finish",
+		"/protobuf.WebAuthnService/FinishWebAuthnLogin", finishWebAuthnLogin)
+	router.add(http.MethodGet, "/audit-events",
+		"/protobuf.AuditService/QueryAuditLog", queryAuditLog)
+	return router
+}
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"context"
+	"log"
+	"net"
+	"net/http"
+
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+)
+
+// Checks the credential of a request against the policy of the operation it
+// is for, main hands in the gRPC auth interceptor so both transports share
+// the same policies.
+type Authenticator interface {
+	Authenticate(
+		ctx context.Context, operation string, sessionKey string, ipAddress string,
+	) (context.Context, *shared.Error)
+}
+
+type HttpServer struct {
+	netHttpServer *http.Server
+	handler       http.Handler
+}
+
+func newApiHandler(authenticator Authenticator) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/authorize", authorize)
+	mux.HandleFunc("/token", token)
+	mux.HandleFunc("/introspect", introspect)
+	mux.HandleFunc("/revoke", revoke)
+	mux.HandleFunc("/userinfo", userInfo(authenticator))
+	mux.HandleFunc("/.well-known/openid-configuration", openIdConfiguration)
+	mux.HandleFunc("/.well-known/jwks.json", jwks)
+	mux.Handle("/", newRestRouter(authenticator))
+	return withTracing(withCorrelationId(mux))
+}
+
+func (hs *HttpServer) Start(lis net.Listener) {
+	hs.netHttpServer.Handler = hs.handler
+	err := hs.netHttpServer.Serve(lis)
+	if err != nil && err != http.ErrServerClosed {
+		log.Fatal(err)
+		return
+	}
+}
+
+func (hs *HttpServer) Stop() {
+	hs.netHttpServer.Close()
+}
+
+// Requests still running when ctx is done get their connections closed.
+func (hs *HttpServer) Shutdown(ctx context.Context) {
+	goerr := hs.netHttpServer.Shutdown(ctx)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		hs.Stop()
+	}
+}
+
+func NewHttpServer(netHttpServer *http.Server, authenticator Authenticator) (*HttpServer, *shared.Error) {
+	return &HttpServer{
+		netHttpServer: netHttpServer,
+		handler:       newApiHandler(authenticator),
+	}, nil
+}
@@ -0,0 +1,46 @@
+package http
+
+import "html/template"
+
+var loginTemplate = template.Must(template.New("login").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Sign in to {{.Request.ClientName}}</title>
+</head>
+<body>
+<h1>Sign in to {{.Request.ClientName}}</h1>
+{{if .Request.Scope}}<p>Requested access: {{.Request.Scope}}</p>{{end}}
+{{if .Message}}<p role="alert">{{.Message}}</p>{{end}}
+<form method="post" action="/authorize">
+<input type="hidden" name="csrf_token" value="{{.CsrfToken}}">
+<input type="hidden" name="response_type" value="{{.Request.ResponseType}}">
+<input type="hidden" name="client_id" value="{{.Request.ClientId}}">
+<input type="hidden" name="redirect_uri" value="{{.Request.RedirectUri}}">
+<input type="hidden" name="scope" value="{{.Request.Scope}}">
+<input type="hidden" name="state" value="{{.Request.State}}">
+<input type="hidden" name="nonce" value="{{.Request.Nonce}}">
+<input type="hidden" name="code_challenge" value="{{.Request.CodeChallenge}}">
+<input type="hidden" name="code_challenge_method" value="{{.Request.CodeChallengeMethod}}">
+<label>Username or email <input name="login" autocomplete="username" required></label>
+<label>Password <input type="password" name="password" autocomplete="current-password" required></label>
+<label>Organization <input name="organization_id"></label>
+<label>One-time or recovery code <input name="mfa_code" autocomplete="one-time-code"></label>
+<button type="submit">Sign in</button>
+</form>
+</body>
+</html>
+`))
+
+var errorTemplate = template.Must(template.New("error").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Authorization error</title>
+</head>
+<body>
+<h1>Authorization error</h1>
+<p>{{.}}</p>
+</body>
+</html>
+`))
@@ -0,0 +1,102 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+// Fields from RFC 7662 section 2.2, an inactive token only carries "active".
+type introspectionResponse struct {
+	Active         bool     `json:"active"`
+	Subject        string   `json:"sub,omitempty"`
+	ClientId       string   `json:"client_id,omitempty"`
+	OrganizationId string   `json:"organization_id,omitempty"`
+	Scope          string   `json:"scope,omitempty"`
+	Audience       []string `json:"aud,omitempty"`
+	ExpiresAt      int64    `json:"exp,omitempty"`
+	TokenType      string   `json:"token_type,omitempty"`
+}
+
+func parseTokenForm(w http.ResponseWriter, r *http.Request) *contracts.ClientCredentialsBody {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return nil
+	}
+	goerr := r.ParseForm()
+	if goerr != nil {
+		writeOAuthError(w, exceptions.NewOAuthInvalidRequest())
+		return nil
+	}
+	client, err := clientCredentials(r)
+	if err != nil {
+		writeOAuthError(w, err)
+		return nil
+	}
+	return client
+}
+
+func introspect(w http.ResponseWriter, r *http.Request) {
+	client := parseTokenForm(w, r)
+	if client == nil {
+		return
+	}
+	introspectTokenPresenter, err := factories.MakeIntrospectTokenPresenter()
+	if err != nil {
+		writeOAuthError(w, err)
+		return
+	}
+	response, err := introspectTokenPresenter.Handle(r.Context(), &contracts.IntrospectTokenPresenterRequest{
+		Body: &contracts.IntrospectTokenPresenterRequestBody{
+			Client:        client,
+			Token:         r.PostForm.Get("token"),
+			TokenTypeHint: r.PostForm.Get("token_type_hint"),
+		},
+	})
+	if err != nil {
+		writeOAuthError(w, err)
+		return
+	}
+	writeJson(w, http.StatusOK, &introspectionResponse{
+		Active:         response.Body.Active,
+		Subject:        response.Body.Subject,
+		ClientId:       response.Body.ClientId,
+		OrganizationId: response.Body.OrganizationId,
+		Scope:          response.Body.Scope,
+		Audience:       response.Body.Audiences,
+		ExpiresAt:      response.Body.ExpiresAt,
+		TokenType:      response.Body.TokenType,
+	})
+}
+
+func revoke(w http.ResponseWriter, r *http.Request) {
+	client := parseTokenForm(w, r)
+	if client == nil {
+		return
+	}
+	revokeTokenPresenter, err := factories.MakeRevokeTokenPresenter()
+	if err != nil {
+		writeOAuthError(w, err)
+		return
+	}
+	_, err = revokeTokenPresenter.Handle(r.Context(), &contracts.RevokeTokenPresenterRequest{
+		Headers: &contracts.PresenterRequestHeaders{
+			IpAddress: remoteIpAddress(r),
+			UserAgent: r.UserAgent(),
+		},
+		Body: &contracts.RevokeTokenPresenterRequestBody{
+			Client:        client,
+			Token:         r.PostForm.Get("token"),
+			TokenTypeHint: r.PostForm.Get("token_type_hint"),
+		},
+	})
+	if err != nil {
+		writeOAuthError(w, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,25 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+)
+
+// Span names leave the path out, REST paths carry ids and would make every
+// request a name of its own.
+func withTracing(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		parent := adapters.ParseTraceParent(r.Header.Get("Traceparent"), r.Header.Get("Tracestate"))
+		ctx, span := adapters.Tracer().StartSpan(r.Context(), "HTTP "+r.Method, adapters.SpanKindServer, parent)
+		span.SetAttribute("http.method", r.Method)
+		span.SetAttribute("http.target", r.URL.Path)
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+		span.SetAttribute("http.status_code", recorder.status)
+		if recorder.status >= http.StatusInternalServerError {
+			span.Fail(http.StatusText(recorder.status))
+		}
+		span.End()
+	})
+}
@@ -0,0 +1,83 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/lib/pq"
+)
+
+type ApiKeyModel struct{}
+
+type apiKeyScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func (apiKeyModel *ApiKeyModel) scan(rows apiKeyScanner) *entities.ApiKeyEntity {
+	var id string
+	var userId sql.NullString
+	var organizationId sql.NullString
+	var name string
+	var prefix string
+	var hash string
+	var scopes []string
+	var allowedIps []string
+	var expiresAt sql.NullTime
+	var revokedAt sql.NullTime
+	var createdAt time.Time
+	var updatedAt time.Time
+	rows.Scan(
+		&id,
+		&userId,
+		&organizationId,
+		&name,
+		&prefix,
+		&hash,
+		pq.Array(&scopes),
+		pq.Array(&allowedIps),
+		&expiresAt,
+		&revokedAt,
+		&createdAt,
+		&updatedAt,
+	)
+	apiKey, err := entities.NewApiKeyEntity(&dtos.ApiKeyDTO{
+		Id:             id,
+		UserId:         userId.String,
+		OrganizationId: organizationId.String,
+		Name:           name,
+		Prefix:         prefix,
+		Hash:           hash,
+		Scopes:         scopes,
+		AllowedIps:     allowedIps,
+		ExpiresAt:      expiresAt.Time,
+		RevokedAt:      revokedAt.Time,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	})
+	if err != nil {
+		return nil
+	}
+	return apiKey
+}
+
+func (apiKeyModel *ApiKeyModel) Scan(rows *sql.Row) *entities.ApiKeyEntity {
+	return apiKeyModel.scan(rows)
+}
+
+func (apiKeyModel *ApiKeyModel) ScanAll(rows *sql.Rows) []*entities.ApiKeyEntity {
+	apiKeys := []*entities.ApiKeyEntity{}
+	for rows.Next() {
+		apiKey := apiKeyModel.scan(rows)
+		if apiKey != nil {
+			apiKeys = append(apiKeys, apiKey)
+		}
+	}
+	return apiKeys
+}
+
+func NewApiKeyModel() (*ApiKeyModel, *shared.Error) {
+	return &ApiKeyModel{}, nil
+}
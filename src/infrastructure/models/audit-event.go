@@ -0,0 +1,59 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type AuditEventModel struct{}
+
+func (auditEventModel *AuditEventModel) ScanAll(
+	rows *sql.Rows,
+) []*entities.AuditEventEntity {
+	auditEvents := []*entities.AuditEventEntity{}
+	for rows.Next() {
+		var id string
+		var eventType string
+		var actorId string
+		var targetId string
+		var organizationId sql.NullString
+		var ipAddress string
+		var userAgent string
+		var detail string
+		var createdAt time.Time
+		rows.Scan(
+			&id,
+			&eventType,
+			&actorId,
+			&targetId,
+			&organizationId,
+			&ipAddress,
+			&userAgent,
+			&detail,
+			&createdAt,
+		)
+		auditEvent, err := entities.NewAuditEventEntity(&dtos.AuditEventDTO{
+			Id:             id,
+			Type:           eventType,
+			ActorId:        actorId,
+			TargetId:       targetId,
+			OrganizationId: organizationId.String,
+			IpAddress:      ipAddress,
+			UserAgent:      userAgent,
+			Detail:         detail,
+			CreatedAt:      createdAt,
+		})
+		if err == nil {
+			auditEvents = append(auditEvents, auditEvent)
+		}
+	}
+	return auditEvents
+}
+
+func NewAuditEventModel() (*AuditEventModel, *shared.Error) {
+	return &AuditEventModel{}, nil
+}
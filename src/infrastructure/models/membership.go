@@ -0,0 +1,64 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type MembershipModel struct{}
+
+type membershipScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func (membershipModel *MembershipModel) scan(rows membershipScanner) *entities.MembershipEntity {
+	var id string
+	var organizationId string
+	var userId string
+	var role string
+	var createdAt time.Time
+	var updatedAt time.Time
+	rows.Scan(
+		&id,
+		&organizationId,
+		&userId,
+		&role,
+		&createdAt,
+		&updatedAt,
+	)
+	membership, err := entities.NewMembershipEntity(&dtos.MembershipDTO{
+		Id:             id,
+		OrganizationId: organizationId,
+		UserId:         userId,
+		Role:           role,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	})
+	if err != nil {
+		return nil
+	}
+	return membership
+}
+
+func (membershipModel *MembershipModel) Scan(rows *sql.Row) *entities.MembershipEntity {
+	return membershipModel.scan(rows)
+}
+
+func (membershipModel *MembershipModel) ScanAll(rows *sql.Rows) []*entities.MembershipEntity {
+	memberships := []*entities.MembershipEntity{}
+	for rows.Next() {
+		membership := membershipModel.scan(rows)
+		if membership != nil {
+			memberships = append(memberships, membership)
+		}
+	}
+	return memberships
+}
+
+func NewMembershipModel() (*MembershipModel, *shared.Error) {
+	return &MembershipModel{}, nil
+}
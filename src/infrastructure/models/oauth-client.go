@@ -0,0 +1,61 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/lib/pq"
+)
+
+type OAuthClientModel struct{}
+
+func (oauthClientModel *OAuthClientModel) Scan(rows *sql.Row) *entities.OAuthClientEntity {
+	var id string
+	var organizationId string
+	var name string
+	var redirectUris []string
+	var authMethod string
+	var secretHash string
+	var publicKey string
+	var scopes []string
+	var audiences []string
+	var createdAt time.Time
+	var updatedAt time.Time
+	rows.Scan(
+		&id,
+		&organizationId,
+		&name,
+		pq.Array(&redirectUris),
+		&authMethod,
+		&secretHash,
+		&publicKey,
+		pq.Array(&scopes),
+		pq.Array(&audiences),
+		&createdAt,
+		&updatedAt,
+	)
+	client, err := entities.NewOAuthClientEntity(&dtos.OAuthClientDTO{
+		Id:             id,
+		OrganizationId: organizationId,
+		Name:           name,
+		RedirectUris:   redirectUris,
+		AuthMethod:     authMethod,
+		SecretHash:     secretHash,
+		PublicKey:      publicKey,
+		Scopes:         scopes,
+		Audiences:      audiences,
+		CreatedAt:      createdAt,
+		UpdatedAt:      updatedAt,
+	})
+	if err != nil {
+		return nil
+	}
+	return client
+}
+
+func NewOAuthClientModel() (*OAuthClientModel, *shared.Error) {
+	return &OAuthClientModel{}, nil
+}
@@ -0,0 +1,39 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type OrganizationModel struct{}
+
+func (organizationModel *OrganizationModel) Scan(rows *sql.Row) *entities.OrganizationEntity {
+	var id string
+	var name string
+	var createdAt time.Time
+	var updatedAt time.Time
+	rows.Scan(
+		&id,
+		&name,
+		&createdAt,
+		&updatedAt,
+	)
+	organization, err := entities.NewOrganizationEntity(&dtos.OrganizationDTO{
+		Id:        id,
+		Name:      name,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	})
+	if err != nil {
+		return nil
+	}
+	return organization
+}
+
+func NewOrganizationModel() (*OrganizationModel, *shared.Error) {
+	return &OrganizationModel{}, nil
+}
@@ -0,0 +1,44 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+type PasswordHistoryModel struct{}
+
+func (passwordHistoryModel *PasswordHistoryModel) ScanAll(
+	rows *sql.Rows,
+) []*entities.PasswordHistoryEntity {
+	entries := []*entities.PasswordHistoryEntity{}
+	for rows.Next() {
+		var id string
+		var userId string
+		var password string
+		var createdAt time.Time
+		rows.Scan(
+			&id,
+			&userId,
+			&password,
+			&createdAt,
+		)
+		entry, err := entities.NewPasswordHistoryEntity(&dtos.PasswordHistoryDTO{
+			Id:        id,
+			UserId:    userId,
+			Password:  password,
+			CreatedAt: createdAt,
+		})
+		if err == nil {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+func NewPasswordHistoryModel() (*PasswordHistoryModel, *shared.Error) {
+	return &PasswordHistoryModel{}, nil
+}
@@ -7,32 +7,48 @@ import (
 	"github.com/AndreyArthur/oganessone/src/core/dtos"
 	"github.com/AndreyArthur/oganessone/src/core/entities"
 	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/lib/pq"
 )
 
 type UserModel struct{}
 
 func (userModel *UserModel) Scan(rows *sql.Row) *entities.UserEntity {
 	var id string
+	var organizationId sql.NullString
 	var username string
 	var email string
 	var password string
+	var emailVerified bool
+	var mfaSecret sql.NullString
+	var mfaEnabled bool
+	var mfaRecoveryCodes []string
 	var createdAt time.Time
 	var updatedAt time.Time
 	rows.Scan(
 		&id,
+		&organizationId,
 		&username,
 		&email,
 		&password,
+		&emailVerified,
+		&mfaSecret,
+		&mfaEnabled,
+		pq.Array(&mfaRecoveryCodes),
 		&createdAt,
 		&updatedAt,
 	)
 	user, err := entities.NewUserEntity(&dtos.UserDTO{
-		Id:        id,
-		Username:  username,
-		Email:     email,
-		Password:  password,
-		CreatedAt: createdAt,
-		UpdatedAt: updatedAt,
+		Id:               id,
+		OrganizationId:   organizationId.String,
+		Username:         username,
+		Email:            email,
+		Password:         password,
+		EmailVerified:    emailVerified,
+		MfaSecret:        mfaSecret.String,
+		MfaEnabled:       mfaEnabled,
+		MfaRecoveryCodes: mfaRecoveryCodes,
+		CreatedAt:        createdAt,
+		UpdatedAt:        updatedAt,
 	})
 	if err != nil {
 		return nil
@@ -0,0 +1,77 @@
+package models
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/lib/pq"
+)
+
+type WebAuthnCredentialModel struct{}
+
+type webAuthnCredentialScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func (webAuthnCredentialModel *WebAuthnCredentialModel) scan(
+	rows webAuthnCredentialScanner,
+) *entities.WebAuthnCredentialEntity {
+	var id string
+	var userId string
+	var credentialId string
+	var publicKey string
+	var signCount int64
+	var transports []string
+	var createdAt time.Time
+	var updatedAt time.Time
+	rows.Scan(
+		&id,
+		&userId,
+		&credentialId,
+		&publicKey,
+		&signCount,
+		pq.Array(&transports),
+		&createdAt,
+		&updatedAt,
+	)
+	webAuthnCredential, err := entities.NewWebAuthnCredentialEntity(&dtos.WebAuthnCredentialDTO{
+		Id:           id,
+		UserId:       userId,
+		CredentialId: credentialId,
+		PublicKey:    publicKey,
+		SignCount:    signCount,
+		Transports:   transports,
+		CreatedAt:    createdAt,
+		UpdatedAt:    updatedAt,
+	})
+	if err != nil {
+		return nil
+	}
+	return webAuthnCredential
+}
+
+func (webAuthnCredentialModel *WebAuthnCredentialModel) Scan(
+	rows *sql.Row,
+) *entities.WebAuthnCredentialEntity {
+	return webAuthnCredentialModel.scan(rows)
+}
+
+func (webAuthnCredentialModel *WebAuthnCredentialModel) ScanAll(
+	rows *sql.Rows,
+) []*entities.WebAuthnCredentialEntity {
+	webAuthnCredentials := []*entities.WebAuthnCredentialEntity{}
+	for rows.Next() {
+		webAuthnCredential := webAuthnCredentialModel.scan(rows)
+		if webAuthnCredential != nil {
+			webAuthnCredentials = append(webAuthnCredentials, webAuthnCredential)
+		}
+	}
+	return webAuthnCredentials
+}
+
+func NewWebAuthnCredentialModel() (*WebAuthnCredentialModel, *shared.Error) {
+	return &WebAuthnCredentialModel{}, nil
+}
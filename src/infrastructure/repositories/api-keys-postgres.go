@@ -0,0 +1,249 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/models"
+	"github.com/lib/pq"
+)
+
+type ApiKeysRepositoryPostgres struct {
+	db *sql.DB
+}
+
+func (apiKeysRepository *ApiKeysRepositoryPostgres) Create(
+	data *dtos.ApiKeyDTO,
+) (*entities.ApiKeyEntity, *shared.Error) {
+	generateNeededValues := func(
+		values *dtos.ApiKeyDTO,
+	) (*dtos.ApiKeyDTO, *shared.Error) {
+		if values.Name == "" || values.Prefix == "" || values.Hash == "" {
+			adapters.LogError(context.Background(), errors.New("name, prefix and hash fields are required"))
+			return nil, exceptions.NewInternalServerError()
+		}
+		var id string
+		var createdAt, updatedAt time.Time
+		uuid, err := helpers.NewUuid()
+		if err != nil {
+			return nil, err
+		}
+		if values.Id == "" {
+			id = uuid.Generate()
+		} else {
+			id = values.Id
+		}
+		now := time.Now().UTC()
+		if values.CreatedAt == (time.Time{}) {
+			createdAt = now
+		} else {
+			createdAt = values.CreatedAt
+		}
+		if values.UpdatedAt == (time.Time{}) {
+			updatedAt = now
+		} else {
+			updatedAt = values.UpdatedAt
+		}
+		scopes := values.Scopes
+		if scopes == nil {
+			scopes = []string{}
+		}
+		allowedIps := values.AllowedIps
+		if allowedIps == nil {
+			allowedIps = []string{}
+		}
+		return &dtos.ApiKeyDTO{
+			Id:             id,
+			UserId:         values.UserId,
+			OrganizationId: values.OrganizationId,
+			Name:           values.Name,
+			Prefix:         values.Prefix,
+			Hash:           values.Hash,
+			Scopes:         scopes,
+			AllowedIps:     allowedIps,
+			ExpiresAt:      values.ExpiresAt,
+			RevokedAt:      values.RevokedAt,
+			CreatedAt:      createdAt,
+			UpdatedAt:      updatedAt,
+		}, nil
+	}
+	dto, err := generateNeededValues(data)
+	if err != nil {
+		return nil, err
+	}
+	apiKey, err := entities.NewApiKeyEntity(dto)
+	if err != nil {
+		return nil, err
+	}
+	return apiKey, nil
+}
+
+func (apiKeysRepository *ApiKeysRepositoryPostgres) findOne(
+	ctx context.Context,
+	query string, argument string,
+) (*entities.ApiKeyEntity, *shared.Error) {
+	stmt, goerr := apiKeysRepository.db.PrepareContext(ctx, query)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	apiKeyModel, err := models.NewApiKeyModel()
+	if err != nil {
+		return nil, err
+	}
+	rows := stmt.QueryRowContext(ctx, argument)
+	apiKey := apiKeyModel.Scan(rows)
+	return apiKey, nil
+}
+
+func (apiKeysRepository *ApiKeysRepositoryPostgres) findMany(
+	ctx context.Context,
+	query string, argument string,
+) ([]*entities.ApiKeyEntity, *shared.Error) {
+	stmt, goerr := apiKeysRepository.db.PrepareContext(ctx, query)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	rows, goerr := stmt.QueryContext(ctx, argument)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	defer rows.Close()
+	apiKeyModel, err := models.NewApiKeyModel()
+	if err != nil {
+		return nil, err
+	}
+	return apiKeyModel.ScanAll(rows), nil
+}
+
+func (apiKeysRepository *ApiKeysRepositoryPostgres) FindById(
+	ctx context.Context,
+	id string,
+) (*entities.ApiKeyEntity, *shared.Error) {
+	return apiKeysRepository.findOne(ctx, `
+		SELECT 
+			id, user_id, organization_id, name, prefix, hash, scopes,
+			allowed_ips, expires_at, revoked_at, created_at, updated_at
+		FROM
+			api_keys
+		WHERE 
+			id::text = $1
+	`, id)
+}
+
+func (apiKeysRepository *ApiKeysRepositoryPostgres) FindByPrefix(
+	ctx context.Context,
+	prefix string,
+) (*entities.ApiKeyEntity, *shared.Error) {
+	return apiKeysRepository.findOne(ctx, `
+		SELECT 
+			id, user_id, organization_id, name, prefix, hash, scopes,
+			allowed_ips, expires_at, revoked_at, created_at, updated_at
+		FROM
+			api_keys
+		WHERE 
+			prefix = $1
+	`, prefix)
+}
+
+func (apiKeysRepository *ApiKeysRepositoryPostgres) FindByUserId(
+	ctx context.Context,
+	userId string,
+) ([]*entities.ApiKeyEntity, *shared.Error) {
+	return apiKeysRepository.findMany(ctx, `
+		SELECT 
+			id, user_id, organization_id, name, prefix, hash, scopes,
+			allowed_ips, expires_at, revoked_at, created_at, updated_at
+		FROM
+			api_keys
+		WHERE 
+			user_id::text = $1
+		ORDER BY created_at
+	`, userId)
+}
+
+func (apiKeysRepository *ApiKeysRepositoryPostgres) FindServiceAccountKeys(
+	ctx context.Context,
+	organizationId string,
+) ([]*entities.ApiKeyEntity, *shared.Error) {
+	return apiKeysRepository.findMany(ctx, `
+		SELECT 
+			id, user_id, organization_id, name, prefix, hash, scopes,
+			allowed_ips, expires_at, revoked_at, created_at, updated_at
+		FROM
+			api_keys
+		WHERE 
+			organization_id::text = $1 AND user_id IS NULL
+		ORDER BY created_at
+	`, organizationId)
+}
+
+func (apiKeysRepository *ApiKeysRepositoryPostgres) Save(
+	ctx context.Context,
+	apiKey *entities.ApiKeyEntity,
+) *shared.Error {
+	stmt, goerr := apiKeysRepository.db.PrepareContext(ctx, `
+		INSERT INTO api_keys
+			(
+				id, user_id, organization_id, name, prefix, hash, scopes,
+				allowed_ips, expires_at, revoked_at, created_at, updated_at
+			)
+		VALUES (
+			$1, NULLIF($2, '')::uuid, NULLIF($3, '')::uuid, $4, $5, $6, $7,
+			$8, $9, $10, $11, $12
+		)
+		ON CONFLICT ( id ) DO UPDATE SET
+			name = EXCLUDED.name,
+			scopes = EXCLUDED.scopes,
+			allowed_ips = EXCLUDED.allowed_ips,
+			expires_at = EXCLUDED.expires_at,
+			revoked_at = EXCLUDED.revoked_at,
+			updated_at = EXCLUDED.updated_at
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	nullableTime := func(value time.Time) sql.NullTime {
+		return sql.NullTime{Time: value, Valid: value != (time.Time{})}
+	}
+	_, goerr = stmt.ExecContext(
+		ctx,
+		apiKey.Id,
+		apiKey.UserId,
+		apiKey.OrganizationId,
+		apiKey.Name,
+		apiKey.Prefix,
+		apiKey.Hash,
+		pq.Array(apiKey.Scopes),
+		pq.Array(apiKey.AllowedIps),
+		nullableTime(apiKey.ExpiresAt),
+		nullableTime(apiKey.RevokedAt),
+		apiKey.CreatedAt,
+		apiKey.UpdatedAt,
+	)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	return nil
+}
+
+func NewApiKeysRepositoryPostgres(db *sql.DB) (*ApiKeysRepositoryPostgres, *shared.Error) {
+	return &ApiKeysRepositoryPostgres{
+		db: db,
+	}, nil
+}
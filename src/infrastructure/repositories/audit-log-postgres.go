@@ -0,0 +1,185 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/models"
+)
+
+var (
+	usersCreated = helpers.Metrics.NewCounter(
+		"oganessone_users_created_total", "Users created.",
+	)
+	loginsSucceeded = helpers.Metrics.NewCounter(
+		"oganessone_logins_succeeded_total", "Logins that passed the password check.",
+	)
+	loginsFailed = helpers.Metrics.NewCounter(
+		"oganessone_logins_failed_total", "Logins refused, by reason.", "reason",
+	)
+)
+
+// Every signup and login attempt is audited, counting the stored events keeps
+// the metrics in step with the audit log.
+func countAuditEvent(auditEvent *entities.AuditEventEntity) {
+	switch auditEvent.Type {
+	case entities.AuditEventUserCreated:
+		usersCreated.Inc()
+	case entities.AuditEventLoginSucceeded:
+		loginsSucceeded.Inc()
+	case entities.AuditEventLoginFailed:
+		loginsFailed.Inc(auditEvent.Detail)
+	}
+}
+
+type AuditLogRepositoryPostgres struct {
+	db *sql.DB
+}
+
+func (auditLogRepository *AuditLogRepositoryPostgres) Create(
+	data *dtos.AuditEventDTO,
+) (*entities.AuditEventEntity, *shared.Error) {
+	if data.Type == "" {
+		adapters.LogError(context.Background(), errors.New("type field is required"))
+		return nil, exceptions.NewInternalServerError()
+	}
+	id := data.Id
+	if id == "" {
+		uuid, err := helpers.NewUuid()
+		if err != nil {
+			return nil, err
+		}
+		id = uuid.Generate()
+	}
+	createdAt := data.CreatedAt
+	if createdAt == (time.Time{}) {
+		createdAt = time.Now().UTC()
+	}
+	return entities.NewAuditEventEntity(&dtos.AuditEventDTO{
+		Id:             id,
+		Type:           data.Type,
+		ActorId:        data.ActorId,
+		TargetId:       data.TargetId,
+		OrganizationId: data.OrganizationId,
+		IpAddress:      data.IpAddress,
+		UserAgent:      data.UserAgent,
+		Detail:         data.Detail,
+		CreatedAt:      createdAt,
+	})
+}
+
+// There is no ON CONFLICT clause, saving an event twice is an error.
+func (auditLogRepository *AuditLogRepositoryPostgres) Save(
+	ctx context.Context,
+	auditEvent *entities.AuditEventEntity,
+) *shared.Error {
+	stmt, goerr := auditLogRepository.db.PrepareContext(ctx, `
+		INSERT INTO audit_events
+			(
+				id, type, actor_id, target_id, organization_id, ip_address,
+				user_agent, detail, created_at
+			)
+		VALUES ( $1, $2, $3, $4, NULLIF($5, '')::uuid, $6, $7, $8, $9 )
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	_, goerr = stmt.ExecContext(
+		ctx,
+		auditEvent.Id,
+		auditEvent.Type,
+		auditEvent.ActorId,
+		auditEvent.TargetId,
+		auditEvent.OrganizationId,
+		auditEvent.IpAddress,
+		auditEvent.UserAgent,
+		auditEvent.Detail,
+		auditEvent.CreatedAt,
+	)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	countAuditEvent(auditEvent)
+	return nil
+}
+
+// Newest events come first. The cursor is compared on (created_at, id) so
+// events sharing a timestamp are neither skipped nor repeated between pages.
+func (auditLogRepository *AuditLogRepositoryPostgres) Query(
+	ctx context.Context,
+	filter *dtos.AuditLogFilterDTO,
+) ([]*entities.AuditEventEntity, *shared.Error) {
+	stmt, goerr := auditLogRepository.db.PrepareContext(ctx, `
+		SELECT
+			id, type, actor_id, target_id, organization_id, ip_address,
+			user_agent, detail, created_at
+		FROM
+			audit_events
+		WHERE
+			($1 = '' OR type = $1) AND
+			($2 = '' OR actor_id = $2) AND
+			($3 = '' OR target_id = $3) AND
+			($4 = '' OR organization_id::text = $4) AND
+			($5 = '' OR actor_id = $5 OR target_id = $5) AND
+			($6::timestamp IS NULL OR created_at >= $6) AND
+			($7::timestamp IS NULL OR created_at < $7) AND
+			($8 = '' OR (created_at, id) < (
+				SELECT created_at, id FROM audit_events WHERE id::text = $8
+			))
+		ORDER BY created_at DESC, id DESC
+		LIMIT $9
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	var since, until sql.NullTime
+	if filter.Since != (time.Time{}) {
+		since = sql.NullTime{Time: filter.Since, Valid: true}
+	}
+	if filter.Until != (time.Time{}) {
+		until = sql.NullTime{Time: filter.Until, Valid: true}
+	}
+	rows, goerr := stmt.QueryContext(
+		ctx,
+		filter.Type,
+		filter.ActorId,
+		filter.TargetId,
+		filter.OrganizationId,
+		filter.Participant,
+		since,
+		until,
+		filter.Cursor,
+		filter.Limit,
+	)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	defer rows.Close()
+	auditEventModel, err := models.NewAuditEventModel()
+	if err != nil {
+		return nil, err
+	}
+	return auditEventModel.ScanAll(rows), nil
+}
+
+func NewAuditLogRepositoryPostgres(
+	db *sql.DB,
+) (*AuditLogRepositoryPostgres, *shared.Error) {
+	return &AuditLogRepositoryPostgres{
+		db: db,
+	}, nil
+}
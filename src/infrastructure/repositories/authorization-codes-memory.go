@@ -0,0 +1,69 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+)
+
+type AuthorizationCodesRepositoryMemory struct {
+	mutex sync.Mutex
+	codes map[string]*entities.AuthorizationCodeEntity
+}
+
+func (authorizationCodesRepository *AuthorizationCodesRepositoryMemory) Create(
+	data *dtos.AuthorizationCodeDTO,
+) (*entities.AuthorizationCodeEntity, *shared.Error) {
+	if data.ExpiresAt == (time.Time{}) {
+		adapters.LogError(context.Background(), errors.New("expires at field is required"))
+		return nil, exceptions.NewInternalServerError()
+	}
+	authorizationCode, err := entities.NewAuthorizationCodeEntity(data)
+	if err != nil {
+		return nil, err
+	}
+	return authorizationCode, nil
+}
+
+func (authorizationCodesRepository *AuthorizationCodesRepositoryMemory) Save(
+	ctx context.Context,
+	authorizationCode *entities.AuthorizationCodeEntity,
+) *shared.Error {
+	authorizationCodesRepository.mutex.Lock()
+	defer authorizationCodesRepository.mutex.Unlock()
+	now := time.Now().UTC()
+	for code, stored := range authorizationCodesRepository.codes {
+		if stored.IsExpired(now) {
+			delete(authorizationCodesRepository.codes, code)
+		}
+	}
+	authorizationCodesRepository.codes[authorizationCode.Code] = authorizationCode
+	return nil
+}
+
+func (authorizationCodesRepository *AuthorizationCodesRepositoryMemory) Consume(
+	ctx context.Context,
+	code string,
+) (*entities.AuthorizationCodeEntity, *shared.Error) {
+	authorizationCodesRepository.mutex.Lock()
+	defer authorizationCodesRepository.mutex.Unlock()
+	authorizationCode, ok := authorizationCodesRepository.codes[code]
+	if !ok {
+		return nil, nil
+	}
+	delete(authorizationCodesRepository.codes, code)
+	return authorizationCode, nil
+}
+
+func NewAuthorizationCodesRepositoryMemory() (*AuthorizationCodesRepositoryMemory, *shared.Error) {
+	return &AuthorizationCodesRepositoryMemory{
+		codes: map[string]*entities.AuthorizationCodeEntity{},
+	}, nil
+}
@@ -0,0 +1,185 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/models"
+)
+
+type MembershipsRepositoryPostgres struct {
+	db *sql.DB
+}
+
+func (membershipsRepository *MembershipsRepositoryPostgres) Create(
+	data *dtos.MembershipDTO,
+) (*entities.MembershipEntity, *shared.Error) {
+	generateNeededValues := func(
+		values *dtos.MembershipDTO,
+	) (*dtos.MembershipDTO, *shared.Error) {
+		if values.OrganizationId == "" || values.UserId == "" || values.Role == "" {
+			adapters.LogError(context.Background(), errors.New("organization id, user id and role fields are required"))
+			return nil, exceptions.NewInternalServerError()
+		}
+		var id string
+		var createdAt, updatedAt time.Time
+		uuid, err := helpers.NewUuid()
+		if err != nil {
+			return nil, err
+		}
+		if values.Id == "" {
+			id = uuid.Generate()
+		} else {
+			id = values.Id
+		}
+		now := time.Now().UTC()
+		if values.CreatedAt == (time.Time{}) {
+			createdAt = now
+		} else {
+			createdAt = values.CreatedAt
+		}
+		if values.UpdatedAt == (time.Time{}) {
+			updatedAt = now
+		} else {
+			updatedAt = values.UpdatedAt
+		}
+		return &dtos.MembershipDTO{
+			Id:             id,
+			OrganizationId: values.OrganizationId,
+			UserId:         values.UserId,
+			Role:           values.Role,
+			CreatedAt:      createdAt,
+			UpdatedAt:      updatedAt,
+		}, nil
+	}
+	dto, err := generateNeededValues(data)
+	if err != nil {
+		return nil, err
+	}
+	membership, err := entities.NewMembershipEntity(dto)
+	if err != nil {
+		return nil, err
+	}
+	return membership, nil
+}
+
+func (membershipsRepository *MembershipsRepositoryPostgres) FindByOrganizationIdAndUserId(
+	ctx context.Context,
+	organizationId string, userId string,
+) (*entities.MembershipEntity, *shared.Error) {
+	stmt, goerr := membershipsRepository.db.PrepareContext(ctx, `
+		SELECT 
+			id, organization_id, user_id, role, created_at, updated_at
+		FROM
+			memberships
+		WHERE 
+			organization_id::text = $1 AND user_id::text = $2
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	membershipModel, err := models.NewMembershipModel()
+	if err != nil {
+		return nil, err
+	}
+	rows := stmt.QueryRowContext(ctx, organizationId, userId)
+	membership := membershipModel.Scan(rows)
+	return membership, nil
+}
+
+func (membershipsRepository *MembershipsRepositoryPostgres) FindByOrganizationId(
+	ctx context.Context,
+	organizationId string,
+) ([]*entities.MembershipEntity, *shared.Error) {
+	stmt, goerr := membershipsRepository.db.PrepareContext(ctx, `
+		SELECT 
+			id, organization_id, user_id, role, created_at, updated_at
+		FROM
+			memberships
+		WHERE 
+			organization_id::text = $1
+		ORDER BY created_at
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	rows, goerr := stmt.QueryContext(ctx, organizationId)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	defer rows.Close()
+	membershipModel, err := models.NewMembershipModel()
+	if err != nil {
+		return nil, err
+	}
+	return membershipModel.ScanAll(rows), nil
+}
+
+func (membershipsRepository *MembershipsRepositoryPostgres) Save(
+	ctx context.Context,
+	membership *entities.MembershipEntity,
+) *shared.Error {
+	stmt, goerr := membershipsRepository.db.PrepareContext(ctx, `
+		INSERT INTO memberships
+			( id, organization_id, user_id, role, created_at, updated_at )
+		VALUES ( $1, $2, $3, $4, $5, $6 )
+		ON CONFLICT ( id ) DO UPDATE SET
+			role = EXCLUDED.role,
+			updated_at = EXCLUDED.updated_at
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	_, goerr = stmt.ExecContext(
+		ctx,
+		membership.Id,
+		membership.OrganizationId,
+		membership.UserId,
+		membership.Role,
+		membership.CreatedAt,
+		membership.UpdatedAt,
+	)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	return nil
+}
+
+func (membershipsRepository *MembershipsRepositoryPostgres) Delete(ctx context.Context, id string) *shared.Error {
+	stmt, goerr := membershipsRepository.db.PrepareContext(ctx, `
+		DELETE FROM memberships WHERE id = $1
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	_, goerr = stmt.ExecContext(ctx, id)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	return nil
+}
+
+func NewMembershipsRepositoryPostgres(db *sql.DB) (*MembershipsRepositoryPostgres, *shared.Error) {
+	return &MembershipsRepositoryPostgres{
+		db: db,
+	}, nil
+}
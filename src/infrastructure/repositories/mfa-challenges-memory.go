@@ -0,0 +1,81 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+)
+
+type MfaChallengesRepositoryMemory struct {
+	mutex      sync.Mutex
+	challenges map[string]*entities.MfaChallengeEntity
+}
+
+func (mfaChallengesRepository *MfaChallengesRepositoryMemory) Create(
+	data *dtos.MfaChallengeDTO,
+) (*entities.MfaChallengeEntity, *shared.Error) {
+	if data.ExpiresAt == (time.Time{}) {
+		adapters.LogError(context.Background(), errors.New("expires at field is required"))
+		return nil, exceptions.NewInternalServerError()
+	}
+	mfaChallenge, err := entities.NewMfaChallengeEntity(data)
+	if err != nil {
+		return nil, err
+	}
+	return mfaChallenge, nil
+}
+
+func (mfaChallengesRepository *MfaChallengesRepositoryMemory) Save(
+	ctx context.Context,
+	mfaChallenge *entities.MfaChallengeEntity,
+) *shared.Error {
+	mfaChallengesRepository.mutex.Lock()
+	defer mfaChallengesRepository.mutex.Unlock()
+	now := time.Now().UTC()
+	for token, stored := range mfaChallengesRepository.challenges {
+		if stored.IsExpired(now) {
+			delete(mfaChallengesRepository.challenges, token)
+		}
+	}
+	stored := *mfaChallenge
+	mfaChallengesRepository.challenges[mfaChallenge.Token] = &stored
+	return nil
+}
+
+// Returns a copy, changes only take effect once saved.
+func (mfaChallengesRepository *MfaChallengesRepositoryMemory) FindByToken(
+	ctx context.Context,
+	token string,
+) (*entities.MfaChallengeEntity, *shared.Error) {
+	mfaChallengesRepository.mutex.Lock()
+	defer mfaChallengesRepository.mutex.Unlock()
+	stored, ok := mfaChallengesRepository.challenges[token]
+	if !ok {
+		return nil, nil
+	}
+	mfaChallenge := *stored
+	return &mfaChallenge, nil
+}
+
+func (mfaChallengesRepository *MfaChallengesRepositoryMemory) Delete(
+	ctx context.Context,
+	token string,
+) *shared.Error {
+	mfaChallengesRepository.mutex.Lock()
+	defer mfaChallengesRepository.mutex.Unlock()
+	delete(mfaChallengesRepository.challenges, token)
+	return nil
+}
+
+func NewMfaChallengesRepositoryMemory() (*MfaChallengesRepositoryMemory, *shared.Error) {
+	return &MfaChallengesRepositoryMemory{
+		challenges: map[string]*entities.MfaChallengeEntity{},
+	}, nil
+}
@@ -0,0 +1,162 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/models"
+	"github.com/lib/pq"
+)
+
+type OAuthClientsRepositoryPostgres struct {
+	db *sql.DB
+}
+
+func (oauthClientsRepository *OAuthClientsRepositoryPostgres) Create(
+	data *dtos.OAuthClientDTO,
+) (*entities.OAuthClientEntity, *shared.Error) {
+	generateNeededValues := func(
+		values *dtos.OAuthClientDTO,
+	) (*dtos.OAuthClientDTO, *shared.Error) {
+		if values.OrganizationId == "" || values.Name == "" {
+			adapters.LogError(context.Background(), errors.New("organization id and name fields are required"))
+			return nil, exceptions.NewInvalidOAuthClientName()
+		}
+		var id string
+		var createdAt, updatedAt time.Time
+		uuid, err := helpers.NewUuid()
+		if err != nil {
+			return nil, err
+		}
+		if values.Id == "" {
+			id = uuid.Generate()
+		} else {
+			id = values.Id
+		}
+		now := time.Now().UTC()
+		if values.CreatedAt == (time.Time{}) {
+			createdAt = now
+		} else {
+			createdAt = values.CreatedAt
+		}
+		if values.UpdatedAt == (time.Time{}) {
+			updatedAt = now
+		} else {
+			updatedAt = values.UpdatedAt
+		}
+		authMethod := values.AuthMethod
+		if authMethod == "" {
+			authMethod = entities.OAuthClientAuthMethodNone
+		}
+		return &dtos.OAuthClientDTO{
+			Id:             id,
+			OrganizationId: values.OrganizationId,
+			Name:           values.Name,
+			RedirectUris:   values.RedirectUris,
+			AuthMethod:     authMethod,
+			SecretHash:     values.SecretHash,
+			PublicKey:      values.PublicKey,
+			Scopes:         values.Scopes,
+			Audiences:      values.Audiences,
+			CreatedAt:      createdAt,
+			UpdatedAt:      updatedAt,
+		}, nil
+	}
+	dto, err := generateNeededValues(data)
+	if err != nil {
+		return nil, err
+	}
+	client, err := entities.NewOAuthClientEntity(dto)
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func (oauthClientsRepository *OAuthClientsRepositoryPostgres) FindById(
+	ctx context.Context,
+	id string,
+) (*entities.OAuthClientEntity, *shared.Error) {
+	stmt, goerr := oauthClientsRepository.db.PrepareContext(ctx, `
+		SELECT 
+			id, organization_id, name, redirect_uris, auth_method, secret_hash,
+			public_key, scopes, audiences, created_at, updated_at
+		FROM
+			oauth_clients
+		WHERE 
+			id::text = $1
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	oauthClientModel, err := models.NewOAuthClientModel()
+	if err != nil {
+		return nil, err
+	}
+	rows := stmt.QueryRowContext(ctx, id)
+	client := oauthClientModel.Scan(rows)
+	return client, nil
+}
+
+func (oauthClientsRepository *OAuthClientsRepositoryPostgres) Save(
+	ctx context.Context,
+	client *entities.OAuthClientEntity,
+) *shared.Error {
+	stmt, goerr := oauthClientsRepository.db.PrepareContext(ctx, `
+		INSERT INTO oauth_clients
+			(
+				id, organization_id, name, redirect_uris, auth_method, secret_hash,
+				public_key, scopes, audiences, created_at, updated_at
+			)
+		VALUES ( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11 )
+		ON CONFLICT ( id ) DO UPDATE SET
+			name = EXCLUDED.name,
+			redirect_uris = EXCLUDED.redirect_uris,
+			auth_method = EXCLUDED.auth_method,
+			secret_hash = EXCLUDED.secret_hash,
+			public_key = EXCLUDED.public_key,
+			scopes = EXCLUDED.scopes,
+			audiences = EXCLUDED.audiences,
+			updated_at = EXCLUDED.updated_at
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	_, goerr = stmt.ExecContext(
+		ctx,
+		client.Id,
+		client.OrganizationId,
+		client.Name,
+		pq.Array(client.RedirectUris),
+		client.AuthMethod,
+		client.SecretHash,
+		client.PublicKey,
+		pq.Array(client.Scopes),
+		pq.Array(client.Audiences),
+		client.CreatedAt,
+		client.UpdatedAt,
+	)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	return nil
+}
+
+func NewOAuthClientsRepositoryPostgres(db *sql.DB) (*OAuthClientsRepositoryPostgres, *shared.Error) {
+	return &OAuthClientsRepositoryPostgres{
+		db: db,
+	}, nil
+}
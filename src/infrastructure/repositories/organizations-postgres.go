@@ -0,0 +1,151 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/models"
+)
+
+type OrganizationsRepositoryPostgres struct {
+	db *sql.DB
+}
+
+func (organizationsRepository *OrganizationsRepositoryPostgres) Create(
+	data *dtos.OrganizationDTO,
+) (*entities.OrganizationEntity, *shared.Error) {
+	generateNeededValues := func(
+		values *dtos.OrganizationDTO,
+	) (*dtos.OrganizationDTO, *shared.Error) {
+		if values.Name == "" {
+			adapters.LogError(context.Background(), errors.New("name field is required"))
+			return nil, exceptions.NewInvalidOrganizationName()
+		}
+		var id string
+		var createdAt, updatedAt time.Time
+		uuid, err := helpers.NewUuid()
+		if err != nil {
+			return nil, err
+		}
+		if values.Id == "" {
+			id = uuid.Generate()
+		} else {
+			id = values.Id
+		}
+		now := time.Now().UTC()
+		if values.CreatedAt == (time.Time{}) {
+			createdAt = now
+		} else {
+			createdAt = values.CreatedAt
+		}
+		if values.UpdatedAt == (time.Time{}) {
+			updatedAt = now
+		} else {
+			updatedAt = values.UpdatedAt
+		}
+		return &dtos.OrganizationDTO{
+			Id:        id,
+			Name:      values.Name,
+			CreatedAt: createdAt,
+			UpdatedAt: updatedAt,
+		}, nil
+	}
+	dto, err := generateNeededValues(data)
+	if err != nil {
+		return nil, err
+	}
+	organization, err := entities.NewOrganizationEntity(dto)
+	if err != nil {
+		return nil, err
+	}
+	return organization, nil
+}
+
+func (organizationsRepository *OrganizationsRepositoryPostgres) FindById(
+	ctx context.Context,
+	id string,
+) (*entities.OrganizationEntity, *shared.Error) {
+	stmt, goerr := organizationsRepository.db.PrepareContext(ctx, `
+		SELECT 
+			id, name, created_at, updated_at
+		FROM
+			organizations
+		WHERE 
+			id::text = $1
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	organizationModel, err := models.NewOrganizationModel()
+	if err != nil {
+		return nil, err
+	}
+	rows := stmt.QueryRowContext(ctx, id)
+	organization := organizationModel.Scan(rows)
+	return organization, nil
+}
+
+func (organizationsRepository *OrganizationsRepositoryPostgres) Save(
+	ctx context.Context,
+	organization *entities.OrganizationEntity,
+) *shared.Error {
+	stmt, goerr := organizationsRepository.db.PrepareContext(ctx, `
+		INSERT INTO organizations
+			( id, name, created_at, updated_at )
+		VALUES ( $1, $2, $3, $4 )
+		ON CONFLICT ( id ) DO UPDATE SET
+			name = EXCLUDED.name,
+			updated_at = EXCLUDED.updated_at
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	_, goerr = stmt.ExecContext(
+		ctx,
+		organization.Id,
+		organization.Name,
+		organization.CreatedAt,
+		organization.UpdatedAt,
+	)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	return nil
+}
+
+// Memberships, users and everything else of the organization go with it.
+func (organizationsRepository *OrganizationsRepositoryPostgres) Delete(ctx context.Context, id string) *shared.Error {
+	stmt, goerr := organizationsRepository.db.PrepareContext(ctx, `
+		DELETE FROM organizations WHERE id = $1
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	_, goerr = stmt.ExecContext(ctx, id)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	return nil
+}
+
+func NewOrganizationsRepositoryPostgres(db *sql.DB) (*OrganizationsRepositoryPostgres, *shared.Error) {
+	return &OrganizationsRepositoryPostgres{
+		db: db,
+	}, nil
+}
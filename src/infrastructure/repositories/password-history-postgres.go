@@ -0,0 +1,147 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/models"
+)
+
+type PasswordHistoryRepositoryPostgres struct {
+	db *sql.DB
+}
+
+func (passwordHistoryRepository *PasswordHistoryRepositoryPostgres) Create(
+	data *dtos.PasswordHistoryDTO,
+) (*entities.PasswordHistoryEntity, *shared.Error) {
+	if data.UserId == "" || data.Password == "" {
+		adapters.LogError(context.Background(), errors.New("user id and password fields are required"))
+		return nil, exceptions.NewInternalServerError()
+	}
+	id := data.Id
+	if id == "" {
+		uuid, err := helpers.NewUuid()
+		if err != nil {
+			return nil, err
+		}
+		id = uuid.Generate()
+	}
+	createdAt := data.CreatedAt
+	if createdAt == (time.Time{}) {
+		createdAt = time.Now().UTC()
+	}
+	return entities.NewPasswordHistoryEntity(&dtos.PasswordHistoryDTO{
+		Id:        id,
+		UserId:    data.UserId,
+		Password:  data.Password,
+		CreatedAt: createdAt,
+	})
+}
+
+func (passwordHistoryRepository *PasswordHistoryRepositoryPostgres) Save(
+	ctx context.Context,
+	passwordHistory *entities.PasswordHistoryEntity,
+) *shared.Error {
+	stmt, goerr := passwordHistoryRepository.db.PrepareContext(ctx, `
+		INSERT INTO password_history
+			(id, user_id, password, created_at)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT ( id ) DO NOTHING
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	_, goerr = stmt.ExecContext(
+		ctx,
+		passwordHistory.Id,
+		passwordHistory.UserId,
+		passwordHistory.Password,
+		passwordHistory.CreatedAt,
+	)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	return nil
+}
+
+func (passwordHistoryRepository *PasswordHistoryRepositoryPostgres) FindRecentByUserId(
+	ctx context.Context,
+	userId string, limit int, since time.Time,
+) ([]*entities.PasswordHistoryEntity, *shared.Error) {
+	stmt, goerr := passwordHistoryRepository.db.PrepareContext(ctx, `
+		SELECT
+			id, user_id, password, created_at
+		FROM
+			password_history
+		WHERE
+			user_id::text = $1 AND created_at >= $2
+		ORDER BY created_at DESC
+		LIMIT $3
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	rows, goerr := stmt.QueryContext(ctx, userId, since, limit)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	defer rows.Close()
+	passwordHistoryModel, err := models.NewPasswordHistoryModel()
+	if err != nil {
+		return nil, err
+	}
+	return passwordHistoryModel.ScanAll(rows), nil
+}
+
+// Deletes every entry of the user that is not among the newest keep ones or
+// was created before the given time.
+func (passwordHistoryRepository *PasswordHistoryRepositoryPostgres) Prune(
+	ctx context.Context,
+	userId string, keep int, before time.Time,
+) *shared.Error {
+	stmt, goerr := passwordHistoryRepository.db.PrepareContext(ctx, `
+		DELETE FROM password_history
+		WHERE
+			user_id::text = $1 AND (
+				created_at < $2 OR id NOT IN (
+					SELECT id FROM password_history
+					WHERE user_id::text = $1
+					ORDER BY created_at DESC
+					LIMIT $3
+				)
+			)
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	_, goerr = stmt.ExecContext(ctx, userId, before, keep)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	return nil
+}
+
+func NewPasswordHistoryRepositoryPostgres(
+	db *sql.DB,
+) (*PasswordHistoryRepositoryPostgres, *shared.Error) {
+	return &PasswordHistoryRepositoryPostgres{
+		db: db,
+	}, nil
+}
@@ -1,9 +1,9 @@
 package repositories
 
 import (
+	"context"
 	"database/sql"
 	"errors"
-	"log"
 	"strings"
 	"time"
 
@@ -11,8 +11,10 @@ import (
 	"github.com/AndreyArthur/oganessone/src/core/entities"
 	"github.com/AndreyArthur/oganessone/src/core/exceptions"
 	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
 	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
 	"github.com/AndreyArthur/oganessone/src/infrastructure/models"
+	"github.com/lib/pq"
 )
 
 type UsersRepositoryPostgres struct {
@@ -26,7 +28,7 @@ func (usersRepository *UsersRepositoryPostgres) Create(
 		values *dtos.UserDTO,
 	) (*dtos.UserDTO, *shared.Error) {
 		if values.Username == "" || values.Email == "" || values.Password == "" {
-			log.Println(errors.New("username email and password fields are required"))
+			adapters.LogError(context.Background(), errors.New("username email and password fields are required"))
 			return nil, exceptions.NewInternalServerError()
 		}
 		var id, username, email, password string
@@ -55,12 +57,17 @@ func (usersRepository *UsersRepositoryPostgres) Create(
 			updatedAt = values.UpdatedAt
 		}
 		return &dtos.UserDTO{
-			Id:        id,
-			Username:  username,
-			Email:     email,
-			Password:  password,
-			CreatedAt: createdAt,
-			UpdatedAt: updatedAt,
+			Id:               id,
+			OrganizationId:   values.OrganizationId,
+			Username:         username,
+			Email:            email,
+			Password:         password,
+			EmailVerified:    values.EmailVerified,
+			MfaSecret:        values.MfaSecret,
+			MfaEnabled:       values.MfaEnabled,
+			MfaRecoveryCodes: values.MfaRecoveryCodes,
+			CreatedAt:        createdAt,
+			UpdatedAt:        updatedAt,
 		}, nil
 	}
 	dto, err := generateNeededValues(data)
@@ -74,8 +81,36 @@ func (usersRepository *UsersRepositoryPostgres) Create(
 	return user, nil
 }
 
+func (usersRepository *UsersRepositoryPostgres) FindById(
+	ctx context.Context,
+	id string,
+) (*entities.UserEntity, *shared.Error) {
+	stmt, goerr := usersRepository.db.PrepareContext(ctx, `
+		SELECT 
+			id, organization_id, username, email, password, email_verified,
+			mfa_secret, mfa_enabled, mfa_recovery_codes, created_at, updated_at
+		FROM
+			users
+		WHERE 
+			id::text = $1
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	userModel, err := models.NewUserModel()
+	if err != nil {
+		return nil, err
+	}
+	rows := stmt.QueryRowContext(ctx, id)
+	user := userModel.Scan(rows)
+	return user, nil
+}
+
 func (usersRepository *UsersRepositoryPostgres) FindByUsername(
-	username string, caseSensitive bool,
+	ctx context.Context,
+	organizationId string, username string, caseSensitive bool,
 ) (*entities.UserEntity, *shared.Error) {
 	generateQuery := func(caseSensitive bool) string {
 		var field string
@@ -86,21 +121,23 @@ func (usersRepository *UsersRepositoryPostgres) FindByUsername(
 		}
 		query := strings.Join([]string{
 			`SELECT 
-				id, username, email, password, created_at, updated_at
+				id, organization_id, username, email, password, email_verified,
+				mfa_secret, mfa_enabled, mfa_recovery_codes, created_at, updated_at
 			FROM
 				users
 			WHERE `,
 			field,
-			" = $1",
+			" = $1 AND ($2 = '' OR organization_id::text = $2)",
 		}, "")
 		return query
 	}
 	query := generateQuery(caseSensitive)
-	stmt, goerr := usersRepository.db.Prepare(query)
+	stmt, goerr := usersRepository.db.PrepareContext(ctx, query)
 	if goerr != nil {
-		log.Println(goerr)
+		adapters.LogError(ctx, goerr)
 		return nil, exceptions.NewInternalServerError()
 	}
+	defer stmt.Close()
 	var queryUsername string
 	if !caseSensitive {
 		queryUsername = strings.ToLower(username)
@@ -111,58 +148,140 @@ func (usersRepository *UsersRepositoryPostgres) FindByUsername(
 	if err != nil {
 		return nil, err
 	}
-	rows := stmt.QueryRow(queryUsername)
+	rows := stmt.QueryRowContext(ctx, queryUsername, organizationId)
 	user := userModel.Scan(rows)
 	return user, nil
 }
 
-func (usersRepository *UsersRepositoryPostgres) FindByEmail(email string) (*entities.UserEntity, *shared.Error) {
-	stmt, goerr := usersRepository.db.Prepare(`
+func (usersRepository *UsersRepositoryPostgres) FindByEmail(
+	ctx context.Context,
+	organizationId string, email string,
+) (*entities.UserEntity, *shared.Error) {
+	stmt, goerr := usersRepository.db.PrepareContext(ctx, `
 		SELECT 
-			id, username, email, password, created_at, updated_at
+			id, organization_id, username, email, password, email_verified,
+			mfa_secret, mfa_enabled, mfa_recovery_codes, created_at, updated_at
 		FROM
 			users
 		WHERE 
-			email = $1
+			email = $1 AND ($2 = '' OR organization_id::text = $2)
 	`)
 	if goerr != nil {
-		log.Println(goerr)
+		adapters.LogError(ctx, goerr)
 		return nil, exceptions.NewInternalServerError()
 	}
+	defer stmt.Close()
 	userModel, err := models.NewUserModel()
 	if err != nil {
 		return nil, err
 	}
-	rows := stmt.QueryRow(email)
+	rows := stmt.QueryRowContext(ctx, email, organizationId)
 	user := userModel.Scan(rows)
 	return user, nil
 }
 
-func (usersRepository *UsersRepositoryPostgres) Save(user *entities.UserEntity) *shared.Error {
-	stmt, goerr := usersRepository.db.Prepare(`
+func (usersRepository *UsersRepositoryPostgres) Save(ctx context.Context, user *entities.UserEntity) *shared.Error {
+	stmt, goerr := usersRepository.db.PrepareContext(ctx, `
 		INSERT INTO users	
-			( id, username, email, password, created_at, updated_at )
-		VALUES ( $1, $2, $3, $4, $5, $6 )
+			(
+				id, organization_id, username, email, password, email_verified,
+				mfa_secret, mfa_enabled, mfa_recovery_codes, created_at, updated_at
+			)
+		VALUES ( $1, NULLIF($2, '')::uuid, $3, $4, $5, $6, NULLIF($7, ''), $8, COALESCE($9::text[], '{}'), $10, $11 )
+		ON CONFLICT ( id ) DO UPDATE SET
+			username = EXCLUDED.username,
+			email = EXCLUDED.email,
+			password = EXCLUDED.password,
+			email_verified = EXCLUDED.email_verified,
+			mfa_secret = EXCLUDED.mfa_secret,
+			mfa_enabled = EXCLUDED.mfa_enabled,
+			mfa_recovery_codes = EXCLUDED.mfa_recovery_codes,
+			updated_at = EXCLUDED.updated_at
 	`)
 	if goerr != nil {
-		log.Println(goerr)
+		adapters.LogError(ctx, goerr)
 		return exceptions.NewInternalServerError()
 	}
-	_, goerr = stmt.Exec(
+	defer stmt.Close()
+	_, goerr = stmt.ExecContext(
+		ctx,
 		user.Id,
+		user.OrganizationId,
 		user.Username,
 		user.Email,
 		user.Password,
+		user.EmailVerified,
+		user.MfaSecret,
+		user.MfaEnabled,
+		pq.Array(user.MfaRecoveryCodes),
 		user.CreatedAt,
 		user.UpdatedAt,
 	)
 	if goerr != nil {
-		log.Println(goerr)
+		adapters.LogError(ctx, goerr)
 		return exceptions.NewInternalServerError()
 	}
 	return nil
 }
 
+// The comparison happens inside the update, two requests racing with the
+// same code can't both claim its step.
+func (usersRepository *UsersRepositoryPostgres) ClaimMfaStep(
+	ctx context.Context,
+	userId string, step int64,
+) (bool, *shared.Error) {
+	stmt, goerr := usersRepository.db.PrepareContext(ctx, `
+		UPDATE users
+		SET mfa_last_step = $2
+		WHERE id::text = $1 AND (mfa_last_step IS NULL OR mfa_last_step < $2)
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return false, exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	result, goerr := stmt.ExecContext(ctx, userId, step)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return false, exceptions.NewInternalServerError()
+	}
+	affected, goerr := result.RowsAffected()
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return false, exceptions.NewInternalServerError()
+	}
+	return affected == 1, nil
+}
+
+// Removes the hash only if it is still there, the same recovery code used by
+// two racing requests is accepted once.
+func (usersRepository *UsersRepositoryPostgres) ConsumeMfaRecoveryCode(
+	ctx context.Context,
+	userId string, hash string,
+) (bool, *shared.Error) {
+	stmt, goerr := usersRepository.db.PrepareContext(ctx, `
+		UPDATE users
+		SET mfa_recovery_codes = array_remove(mfa_recovery_codes, $2)
+		WHERE id::text = $1 AND $2 = ANY(mfa_recovery_codes)
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return false, exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	result, goerr := stmt.ExecContext(ctx, userId, hash)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return false, exceptions.NewInternalServerError()
+	}
+	affected, goerr := result.RowsAffected()
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return false, exceptions.NewInternalServerError()
+	}
+	return affected == 1, nil
+}
+
 func NewUsersRepositoryPostgres(db *sql.DB) (*UsersRepositoryPostgres, *shared.Error) {
 	return &UsersRepositoryPostgres{
 		db: db,
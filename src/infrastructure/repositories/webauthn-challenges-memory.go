@@ -0,0 +1,70 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+)
+
+type WebAuthnChallengesRepositoryMemory struct {
+	mutex      sync.Mutex
+	challenges map[string]*entities.WebAuthnChallengeEntity
+}
+
+func (webAuthnChallengesRepository *WebAuthnChallengesRepositoryMemory) Create(
+	data *dtos.WebAuthnChallengeDTO,
+) (*entities.WebAuthnChallengeEntity, *shared.Error) {
+	if data.ExpiresAt == (time.Time{}) {
+		adapters.LogError(context.Background(), errors.New("expires at field is required"))
+		return nil, exceptions.NewInternalServerError()
+	}
+	webAuthnChallenge, err := entities.NewWebAuthnChallengeEntity(data)
+	if err != nil {
+		return nil, err
+	}
+	return webAuthnChallenge, nil
+}
+
+func (webAuthnChallengesRepository *WebAuthnChallengesRepositoryMemory) Save(
+	ctx context.Context,
+	webAuthnChallenge *entities.WebAuthnChallengeEntity,
+) *shared.Error {
+	webAuthnChallengesRepository.mutex.Lock()
+	defer webAuthnChallengesRepository.mutex.Unlock()
+	now := time.Now().UTC()
+	for challenge, stored := range webAuthnChallengesRepository.challenges {
+		if stored.IsExpired(now) {
+			delete(webAuthnChallengesRepository.challenges, challenge)
+		}
+	}
+	stored := *webAuthnChallenge
+	webAuthnChallengesRepository.challenges[webAuthnChallenge.Challenge] = &stored
+	return nil
+}
+
+func (webAuthnChallengesRepository *WebAuthnChallengesRepositoryMemory) Consume(
+	ctx context.Context,
+	challenge string,
+) (*entities.WebAuthnChallengeEntity, *shared.Error) {
+	webAuthnChallengesRepository.mutex.Lock()
+	defer webAuthnChallengesRepository.mutex.Unlock()
+	stored, ok := webAuthnChallengesRepository.challenges[challenge]
+	if !ok {
+		return nil, nil
+	}
+	delete(webAuthnChallengesRepository.challenges, challenge)
+	return stored, nil
+}
+
+func NewWebAuthnChallengesRepositoryMemory() (*WebAuthnChallengesRepositoryMemory, *shared.Error) {
+	return &WebAuthnChallengesRepositoryMemory{
+		challenges: map[string]*entities.WebAuthnChallengeEntity{},
+	}, nil
+}
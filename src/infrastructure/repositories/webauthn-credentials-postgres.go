@@ -0,0 +1,185 @@
+package repositories
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/models"
+	"github.com/lib/pq"
+)
+
+type WebAuthnCredentialsRepositoryPostgres struct {
+	db *sql.DB
+}
+
+func (webAuthnCredentialsRepository *WebAuthnCredentialsRepositoryPostgres) Create(
+	data *dtos.WebAuthnCredentialDTO,
+) (*entities.WebAuthnCredentialEntity, *shared.Error) {
+	generateNeededValues := func(
+		values *dtos.WebAuthnCredentialDTO,
+	) (*dtos.WebAuthnCredentialDTO, *shared.Error) {
+		if values.UserId == "" || values.CredentialId == "" || values.PublicKey == "" {
+			adapters.LogError(context.Background(), errors.New("user id, credential id and public key fields are required"))
+			return nil, exceptions.NewInternalServerError()
+		}
+		var id string
+		var createdAt, updatedAt time.Time
+		uuid, err := helpers.NewUuid()
+		if err != nil {
+			return nil, err
+		}
+		if values.Id == "" {
+			id = uuid.Generate()
+		} else {
+			id = values.Id
+		}
+		now := time.Now().UTC()
+		if values.CreatedAt == (time.Time{}) {
+			createdAt = now
+		} else {
+			createdAt = values.CreatedAt
+		}
+		if values.UpdatedAt == (time.Time{}) {
+			updatedAt = now
+		} else {
+			updatedAt = values.UpdatedAt
+		}
+		transports := values.Transports
+		if transports == nil {
+			transports = []string{}
+		}
+		return &dtos.WebAuthnCredentialDTO{
+			Id:           id,
+			UserId:       values.UserId,
+			CredentialId: values.CredentialId,
+			PublicKey:    values.PublicKey,
+			SignCount:    values.SignCount,
+			Transports:   transports,
+			CreatedAt:    createdAt,
+			UpdatedAt:    updatedAt,
+		}, nil
+	}
+	dto, err := generateNeededValues(data)
+	if err != nil {
+		return nil, err
+	}
+	webAuthnCredential, err := entities.NewWebAuthnCredentialEntity(dto)
+	if err != nil {
+		return nil, err
+	}
+	return webAuthnCredential, nil
+}
+
+func (webAuthnCredentialsRepository *WebAuthnCredentialsRepositoryPostgres) FindByCredentialId(
+	ctx context.Context,
+	credentialId string,
+) (*entities.WebAuthnCredentialEntity, *shared.Error) {
+	stmt, goerr := webAuthnCredentialsRepository.db.PrepareContext(ctx, `
+		SELECT 
+			id, user_id, credential_id, public_key, sign_count, transports,
+			created_at, updated_at
+		FROM
+			webauthn_credentials
+		WHERE 
+			credential_id = $1
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	webAuthnCredentialModel, err := models.NewWebAuthnCredentialModel()
+	if err != nil {
+		return nil, err
+	}
+	rows := stmt.QueryRowContext(ctx, credentialId)
+	webAuthnCredential := webAuthnCredentialModel.Scan(rows)
+	return webAuthnCredential, nil
+}
+
+func (webAuthnCredentialsRepository *WebAuthnCredentialsRepositoryPostgres) FindByUserId(
+	ctx context.Context,
+	userId string,
+) ([]*entities.WebAuthnCredentialEntity, *shared.Error) {
+	stmt, goerr := webAuthnCredentialsRepository.db.PrepareContext(ctx, `
+		SELECT 
+			id, user_id, credential_id, public_key, sign_count, transports,
+			created_at, updated_at
+		FROM
+			webauthn_credentials
+		WHERE 
+			user_id::text = $1
+		ORDER BY created_at
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	rows, goerr := stmt.QueryContext(ctx, userId)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return nil, exceptions.NewInternalServerError()
+	}
+	defer rows.Close()
+	webAuthnCredentialModel, err := models.NewWebAuthnCredentialModel()
+	if err != nil {
+		return nil, err
+	}
+	return webAuthnCredentialModel.ScanAll(rows), nil
+}
+
+func (webAuthnCredentialsRepository *WebAuthnCredentialsRepositoryPostgres) Save(
+	ctx context.Context,
+	webAuthnCredential *entities.WebAuthnCredentialEntity,
+) *shared.Error {
+	stmt, goerr := webAuthnCredentialsRepository.db.PrepareContext(ctx, `
+		INSERT INTO webauthn_credentials
+			(
+				id, user_id, credential_id, public_key, sign_count, transports,
+				created_at, updated_at
+			)
+		VALUES ($1, $2, $3, $4, $5, COALESCE($6::text[], '{}'), $7, $8)
+		ON CONFLICT ( id ) DO UPDATE SET
+			sign_count = EXCLUDED.sign_count,
+			transports = EXCLUDED.transports,
+			updated_at = EXCLUDED.updated_at
+	`)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	defer stmt.Close()
+	_, goerr = stmt.ExecContext(
+		ctx,
+		webAuthnCredential.Id,
+		webAuthnCredential.UserId,
+		webAuthnCredential.CredentialId,
+		webAuthnCredential.PublicKey,
+		webAuthnCredential.SignCount,
+		pq.Array(webAuthnCredential.Transports),
+		webAuthnCredential.CreatedAt,
+		webAuthnCredential.UpdatedAt,
+	)
+	if goerr != nil {
+		adapters.LogError(ctx, goerr)
+		return exceptions.NewInternalServerError()
+	}
+	return nil
+}
+
+func NewWebAuthnCredentialsRepositoryPostgres(
+	db *sql.DB,
+) (*WebAuthnCredentialsRepositoryPostgres, *shared.Error) {
+	return &WebAuthnCredentialsRepositoryPostgres{
+		db: db,
+	}, nil
+}
@@ -1,13 +1,23 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"log"
 	"net"
+	net_http "net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
 
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
 	"github.com/AndreyArthur/oganessone/src/infrastructure/grpc"
 	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/http"
 	google_grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 func main() {
@@ -24,16 +34,118 @@ func main() {
 		log.Fatal(err)
 		return
 	}
-	googleGrpcServer := google_grpc.NewServer()
+	logger, err := factories.MakeLogger()
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	_, err = factories.MakeTracer()
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	tlsConfig, err := factories.MakeGrpcTlsConfig()
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	authenticatePrincipal, err := factories.MakeAuthenticatePrincipalUseCase()
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	authInterceptor, err := grpc.NewAuthInterceptor(authenticatePrincipal)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	options := []google_grpc.ServerOption{
+		google_grpc.ChainUnaryInterceptor(
+			grpc.UnaryTracingInterceptor, grpc.UnaryCorrelationInterceptor,
+			grpc.UnaryMetricsInterceptor, authInterceptor.Unary,
+		),
+		google_grpc.ChainStreamInterceptor(
+			grpc.StreamTracingInterceptor, grpc.StreamCorrelationInterceptor,
+			grpc.StreamMetricsInterceptor, authInterceptor.Stream,
+		),
+	}
+	if tlsConfig != nil {
+		options = append(options, google_grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	googleGrpcServer := google_grpc.NewServer(options...)
 	server, err := grpc.NewGrpcServer(googleGrpcServer)
 	if err != nil {
 		log.Fatal(err)
 		return
 	}
-	listener, goerr := net.Listen("tcp", "0.0.0.0:50051")
+	httpServer, err := http.NewHttpServer(&net_http.Server{}, authInterceptor)
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	httpAddress := os.Getenv("HTTP_ADDRESS")
+	if httpAddress == "" {
+		httpAddress = "0.0.0.0:8080"
+	}
+	httpListener, goerr := net.Listen("tcp", httpAddress)
 	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
+	go httpServer.Start(httpListener)
+	metricsServer, err := http.NewMetricsHttpServer(&net_http.Server{})
+	if err != nil {
+		log.Fatal(err)
+		return
+	}
+	metricsAddress := os.Getenv("METRICS_ADDRESS")
+	if metricsAddress == "" {
+		metricsAddress = "127.0.0.1:9464"
+	}
+	metricsListener, goerr := net.Listen("tcp", metricsAddress)
+	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
+	go metricsServer.Start(metricsListener)
+	grpcAddress := os.Getenv("GRPC_ADDRESS")
+	if grpcAddress == "" {
+		grpcAddress = "0.0.0.0:50051"
+	}
+	listener, goerr := net.Listen("tcp", grpcAddress)
+	if goerr != nil {
+		log.Fatal(goerr)
+		return
+	}
+	shutdownTimeout, err := factories.MakeShutdownTimeout()
+	if err != nil {
 		log.Fatal(err)
 		return
 	}
-	server.Start(listener)
+	go server.Start(listener)
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	received := <-signals
+	logger.Info(context.Background(), "shutting down", providers.LogFields{
+		"signal": received.String(),
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	var drained sync.WaitGroup
+	drained.Add(3)
+	go func() {
+		server.Shutdown(ctx)
+		drained.Done()
+	}()
+	go func() {
+		httpServer.Shutdown(ctx)
+		drained.Done()
+	}()
+	go func() {
+		metricsServer.Shutdown(ctx)
+		drained.Done()
+	}()
+	drained.Wait()
+	factories.CloseTracer(ctx)
+	factories.CloseDatabaseConnection()
 }
@@ -0,0 +1,20 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type AuthorizePresenterRequestBody struct {
+	Request        *AuthorizationRequestBody
+	Login          string
+	Password       string
+	OrganizationId string
+	MfaCode        string
+}
+
+type AuthorizePresenterRequest struct {
+	Headers *PresenterRequestHeaders
+	Body    *AuthorizePresenterRequestBody
+}
+
+type AuthorizePresenterResponse struct {
+	Body *views.AuthorizationView
+}
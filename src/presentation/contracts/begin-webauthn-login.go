@@ -0,0 +1,16 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type BeginWebAuthnLoginPresenterRequestBody struct {
+	Login          string
+	OrganizationId string
+}
+
+type BeginWebAuthnLoginPresenterRequest struct {
+	Body *BeginWebAuthnLoginPresenterRequestBody
+}
+
+type BeginWebAuthnLoginPresenterResponse struct {
+	Body *views.WebAuthnLoginOptionsView
+}
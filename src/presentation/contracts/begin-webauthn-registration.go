@@ -0,0 +1,11 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type BeginWebAuthnRegistrationPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+}
+
+type BeginWebAuthnRegistrationPresenterResponse struct {
+	Body *views.WebAuthnRegistrationOptionsView
+}
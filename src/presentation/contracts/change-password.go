@@ -0,0 +1,13 @@
+package contracts
+
+type ChangePasswordPresenterRequestBody struct {
+	CurrentPassword string
+	NewPassword     string
+}
+
+type ChangePasswordPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+	Body    *ChangePasswordPresenterRequestBody
+}
+
+type ChangePasswordPresenterResponse struct{}
@@ -0,0 +1,12 @@
+package contracts
+
+type ConfirmMfaPresenterRequestBody struct {
+	Code string
+}
+
+type ConfirmMfaPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+	Body    *ConfirmMfaPresenterRequestBody
+}
+
+type ConfirmMfaPresenterResponse struct{}
@@ -0,0 +1,21 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type CreateApiKeyPresenterRequestBody struct {
+	OrganizationId string
+	ServiceAccount bool
+	Name           string
+	Scopes         []string
+	AllowedIps     []string
+	ExpiresAt      string
+}
+
+type CreateApiKeyPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+	Body    *CreateApiKeyPresenterRequestBody
+}
+
+type CreateApiKeyPresenterResponse struct {
+	Body *views.CreatedApiKeyView
+}
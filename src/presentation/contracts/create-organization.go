@@ -0,0 +1,16 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type CreateOrganizationPresenterRequestBody struct {
+	Name string
+}
+
+type CreateOrganizationPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+	Body    *CreateOrganizationPresenterRequestBody
+}
+
+type CreateOrganizationPresenterResponse struct {
+	Body *views.OrganizationView
+}
@@ -0,0 +1,18 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type CreateSessionPresenterRequestBody struct {
+	Login          string
+	Password       string
+	OrganizationId string
+}
+
+type CreateSessionPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+	Body    *CreateSessionPresenterRequestBody
+}
+
+type CreateSessionPresenterResponse struct {
+	Body *views.SessionView
+}
@@ -3,13 +3,15 @@ package contracts
 import "github.com/AndreyArthur/oganessone/src/presentation/views"
 
 type CreateUserPresenterRequestBody struct {
-	Username string
-	Email    string
-	Password string
+	Username       string
+	Email          string
+	Password       string
+	OrganizationId string
 }
 
 type CreateUserPresenterRequest struct {
-	Body *CreateUserPresenterRequestBody
+	Headers *PresenterRequestHeaders
+	Body    *CreateUserPresenterRequestBody
 }
 
 type CreateUserPresenterResponse struct {
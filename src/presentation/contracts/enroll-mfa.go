@@ -0,0 +1,11 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type EnrollMfaPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+}
+
+type EnrollMfaPresenterResponse struct {
+	Body *views.MfaEnrollmentView
+}
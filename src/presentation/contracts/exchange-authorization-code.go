@@ -0,0 +1,25 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type ClientCredentialsBody struct {
+	ClientId            string
+	ClientSecret        string
+	ClientAssertionType string
+	ClientAssertion     string
+}
+
+type ExchangeAuthorizationCodePresenterRequestBody struct {
+	Code         string
+	RedirectUri  string
+	Client       *ClientCredentialsBody
+	CodeVerifier string
+}
+
+type ExchangeAuthorizationCodePresenterRequest struct {
+	Body *ExchangeAuthorizationCodePresenterRequestBody
+}
+
+type ExchangeAuthorizationCodePresenterResponse struct {
+	Body *views.TokenView
+}
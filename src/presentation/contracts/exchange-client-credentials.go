@@ -0,0 +1,17 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type ExchangeClientCredentialsPresenterRequestBody struct {
+	Client    *ClientCredentialsBody
+	Scope     string
+	Audiences []string
+}
+
+type ExchangeClientCredentialsPresenterRequest struct {
+	Body *ExchangeClientCredentialsPresenterRequestBody
+}
+
+type ExchangeClientCredentialsPresenterResponse struct {
+	Body *views.TokenView
+}
@@ -0,0 +1,20 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type FinishWebAuthnLoginPresenterRequestBody struct {
+	CredentialId      string
+	ClientDataJson    string
+	AuthenticatorData string
+	Signature         string
+	UserHandle        string
+}
+
+type FinishWebAuthnLoginPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+	Body    *FinishWebAuthnLoginPresenterRequestBody
+}
+
+type FinishWebAuthnLoginPresenterResponse struct {
+	Body *views.SessionView
+}
@@ -0,0 +1,18 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type FinishWebAuthnRegistrationPresenterRequestBody struct {
+	ClientDataJson    string
+	AttestationObject string
+	Transports        []string
+}
+
+type FinishWebAuthnRegistrationPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+	Body    *FinishWebAuthnRegistrationPresenterRequestBody
+}
+
+type FinishWebAuthnRegistrationPresenterResponse struct {
+	Body *views.WebAuthnCredentialView
+}
@@ -0,0 +1,11 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type GetMfaStatusPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+}
+
+type GetMfaStatusPresenterResponse struct {
+	Body *views.MfaStatusView
+}
@@ -0,0 +1,9 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type GetPasswordPolicyPresenterRequest struct{}
+
+type GetPasswordPolicyPresenterResponse struct {
+	Body *views.PasswordPolicyView
+}
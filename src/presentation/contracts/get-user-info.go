@@ -0,0 +1,11 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type GetUserInfoPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+}
+
+type GetUserInfoPresenterResponse struct {
+	Body views.UserInfoView
+}
@@ -0,0 +1,7 @@
+package contracts
+
+type PresenterRequestHeaders struct {
+	SessionKey string
+	IpAddress  string
+	UserAgent  string
+}
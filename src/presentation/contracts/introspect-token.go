@@ -0,0 +1,17 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type IntrospectTokenPresenterRequestBody struct {
+	Client        *ClientCredentialsBody
+	Token         string
+	TokenTypeHint string
+}
+
+type IntrospectTokenPresenterRequest struct {
+	Body *IntrospectTokenPresenterRequestBody
+}
+
+type IntrospectTokenPresenterResponse struct {
+	Body *views.IntrospectionView
+}
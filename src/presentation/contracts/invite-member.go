@@ -0,0 +1,18 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type InviteMemberPresenterRequestBody struct {
+	OrganizationId string
+	UserId         string
+	Role           string
+}
+
+type InviteMemberPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+	Body    *InviteMemberPresenterRequestBody
+}
+
+type InviteMemberPresenterResponse struct {
+	Body *views.MembershipView
+}
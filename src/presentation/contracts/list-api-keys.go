@@ -0,0 +1,16 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type ListApiKeysPresenterRequestBody struct {
+	OrganizationId string
+}
+
+type ListApiKeysPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+	Body    *ListApiKeysPresenterRequestBody
+}
+
+type ListApiKeysPresenterResponse struct {
+	Body []*views.ApiKeyView
+}
@@ -0,0 +1,24 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+// Since and Until are RFC 3339 timestamps, empty leaves them out.
+type QueryAuditLogPresenterRequestBody struct {
+	Type           string
+	ActorId        string
+	TargetId       string
+	OrganizationId string
+	Since          string
+	Until          string
+	PageSize       int
+	PageToken      string
+}
+
+type QueryAuditLogPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+	Body    *QueryAuditLogPresenterRequestBody
+}
+
+type QueryAuditLogPresenterResponse struct {
+	Body *views.AuditEventsView
+}
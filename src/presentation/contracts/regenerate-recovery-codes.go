@@ -0,0 +1,16 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type RegenerateRecoveryCodesPresenterRequestBody struct {
+	Password string
+}
+
+type RegenerateRecoveryCodesPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+	Body    *RegenerateRecoveryCodesPresenterRequestBody
+}
+
+type RegenerateRecoveryCodesPresenterResponse struct {
+	Body *views.RecoveryCodesView
+}
@@ -0,0 +1,22 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type RegisterOAuthClientPresenterRequestBody struct {
+	OrganizationId string
+	Name           string
+	RedirectUris   []string
+	AuthMethod     string
+	PublicKey      string
+	Scopes         []string
+	Audiences      []string
+}
+
+type RegisterOAuthClientPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+	Body    *RegisterOAuthClientPresenterRequestBody
+}
+
+type RegisterOAuthClientPresenterResponse struct {
+	Body *views.RegisteredOAuthClientView
+}
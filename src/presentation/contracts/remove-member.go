@@ -0,0 +1,13 @@
+package contracts
+
+type RemoveMemberPresenterRequestBody struct {
+	OrganizationId string
+	UserId         string
+}
+
+type RemoveMemberPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+	Body    *RemoveMemberPresenterRequestBody
+}
+
+type RemoveMemberPresenterResponse struct{}
@@ -0,0 +1,16 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type RevokeApiKeyPresenterRequestBody struct {
+	ApiKeyId string
+}
+
+type RevokeApiKeyPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+	Body    *RevokeApiKeyPresenterRequestBody
+}
+
+type RevokeApiKeyPresenterResponse struct {
+	Body *views.ApiKeyView
+}
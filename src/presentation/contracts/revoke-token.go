@@ -0,0 +1,14 @@
+package contracts
+
+type RevokeTokenPresenterRequestBody struct {
+	Client        *ClientCredentialsBody
+	Token         string
+	TokenTypeHint string
+}
+
+type RevokeTokenPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+	Body    *RevokeTokenPresenterRequestBody
+}
+
+type RevokeTokenPresenterResponse struct{}
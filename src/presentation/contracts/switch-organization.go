@@ -0,0 +1,16 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type SwitchOrganizationPresenterRequestBody struct {
+	OrganizationId string
+}
+
+type SwitchOrganizationPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+	Body    *SwitchOrganizationPresenterRequestBody
+}
+
+type SwitchOrganizationPresenterResponse struct {
+	Body *views.SessionView
+}
@@ -0,0 +1,22 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type AuthorizationRequestBody struct {
+	ResponseType        string
+	ClientId            string
+	RedirectUri         string
+	Scope               string
+	State               string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+}
+
+type ValidateAuthorizationRequestPresenterRequest struct {
+	Body *AuthorizationRequestBody
+}
+
+type ValidateAuthorizationRequestPresenterResponse struct {
+	Body *views.AuthorizationRequestView
+}
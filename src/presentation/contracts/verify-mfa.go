@@ -0,0 +1,17 @@
+package contracts
+
+import "github.com/AndreyArthur/oganessone/src/presentation/views"
+
+type VerifyMfaPresenterRequestBody struct {
+	MfaToken string
+	Code     string
+}
+
+type VerifyMfaPresenterRequest struct {
+	Headers *PresenterRequestHeaders
+	Body    *VerifyMfaPresenterRequestBody
+}
+
+type VerifyMfaPresenterResponse struct {
+	Body *views.SessionView
+}
@@ -0,0 +1,48 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+type AuthorizePresenter struct {
+	authorize definitions.Authorize
+}
+
+func (authorizePresenter *AuthorizePresenter) Handle(
+	ctx context.Context,
+	request *contracts.AuthorizePresenterRequest,
+) (*contracts.AuthorizePresenterResponse, *shared.Error) {
+	result, err := authorizePresenter.authorize.
+		Execute(ctx, &definitions.AuthorizeDTO{
+			Request:        newAuthorizationRequestDTO(request.Body.Request),
+			Login:          request.Body.Login,
+			Password:       request.Body.Password,
+			OrganizationId: request.Body.OrganizationId,
+			MfaCode:        request.Body.MfaCode,
+			IpAddress:      request.Headers.IpAddress,
+			UserAgent:      request.Headers.UserAgent,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.AuthorizePresenterResponse{
+		Body: &views.AuthorizationView{
+			RedirectUri: result.RedirectUri,
+			Code:        result.Code,
+			State:       result.State,
+		},
+	}, nil
+}
+
+func NewAuthorizePresenter(
+	authorize definitions.Authorize,
+) (*AuthorizePresenter, *shared.Error) {
+	return &AuthorizePresenter{
+		authorize: authorize,
+	}, nil
+}
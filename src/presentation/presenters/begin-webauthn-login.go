@@ -0,0 +1,44 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+type BeginWebAuthnLoginPresenter struct {
+	beginWebAuthnLogin definitions.BeginWebAuthnLogin
+}
+
+func (beginWebAuthnLoginPresenter *BeginWebAuthnLoginPresenter) Handle(
+	ctx context.Context,
+	request *contracts.BeginWebAuthnLoginPresenterRequest,
+) (*contracts.BeginWebAuthnLoginPresenterResponse, *shared.Error) {
+	options, err := beginWebAuthnLoginPresenter.beginWebAuthnLogin.
+		Execute(ctx, &definitions.BeginWebAuthnLoginDTO{
+			Login:          request.Body.Login,
+			OrganizationId: request.Body.OrganizationId,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.BeginWebAuthnLoginPresenterResponse{
+		Body: &views.WebAuthnLoginOptionsView{
+			Challenge:        options.Challenge,
+			RpId:             options.RpId,
+			AllowCredentials: options.AllowCredentials,
+			Timeout:          options.Timeout.Milliseconds(),
+		},
+	}, nil
+}
+
+func NewBeginWebAuthnLoginPresenter(
+	beginWebAuthnLogin definitions.BeginWebAuthnLogin,
+) (*BeginWebAuthnLoginPresenter, *shared.Error) {
+	return &BeginWebAuthnLoginPresenter{
+		beginWebAuthnLogin: beginWebAuthnLogin,
+	}, nil
+}
@@ -0,0 +1,50 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+type BeginWebAuthnRegistrationPresenter struct {
+	beginWebAuthnRegistration definitions.BeginWebAuthnRegistration
+}
+
+func (beginWebAuthnRegistrationPresenter *BeginWebAuthnRegistrationPresenter) Handle(
+	ctx context.Context,
+	request *contracts.BeginWebAuthnRegistrationPresenterRequest,
+) (*contracts.BeginWebAuthnRegistrationPresenterResponse, *shared.Error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	options, err := beginWebAuthnRegistrationPresenter.beginWebAuthnRegistration.
+		Execute(ctx, &definitions.BeginWebAuthnRegistrationDTO{
+			ActorId: principal.UserId,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.BeginWebAuthnRegistrationPresenterResponse{
+		Body: &views.WebAuthnRegistrationOptionsView{
+			Challenge:          options.Challenge,
+			RpId:               options.RpId,
+			RpName:             options.RpName,
+			UserHandle:         options.UserHandle,
+			UserName:           options.UserName,
+			ExcludeCredentials: options.ExcludeCredentials,
+			Timeout:            options.Timeout.Milliseconds(),
+		},
+	}, nil
+}
+
+func NewBeginWebAuthnRegistrationPresenter(
+	beginWebAuthnRegistration definitions.BeginWebAuthnRegistration,
+) (*BeginWebAuthnRegistrationPresenter, *shared.Error) {
+	return &BeginWebAuthnRegistrationPresenter{
+		beginWebAuthnRegistration: beginWebAuthnRegistration,
+	}, nil
+}
@@ -0,0 +1,43 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+type ChangePasswordPresenter struct {
+	changePassword definitions.ChangePassword
+}
+
+func (changePasswordPresenter *ChangePasswordPresenter) Handle(
+	ctx context.Context,
+	request *contracts.ChangePasswordPresenterRequest,
+) (*contracts.ChangePasswordPresenterResponse, *shared.Error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = changePasswordPresenter.changePassword.
+		Execute(ctx, &definitions.ChangePasswordDTO{
+			ActorId:         principal.UserId,
+			CurrentPassword: request.Body.CurrentPassword,
+			NewPassword:     request.Body.NewPassword,
+			IpAddress:       request.Headers.IpAddress,
+			UserAgent:       request.Headers.UserAgent,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.ChangePasswordPresenterResponse{}, nil
+}
+
+func NewChangePasswordPresenter(
+	changePassword definitions.ChangePassword,
+) (*ChangePasswordPresenter, *shared.Error) {
+	return &ChangePasswordPresenter{
+		changePassword: changePassword,
+	}, nil
+}
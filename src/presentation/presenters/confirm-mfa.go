@@ -0,0 +1,40 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+type ConfirmMfaPresenter struct {
+	confirmMfa definitions.ConfirmMfa
+}
+
+func (confirmMfaPresenter *ConfirmMfaPresenter) Handle(
+	ctx context.Context,
+	request *contracts.ConfirmMfaPresenterRequest,
+) (*contracts.ConfirmMfaPresenterResponse, *shared.Error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = confirmMfaPresenter.confirmMfa.
+		Execute(ctx, &definitions.ConfirmMfaDTO{
+			ActorId: principal.UserId,
+			Code:    request.Body.Code,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.ConfirmMfaPresenterResponse{}, nil
+}
+
+func NewConfirmMfaPresenter(
+	confirmMfa definitions.ConfirmMfa,
+) (*ConfirmMfaPresenter, *shared.Error) {
+	return &ConfirmMfaPresenter{
+		confirmMfa: confirmMfa,
+	}, nil
+}
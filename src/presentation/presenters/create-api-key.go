@@ -0,0 +1,86 @@
+package presenters
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+func formatOptionalTime(value time.Time) string {
+	if value == (time.Time{}) {
+		return ""
+	}
+	return value.Format(time.RFC3339)
+}
+
+func newApiKeyView(apiKey *entities.ApiKeyEntity) *views.ApiKeyView {
+	return &views.ApiKeyView{
+		Id:             apiKey.Id,
+		UserId:         apiKey.UserId,
+		OrganizationId: apiKey.OrganizationId,
+		Name:           apiKey.Name,
+		Prefix:         apiKey.Prefix,
+		Scopes:         apiKey.Scopes,
+		AllowedIps:     apiKey.AllowedIps,
+		ExpiresAt:      formatOptionalTime(apiKey.ExpiresAt),
+		RevokedAt:      formatOptionalTime(apiKey.RevokedAt),
+		CreatedAt:      apiKey.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:      apiKey.UpdatedAt.Format(time.RFC3339),
+	}
+}
+
+type CreateApiKeyPresenter struct {
+	createApiKey definitions.CreateApiKey
+}
+
+func (createApiKeyPresenter *CreateApiKeyPresenter) Handle(
+	ctx context.Context,
+	request *contracts.CreateApiKeyPresenterRequest,
+) (*contracts.CreateApiKeyPresenterResponse, *shared.Error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var expiresAt time.Time
+	if request.Body.ExpiresAt != "" {
+		parsed, goerr := time.Parse(time.RFC3339, request.Body.ExpiresAt)
+		if goerr != nil {
+			return nil, exceptions.NewInvalidApiKeyExpiration()
+		}
+		expiresAt = parsed.UTC()
+	}
+	result, err := createApiKeyPresenter.createApiKey.
+		Execute(ctx, &definitions.CreateApiKeyDTO{
+			ActorId:        principal.UserId,
+			ActorScopes:    principal.Scopes,
+			OrganizationId: request.Body.OrganizationId,
+			ServiceAccount: request.Body.ServiceAccount,
+			Name:           request.Body.Name,
+			Scopes:         request.Body.Scopes,
+			AllowedIps:     request.Body.AllowedIps,
+			ExpiresAt:      expiresAt,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.CreateApiKeyPresenterResponse{
+		Body: &views.CreatedApiKeyView{
+			Key:    result.Key,
+			ApiKey: newApiKeyView(result.ApiKey),
+		},
+	}, nil
+}
+
+func NewCreateApiKeyPresenter(
+	createApiKey definitions.CreateApiKey,
+) (*CreateApiKeyPresenter, *shared.Error) {
+	return &CreateApiKeyPresenter{
+		createApiKey: createApiKey,
+	}, nil
+}
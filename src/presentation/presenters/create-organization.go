@@ -0,0 +1,49 @@
+package presenters
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+type CreateOrganizationPresenter struct {
+	createOrganization definitions.CreateOrganization
+}
+
+func (createOrganizationPresenter *CreateOrganizationPresenter) Handle(
+	ctx context.Context,
+	request *contracts.CreateOrganizationPresenterRequest,
+) (*contracts.CreateOrganizationPresenterResponse, *shared.Error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	organization, err := createOrganizationPresenter.createOrganization.
+		Execute(ctx, &definitions.CreateOrganizationDTO{
+			ActorId: principal.UserId,
+			Name:    request.Body.Name,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.CreateOrganizationPresenterResponse{
+		Body: &views.OrganizationView{
+			Id:        organization.Id,
+			Name:      organization.Name,
+			CreatedAt: organization.CreatedAt.Format(time.RFC3339),
+			UpdatedAt: organization.UpdatedAt.Format(time.RFC3339),
+		},
+	}, nil
+}
+
+func NewCreateOrganizationPresenter(
+	createOrganization definitions.CreateOrganization,
+) (*CreateOrganizationPresenter, *shared.Error) {
+	return &CreateOrganizationPresenter{
+		createOrganization: createOrganization,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package presenters
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+func newSessionView(result *definitions.CreateSessionResult) *views.SessionView {
+	user := result.User
+	return &views.SessionView{
+		SessionKey:     result.SessionKey,
+		OrganizationId: result.OrganizationId,
+		User: &views.UserView{
+			Id:             user.Id,
+			OrganizationId: user.OrganizationId,
+			Username:       user.Username,
+			Email:          user.Email,
+			CreatedAt:      user.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:      user.UpdatedAt.Format(time.RFC3339),
+		},
+		MfaRequired: result.MfaRequired,
+		MfaToken:    result.MfaToken,
+	}
+}
+
+type CreateSessionPresenter struct {
+	createSession definitions.CreateSession
+}
+
+func (createSessionPresenter *CreateSessionPresenter) Handle(
+	ctx context.Context,
+	request *contracts.CreateSessionPresenterRequest,
+) (*contracts.CreateSessionPresenterResponse, *shared.Error) {
+	result, err := createSessionPresenter.createSession.
+		Execute(ctx, &definitions.CreateSessionDTO{
+			Login:          request.Body.Login,
+			Password:       request.Body.Password,
+			OrganizationId: request.Body.OrganizationId,
+			IpAddress:      request.Headers.IpAddress,
+			UserAgent:      request.Headers.UserAgent,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.CreateSessionPresenterResponse{
+		Body: newSessionView(result),
+	}, nil
+}
+
+func NewCreateSessionPresenter(
+	createSession definitions.CreateSession,
+) (*CreateSessionPresenter, *shared.Error) {
+	return &CreateSessionPresenter{
+		createSession: createSession,
+	}, nil
+}
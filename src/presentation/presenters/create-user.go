@@ -1,9 +1,11 @@
 package presenters
 
 import (
+	"context"
 	"time"
 
 	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
 	"github.com/AndreyArthur/oganessone/src/core/shared"
 	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
 	"github.com/AndreyArthur/oganessone/src/presentation/views"
@@ -11,35 +13,46 @@ import (
 
 type CreateUserPresenter struct {
 	createUser definitions.CreateUser
+	tracer     providers.TracerProvider
 }
 
 func (createUserPresenter *CreateUserPresenter) Handle(
+	ctx context.Context,
 	request *contracts.CreateUserPresenterRequest,
 ) (*contracts.CreateUserPresenterResponse, *shared.Error) {
+	ctx, span := createUserPresenter.tracer.Start(ctx, "CreateUserPresenter.Handle")
+	defer span.End()
 	user, err := createUserPresenter.createUser.
-		Execute(&definitions.CreateUserDTO{
-			Username: request.Body.Username,
-			Email:    request.Body.Email,
-			Password: request.Body.Password,
+		Execute(ctx, &definitions.CreateUserDTO{
+			Username:       request.Body.Username,
+			Email:          request.Body.Email,
+			Password:       request.Body.Password,
+			OrganizationId: request.Body.OrganizationId,
+			IpAddress:      request.Headers.IpAddress,
+			UserAgent:      request.Headers.UserAgent,
 		})
 	if err != nil {
+		span.RecordError(err)
 		return nil, err
 	}
 	return &contracts.CreateUserPresenterResponse{
 		Body: &views.UserView{
-			Id:        user.Id,
-			Username:  user.Username,
-			Email:     user.Email,
-			CreatedAt: user.CreatedAt.Format(time.RFC3339),
-			UpdatedAt: user.UpdatedAt.Format(time.RFC3339),
+			Id:             user.Id,
+			OrganizationId: user.OrganizationId,
+			Username:       user.Username,
+			Email:          user.Email,
+			CreatedAt:      user.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:      user.UpdatedAt.Format(time.RFC3339),
 		},
 	}, nil
 }
 
 func NewCreateUserPresenter(
 	createUser definitions.CreateUser,
+	tracer providers.TracerProvider,
 ) (*CreateUserPresenter, *shared.Error) {
 	return &CreateUserPresenter{
 		createUser: createUser,
+		tracer:     tracer,
 	}, nil
 }
@@ -0,0 +1,46 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+type EnrollMfaPresenter struct {
+	enrollMfa definitions.EnrollMfa
+}
+
+func (enrollMfaPresenter *EnrollMfaPresenter) Handle(
+	ctx context.Context,
+	request *contracts.EnrollMfaPresenterRequest,
+) (*contracts.EnrollMfaPresenterResponse, *shared.Error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	enrollment, err := enrollMfaPresenter.enrollMfa.
+		Execute(ctx, &definitions.EnrollMfaDTO{
+			ActorId: principal.UserId,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.EnrollMfaPresenterResponse{
+		Body: &views.MfaEnrollmentView{
+			Secret:        enrollment.Secret,
+			Uri:           enrollment.Uri,
+			RecoveryCodes: enrollment.RecoveryCodes,
+		},
+	}, nil
+}
+
+func NewEnrollMfaPresenter(
+	enrollMfa definitions.EnrollMfa,
+) (*EnrollMfaPresenter, *shared.Error) {
+	return &EnrollMfaPresenter{
+		enrollMfa: enrollMfa,
+	}, nil
+}
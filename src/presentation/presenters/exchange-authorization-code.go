@@ -0,0 +1,62 @@
+package presenters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+func newAuthenticateOAuthClientDTO(
+	body *contracts.ClientCredentialsBody,
+) *definitions.AuthenticateOAuthClientDTO {
+	if body == nil {
+		return nil
+	}
+	return &definitions.AuthenticateOAuthClientDTO{
+		ClientId:            body.ClientId,
+		ClientSecret:        body.ClientSecret,
+		ClientAssertionType: body.ClientAssertionType,
+		ClientAssertion:     body.ClientAssertion,
+	}
+}
+
+type ExchangeAuthorizationCodePresenter struct {
+	exchangeAuthorizationCode definitions.ExchangeAuthorizationCode
+}
+
+func (exchangeAuthorizationCodePresenter *ExchangeAuthorizationCodePresenter) Handle(
+	ctx context.Context,
+	request *contracts.ExchangeAuthorizationCodePresenterRequest,
+) (*contracts.ExchangeAuthorizationCodePresenterResponse, *shared.Error) {
+	result, err := exchangeAuthorizationCodePresenter.exchangeAuthorizationCode.
+		Execute(ctx, &definitions.ExchangeAuthorizationCodeDTO{
+			Code:         request.Body.Code,
+			RedirectUri:  request.Body.RedirectUri,
+			Client:       newAuthenticateOAuthClientDTO(request.Body.Client),
+			CodeVerifier: request.Body.CodeVerifier,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.ExchangeAuthorizationCodePresenterResponse{
+		Body: &views.TokenView{
+			AccessToken: result.AccessToken,
+			TokenType:   result.TokenType,
+			ExpiresIn:   result.ExpiresIn,
+			Scope:       strings.Join(result.Scopes, " "),
+			IdToken:     result.IdToken,
+		},
+	}, nil
+}
+
+func NewExchangeAuthorizationCodePresenter(
+	exchangeAuthorizationCode definitions.ExchangeAuthorizationCode,
+) (*ExchangeAuthorizationCodePresenter, *shared.Error) {
+	return &ExchangeAuthorizationCodePresenter{
+		exchangeAuthorizationCode: exchangeAuthorizationCode,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package presenters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+type ExchangeClientCredentialsPresenter struct {
+	exchangeClientCredentials definitions.ExchangeClientCredentials
+}
+
+func (exchangeClientCredentialsPresenter *ExchangeClientCredentialsPresenter) Handle(
+	ctx context.Context,
+	request *contracts.ExchangeClientCredentialsPresenterRequest,
+) (*contracts.ExchangeClientCredentialsPresenterResponse, *shared.Error) {
+	result, err := exchangeClientCredentialsPresenter.exchangeClientCredentials.
+		Execute(ctx, &definitions.ExchangeClientCredentialsDTO{
+			Client:    newAuthenticateOAuthClientDTO(request.Body.Client),
+			Scope:     request.Body.Scope,
+			Audiences: request.Body.Audiences,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.ExchangeClientCredentialsPresenterResponse{
+		Body: &views.TokenView{
+			AccessToken: result.AccessToken,
+			TokenType:   result.TokenType,
+			ExpiresIn:   result.ExpiresIn,
+			Scope:       strings.Join(result.Scopes, " "),
+			Audiences:   result.Audiences,
+		},
+	}, nil
+}
+
+func NewExchangeClientCredentialsPresenter(
+	exchangeClientCredentials definitions.ExchangeClientCredentials,
+) (*ExchangeClientCredentialsPresenter, *shared.Error) {
+	return &ExchangeClientCredentialsPresenter{
+		exchangeClientCredentials: exchangeClientCredentials,
+	}, nil
+}
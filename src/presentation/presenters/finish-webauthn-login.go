@@ -0,0 +1,43 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+type FinishWebAuthnLoginPresenter struct {
+	finishWebAuthnLogin definitions.FinishWebAuthnLogin
+}
+
+func (finishWebAuthnLoginPresenter *FinishWebAuthnLoginPresenter) Handle(
+	ctx context.Context,
+	request *contracts.FinishWebAuthnLoginPresenterRequest,
+) (*contracts.FinishWebAuthnLoginPresenterResponse, *shared.Error) {
+	result, err := finishWebAuthnLoginPresenter.finishWebAuthnLogin.
+		Execute(ctx, &definitions.FinishWebAuthnLoginDTO{
+			CredentialId:      request.Body.CredentialId,
+			ClientDataJson:    request.Body.ClientDataJson,
+			AuthenticatorData: request.Body.AuthenticatorData,
+			Signature:         request.Body.Signature,
+			UserHandle:        request.Body.UserHandle,
+			IpAddress:         request.Headers.IpAddress,
+			UserAgent:         request.Headers.UserAgent,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.FinishWebAuthnLoginPresenterResponse{
+		Body: newSessionView(result),
+	}, nil
+}
+
+func NewFinishWebAuthnLoginPresenter(
+	finishWebAuthnLogin definitions.FinishWebAuthnLogin,
+) (*FinishWebAuthnLoginPresenter, *shared.Error) {
+	return &FinishWebAuthnLoginPresenter{
+		finishWebAuthnLogin: finishWebAuthnLogin,
+	}, nil
+}
@@ -0,0 +1,53 @@
+package presenters
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+type FinishWebAuthnRegistrationPresenter struct {
+	finishWebAuthnRegistration definitions.FinishWebAuthnRegistration
+}
+
+func (finishWebAuthnRegistrationPresenter *FinishWebAuthnRegistrationPresenter) Handle(
+	ctx context.Context,
+	request *contracts.FinishWebAuthnRegistrationPresenterRequest,
+) (*contracts.FinishWebAuthnRegistrationPresenterResponse, *shared.Error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	credential, err := finishWebAuthnRegistrationPresenter.finishWebAuthnRegistration.
+		Execute(ctx, &definitions.FinishWebAuthnRegistrationDTO{
+			ActorId:           principal.UserId,
+			ClientDataJson:    request.Body.ClientDataJson,
+			AttestationObject: request.Body.AttestationObject,
+			Transports:        request.Body.Transports,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.FinishWebAuthnRegistrationPresenterResponse{
+		Body: &views.WebAuthnCredentialView{
+			Id:           credential.Id,
+			UserId:       credential.UserId,
+			CredentialId: credential.CredentialId,
+			Transports:   credential.Transports,
+			CreatedAt:    credential.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:    credential.UpdatedAt.Format(time.RFC3339),
+		},
+	}, nil
+}
+
+func NewFinishWebAuthnRegistrationPresenter(
+	finishWebAuthnRegistration definitions.FinishWebAuthnRegistration,
+) (*FinishWebAuthnRegistrationPresenter, *shared.Error) {
+	return &FinishWebAuthnRegistrationPresenter{
+		finishWebAuthnRegistration: finishWebAuthnRegistration,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+type GetMfaStatusPresenter struct {
+	getMfaStatus definitions.GetMfaStatus
+}
+
+func (getMfaStatusPresenter *GetMfaStatusPresenter) Handle(
+	ctx context.Context,
+	request *contracts.GetMfaStatusPresenterRequest,
+) (*contracts.GetMfaStatusPresenterResponse, *shared.Error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status, err := getMfaStatusPresenter.getMfaStatus.
+		Execute(ctx, &definitions.GetMfaStatusDTO{
+			ActorId: principal.UserId,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.GetMfaStatusPresenterResponse{
+		Body: &views.MfaStatusView{
+			Enabled:                status.Enabled,
+			RecoveryCodesRemaining: status.RecoveryCodesRemaining,
+		},
+	}, nil
+}
+
+func NewGetMfaStatusPresenter(
+	getMfaStatus definitions.GetMfaStatus,
+) (*GetMfaStatusPresenter, *shared.Error) {
+	return &GetMfaStatusPresenter{
+		getMfaStatus: getMfaStatus,
+	}, nil
+}
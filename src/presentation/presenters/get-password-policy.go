@@ -0,0 +1,43 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+type GetPasswordPolicyPresenter struct {
+	getPasswordPolicy definitions.GetPasswordPolicy
+}
+
+func (getPasswordPolicyPresenter *GetPasswordPolicyPresenter) Handle(
+	ctx context.Context,
+	request *contracts.GetPasswordPolicyPresenterRequest,
+) (*contracts.GetPasswordPolicyPresenterResponse, *shared.Error) {
+	policy, err := getPasswordPolicyPresenter.getPasswordPolicy.
+		Execute(ctx, &definitions.GetPasswordPolicyDTO{})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.GetPasswordPolicyPresenterResponse{
+		Body: &views.PasswordPolicyView{
+			MinLength:               policy.MinLength,
+			MaxLength:               policy.MaxLength,
+			RequiredClasses:         policy.RequiredClasses,
+			MaxRepeatedCharacters:   policy.MaxRepeatedCharacters,
+			DisallowUserInfo:        policy.DisallowUserInfo,
+			DisallowDictionaryWords: policy.DisallowDictionaryWords,
+		},
+	}, nil
+}
+
+func NewGetPasswordPolicyPresenter(
+	getPasswordPolicy definitions.GetPasswordPolicy,
+) (*GetPasswordPolicyPresenter, *shared.Error) {
+	return &GetPasswordPolicyPresenter{
+		getPasswordPolicy: getPasswordPolicy,
+	}, nil
+}
@@ -0,0 +1,42 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+type GetUserInfoPresenter struct {
+	getUserInfo definitions.GetUserInfo
+}
+
+func (getUserInfoPresenter *GetUserInfoPresenter) Handle(
+	ctx context.Context,
+	request *contracts.GetUserInfoPresenterRequest,
+) (*contracts.GetUserInfoPresenterResponse, *shared.Error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	claims, err := getUserInfoPresenter.getUserInfo.
+		Execute(ctx, &definitions.GetUserInfoDTO{
+			UserId: principal.UserId,
+			Scopes: principal.Scopes,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.GetUserInfoPresenterResponse{
+		Body: claims,
+	}, nil
+}
+
+func NewGetUserInfoPresenter(
+	getUserInfo definitions.GetUserInfo,
+) (*GetUserInfoPresenter, *shared.Error) {
+	return &GetUserInfoPresenter{
+		getUserInfo: getUserInfo,
+	}, nil
+}
@@ -0,0 +1,55 @@
+package presenters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+type IntrospectTokenPresenter struct {
+	introspectToken definitions.IntrospectToken
+}
+
+func (introspectTokenPresenter *IntrospectTokenPresenter) Handle(
+	ctx context.Context,
+	request *contracts.IntrospectTokenPresenterRequest,
+) (*contracts.IntrospectTokenPresenterResponse, *shared.Error) {
+	result, err := introspectTokenPresenter.introspectToken.
+		Execute(ctx, &definitions.IntrospectTokenDTO{
+			Client:        newAuthenticateOAuthClientDTO(request.Body.Client),
+			Token:         request.Body.Token,
+			TokenTypeHint: request.Body.TokenTypeHint,
+		})
+	if err != nil {
+		return nil, err
+	}
+	if !result.Active {
+		return &contracts.IntrospectTokenPresenterResponse{
+			Body: &views.IntrospectionView{Active: false},
+		}, nil
+	}
+	return &contracts.IntrospectTokenPresenterResponse{
+		Body: &views.IntrospectionView{
+			Active:         true,
+			Subject:        result.Subject,
+			ClientId:       result.ClientId,
+			OrganizationId: result.OrganizationId,
+			Scope:          strings.Join(result.Scopes, " "),
+			Audiences:      result.Audiences,
+			ExpiresAt:      result.ExpiresAt,
+			TokenType:      "Bearer",
+		},
+	}, nil
+}
+
+func NewIntrospectTokenPresenter(
+	introspectToken definitions.IntrospectToken,
+) (*IntrospectTokenPresenter, *shared.Error) {
+	return &IntrospectTokenPresenter{
+		introspectToken: introspectToken,
+	}, nil
+}
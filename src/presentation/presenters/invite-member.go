@@ -0,0 +1,55 @@
+package presenters
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+type InviteMemberPresenter struct {
+	inviteMember definitions.InviteMember
+}
+
+func (inviteMemberPresenter *InviteMemberPresenter) Handle(
+	ctx context.Context,
+	request *contracts.InviteMemberPresenterRequest,
+) (*contracts.InviteMemberPresenterResponse, *shared.Error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	membership, err := inviteMemberPresenter.inviteMember.
+		Execute(ctx, &definitions.InviteMemberDTO{
+			ActorId:        principal.UserId,
+			OrganizationId: request.Body.OrganizationId,
+			UserId:         request.Body.UserId,
+			Role:           request.Body.Role,
+			IpAddress:      request.Headers.IpAddress,
+			UserAgent:      request.Headers.UserAgent,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.InviteMemberPresenterResponse{
+		Body: &views.MembershipView{
+			Id:             membership.Id,
+			OrganizationId: membership.OrganizationId,
+			UserId:         membership.UserId,
+			Role:           membership.Role,
+			CreatedAt:      membership.CreatedAt.Format(time.RFC3339),
+			UpdatedAt:      membership.UpdatedAt.Format(time.RFC3339),
+		},
+	}, nil
+}
+
+func NewInviteMemberPresenter(
+	inviteMember definitions.InviteMember,
+) (*InviteMemberPresenter, *shared.Error) {
+	return &InviteMemberPresenter{
+		inviteMember: inviteMember,
+	}, nil
+}
@@ -0,0 +1,47 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+type ListApiKeysPresenter struct {
+	listApiKeys definitions.ListApiKeys
+}
+
+func (listApiKeysPresenter *ListApiKeysPresenter) Handle(
+	ctx context.Context,
+	request *contracts.ListApiKeysPresenterRequest,
+) (*contracts.ListApiKeysPresenterResponse, *shared.Error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	apiKeys, err := listApiKeysPresenter.listApiKeys.
+		Execute(ctx, &definitions.ListApiKeysDTO{
+			ActorId:        principal.UserId,
+			OrganizationId: request.Body.OrganizationId,
+		})
+	if err != nil {
+		return nil, err
+	}
+	body := []*views.ApiKeyView{}
+	for _, apiKey := range apiKeys {
+		body = append(body, newApiKeyView(apiKey))
+	}
+	return &contracts.ListApiKeysPresenterResponse{
+		Body: body,
+	}, nil
+}
+
+func NewListApiKeysPresenter(
+	listApiKeys definitions.ListApiKeys,
+) (*ListApiKeysPresenter, *shared.Error) {
+	return &ListApiKeysPresenter{
+		listApiKeys: listApiKeys,
+	}, nil
+}
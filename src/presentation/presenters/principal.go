@@ -0,0 +1,19 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+)
+
+// The transports authenticate the caller against the policy of the method
+// before handing the request over, a presenter only reads the result.
+func principalFromContext(ctx context.Context) (*definitions.AuthenticatePrincipalResult, *shared.Error) {
+	principal, ok := definitions.PrincipalFromContext(ctx)
+	if !ok {
+		return nil, exceptions.NewSessionNotFound()
+	}
+	return principal, nil
+}
@@ -0,0 +1,94 @@
+package presenters
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+func newAuditEventView(auditEvent *entities.AuditEventEntity) *views.AuditEventView {
+	return &views.AuditEventView{
+		Id:             auditEvent.Id,
+		Type:           auditEvent.Type,
+		ActorId:        auditEvent.ActorId,
+		TargetId:       auditEvent.TargetId,
+		OrganizationId: auditEvent.OrganizationId,
+		IpAddress:      auditEvent.IpAddress,
+		UserAgent:      auditEvent.UserAgent,
+		Detail:         auditEvent.Detail,
+		CreatedAt:      auditEvent.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func parseOptionalTime(value string) (time.Time, *shared.Error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	parsed, goerr := time.Parse(time.RFC3339, value)
+	if goerr != nil {
+		return time.Time{}, exceptions.NewInvalidAuditLogQuery()
+	}
+	return parsed.UTC(), nil
+}
+
+type QueryAuditLogPresenter struct {
+	queryAuditLog definitions.QueryAuditLog
+}
+
+func (queryAuditLogPresenter *QueryAuditLogPresenter) Handle(
+	ctx context.Context,
+	request *contracts.QueryAuditLogPresenterRequest,
+) (*contracts.QueryAuditLogPresenterResponse, *shared.Error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	since, err := parseOptionalTime(request.Body.Since)
+	if err != nil {
+		return nil, err
+	}
+	until, err := parseOptionalTime(request.Body.Until)
+	if err != nil {
+		return nil, err
+	}
+	result, err := queryAuditLogPresenter.queryAuditLog.
+		Execute(ctx, &definitions.QueryAuditLogDTO{
+			ActorId:              principal.UserId,
+			OrganizationId:       principal.OrganizationId,
+			Type:                 request.Body.Type,
+			FilterActorId:        request.Body.ActorId,
+			FilterTargetId:       request.Body.TargetId,
+			FilterOrganizationId: request.Body.OrganizationId,
+			Since:                since,
+			Until:                until,
+			PageSize:             request.Body.PageSize,
+			PageToken:            request.Body.PageToken,
+		})
+	if err != nil {
+		return nil, err
+	}
+	events := []*views.AuditEventView{}
+	for _, auditEvent := range result.Events {
+		events = append(events, newAuditEventView(auditEvent))
+	}
+	return &contracts.QueryAuditLogPresenterResponse{
+		Body: &views.AuditEventsView{
+			Events:        events,
+			NextPageToken: result.NextPageToken,
+		},
+	}, nil
+}
+
+func NewQueryAuditLogPresenter(
+	queryAuditLog definitions.QueryAuditLog,
+) (*QueryAuditLogPresenter, *shared.Error) {
+	return &QueryAuditLogPresenter{
+		queryAuditLog: queryAuditLog,
+	}, nil
+}
@@ -0,0 +1,45 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+type RegenerateRecoveryCodesPresenter struct {
+	regenerateRecoveryCodes definitions.RegenerateRecoveryCodes
+}
+
+func (regenerateRecoveryCodesPresenter *RegenerateRecoveryCodesPresenter) Handle(
+	ctx context.Context,
+	request *contracts.RegenerateRecoveryCodesPresenterRequest,
+) (*contracts.RegenerateRecoveryCodesPresenterResponse, *shared.Error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := regenerateRecoveryCodesPresenter.regenerateRecoveryCodes.
+		Execute(ctx, &definitions.RegenerateRecoveryCodesDTO{
+			ActorId:  principal.UserId,
+			Password: request.Body.Password,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.RegenerateRecoveryCodesPresenterResponse{
+		Body: &views.RecoveryCodesView{
+			RecoveryCodes: result.RecoveryCodes,
+		},
+	}, nil
+}
+
+func NewRegenerateRecoveryCodesPresenter(
+	regenerateRecoveryCodes definitions.RegenerateRecoveryCodes,
+) (*RegenerateRecoveryCodesPresenter, *shared.Error) {
+	return &RegenerateRecoveryCodesPresenter{
+		regenerateRecoveryCodes: regenerateRecoveryCodes,
+	}, nil
+}
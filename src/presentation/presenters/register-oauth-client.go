@@ -0,0 +1,64 @@
+package presenters
+
+import (
+	"context"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+type RegisterOAuthClientPresenter struct {
+	registerOAuthClient definitions.RegisterOAuthClient
+}
+
+func (registerOAuthClientPresenter *RegisterOAuthClientPresenter) Handle(
+	ctx context.Context,
+	request *contracts.RegisterOAuthClientPresenterRequest,
+) (*contracts.RegisterOAuthClientPresenterResponse, *shared.Error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := registerOAuthClientPresenter.registerOAuthClient.
+		Execute(ctx, &definitions.RegisterOAuthClientDTO{
+			ActorId:        principal.UserId,
+			OrganizationId: request.Body.OrganizationId,
+			Name:           request.Body.Name,
+			RedirectUris:   request.Body.RedirectUris,
+			AuthMethod:     request.Body.AuthMethod,
+			PublicKey:      request.Body.PublicKey,
+			Scopes:         request.Body.Scopes,
+			Audiences:      request.Body.Audiences,
+		})
+	if err != nil {
+		return nil, err
+	}
+	client := result.Client
+	return &contracts.RegisterOAuthClientPresenterResponse{
+		Body: &views.RegisteredOAuthClientView{
+			ClientSecret: result.ClientSecret,
+			Client: &views.OAuthClientView{
+				Id:             client.Id,
+				OrganizationId: client.OrganizationId,
+				Name:           client.Name,
+				RedirectUris:   client.RedirectUris,
+				AuthMethod:     client.AuthMethod,
+				Scopes:         client.Scopes,
+				Audiences:      client.Audiences,
+				CreatedAt:      client.CreatedAt.Format(time.RFC3339),
+				UpdatedAt:      client.UpdatedAt.Format(time.RFC3339),
+			},
+		},
+	}, nil
+}
+
+func NewRegisterOAuthClientPresenter(
+	registerOAuthClient definitions.RegisterOAuthClient,
+) (*RegisterOAuthClientPresenter, *shared.Error) {
+	return &RegisterOAuthClientPresenter{
+		registerOAuthClient: registerOAuthClient,
+	}, nil
+}
@@ -0,0 +1,41 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+type RemoveMemberPresenter struct {
+	removeMember definitions.RemoveMember
+}
+
+func (removeMemberPresenter *RemoveMemberPresenter) Handle(
+	ctx context.Context,
+	request *contracts.RemoveMemberPresenterRequest,
+) (*contracts.RemoveMemberPresenterResponse, *shared.Error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = removeMemberPresenter.removeMember.
+		Execute(ctx, &definitions.RemoveMemberDTO{
+			ActorId:        principal.UserId,
+			OrganizationId: request.Body.OrganizationId,
+			UserId:         request.Body.UserId,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.RemoveMemberPresenterResponse{}, nil
+}
+
+func NewRemoveMemberPresenter(
+	removeMember definitions.RemoveMember,
+) (*RemoveMemberPresenter, *shared.Error) {
+	return &RemoveMemberPresenter{
+		removeMember: removeMember,
+	}, nil
+}
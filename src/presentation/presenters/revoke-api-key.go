@@ -0,0 +1,42 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+type RevokeApiKeyPresenter struct {
+	revokeApiKey definitions.RevokeApiKey
+}
+
+func (revokeApiKeyPresenter *RevokeApiKeyPresenter) Handle(
+	ctx context.Context,
+	request *contracts.RevokeApiKeyPresenterRequest,
+) (*contracts.RevokeApiKeyPresenterResponse, *shared.Error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	apiKey, err := revokeApiKeyPresenter.revokeApiKey.
+		Execute(ctx, &definitions.RevokeApiKeyDTO{
+			ActorId:  principal.UserId,
+			ApiKeyId: request.Body.ApiKeyId,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.RevokeApiKeyPresenterResponse{
+		Body: newApiKeyView(apiKey),
+	}, nil
+}
+
+func NewRevokeApiKeyPresenter(
+	revokeApiKey definitions.RevokeApiKey,
+) (*RevokeApiKeyPresenter, *shared.Error) {
+	return &RevokeApiKeyPresenter{
+		revokeApiKey: revokeApiKey,
+	}, nil
+}
@@ -0,0 +1,39 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+type RevokeTokenPresenter struct {
+	revokeToken definitions.RevokeToken
+}
+
+func (revokeTokenPresenter *RevokeTokenPresenter) Handle(
+	ctx context.Context,
+	request *contracts.RevokeTokenPresenterRequest,
+) (*contracts.RevokeTokenPresenterResponse, *shared.Error) {
+	err := revokeTokenPresenter.revokeToken.
+		Execute(ctx, &definitions.RevokeTokenDTO{
+			Client:        newAuthenticateOAuthClientDTO(request.Body.Client),
+			Token:         request.Body.Token,
+			TokenTypeHint: request.Body.TokenTypeHint,
+			IpAddress:     request.Headers.IpAddress,
+			UserAgent:     request.Headers.UserAgent,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.RevokeTokenPresenterResponse{}, nil
+}
+
+func NewRevokeTokenPresenter(
+	revokeToken definitions.RevokeToken,
+) (*RevokeTokenPresenter, *shared.Error) {
+	return &RevokeTokenPresenter{
+		revokeToken: revokeToken,
+	}, nil
+}
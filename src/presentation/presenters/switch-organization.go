@@ -0,0 +1,47 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+type SwitchOrganizationPresenter struct {
+	switchOrganization definitions.SwitchOrganization
+}
+
+func (switchOrganizationPresenter *SwitchOrganizationPresenter) Handle(
+	ctx context.Context,
+	request *contracts.SwitchOrganizationPresenterRequest,
+) (*contracts.SwitchOrganizationPresenterResponse, *shared.Error) {
+	principal, err := principalFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result, err := switchOrganizationPresenter.switchOrganization.
+		Execute(ctx, &definitions.SwitchOrganizationDTO{
+			SessionKey:     principal.SessionKey,
+			UserId:         principal.UserId,
+			OrganizationId: request.Body.OrganizationId,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.SwitchOrganizationPresenterResponse{
+		Body: &views.SessionView{
+			SessionKey:     result.SessionKey,
+			OrganizationId: result.OrganizationId,
+		},
+	}, nil
+}
+
+func NewSwitchOrganizationPresenter(
+	switchOrganization definitions.SwitchOrganization,
+) (*SwitchOrganizationPresenter, *shared.Error) {
+	return &SwitchOrganizationPresenter{
+		switchOrganization: switchOrganization,
+	}, nil
+}
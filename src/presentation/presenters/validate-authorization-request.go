@@ -0,0 +1,62 @@
+package presenters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+	"github.com/AndreyArthur/oganessone/src/presentation/views"
+)
+
+func newAuthorizationRequestDTO(
+	body *contracts.AuthorizationRequestBody,
+) *definitions.AuthorizationRequestDTO {
+	return &definitions.AuthorizationRequestDTO{
+		ResponseType:        body.ResponseType,
+		ClientId:            body.ClientId,
+		RedirectUri:         body.RedirectUri,
+		Scope:               body.Scope,
+		State:               body.State,
+		Nonce:               body.Nonce,
+		CodeChallenge:       body.CodeChallenge,
+		CodeChallengeMethod: body.CodeChallengeMethod,
+	}
+}
+
+type ValidateAuthorizationRequestPresenter struct {
+	validateAuthorizationRequest definitions.ValidateAuthorizationRequest
+}
+
+func (validateAuthorizationRequestPresenter *ValidateAuthorizationRequestPresenter) Handle(
+	ctx context.Context,
+	request *contracts.ValidateAuthorizationRequestPresenterRequest,
+) (*contracts.ValidateAuthorizationRequestPresenterResponse, *shared.Error) {
+	result, err := validateAuthorizationRequestPresenter.validateAuthorizationRequest.
+		Execute(ctx, newAuthorizationRequestDTO(request.Body))
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.ValidateAuthorizationRequestPresenterResponse{
+		Body: &views.AuthorizationRequestView{
+			ResponseType:        request.Body.ResponseType,
+			ClientId:            result.Client.Id,
+			ClientName:          result.Client.Name,
+			RedirectUri:         request.Body.RedirectUri,
+			Scope:               strings.Join(result.Scopes, " "),
+			State:               request.Body.State,
+			Nonce:               request.Body.Nonce,
+			CodeChallenge:       request.Body.CodeChallenge,
+			CodeChallengeMethod: request.Body.CodeChallengeMethod,
+		},
+	}, nil
+}
+
+func NewValidateAuthorizationRequestPresenter(
+	validateAuthorizationRequest definitions.ValidateAuthorizationRequest,
+) (*ValidateAuthorizationRequestPresenter, *shared.Error) {
+	return &ValidateAuthorizationRequestPresenter{
+		validateAuthorizationRequest: validateAuthorizationRequest,
+	}, nil
+}
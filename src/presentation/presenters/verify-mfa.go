@@ -0,0 +1,40 @@
+package presenters
+
+import (
+	"context"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/presentation/contracts"
+)
+
+type VerifyMfaPresenter struct {
+	verifyMfa definitions.VerifyMfa
+}
+
+func (verifyMfaPresenter *VerifyMfaPresenter) Handle(
+	ctx context.Context,
+	request *contracts.VerifyMfaPresenterRequest,
+) (*contracts.VerifyMfaPresenterResponse, *shared.Error) {
+	result, err := verifyMfaPresenter.verifyMfa.
+		Execute(ctx, &definitions.VerifyMfaDTO{
+			MfaToken:  request.Body.MfaToken,
+			Code:      request.Body.Code,
+			IpAddress: request.Headers.IpAddress,
+			UserAgent: request.Headers.UserAgent,
+		})
+	if err != nil {
+		return nil, err
+	}
+	return &contracts.VerifyMfaPresenterResponse{
+		Body: newSessionView(result),
+	}, nil
+}
+
+func NewVerifyMfaPresenter(
+	verifyMfa definitions.VerifyMfa,
+) (*VerifyMfaPresenter, *shared.Error) {
+	return &VerifyMfaPresenter{
+		verifyMfa: verifyMfa,
+	}, nil
+}
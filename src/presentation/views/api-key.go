@@ -0,0 +1,20 @@
+package views
+
+type ApiKeyView struct {
+	Id             string   `json:"id"`
+	UserId         string   `json:"userId"`
+	OrganizationId string   `json:"organizationId"`
+	Name           string   `json:"name"`
+	Prefix         string   `json:"prefix"`
+	Scopes         []string `json:"scopes"`
+	AllowedIps     []string `json:"allowedIps"`
+	ExpiresAt      string   `json:"expiresAt"`
+	RevokedAt      string   `json:"revokedAt"`
+	CreatedAt      string   `json:"createdAt"`
+	UpdatedAt      string   `json:"updatedAt"`
+}
+
+type CreatedApiKeyView struct {
+	Key    string      `json:"key"`
+	ApiKey *ApiKeyView `json:"apiKey"`
+}
@@ -0,0 +1,18 @@
+package views
+
+type AuditEventView struct {
+	Id             string `json:"id"`
+	Type           string `json:"type"`
+	ActorId        string `json:"actorId"`
+	TargetId       string `json:"targetId"`
+	OrganizationId string `json:"organizationId"`
+	IpAddress      string `json:"ipAddress"`
+	UserAgent      string `json:"userAgent"`
+	Detail         string `json:"detail"`
+	CreatedAt      string `json:"createdAt"`
+}
+
+type AuditEventsView struct {
+	Events        []*AuditEventView `json:"events"`
+	NextPageToken string            `json:"nextPageToken"`
+}
@@ -0,0 +1,16 @@
+package views
+
+type MfaEnrollmentView struct {
+	Secret        string   `json:"secret"`
+	Uri           string   `json:"uri"`
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+type MfaStatusView struct {
+	Enabled                bool `json:"enabled"`
+	RecoveryCodesRemaining int  `json:"recoveryCodesRemaining"`
+}
+
+type RecoveryCodesView struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
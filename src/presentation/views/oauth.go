@@ -0,0 +1,58 @@
+package views
+
+type OAuthClientView struct {
+	Id             string   `json:"id"`
+	OrganizationId string   `json:"organizationId"`
+	Name           string   `json:"name"`
+	RedirectUris   []string `json:"redirectUris"`
+	AuthMethod     string   `json:"authMethod"`
+	Scopes         []string `json:"scopes"`
+	Audiences      []string `json:"audiences"`
+	CreatedAt      string   `json:"createdAt"`
+	UpdatedAt      string   `json:"updatedAt"`
+}
+
+type RegisteredOAuthClientView struct {
+	ClientSecret string           `json:"clientSecret"`
+	Client       *OAuthClientView `json:"client"`
+}
+
+type AuthorizationRequestView struct {
+	ResponseType        string `json:"responseType"`
+	ClientId            string `json:"clientId"`
+	ClientName          string `json:"clientName"`
+	RedirectUri         string `json:"redirectUri"`
+	Scope               string `json:"scope"`
+	State               string `json:"state"`
+	Nonce               string `json:"nonce"`
+	CodeChallenge       string `json:"codeChallenge"`
+	CodeChallengeMethod string `json:"codeChallengeMethod"`
+}
+
+type AuthorizationView struct {
+	RedirectUri string `json:"redirectUri"`
+	Code        string `json:"code"`
+	State       string `json:"state"`
+}
+
+type TokenView struct {
+	AccessToken string   `json:"accessToken"`
+	TokenType   string   `json:"tokenType"`
+	ExpiresIn   int64    `json:"expiresIn"`
+	Scope       string   `json:"scope"`
+	IdToken     string   `json:"idToken"`
+	Audiences   []string `json:"audiences"`
+}
+
+type UserInfoView = map[string]interface{}
+
+type IntrospectionView struct {
+	Active         bool     `json:"active"`
+	Subject        string   `json:"subject"`
+	ClientId       string   `json:"clientId"`
+	OrganizationId string   `json:"organizationId"`
+	Scope          string   `json:"scope"`
+	Audiences      []string `json:"audiences"`
+	ExpiresAt      int64    `json:"expiresAt"`
+	TokenType      string   `json:"tokenType"`
+}
@@ -0,0 +1,9 @@
+package views
+
+type SessionView struct {
+	SessionKey     string    `json:"sessionKey"`
+	OrganizationId string    `json:"organizationId"`
+	User           *UserView `json:"user"`
+	MfaRequired    bool      `json:"mfaRequired"`
+	MfaToken       string    `json:"mfaToken"`
+}
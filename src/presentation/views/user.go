@@ -1,9 +1,19 @@
 package views
 
 type UserView struct {
-	Id        string
-	Username  string
-	Email     string
-	CreatedAt string
-	UpdatedAt string
+	Id             string `json:"id"`
+	OrganizationId string `json:"organizationId"`
+	Username       string `json:"username"`
+	Email          string `json:"email"`
+	CreatedAt      string `json:"createdAt"`
+	UpdatedAt      string `json:"updatedAt"`
+}
+
+type PasswordPolicyView struct {
+	MinLength               int      `json:"minLength"`
+	MaxLength               int      `json:"maxLength"`
+	RequiredClasses         []string `json:"requiredClasses"`
+	MaxRepeatedCharacters   int      `json:"maxRepeatedCharacters"`
+	DisallowUserInfo        bool     `json:"disallowUserInfo"`
+	DisallowDictionaryWords bool     `json:"disallowDictionaryWords"`
 }
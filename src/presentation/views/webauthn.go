@@ -0,0 +1,27 @@
+package views
+
+type WebAuthnRegistrationOptionsView struct {
+	Challenge          string   `json:"challenge"`
+	RpId               string   `json:"rpId"`
+	RpName             string   `json:"rpName"`
+	UserHandle         string   `json:"userHandle"`
+	UserName           string   `json:"userName"`
+	ExcludeCredentials []string `json:"excludeCredentials"`
+	Timeout            int64    `json:"timeout"`
+}
+
+type WebAuthnLoginOptionsView struct {
+	Challenge        string   `json:"challenge"`
+	RpId             string   `json:"rpId"`
+	AllowCredentials []string `json:"allowCredentials"`
+	Timeout          int64    `json:"timeout"`
+}
+
+type WebAuthnCredentialView struct {
+	Id           string   `json:"id"`
+	UserId       string   `json:"userId"`
+	CredentialId string   `json:"credentialId"`
+	Transports   []string `json:"transports"`
+	CreatedAt    string   `json:"createdAt"`
+	UpdatedAt    string   `json:"updatedAt"`
+}
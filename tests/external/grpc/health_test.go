@@ -0,0 +1,81 @@
+package test_grpc
+
+import (
+	"context"
+	"log"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/grpc"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/stretchr/testify/assert"
+	google_grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type HealthGrpcTest struct{}
+
+func (*HealthGrpcTest) setup() (*grpc.GrpcServer, grpc_health_v1.HealthClient, func()) {
+	env, err := helpers.NewEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = env.Load("test")
+	if err != nil {
+		log.Fatal(err)
+	}
+	lis, goerr := net.Listen("tcp", "127.0.0.1:0")
+	if goerr != nil {
+		log.Fatal(goerr)
+	}
+	server, err := grpc.NewGrpcServer(google_grpc.NewServer())
+	if err != nil {
+		log.Fatal(err)
+	}
+	go server.Start(lis)
+	connection, goerr := google_grpc.Dial(lis.Addr().String(), google_grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if goerr != nil {
+		log.Fatal(goerr)
+	}
+	return server, grpc_health_v1.NewHealthClient(connection), func() { connection.Close() }
+}
+
+func TestGrpcHealth_ServingWithPostgres(t *testing.T) {
+	// arrange
+	server, client, closeConnection := (&HealthGrpcTest{}).setup()
+	defer server.Shutdown(context.Background())
+	defer closeConnection()
+	// act
+	response, goerr := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{
+		Service: "protobuf.UsersService",
+	})
+	// assert
+	assert.Nil(t, goerr)
+	assert.Equal(t, response.Status, grpc_health_v1.HealthCheckResponse_SERVING)
+}
+
+func TestGrpcHealth_NotServingOnceShutdownStarts(t *testing.T) {
+	// arrange
+	server, client, closeConnection := (&HealthGrpcTest{}).setup()
+	defer closeConnection()
+	watch, goerr := client.Watch(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	if goerr != nil {
+		t.Fatal(goerr)
+	}
+	before, goerr := watch.Recv()
+	if goerr != nil {
+		t.Fatal(goerr)
+	}
+	// The open watch keeps the drain waiting until the deadline.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	// act
+	go server.Shutdown(ctx)
+	after, goerr := watch.Recv()
+	// assert
+	assert.Nil(t, goerr)
+	assert.Equal(t, before.Status, grpc_health_v1.HealthCheckResponse_SERVING)
+	assert.Equal(t, after.Status, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+}
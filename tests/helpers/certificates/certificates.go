@@ -0,0 +1,64 @@
+package certificates
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// Writes a self-signed certificate for commonName and its key as name.crt
+// and name.key in dir, the certificate doubles as its own CA bundle.
+func Write(t *testing.T, dir string, name string, commonName string) (string, string) {
+	key, goerr := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if goerr != nil {
+		t.Fatal(goerr)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	certificate, goerr := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if goerr != nil {
+		t.Fatal(goerr)
+	}
+	encodedKey, goerr := x509.MarshalECPrivateKey(key)
+	if goerr != nil {
+		t.Fatal(goerr)
+	}
+	certFile, keyFile := filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key")
+	WriteFile(t, certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certificate}))
+	WriteFile(t, keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: encodedKey}))
+	return certFile, keyFile
+}
+
+// Modification times move forward a second on every write, rotations within
+// the resolution of the file system would otherwise go unnoticed.
+func WriteFile(t *testing.T, filename string, contents []byte) {
+	modTime := time.Now()
+	info, goerr := os.Stat(filename)
+	if goerr == nil {
+		modTime = info.ModTime().Add(time.Second)
+	}
+	goerr = os.WriteFile(filename, contents, 0600)
+	if goerr != nil {
+		t.Fatal(goerr)
+	}
+	goerr = os.Chtimes(filename, modTime, modTime)
+	if goerr != nil {
+		t.Fatal(goerr)
+	}
+}
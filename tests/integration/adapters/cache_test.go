@@ -0,0 +1,61 @@
+package test_adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheAdapter_SetGetDelete(t *testing.T) {
+	// arrange
+	cache, _ := adapters.NewCacheAdapter()
+	key, value := "key", "value"
+	// act
+	setErr := cache.Set(context.Background(), key, value, time.Now().Add(time.Hour))
+	found, getErr := cache.Get(context.Background(), key)
+	deleteErr := cache.Delete(context.Background(), key)
+	deleted, _ := cache.Get(context.Background(), key)
+	// assert
+	assert.Nil(t, setErr)
+	assert.Nil(t, getErr)
+	assert.Nil(t, deleteErr)
+	assert.Equal(t, found, value)
+	assert.Equal(t, deleted, "")
+}
+
+func TestCacheAdapter_ExpiredEntriesReadAsMissing(t *testing.T) {
+	// arrange
+	cache, _ := adapters.NewCacheAdapter()
+	cache.Set(context.Background(), "expired", "value", time.Now().Add(-time.Second))
+	cache.Set(context.Background(), "live", "value", time.Now().Add(time.Hour))
+	// act
+	expired, expiredErr := cache.Get(context.Background(), "expired")
+	live, liveErr := cache.Get(context.Background(), "live")
+	count := cache.Count(func(key string, value string) bool {
+		return true
+	})
+	// assert
+	assert.Nil(t, expiredErr)
+	assert.Nil(t, liveErr)
+	assert.Equal(t, expired, "")
+	assert.Equal(t, live, "value")
+	assert.Equal(t, count, 1)
+}
+
+func TestCacheAdapter_Count(t *testing.T) {
+	// arrange
+	cache, _ := adapters.NewCacheAdapter()
+	expiresAt := time.Now().Add(time.Hour)
+	cache.Set(context.Background(), "first", "match", expiresAt)
+	cache.Set(context.Background(), "second", "match", expiresAt)
+	cache.Set(context.Background(), "third", "other", expiresAt)
+	// act
+	count := cache.Count(func(key string, value string) bool {
+		return value == "match"
+	})
+	// assert
+	assert.Equal(t, count, 2)
+}
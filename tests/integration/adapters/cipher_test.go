@@ -0,0 +1,37 @@
+package test_adapters
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCipherAdapter_EncryptAndDecrypt(t *testing.T) {
+	// arrange
+	cipher, _ := adapters.NewCipherAdapter(bytes.Repeat([]byte{7}, 32))
+	otherCipher, _ := adapters.NewCipherAdapter(bytes.Repeat([]byte{8}, 32))
+	text := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	// act
+	ciphertext, err := cipher.Encrypt(text)
+	otherCiphertext, _ := cipher.Encrypt(text)
+	decrypted, decryptError := cipher.Decrypt(ciphertext)
+	_, otherKeyError := otherCipher.Decrypt(ciphertext)
+	// assert
+	assert.Nil(t, err)
+	assert.NotEqual(t, ciphertext, text)
+	assert.NotEqual(t, ciphertext, otherCiphertext)
+	assert.Nil(t, decryptError)
+	assert.Equal(t, decrypted, text)
+	assert.Equal(t, otherKeyError, exceptions.NewInternalServerError())
+}
+
+func TestCipherAdapter_InvalidKey(t *testing.T) {
+	// act
+	cipher, err := adapters.NewCipherAdapter([]byte("short"))
+	// assert
+	assert.Nil(t, cipher)
+	assert.Equal(t, err, exceptions.NewInternalServerError())
+}
@@ -0,0 +1,20 @@
+package test_adapters
+
+import (
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigestAdapter_Matches(t *testing.T) {
+	// arrange
+	digest, _ := adapters.NewDigestAdapter()
+	key := "og_AbCd1234_0123456789abcdefghijABCDEFGHIJkl"
+	// act
+	stored := digest.Digest(key)
+	// assert
+	assert.True(t, digest.Matches(key, stored))
+	assert.False(t, digest.Matches("og_AbCd1234_wrongsecret", stored))
+	assert.Equal(t, len(stored), len("sha256:")+64)
+}
@@ -1,42 +1,149 @@
 package test_adapters
 
 import (
+	"context"
 	"regexp"
 	"testing"
 
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
 	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
 	"github.com/stretchr/testify/assert"
 )
 
+func bcryptEncrypter(cost int) *adapters.EncrypterAdapter {
+	encrypter, _ := adapters.NewEncrypterAdapter(&adapters.EncrypterParameters{
+		Algorithm:  adapters.EncrypterAlgorithmBcrypt,
+		BcryptCost: cost,
+	})
+	return encrypter
+}
+
+func argon2idEncrypter(memory uint32) *adapters.EncrypterAdapter {
+	encrypter, _ := adapters.NewEncrypterAdapter(&adapters.EncrypterParameters{
+		Algorithm:         adapters.EncrypterAlgorithmArgon2id,
+		Argon2Memory:      memory,
+		Argon2Iterations:  1,
+		Argon2Parallelism: 1,
+	})
+	return encrypter
+}
+
 func TestEncrypterAdapter_Hash(t *testing.T) {
 	// arrange
-	encrypter, _ := adapters.NewEncrypterAdapter()
+	encrypter := bcryptEncrypter(10)
 	text := "any_text"
 	regex := regexp.MustCompile(`^\$2[aby]?\$\d{1,2}\$[.\/A-Za-z0-9]{53}$`)
 	// act
-	hash, _ := encrypter.Hash(text)
+	hash, _ := encrypter.Hash(context.Background(), text)
 	// assert
 	assert.True(t, regex.Match([]byte(hash)))
 }
 
+func TestEncrypterAdapter_HashCancelled(t *testing.T) {
+	// arrange
+	encrypter := bcryptEncrypter(10)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// act
+	hash, err := encrypter.Hash(ctx, "any_text")
+	// assert
+	assert.Equal(t, hash, "")
+	assert.Equal(t, err, exceptions.NewRequestCancelled())
+}
+
 func TestEncrypterAdapter_SuccessCompare(t *testing.T) {
 	// arrange
-	encrypter, _ := adapters.NewEncrypterAdapter()
+	encrypter := bcryptEncrypter(10)
 	text := "any_text"
-	hash, _ := encrypter.Hash(text)
+	hash, _ := encrypter.Hash(context.Background(), text)
 	// act
-	result, _ := encrypter.Compare(text, hash)
+	result, _ := encrypter.Compare(context.Background(), text, hash)
 	// assert
 	assert.True(t, result)
 }
 
 func TestEncrypterAdapter_FailCompare(t *testing.T) {
 	// arrange
-	encrypter, _ := adapters.NewEncrypterAdapter()
+	encrypter := bcryptEncrypter(10)
 	text := "any_text"
-	hash, _ := encrypter.Hash("other_text")
+	hash, _ := encrypter.Hash(context.Background(), "other_text")
 	// act
-	result, _ := encrypter.Compare(text, hash)
+	result, _ := encrypter.Compare(context.Background(), text, hash)
 	// assert
 	assert.False(t, result)
 }
+
+func TestEncrypterAdapter_Argon2id(t *testing.T) {
+	// arrange
+	encrypter := argon2idEncrypter(1024)
+	text := "any_text"
+	regex := regexp.MustCompile(`^\$argon2id\$v=19\$m=1024,t=1,p=1\$[A-Za-z0-9+\/]{22}\$[A-Za-z0-9+\/]{43}$`)
+	// act
+	hash, err := encrypter.Hash(context.Background(), text)
+	matches, _ := encrypter.Compare(context.Background(), text, hash)
+	mismatches, _ := encrypter.Compare(context.Background(), "other_text", hash)
+	// assert
+	assert.Nil(t, err)
+	assert.True(t, regex.Match([]byte(hash)))
+	assert.True(t, matches)
+	assert.False(t, mismatches)
+}
+
+func TestEncrypterAdapter_ComparesEveryFormat(t *testing.T) {
+	// arrange
+	bcrypt := bcryptEncrypter(4)
+	argon2id := argon2idEncrypter(1024)
+	text := "any_text"
+	bcryptHash, _ := bcrypt.Hash(context.Background(), text)
+	argon2idHash, _ := argon2id.Hash(context.Background(), text)
+	// act
+	bcryptMatches, _ := argon2id.Compare(context.Background(), text, bcryptHash)
+	argon2idMatches, _ := bcrypt.Compare(context.Background(), text, argon2idHash)
+	malformed, malformedError := argon2id.Compare(context.Background(), text, "$argon2id$v=19$m=0,t=1,p=1$AA$AA")
+	// assert
+	assert.True(t, bcryptMatches)
+	assert.True(t, argon2idMatches)
+	assert.False(t, malformed)
+	assert.Nil(t, malformedError)
+}
+
+func TestEncrypterAdapter_NeedsRehash(t *testing.T) {
+	// arrange
+	cheapBcrypt := bcryptEncrypter(4)
+	bcrypt := bcryptEncrypter(5)
+	argon2id := argon2idEncrypter(1024)
+	strongerArgon2id := argon2idEncrypter(2048)
+	cheapBcryptHash, _ := cheapBcrypt.Hash(context.Background(), "any_text")
+	argon2idHash, _ := argon2id.Hash(context.Background(), "any_text")
+	// act
+	sameCost, _ := cheapBcrypt.NeedsRehash(cheapBcryptHash)
+	higherCost, _ := bcrypt.NeedsRehash(cheapBcryptHash)
+	otherAlgorithm, _ := argon2id.NeedsRehash(cheapBcryptHash)
+	sameParameters, _ := argon2id.NeedsRehash(argon2idHash)
+	moreMemory, _ := strongerArgon2id.NeedsRehash(argon2idHash)
+	downgrade, _ := bcrypt.NeedsRehash(argon2idHash)
+	// assert
+	assert.False(t, sameCost)
+	assert.True(t, higherCost)
+	assert.True(t, otherAlgorithm)
+	assert.False(t, sameParameters)
+	assert.True(t, moreMemory)
+	assert.True(t, downgrade)
+}
+
+func TestEncrypterAdapter_InvalidParameters(t *testing.T) {
+	// act
+	unknown, unknownError := adapters.NewEncrypterAdapter(&adapters.EncrypterParameters{Algorithm: "md5"})
+	_, costError := adapters.NewEncrypterAdapter(&adapters.EncrypterParameters{
+		Algorithm:  adapters.EncrypterAlgorithmBcrypt,
+		BcryptCost: 40,
+	})
+	_, argon2Error := adapters.NewEncrypterAdapter(&adapters.EncrypterParameters{
+		Algorithm: adapters.EncrypterAlgorithmArgon2id,
+	})
+	// assert
+	assert.Nil(t, unknown)
+	assert.Equal(t, unknownError, exceptions.NewInternalServerError())
+	assert.Equal(t, costError, exceptions.NewInternalServerError())
+	assert.Equal(t, argon2Error, exceptions.NewInternalServerError())
+}
@@ -0,0 +1,57 @@
+package test_adapters
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoggerAdapter_WritesJson(t *testing.T) {
+	// arrange
+	output := &bytes.Buffer{}
+	logger, _ := adapters.NewLoggerAdapter(&adapters.LoggerParameters{
+		Level:  adapters.LogLevelInfo,
+		Output: output,
+	})
+	ctx := helpers.WithCorrelationId(context.Background(), "correlation_id_example")
+	// act
+	logger.Error(ctx, "query failed", providers.LogFields{"error": errors.New("connection refused")})
+	// assert
+	var entry map[string]interface{}
+	assert.Nil(t, json.Unmarshal(output.Bytes(), &entry))
+	assert.Equal(t, entry["level"], "error")
+	assert.Equal(t, entry["message"], "query failed")
+	assert.Equal(t, entry["error"], "connection refused")
+	assert.Equal(t, entry["correlation_id"], "correlation_id_example")
+	assert.NotEmpty(t, entry["time"])
+}
+
+func TestLoggerAdapter_SkipsLowerLevels(t *testing.T) {
+	// arrange
+	output := &bytes.Buffer{}
+	logger, _ := adapters.NewLoggerAdapter(&adapters.LoggerParameters{
+		Level:  adapters.LogLevelWarn,
+		Output: output,
+	})
+	// act
+	logger.Debug(context.Background(), "debug", nil)
+	logger.Info(context.Background(), "info", nil)
+	// assert
+	assert.Equal(t, output.Len(), 0)
+}
+
+func TestLoggerAdapter_InvalidLevel(t *testing.T) {
+	// act
+	logger, err := adapters.NewLoggerAdapter(&adapters.LoggerParameters{Level: "verbose"})
+	// assert
+	assert.Nil(t, logger)
+	assert.Equal(t, err, exceptions.NewInternalServerError())
+}
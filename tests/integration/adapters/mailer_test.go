@@ -0,0 +1,100 @@
+package test_adapters
+
+import (
+	"bufio"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/stretchr/testify/assert"
+)
+
+// Speaks just enough smtp for net/smtp to hand over one message.
+func fakeSmtpServer(t *testing.T) (int, chan string) {
+	listener, goerr := net.Listen("tcp", "127.0.0.1:0")
+	if goerr != nil {
+		t.Fatal(goerr)
+	}
+	received := make(chan string, 1)
+	go func() {
+		defer listener.Close()
+		conn, goerr := listener.Accept()
+		if goerr != nil {
+			return
+		}
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+		reply("220 localhost")
+		data := []string{}
+		inData := false
+		for {
+			line, goerr := reader.ReadString('\n')
+			if goerr != nil {
+				return
+			}
+			line = strings.TrimRight(line, "\r\n")
+			if inData {
+				if line == "." {
+					inData = false
+					received <- strings.Join(data, "\n")
+					reply("250 ok")
+					continue
+				}
+				data = append(data, line)
+				continue
+			}
+			switch {
+			case strings.HasPrefix(line, "EHLO"), strings.HasPrefix(line, "HELO"):
+				reply("250 localhost")
+			case line == "DATA":
+				inData = true
+				reply("354 go ahead")
+			case line == "QUIT":
+				reply("221 bye")
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+	}()
+	return listener.Addr().(*net.TCPAddr).Port, received
+}
+
+func TestMailerAdapter_Send(t *testing.T) {
+	// arrange
+	port, received := fakeSmtpServer(t)
+	mailer, _ := adapters.NewMailerAdapter(&adapters.MailerParameters{
+		Host: "127.0.0.1",
+		Port: port,
+		From: "no-reply@oganessone.test",
+	})
+	// act
+	err := mailer.Send("user@email.com", "Sign up attempt", "Someone tried to sign up.")
+	var message string
+	select {
+	case message = <-received:
+	case <-time.After(5 * time.Second):
+	}
+	// assert
+	assert.Nil(t, err)
+	assert.Contains(t, message, "To: user@email.com")
+	assert.Contains(t, message, "Subject: Sign up attempt")
+	assert.Contains(t, message, "Someone tried to sign up.")
+}
+
+func TestMailerAdapter_InvalidInput(t *testing.T) {
+	// arrange
+	mailer, _ := adapters.NewMailerAdapter(&adapters.MailerParameters{})
+	// act
+	logOnlyErr := mailer.Send("user@email.com", "Subject", "Body")
+	injectionErr := mailer.Send("user@email.com\r\nBcc: other@email.com", "Subject", "Body")
+	_, parametersErr := adapters.NewMailerAdapter(&adapters.MailerParameters{Host: "localhost"})
+	// assert
+	assert.Nil(t, logOnlyErr)
+	assert.Equal(t, injectionErr, exceptions.NewInternalServerError())
+	assert.Equal(t, parametersErr, exceptions.NewInternalServerError())
+}
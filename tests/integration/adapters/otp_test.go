@@ -0,0 +1,39 @@
+package test_adapters
+
+import (
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/stretchr/testify/assert"
+)
+
+// The SHA1 seed of RFC 6238 appendix B, base32 encoded, the six digit codes
+// are the last digits of the eight digit ones listed there.
+func TestOtpAdapter_Code(t *testing.T) {
+	// arrange
+	otp, _ := adapters.NewOtpAdapter()
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	// act
+	first, err := otp.Code(secret, 59/30)
+	second, _ := otp.Code(secret, 1111111109/30)
+	third, _ := otp.Code(secret, 20000000000/30)
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, first, "287082")
+	assert.Equal(t, second, "081804")
+	assert.Equal(t, third, "353130")
+}
+
+func TestOtpAdapter_Secret(t *testing.T) {
+	// arrange
+	otp, _ := adapters.NewOtpAdapter()
+	// act
+	secret, err := otp.Secret()
+	otherSecret, _ := otp.Secret()
+	_, codeError := otp.Code(secret, 1)
+	// assert
+	assert.Nil(t, err)
+	assert.Len(t, secret, 32)
+	assert.NotEqual(t, secret, otherSecret)
+	assert.Nil(t, codeError)
+}
@@ -0,0 +1,87 @@
+package test_adapters
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/stretchr/testify/assert"
+)
+
+func pwnedPasswordsLines(counts map[string]int) string {
+	lines := []string{}
+	for password, count := range counts {
+		hash := sha1.Sum([]byte(password))
+		lines = append(lines, strings.ToUpper(hex.EncodeToString(hash[:]))+":"+strings.Repeat("1", count))
+	}
+	sort.Strings(lines)
+	return strings.Join(lines, "\r\n") + "\r\n"
+}
+
+func writePasswordScreenerIndex(t *testing.T, input string, minimumCount int) (string, int, error) {
+	path := filepath.Join(t.TempDir(), "pwned.idx")
+	output, _ := os.Create(path)
+	defer output.Close()
+	written, goerr := adapters.WritePasswordScreenerIndex(strings.NewReader(input), output, minimumCount)
+	return path, written, goerr
+}
+
+func TestPasswordScreenerAdapter_IsBreached(t *testing.T) {
+	// arrange
+	input := pwnedPasswordsLines(map[string]int{
+		"password": 3, "123456": 3, "qwerty": 3, "letmein": 3, "rare-password": 1,
+	})
+	path, written, writeError := writePasswordScreenerIndex(t, input, 2)
+	screener, err := adapters.NewPasswordScreenerAdapter(path)
+	// act
+	breached, breachedError := screener.IsBreached(context.Background(), "password")
+	letmein, _ := screener.IsBreached(context.Background(), "letmein")
+	rare, _ := screener.IsBreached(context.Background(), "rare-password")
+	unknown, _ := screener.IsBreached(context.Background(), "correct horse battery staple")
+	// assert
+	assert.Nil(t, writeError)
+	assert.Equal(t, written, 4)
+	assert.Nil(t, err)
+	assert.Nil(t, breachedError)
+	assert.True(t, breached)
+	assert.True(t, letmein)
+	assert.False(t, rare)
+	assert.False(t, unknown)
+}
+
+func TestPasswordScreenerAdapter_Disabled(t *testing.T) {
+	// arrange
+	screener, err := adapters.NewPasswordScreenerAdapter("")
+	// act
+	breached, breachedError := screener.IsBreached(context.Background(), "password")
+	// assert
+	assert.Nil(t, err)
+	assert.Nil(t, breachedError)
+	assert.False(t, breached)
+}
+
+func TestPasswordScreenerAdapter_InvalidIndex(t *testing.T) {
+	// arrange
+	path, _, _ := writePasswordScreenerIndex(t, pwnedPasswordsLines(map[string]int{"password": 1}), 1)
+	content, _ := os.ReadFile(path)
+	truncated := filepath.Join(t.TempDir(), "truncated.idx")
+	os.WriteFile(truncated, content[:len(content)-1], 0600)
+	_, _, unsortedError := writePasswordScreenerIndex(t,
+		"FFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFF:1\n0000000000000000000000000000000000000000:1\n", 1)
+	_, _, malformedError := writePasswordScreenerIndex(t, "not a hash:1\n", 1)
+	// act
+	_, truncatedError := adapters.NewPasswordScreenerAdapter(truncated)
+	_, missingError := adapters.NewPasswordScreenerAdapter(filepath.Join(t.TempDir(), "missing.idx"))
+	// assert
+	assert.Equal(t, truncatedError, exceptions.NewInternalServerError())
+	assert.Equal(t, missingError, exceptions.NewInternalServerError())
+	assert.NotNil(t, unsortedError)
+	assert.NotNil(t, malformedError)
+}
@@ -0,0 +1,21 @@
+package test_adapters
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRandomAdapter_String(t *testing.T) {
+	// arrange
+	random, _ := adapters.NewRandomAdapter()
+	// act
+	first, err := random.String(32)
+	second, _ := random.String(32)
+	// assert
+	assert.Nil(t, err)
+	assert.Regexp(t, regexp.MustCompile("^[A-Za-z0-9]{32}$"), first)
+	assert.NotEqual(t, first, second)
+}
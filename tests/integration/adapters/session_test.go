@@ -1,9 +1,7 @@
 package test_adapters
 
 import (
-	"math/rand"
 	"testing"
-	"time"
 
 	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
 	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
@@ -13,12 +11,11 @@ import (
 
 func TestSessionAdapter_Genarate(t *testing.T) {
 	// arrange
-	rand.Seed(time.Now().UnixNano())
 	session, _ := adapters.NewSessionAdapter()
 	uuid, _ := helpers.NewUuid()
 	id := uuid.Generate()
 	// act
-	sessionData, err := session.Generate(id)
+	sessionData, err := session.Generate(id, "")
 	// assert
 	assert.Nil(t, err)
 	assert.Equal(t, sessionData.UserId, id)
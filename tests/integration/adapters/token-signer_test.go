@@ -0,0 +1,56 @@
+package test_adapters
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenSignerAdapter_Sign(t *testing.T) {
+	// arrange
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	signer, _ := adapters.NewTokenSignerAdapter(key)
+	// act
+	token, err := signer.Sign(map[string]interface{}{
+		"sub":   "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"nonce": "n-0S6_WzA2Mj",
+	})
+	// assert
+	assert.Nil(t, err)
+	segments := strings.Split(token, ".")
+	assert.Len(t, segments, 3)
+	var header map[string]string
+	rawHeader, _ := base64.RawURLEncoding.DecodeString(segments[0])
+	json.Unmarshal(rawHeader, &header)
+	assert.Equal(t, header["alg"], "RS256")
+	assert.Equal(t, header["kid"], signer.PublicJwk()["kid"])
+	var claims map[string]interface{}
+	rawClaims, _ := base64.RawURLEncoding.DecodeString(segments[1])
+	json.Unmarshal(rawClaims, &claims)
+	assert.Equal(t, claims["nonce"], "n-0S6_WzA2Mj")
+	signature, _ := base64.RawURLEncoding.DecodeString(segments[2])
+	digest := sha256.Sum256([]byte(segments[0] + "." + segments[1]))
+	assert.Nil(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature))
+}
+
+func TestTokenSignerAdapter_PublicJwk(t *testing.T) {
+	// arrange
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	signer, _ := adapters.NewTokenSignerAdapter(key)
+	// act
+	jwk := signer.PublicJwk()
+	// assert
+	assert.Equal(t, jwk["kty"], "RSA")
+	assert.Equal(t, jwk["e"], "AQAB")
+	modulus, _ := base64.RawURLEncoding.DecodeString(jwk["n"])
+	assert.Equal(t, modulus, key.PublicKey.N.Bytes())
+	assert.NotEmpty(t, jwk["kid"])
+}
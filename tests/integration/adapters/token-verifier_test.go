@@ -0,0 +1,41 @@
+package test_adapters
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/stretchr/testify/assert"
+)
+
+func publicKeyPem(key *rsa.PrivateKey) string {
+	der, _ := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+func TestTokenVerifierAdapter_Verify(t *testing.T) {
+	// arrange
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	otherKey, _ := rsa.GenerateKey(rand.Reader, 2048)
+	signer, _ := adapters.NewTokenSignerAdapter(key)
+	verifier, _ := adapters.NewTokenVerifierAdapter()
+	token, _ := signer.Sign(map[string]interface{}{
+		"iss": "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+	})
+	segments := strings.Split(token, ".")
+	// act
+	claims, err := verifier.Verify(token, publicKeyPem(key))
+	otherKeyClaims, _ := verifier.Verify(token, publicKeyPem(otherKey))
+	unsignedClaims, _ := verifier.Verify(
+		"eyJhbGciOiJub25lIn0."+segments[1]+".", publicKeyPem(key),
+	)
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, claims["iss"], "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d")
+	assert.Nil(t, otherKeyClaims)
+	assert.Nil(t, unsignedClaims)
+}
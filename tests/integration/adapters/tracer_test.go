@@ -0,0 +1,86 @@
+package test_adapters
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/stretchr/testify/assert"
+)
+
+type exportedTraces struct {
+	ResourceSpans []struct {
+		ScopeSpans []struct {
+			Spans []struct {
+				TraceId      string `json:"traceId"`
+				SpanId       string `json:"spanId"`
+				ParentSpanId string `json:"parentSpanId"`
+				Name         string `json:"name"`
+				Kind         int    `json:"kind"`
+				Status       struct {
+					Code    int    `json:"code"`
+					Message string `json:"message"`
+				} `json:"status"`
+			} `json:"spans"`
+		} `json:"scopeSpans"`
+	} `json:"resourceSpans"`
+}
+
+func TestTracerAdapter_ParseTraceParent(t *testing.T) {
+	// act
+	valid := adapters.ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", "vendor=value")
+	zeroTrace := adapters.ParseTraceParent("00-00000000000000000000000000000000-00f067aa0ba902b7-01", "")
+	uppercase := adapters.ParseTraceParent("00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01", "")
+	// assert
+	assert.True(t, valid.IsValid())
+	assert.True(t, valid.Sampled)
+	assert.Equal(t, valid.TraceState, "vendor=value")
+	assert.Equal(t, valid.TraceParent(), "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	assert.False(t, zeroTrace.IsValid())
+	assert.False(t, uppercase.IsValid())
+}
+
+func TestTracerAdapter_ExportsChildSpans(t *testing.T) {
+	// arrange
+	output := &bytes.Buffer{}
+	tracer, _ := adapters.NewTracerAdapter(&adapters.TracerParameters{
+		ServiceName: "oganessone",
+		Exporter:    adapters.NewWriterSpanExporter("oganessone", output),
+		SampleRatio: 1,
+	})
+	parent := adapters.ParseTraceParent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", "")
+	// act
+	ctx, server := tracer.StartSpan(context.Background(), "/Users/Create", adapters.SpanKindServer, parent)
+	_, child := tracer.Start(ctx, "password.hash")
+	child.RecordError(exceptions.NewInternalServerError())
+	child.End()
+	server.End()
+	tracer.Shutdown(context.Background())
+	// assert
+	var traces exportedTraces
+	assert.Nil(t, json.Unmarshal(output.Bytes(), &traces))
+	spans := traces.ResourceSpans[0].ScopeSpans[0].Spans
+	assert.Equal(t, len(spans), 2)
+	assert.Equal(t, spans[0].Name, "password.hash")
+	assert.Equal(t, spans[0].TraceId, "4bf92f3577b34da6a3ce929d0e0e4736")
+	assert.Equal(t, spans[0].ParentSpanId, spans[1].SpanId)
+	assert.Equal(t, spans[0].Status.Code, 2)
+	assert.Equal(t, spans[1].ParentSpanId, "00f067aa0ba902b7")
+	assert.Equal(t, spans[1].Kind, adapters.SpanKindServer)
+}
+
+func TestTracerAdapter_DisabledWithoutExporter(t *testing.T) {
+	// arrange
+	tracer, _ := adapters.NewTracerAdapter(&adapters.TracerParameters{})
+	ctx := context.Background()
+	// act
+	spanCtx, span := tracer.StartSpan(ctx, "ignored", adapters.SpanKindInternal, adapters.SpanContext{})
+	span.End()
+	// assert
+	assert.Nil(t, span)
+	assert.Equal(t, spanCtx, ctx)
+	assert.Nil(t, tracer.Shutdown(context.Background()))
+}
@@ -0,0 +1,266 @@
+package test_adapters
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/adapters"
+	"github.com/stretchr/testify/assert"
+)
+
+type cborPair struct {
+	key   interface{}
+	value interface{}
+}
+
+func cborHead(major byte, argument uint64) []byte {
+	switch {
+	case argument < 24:
+		return []byte{major<<5 | byte(argument)}
+	case argument < 1<<8:
+		return []byte{major<<5 | 24, byte(argument)}
+	case argument < 1<<16:
+		return []byte{major<<5 | 25, byte(argument >> 8), byte(argument)}
+	}
+	head := []byte{major<<5 | 26, 0, 0, 0, 0}
+	binary.BigEndian.PutUint32(head[1:], uint32(argument))
+	return head
+}
+
+func cborEncode(value interface{}) []byte {
+	switch typed := value.(type) {
+	case int:
+		if typed < 0 {
+			return cborHead(1, uint64(-1-typed))
+		}
+		return cborHead(0, uint64(typed))
+	case []byte:
+		return append(cborHead(2, uint64(len(typed))), typed...)
+	case string:
+		return append(cborHead(3, uint64(len(typed))), typed...)
+	case []interface{}:
+		encoded := cborHead(4, uint64(len(typed)))
+		for _, item := range typed {
+			encoded = append(encoded, cborEncode(item)...)
+		}
+		return encoded
+	case []cborPair:
+		encoded := cborHead(5, uint64(len(typed)))
+		for _, pair := range typed {
+			encoded = append(encoded, cborEncode(pair.key)...)
+			encoded = append(encoded, cborEncode(pair.value)...)
+		}
+		return encoded
+	}
+	panic("unsupported cbor value")
+}
+
+// Plays the part of a platform authenticator holding a P-256 passkey.
+type softwareAuthenticator struct {
+	key          *ecdsa.PrivateKey
+	credentialId []byte
+	rpId         string
+}
+
+func newSoftwareAuthenticator() *softwareAuthenticator {
+	key, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	return &softwareAuthenticator{
+		key:          key,
+		credentialId: []byte("software-authenticator-credential"),
+		rpId:         "localhost",
+	}
+}
+
+func (authenticator *softwareAuthenticator) coseKey() []byte {
+	x := make([]byte, 32)
+	y := make([]byte, 32)
+	authenticator.key.X.FillBytes(x)
+	authenticator.key.Y.FillBytes(y)
+	return cborEncode([]cborPair{
+		{1, 2}, {3, -7}, {-1, 1}, {-2, x}, {-3, y},
+	})
+}
+
+func (authenticator *softwareAuthenticator) authenticatorData(
+	flags byte, signCount uint32, attested bool,
+) []byte {
+	rpIdHash := sha256.Sum256([]byte(authenticator.rpId))
+	data := append([]byte{}, rpIdHash[:]...)
+	data = append(data, flags)
+	counter := make([]byte, 4)
+	binary.BigEndian.PutUint32(counter, signCount)
+	data = append(data, counter...)
+	if attested {
+		data = append(data, make([]byte, 16)...)
+		data = append(data, byte(len(authenticator.credentialId)>>8), byte(len(authenticator.credentialId)))
+		data = append(data, authenticator.credentialId...)
+		data = append(data, authenticator.coseKey()...)
+	}
+	return data
+}
+
+func (authenticator *softwareAuthenticator) sign(data []byte) []byte {
+	digest := sha256.Sum256(data)
+	signature, _ := ecdsa.SignASN1(rand.Reader, authenticator.key, digest[:])
+	return signature
+}
+
+func (authenticator *softwareAuthenticator) attestationObject(
+	format string, statement []cborPair, authenticatorData []byte,
+) []byte {
+	return cborEncode([]cborPair{
+		{"fmt", format}, {"attStmt", statement}, {"authData", authenticatorData},
+	})
+}
+
+func TestWebAuthnAdapter_NoneAttestationAndAssertion(t *testing.T) {
+	// arrange
+	webAuthn, _ := adapters.NewWebAuthnAdapter()
+	authenticator := newSoftwareAuthenticator()
+	clientDataHash := sha256.Sum256([]byte(`{"type":"webauthn.create"}`))
+	attestationObject := authenticator.attestationObject(
+		"none", []cborPair{}, authenticator.authenticatorData(0x45, 0, true),
+	)
+	assertionData := authenticator.authenticatorData(0x05, 1, false)
+	assertionHash := sha256.Sum256([]byte(`{"type":"webauthn.get"}`))
+	signed := append(append([]byte{}, assertionData...), assertionHash[:]...)
+	signature := authenticator.sign(signed)
+	// act
+	registered, err := webAuthn.VerifyAttestation(attestationObject, clientDataHash[:])
+	asserted, parseError := webAuthn.ParseAuthenticatorData(assertionData)
+	valid, verifyError := webAuthn.VerifySignature(registered.PublicKey, signed, signature)
+	tampered, _ := webAuthn.VerifySignature(registered.PublicKey, assertionData, signature)
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, registered.CredentialId, authenticator.credentialId)
+	assert.Equal(t, registered.PublicKey, authenticator.coseKey())
+	assert.True(t, registered.UserPresent)
+	assert.True(t, registered.UserVerified)
+	assert.Nil(t, parseError)
+	assert.Equal(t, asserted.SignCount, uint32(1))
+	assert.Nil(t, asserted.CredentialId)
+	assert.Nil(t, verifyError)
+	assert.True(t, valid)
+	assert.False(t, tampered)
+}
+
+func TestWebAuthnAdapter_PackedSelfAttestation(t *testing.T) {
+	// arrange
+	webAuthn, _ := adapters.NewWebAuthnAdapter()
+	authenticator := newSoftwareAuthenticator()
+	clientDataHash := sha256.Sum256([]byte(`{"type":"webauthn.create"}`))
+	authenticatorData := authenticator.authenticatorData(0x45, 0, true)
+	signature := authenticator.sign(append(append([]byte{}, authenticatorData...), clientDataHash[:]...))
+	attestationObject := authenticator.attestationObject("packed", []cborPair{
+		{"alg", -7}, {"sig", signature},
+	}, authenticatorData)
+	wrongAlgorithm := authenticator.attestationObject("packed", []cborPair{
+		{"alg", -257}, {"sig", signature},
+	}, authenticatorData)
+	otherHash := sha256.Sum256([]byte(`{"type":"webauthn.get"}`))
+	// act
+	registered, err := webAuthn.VerifyAttestation(attestationObject, clientDataHash[:])
+	_, wrongAlgorithmError := webAuthn.VerifyAttestation(wrongAlgorithm, clientDataHash[:])
+	_, otherHashError := webAuthn.VerifyAttestation(attestationObject, otherHash[:])
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, registered.CredentialId, authenticator.credentialId)
+	assert.Equal(t, wrongAlgorithmError, exceptions.NewInvalidWebAuthnResponse())
+	assert.Equal(t, otherHashError, exceptions.NewInvalidWebAuthnResponse())
+}
+
+func TestWebAuthnAdapter_PackedCertificateAttestation(t *testing.T) {
+	// arrange
+	webAuthn, _ := adapters.NewWebAuthnAdapter()
+	authenticator := newSoftwareAuthenticator()
+	attestationKey, _ := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject: pkix.Name{
+			Organization:       []string{"Authenticator Vendor"},
+			OrganizationalUnit: []string{"Authenticator Attestation"},
+			CommonName:         "Software Authenticator",
+		},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+	}
+	certificate, _ := x509.CreateCertificate(rand.Reader, template, template, &attestationKey.PublicKey, attestationKey)
+	clientDataHash := sha256.Sum256([]byte(`{"type":"webauthn.create"}`))
+	authenticatorData := authenticator.authenticatorData(0x45, 0, true)
+	digest := sha256.Sum256(append(append([]byte{}, authenticatorData...), clientDataHash[:]...))
+	signature, _ := ecdsa.SignASN1(rand.Reader, attestationKey, digest[:])
+	attestationObject := authenticator.attestationObject("packed", []cborPair{
+		{"alg", -7}, {"sig", signature}, {"x5c", []interface{}{certificate}},
+	}, authenticatorData)
+	selfSigned := authenticator.attestationObject("packed", []cborPair{
+		{"alg", -7}, {"sig", authenticator.sign(digest[:])}, {"x5c", []interface{}{certificate}},
+	}, authenticatorData)
+	// act
+	registered, err := webAuthn.VerifyAttestation(attestationObject, clientDataHash[:])
+	_, selfSignedError := webAuthn.VerifyAttestation(selfSigned, clientDataHash[:])
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, registered.CredentialId, authenticator.credentialId)
+	assert.Equal(t, selfSignedError, exceptions.NewInvalidWebAuthnResponse())
+}
+
+func TestWebAuthnAdapter_MalformedResponses(t *testing.T) {
+	// arrange
+	webAuthn, _ := adapters.NewWebAuthnAdapter()
+	authenticator := newSoftwareAuthenticator()
+	clientDataHash := sha256.Sum256([]byte(`{"type":"webauthn.create"}`))
+	authenticatorData := authenticator.authenticatorData(0x45, 0, true)
+	unsupported := authenticator.attestationObject("fido-u2f", []cborPair{}, authenticatorData)
+	valid := authenticator.attestationObject("none", []cborPair{}, authenticatorData)
+	withoutCredential := authenticator.attestationObject(
+		"none", []cborPair{}, authenticator.authenticatorData(0x05, 0, false),
+	)
+	// act
+	_, unsupportedError := webAuthn.VerifyAttestation(unsupported, clientDataHash[:])
+	_, truncatedError := webAuthn.VerifyAttestation(valid[:len(valid)-1], clientDataHash[:])
+	_, trailingError := webAuthn.VerifyAttestation(append(valid, 0), clientDataHash[:])
+	_, withoutCredentialError := webAuthn.VerifyAttestation(withoutCredential, clientDataHash[:])
+	_, shortDataError := webAuthn.ParseAuthenticatorData(authenticatorData[:36])
+	_, trailingDataError := webAuthn.ParseAuthenticatorData(append(authenticatorData, 0))
+	// assert
+	assert.Equal(t, unsupportedError, exceptions.NewInvalidWebAuthnResponse())
+	assert.Equal(t, truncatedError, exceptions.NewInvalidWebAuthnResponse())
+	assert.Equal(t, trailingError, exceptions.NewInvalidWebAuthnResponse())
+	assert.Equal(t, withoutCredentialError, exceptions.NewInvalidWebAuthnResponse())
+	assert.Equal(t, shortDataError, exceptions.NewInvalidWebAuthnResponse())
+	assert.Equal(t, trailingDataError, exceptions.NewInvalidWebAuthnResponse())
+}
+
+func TestWebAuthnAdapter_RsaSignature(t *testing.T) {
+	// arrange
+	webAuthn, _ := adapters.NewWebAuthnAdapter()
+	key, _ := rsa.GenerateKey(rand.Reader, 2048)
+	exponent := big.NewInt(int64(key.E)).Bytes()
+	publicKey := cborEncode([]cborPair{
+		{1, 3}, {3, -257}, {-1, key.N.Bytes()}, {-2, exponent},
+	})
+	data := []byte("authenticator data and client data hash")
+	digest := sha256.Sum256(data)
+	signature, _ := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	// act
+	valid, err := webAuthn.VerifySignature(publicKey, data, signature)
+	tampered, _ := webAuthn.VerifySignature(publicKey, data[1:], signature)
+	_, unsupportedError := webAuthn.VerifySignature(cborEncode([]cborPair{{1, 1}, {3, -8}}), data, signature)
+	// assert
+	assert.Nil(t, err)
+	assert.True(t, valid)
+	assert.False(t, tampered)
+	assert.Equal(t, unsupportedError, exceptions.NewInternalServerError())
+}
@@ -0,0 +1,88 @@
+package test_repositories
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/database"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+type ApiKeysRepositoryPostgresTest struct{}
+
+func (*ApiKeysRepositoryPostgresTest) setup() (*repositories.ApiKeysRepositoryPostgres, *entities.OrganizationEntity, *entities.UserEntity, *sql.DB) {
+	env, err := helpers.NewEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = env.Load("test")
+	if err != nil {
+		log.Fatal(err)
+	}
+	db, _ := database.NewDatabase()
+	sql, _ := db.Connect()
+	organizations, _ := repositories.NewOrganizationsRepositoryPostgres(sql)
+	users, _ := repositories.NewUsersRepositoryPostgres(sql)
+	repo, _ := repositories.NewApiKeysRepositoryPostgres(sql)
+	organization, _ := organizations.Create(&dtos.OrganizationDTO{Name: "Organization"})
+	organizations.Save(context.Background(), organization)
+	user, _ := users.Create(&dtos.UserDTO{
+		Username: "username",
+		Email:    "user@email.com",
+		Password: "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW",
+	})
+	users.Save(context.Background(), user)
+	return repo, organization, user, sql
+}
+
+func TestApiKeysRepositoryPostgres_SaveAndFind(t *testing.T) {
+	// arrange
+	repo, organization, user, sql := (&ApiKeysRepositoryPostgresTest{}).setup()
+	defer sql.Query("DELETE FROM organizations;")
+	defer sql.Query("DELETE FROM users;")
+	userKey, _ := repo.Create(&dtos.ApiKeyDTO{
+		UserId:     user.Id,
+		Name:       "deploy job",
+		Prefix:     "AbCd1234",
+		Hash:       "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW",
+		Scopes:     []string{"users:read"},
+		AllowedIps: []string{"10.0.0.0/8"},
+		ExpiresAt:  time.Now().UTC().Add(time.Hour),
+	})
+	serviceKey, _ := repo.Create(&dtos.ApiKeyDTO{
+		OrganizationId: organization.Id,
+		Name:           "billing job",
+		Prefix:         "EfGh5678",
+		Hash:           "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW",
+	})
+	// act
+	saveUserKeyErr := repo.Save(context.Background(), userKey)
+	saveServiceKeyErr := repo.Save(context.Background(), serviceKey)
+	byPrefix, findByPrefixErr := repo.FindByPrefix(context.Background(), "AbCd1234")
+	byUser, findByUserErr := repo.FindByUserId(context.Background(), user.Id)
+	serviceKeys, findServiceKeysErr := repo.FindServiceAccountKeys(context.Background(), organization.Id)
+	serviceKey.RevokedAt = time.Now().UTC()
+	revokeErr := repo.Save(context.Background(), serviceKey)
+	revoked, _ := repo.FindById(context.Background(), serviceKey.Id)
+	// assert
+	assert.Nil(t, saveUserKeyErr)
+	assert.Nil(t, saveServiceKeyErr)
+	assert.Nil(t, findByPrefixErr)
+	assert.Nil(t, findByUserErr)
+	assert.Nil(t, findServiceKeysErr)
+	assert.Nil(t, revokeErr)
+	assert.Equal(t, byPrefix.Id, userKey.Id)
+	assert.Equal(t, byPrefix.Scopes, []string{"users:read"})
+	assert.Equal(t, byPrefix.AllowedIps, []string{"10.0.0.0/8"})
+	assert.Equal(t, len(byUser), 1)
+	assert.Equal(t, len(serviceKeys), 1)
+	assert.True(t, serviceKeys[0].IsServiceAccount())
+	assert.True(t, revoked.IsRevoked())
+}
@@ -0,0 +1,100 @@
+package test_repositories
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/database"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+type AuditLogRepositoryPostgresTest struct{}
+
+func (*AuditLogRepositoryPostgresTest) setup() (*repositories.AuditLogRepositoryPostgres, *sql.DB) {
+	env, err := helpers.NewEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = env.Load("test")
+	if err != nil {
+		log.Fatal(err)
+	}
+	db, _ := database.NewDatabase()
+	sql, _ := db.Connect()
+	repo, _ := repositories.NewAuditLogRepositoryPostgres(sql)
+	return repo, sql
+}
+
+// Events can't be deleted, every run uses its own target so earlier runs
+// don't show up in the results.
+func TestAuditLogRepositoryPostgres_SaveAndQuery(t *testing.T) {
+	// arrange
+	repo, _ := (&AuditLogRepositoryPostgresTest{}).setup()
+	uuid, _ := helpers.NewUuid()
+	targetId := uuid.Generate()
+	now := time.Now().UTC().Truncate(time.Second)
+	for index, eventType := range []string{
+		entities.AuditEventUserCreated,
+		entities.AuditEventLoginFailed,
+		entities.AuditEventLoginSucceeded,
+	} {
+		auditEvent, _ := repo.Create(&dtos.AuditEventDTO{
+			Type:      eventType,
+			ActorId:   targetId,
+			TargetId:  targetId,
+			IpAddress: "203.0.113.7",
+			CreatedAt: now.Add(time.Duration(index) * time.Minute),
+		})
+		assert.Nil(t, repo.Save(context.Background(), auditEvent))
+	}
+	// act
+	firstPage, firstErr := repo.Query(context.Background(), &dtos.AuditLogFilterDTO{
+		TargetId: targetId,
+		Limit:    2,
+	})
+	secondPage, secondErr := repo.Query(context.Background(), &dtos.AuditLogFilterDTO{
+		TargetId: targetId,
+		Cursor:   firstPage[len(firstPage)-1].Id,
+		Limit:    2,
+	})
+	failed, failedErr := repo.Query(context.Background(), &dtos.AuditLogFilterDTO{
+		Type:        entities.AuditEventLoginFailed,
+		Participant: targetId,
+		Since:       now,
+		Limit:       10,
+	})
+	// assert
+	assert.Nil(t, firstErr)
+	assert.Len(t, firstPage, 2)
+	assert.Equal(t, firstPage[0].Type, entities.AuditEventLoginSucceeded)
+	assert.Nil(t, secondErr)
+	assert.Len(t, secondPage, 1)
+	assert.Equal(t, secondPage[0].Type, entities.AuditEventUserCreated)
+	assert.Nil(t, failedErr)
+	assert.Len(t, failed, 1)
+	assert.Equal(t, failed[0].IpAddress, "203.0.113.7")
+}
+
+func TestAuditLogRepositoryPostgres_IsAppendOnly(t *testing.T) {
+	// arrange
+	repo, sql := (&AuditLogRepositoryPostgresTest{}).setup()
+	auditEvent, _ := repo.Create(&dtos.AuditEventDTO{
+		Type: entities.AuditEventSessionRevoked,
+	})
+	repo.Save(context.Background(), auditEvent)
+	// act
+	_, updateErr := sql.Exec("UPDATE audit_events SET detail = 'changed' WHERE id = $1", auditEvent.Id)
+	_, deleteErr := sql.Exec("DELETE FROM audit_events WHERE id = $1", auditEvent.Id)
+	saveErr := repo.Save(context.Background(), auditEvent)
+	// assert
+	assert.NotNil(t, updateErr)
+	assert.NotNil(t, deleteErr)
+	assert.NotNil(t, saveErr)
+}
@@ -0,0 +1,36 @@
+package test_repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthorizationCodesRepositoryMemory_ConsumeOnce(t *testing.T) {
+	// arrange
+	repo, _ := repositories.NewAuthorizationCodesRepositoryMemory()
+	authorizationCode, _ := repo.Create(&dtos.AuthorizationCodeDTO{
+		Code:                "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q",
+		ClientId:            "cc58997a-2403-af1e-7836-f0b338edcd60",
+		UserId:              "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		SessionKey:          "session_key_example",
+		RedirectUri:         "https://app.example.com/callback",
+		CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+		CodeChallengeMethod: "S256",
+		ExpiresAt:           time.Now().UTC().Add(time.Minute),
+	})
+	// act
+	saveErr := repo.Save(context.Background(), authorizationCode)
+	first, firstErr := repo.Consume(context.Background(), authorizationCode.Code)
+	second, secondErr := repo.Consume(context.Background(), authorizationCode.Code)
+	// assert
+	assert.Nil(t, saveErr)
+	assert.Nil(t, firstErr)
+	assert.Nil(t, secondErr)
+	assert.Equal(t, first, authorizationCode)
+	assert.Nil(t, second)
+}
@@ -0,0 +1,68 @@
+package test_repositories
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/database"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+type MembershipsRepositoryPostgresTest struct{}
+
+func (*MembershipsRepositoryPostgresTest) setup() (*repositories.MembershipsRepositoryPostgres, *entities.OrganizationEntity, *entities.UserEntity, *sql.DB) {
+	env, err := helpers.NewEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = env.Load("test")
+	if err != nil {
+		log.Fatal(err)
+	}
+	db, _ := database.NewDatabase()
+	sql, _ := db.Connect()
+	organizations, _ := repositories.NewOrganizationsRepositoryPostgres(sql)
+	users, _ := repositories.NewUsersRepositoryPostgres(sql)
+	repo, _ := repositories.NewMembershipsRepositoryPostgres(sql)
+	organization, _ := organizations.Create(&dtos.OrganizationDTO{Name: "Organization"})
+	organizations.Save(context.Background(), organization)
+	user, _ := users.Create(&dtos.UserDTO{
+		Username: "username",
+		Email:    "user@email.com",
+		Password: "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW",
+	})
+	users.Save(context.Background(), user)
+	return repo, organization, user, sql
+}
+
+func TestMembershipsRepositoryPostgres_SaveFindAndDelete(t *testing.T) {
+	// arrange
+	repo, organization, user, sql := (&MembershipsRepositoryPostgresTest{}).setup()
+	defer sql.Query("DELETE FROM organizations;")
+	defer sql.Query("DELETE FROM users;")
+	membership, _ := repo.Create(&dtos.MembershipDTO{
+		OrganizationId: organization.Id,
+		UserId:         user.Id,
+		Role:           entities.MembershipRoleOwner,
+	})
+	// act
+	saveErr := repo.Save(context.Background(), membership)
+	found, findErr := repo.FindByOrganizationIdAndUserId(context.Background(), organization.Id, user.Id)
+	all, findAllErr := repo.FindByOrganizationId(context.Background(), organization.Id)
+	deleteErr := repo.Delete(context.Background(), membership.Id)
+	deleted, _ := repo.FindByOrganizationIdAndUserId(context.Background(), organization.Id, user.Id)
+	// assert
+	assert.Nil(t, saveErr)
+	assert.Nil(t, findErr)
+	assert.Nil(t, findAllErr)
+	assert.Nil(t, deleteErr)
+	assert.Equal(t, found.Id, membership.Id)
+	assert.Equal(t, len(all), 1)
+	assert.Nil(t, deleted)
+}
@@ -0,0 +1,78 @@
+package test_repositories
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/database"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+type OAuthClientsRepositoryPostgresTest struct{}
+
+func (*OAuthClientsRepositoryPostgresTest) setup() (*repositories.OAuthClientsRepositoryPostgres, *entities.OrganizationEntity, *sql.DB) {
+	env, err := helpers.NewEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = env.Load("test")
+	if err != nil {
+		log.Fatal(err)
+	}
+	db, _ := database.NewDatabase()
+	sql, _ := db.Connect()
+	organizations, _ := repositories.NewOrganizationsRepositoryPostgres(sql)
+	repo, _ := repositories.NewOAuthClientsRepositoryPostgres(sql)
+	organization, _ := organizations.Create(&dtos.OrganizationDTO{Name: "Organization"})
+	organizations.Save(context.Background(), organization)
+	return repo, organization, sql
+}
+
+func TestOAuthClientsRepositoryPostgres_SaveAndFindById(t *testing.T) {
+	// arrange
+	repo, organization, sql := (&OAuthClientsRepositoryPostgresTest{}).setup()
+	defer sql.Query("DELETE FROM organizations;")
+	client, _ := repo.Create(&dtos.OAuthClientDTO{
+		OrganizationId: organization.Id,
+		Name:           "Web app",
+		RedirectUris:   []string{"https://app.example.com/callback"},
+	})
+	// act
+	saveErr := repo.Save(context.Background(), client)
+	found, findErr := repo.FindById(context.Background(), client.Id)
+	// assert
+	assert.Nil(t, saveErr)
+	assert.Nil(t, findErr)
+	assert.Equal(t, found.Id, client.Id)
+	assert.Equal(t, found.RedirectUris, client.RedirectUris)
+}
+
+func TestOAuthClientsRepositoryPostgres_SaveConfidentialClient(t *testing.T) {
+	// arrange
+	repo, organization, sql := (&OAuthClientsRepositoryPostgresTest{}).setup()
+	defer sql.Query("DELETE FROM organizations;")
+	client, _ := repo.Create(&dtos.OAuthClientDTO{
+		OrganizationId: organization.Id,
+		Name:           "Billing job",
+		AuthMethod:     entities.OAuthClientAuthMethodClientSecretBasic,
+		SecretHash:     "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW",
+		Scopes:         []string{"invoices:read"},
+		Audiences:      []string{"https://billing.example.com"},
+	})
+	// act
+	saveErr := repo.Save(context.Background(), client)
+	found, findErr := repo.FindById(context.Background(), client.Id)
+	// assert
+	assert.Nil(t, saveErr)
+	assert.Nil(t, findErr)
+	assert.Equal(t, found.AuthMethod, client.AuthMethod)
+	assert.Equal(t, found.SecretHash, client.SecretHash)
+	assert.Equal(t, found.Scopes, client.Scopes)
+	assert.Equal(t, found.Audiences, client.Audiences)
+}
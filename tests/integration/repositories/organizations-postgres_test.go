@@ -0,0 +1,72 @@
+package test_repositories
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/database"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+type OrganizationsRepositoryPostgresTest struct{}
+
+func (*OrganizationsRepositoryPostgresTest) setup() (*repositories.OrganizationsRepositoryPostgres, *sql.DB) {
+	env, err := helpers.NewEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = env.Load("test")
+	if err != nil {
+		log.Fatal(err)
+	}
+	db, _ := database.NewDatabase()
+	sql, _ := db.Connect()
+	repo, _ := repositories.NewOrganizationsRepositoryPostgres(sql)
+	return repo, sql
+}
+
+func TestOrganizationsRepositoryPostgres_CreateWithoutName(t *testing.T) {
+	// arrange
+	repo, _ := (&OrganizationsRepositoryPostgresTest{}).setup()
+	// act
+	organization, err := repo.Create(&dtos.OrganizationDTO{})
+	// assert
+	assert.Nil(t, organization)
+	assert.Equal(t, err, exceptions.NewInvalidOrganizationName())
+}
+
+func TestOrganizationsRepositoryPostgres_SaveAndFindById(t *testing.T) {
+	// arrange
+	repo, sql := (&OrganizationsRepositoryPostgresTest{}).setup()
+	defer sql.Query("DELETE FROM organizations;")
+	organization, _ := repo.Create(&dtos.OrganizationDTO{Name: "Organization"})
+	// act
+	err := repo.Save(context.Background(), organization)
+	found, findErr := repo.FindById(context.Background(), organization.Id)
+	// assert
+	assert.Nil(t, err)
+	assert.Nil(t, findErr)
+	assert.Equal(t, found.Id, organization.Id)
+	assert.Equal(t, found.Name, organization.Name)
+}
+
+func TestOrganizationsRepositoryPostgres_Delete(t *testing.T) {
+	// arrange
+	repo, sql := (&OrganizationsRepositoryPostgresTest{}).setup()
+	defer sql.Query("DELETE FROM organizations;")
+	organization, _ := repo.Create(&dtos.OrganizationDTO{Name: "Organization"})
+	repo.Save(context.Background(), organization)
+	// act
+	err := repo.Delete(context.Background(), organization.Id)
+	found, findErr := repo.FindById(context.Background(), organization.Id)
+	// assert
+	assert.Nil(t, err)
+	assert.Nil(t, findErr)
+	assert.Nil(t, found)
+}
@@ -0,0 +1,69 @@
+package test_repositories
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/database"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+type PasswordHistoryRepositoryPostgresTest struct{}
+
+func (*PasswordHistoryRepositoryPostgresTest) setup() (*repositories.PasswordHistoryRepositoryPostgres, *entities.UserEntity, *sql.DB) {
+	env, err := helpers.NewEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = env.Load("test")
+	if err != nil {
+		log.Fatal(err)
+	}
+	db, _ := database.NewDatabase()
+	sql, _ := db.Connect()
+	users, _ := repositories.NewUsersRepositoryPostgres(sql)
+	repo, _ := repositories.NewPasswordHistoryRepositoryPostgres(sql)
+	user, _ := users.Create(&dtos.UserDTO{
+		Username: "username",
+		Email:    "user@email.com",
+		Password: "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW",
+	})
+	users.Save(context.Background(), user)
+	return repo, user, sql
+}
+
+func TestPasswordHistoryRepositoryPostgres_SaveFindAndPrune(t *testing.T) {
+	// arrange
+	repo, user, sql := (&PasswordHistoryRepositoryPostgresTest{}).setup()
+	defer sql.Query("DELETE FROM users;")
+	now := time.Now().UTC().Truncate(time.Second)
+	for index, hash := range []string{
+		"$2a$10$AAAAAAAAAAAAAAAAAAAAAOeLQ2ZHJkVHF0z/wR1X2nYy5ZQ5Y8Gxe",
+		"$2a$10$BBBBBBBBBBBBBBBBBBBBBOeLQ2ZHJkVHF0z/wR1X2nYy5ZQ5Y8Gxe",
+		"$2a$10$CCCCCCCCCCCCCCCCCCCCCOeLQ2ZHJkVHF0z/wR1X2nYy5ZQ5Y8Gxe",
+	} {
+		entry, _ := repo.Create(&dtos.PasswordHistoryDTO{
+			UserId:    user.Id,
+			Password:  hash,
+			CreatedAt: now.Add(time.Duration(index-3) * time.Hour),
+		})
+		repo.Save(context.Background(), entry)
+	}
+	// act
+	recent, findErr := repo.FindRecentByUserId(context.Background(), user.Id, 2, time.Time{})
+	pruneErr := repo.Prune(context.Background(), user.Id, 2, now.Add(-150*time.Minute))
+	remaining, _ := repo.FindRecentByUserId(context.Background(), user.Id, 10, time.Time{})
+	// assert
+	assert.Nil(t, findErr)
+	assert.Len(t, recent, 2)
+	assert.Equal(t, recent[0].Password[7:8], "C")
+	assert.Nil(t, pruneErr)
+	assert.Len(t, remaining, 2)
+}
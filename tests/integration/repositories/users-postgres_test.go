@@ -1,6 +1,7 @@
 package test_repositories
 
 import (
+	"context"
 	"database/sql"
 	"log"
 	"strings"
@@ -128,7 +129,7 @@ func TestUsersRepositoryPostgres_FindByUsernameCaseSensitive(t *testing.T) {
 		return
 	}
 	// act
-	user, err := repo.FindByUsername(username, true)
+	user, err := repo.FindByUsername(context.Background(), "", username, true)
 	// assert
 	assert.Nil(t, err)
 	assert.Nil(t, user.IsValid())
@@ -160,7 +161,7 @@ func TestUsersRepositoryPostgres_FindByUsernameCaseInsensitive(t *testing.T) {
 		return
 	}
 	// act
-	user, err := repo.FindByUsername(strings.ToUpper(username), false)
+	user, err := repo.FindByUsername(context.Background(), "", strings.ToUpper(username), false)
 	// assert
 	assert.Nil(t, err)
 	assert.Nil(t, user.IsValid())
@@ -171,7 +172,7 @@ func TestUsersRepositoryPostgres_FindByUsernameReturnNil(t *testing.T) {
 	repo, _ := (&UsersRepositoryPostgresTest{}).setup()
 	username := "username"
 	// act
-	user, err := repo.FindByUsername(username, true)
+	user, err := repo.FindByUsername(context.Background(), "", username, true)
 	// assert
 	assert.Nil(t, err)
 	assert.Nil(t, user)
@@ -203,7 +204,7 @@ func TestUsersRepositoryPostgres_FindByEmail(t *testing.T) {
 		return
 	}
 	// act
-	user, err := repo.FindByEmail(email)
+	user, err := repo.FindByEmail(context.Background(), "", email)
 	// assert
 	assert.Nil(t, err)
 	assert.Nil(t, user.IsValid())
@@ -214,7 +215,7 @@ func TestUsersRepositoryPostgres_FindByEmailReturnNil(t *testing.T) {
 	repo, _ := (&UsersRepositoryPostgresTest{}).setup()
 	email := "user@email.com"
 	// act
-	user, err := repo.FindByEmail(email)
+	user, err := repo.FindByEmail(context.Background(), "", email)
 	// assert
 	assert.Nil(t, err)
 	assert.Nil(t, user)
@@ -248,7 +249,7 @@ func TestUsersRepositoryPostgres_Save(t *testing.T) {
 		updatedAt time.Time
 	}{}
 	// act
-	err := repo.Save(user)
+	err := repo.Save(context.Background(), user)
 	stmt, goerr := sql.Prepare(`
 		SELECT 
 			id, username, email, password, created_at, updated_at
@@ -280,3 +281,69 @@ func TestUsersRepositoryPostgres_Save(t *testing.T) {
 	assert.Equal(t, user.CreatedAt.Format(time.RFC3339), data.createdAt.Format(time.RFC3339))
 	assert.Equal(t, user.UpdatedAt.Format(time.RFC3339), data.updatedAt.Format(time.RFC3339))
 }
+
+func TestUsersRepositoryPostgres_SaveUpdatesMfa(t *testing.T) {
+	// arrange
+	repo, sql := (&UsersRepositoryPostgresTest{}).setup()
+	defer sql.Query("DELETE FROM users;")
+	user, _ := repo.Create(&dtos.UserDTO{
+		Username: "username",
+		Email:    "user@email.com",
+		Password: "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW",
+	})
+	repo.Save(context.Background(), user)
+	user.MfaSecret = "encrypted_secret"
+	user.MfaEnabled = true
+	// act
+	err := repo.Save(context.Background(), user)
+	found, _ := repo.FindById(context.Background(), user.Id)
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, found.MfaSecret, "encrypted_secret")
+	assert.True(t, found.MfaEnabled)
+}
+
+func TestUsersRepositoryPostgres_ClaimMfaStep(t *testing.T) {
+	// arrange
+	repo, sql := (&UsersRepositoryPostgresTest{}).setup()
+	defer sql.Query("DELETE FROM users;")
+	user, _ := repo.Create(&dtos.UserDTO{
+		Username: "username",
+		Email:    "user@email.com",
+		Password: "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW",
+	})
+	repo.Save(context.Background(), user)
+	// act
+	first, err := repo.ClaimMfaStep(context.Background(), user.Id, 100)
+	replayed, _ := repo.ClaimMfaStep(context.Background(), user.Id, 100)
+	older, _ := repo.ClaimMfaStep(context.Background(), user.Id, 99)
+	newer, _ := repo.ClaimMfaStep(context.Background(), user.Id, 101)
+	// assert
+	assert.Nil(t, err)
+	assert.True(t, first)
+	assert.False(t, replayed)
+	assert.False(t, older)
+	assert.True(t, newer)
+}
+
+func TestUsersRepositoryPostgres_ConsumeMfaRecoveryCode(t *testing.T) {
+	// arrange
+	repo, sql := (&UsersRepositoryPostgresTest{}).setup()
+	defer sql.Query("DELETE FROM users;")
+	user, _ := repo.Create(&dtos.UserDTO{
+		Username:         "username",
+		Email:            "user@email.com",
+		Password:         "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW",
+		MfaRecoveryCodes: []string{"first_hash", "second_hash"},
+	})
+	repo.Save(context.Background(), user)
+	// act
+	consumed, err := repo.ConsumeMfaRecoveryCode(context.Background(), user.Id, "first_hash")
+	replayed, _ := repo.ConsumeMfaRecoveryCode(context.Background(), user.Id, "first_hash")
+	found, _ := repo.FindById(context.Background(), user.Id)
+	// assert
+	assert.Nil(t, err)
+	assert.True(t, consumed)
+	assert.False(t, replayed)
+	assert.Equal(t, found.MfaRecoveryCodes, []string{"second_hash"})
+}
@@ -0,0 +1,33 @@
+package test_repositories
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebAuthnChallengesRepositoryMemory_ConsumeOnce(t *testing.T) {
+	// arrange
+	repo, _ := repositories.NewWebAuthnChallengesRepositoryMemory()
+	webAuthnChallenge, _ := repo.Create(&dtos.WebAuthnChallengeDTO{
+		Challenge: "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q",
+		Ceremony:  entities.WebAuthnCeremonyRegistration,
+		UserId:    "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	})
+	// act
+	saveErr := repo.Save(context.Background(), webAuthnChallenge)
+	first, firstErr := repo.Consume(context.Background(), webAuthnChallenge.Challenge)
+	second, secondErr := repo.Consume(context.Background(), webAuthnChallenge.Challenge)
+	// assert
+	assert.Nil(t, saveErr)
+	assert.Nil(t, firstErr)
+	assert.Nil(t, secondErr)
+	assert.Equal(t, first, webAuthnChallenge)
+	assert.Nil(t, second)
+}
@@ -0,0 +1,83 @@
+package test_repositories
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/database"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/repositories"
+	"github.com/stretchr/testify/assert"
+)
+
+type WebAuthnCredentialsRepositoryPostgresTest struct{}
+
+func (*WebAuthnCredentialsRepositoryPostgresTest) setup() (*repositories.WebAuthnCredentialsRepositoryPostgres, *entities.UserEntity, *sql.DB) {
+	env, err := helpers.NewEnv()
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = env.Load("test")
+	if err != nil {
+		log.Fatal(err)
+	}
+	db, _ := database.NewDatabase()
+	sql, _ := db.Connect()
+	users, _ := repositories.NewUsersRepositoryPostgres(sql)
+	repo, _ := repositories.NewWebAuthnCredentialsRepositoryPostgres(sql)
+	user, _ := users.Create(&dtos.UserDTO{
+		Username: "username",
+		Email:    "user@email.com",
+		Password: "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW",
+	})
+	users.Save(context.Background(), user)
+	return repo, user, sql
+}
+
+func TestWebAuthnCredentialsRepositoryPostgres_SaveAndFind(t *testing.T) {
+	// arrange
+	repo, user, sql := (&WebAuthnCredentialsRepositoryPostgresTest{}).setup()
+	defer sql.Query("DELETE FROM users;")
+	webAuthnCredential, _ := repo.Create(&dtos.WebAuthnCredentialDTO{
+		UserId:       user.Id,
+		CredentialId: "q83vEjRWeJA",
+		PublicKey:    "pQECAyYgASFYIA",
+	})
+	// act
+	saveErr := repo.Save(context.Background(), webAuthnCredential)
+	foundByCredentialId, findErr := repo.FindByCredentialId(context.Background(), webAuthnCredential.CredentialId)
+	foundByUserId, _ := repo.FindByUserId(context.Background(), user.Id)
+	missing, _ := repo.FindByCredentialId(context.Background(), "AAAA")
+	// assert
+	assert.Nil(t, saveErr)
+	assert.Nil(t, findErr)
+	assert.Equal(t, foundByCredentialId.Id, webAuthnCredential.Id)
+	assert.Equal(t, foundByCredentialId.Transports, []string{})
+	assert.Len(t, foundByUserId, 1)
+	assert.Nil(t, missing)
+}
+
+func TestWebAuthnCredentialsRepositoryPostgres_SaveUpdatesSignCount(t *testing.T) {
+	// arrange
+	repo, user, sql := (&WebAuthnCredentialsRepositoryPostgresTest{}).setup()
+	defer sql.Query("DELETE FROM users;")
+	webAuthnCredential, _ := repo.Create(&dtos.WebAuthnCredentialDTO{
+		UserId:       user.Id,
+		CredentialId: "q83vEjRWeJA",
+		PublicKey:    "pQECAyYgASFYIA",
+		Transports:   []string{"internal"},
+	})
+	repo.Save(context.Background(), webAuthnCredential)
+	webAuthnCredential.SignCount = 12
+	// act
+	err := repo.Save(context.Background(), webAuthnCredential)
+	found, _ := repo.FindByCredentialId(context.Background(), webAuthnCredential.CredentialId)
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, found.SignCount, int64(12))
+	assert.Equal(t, found.Transports, []string{"internal"})
+}
@@ -0,0 +1,120 @@
+package test_entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/stretchr/testify/assert"
+)
+
+type ApiKeyEntityTest struct{}
+
+func (*ApiKeyEntityTest) setup() *entities.ApiKeyEntity {
+	apiKey, _ := entities.NewApiKeyEntity(&dtos.ApiKeyDTO{
+		Id:         "cc58997a-2403-af1e-7836-f0b338edcd60",
+		UserId:     "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Name:       "deploy job",
+		Prefix:     "AbCd1234",
+		Hash:       "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW",
+		Scopes:     []string{"users:read", "organizations:write"},
+		AllowedIps: []string{"10.0.0.0/8", "192.168.0.10"},
+		CreatedAt:  time.Now(),
+		UpdatedAt:  time.Now(),
+	})
+	return apiKey
+}
+
+func TestApiKeyEntity_isIdValid(t *testing.T) {
+	// arrange
+	apiKey := (&ApiKeyEntityTest{}).setup()
+	// act
+	err := apiKey.IsValid()
+	// assert
+	assert.Nil(t, err)
+
+	// arrange
+	apiKey.Id = "not_an_uuid"
+	// act
+	err = apiKey.IsValid()
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidApiKeyId())
+}
+
+func TestApiKeyEntity_isOwnerValid(t *testing.T) {
+	// arrange
+	apiKey := (&ApiKeyEntityTest{}).setup()
+	apiKey.UserId = ""
+	// act
+	err := apiKey.IsValid()
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidApiKeyOwner())
+
+	// arrange
+	apiKey.OrganizationId = "4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11"
+	// act
+	err = apiKey.IsValid()
+	// assert
+	assert.Nil(t, err)
+	assert.True(t, apiKey.IsServiceAccount())
+}
+
+func TestApiKeyEntity_isNameValid(t *testing.T) {
+	// arrange
+	apiKey := (&ApiKeyEntityTest{}).setup()
+	apiKey.Name = ""
+	// act
+	err := apiKey.IsValid()
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidApiKeyName())
+}
+
+func TestApiKeyEntity_areScopesValid(t *testing.T) {
+	// arrange
+	apiKey := (&ApiKeyEntityTest{}).setup()
+	apiKey.Scopes = []string{"Users:Read"}
+	// act
+	err := apiKey.IsValid()
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidApiKeyScope())
+}
+
+func TestApiKeyEntity_areAllowedIpsValid(t *testing.T) {
+	// arrange
+	apiKey := (&ApiKeyEntityTest{}).setup()
+	apiKey.AllowedIps = []string{"10.0.0.0/33"}
+	// act
+	err := apiKey.IsValid()
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidApiKeyAllowedIp())
+}
+
+func TestApiKeyEntity_IsExpired(t *testing.T) {
+	// arrange
+	apiKey := (&ApiKeyEntityTest{}).setup()
+	now := time.Now().UTC()
+	// act
+	neverExpires := apiKey.IsExpired(now)
+	apiKey.ExpiresAt = now.Add(-time.Minute)
+	expired := apiKey.IsExpired(now)
+	// assert
+	assert.False(t, neverExpires)
+	assert.True(t, expired)
+}
+
+func TestApiKeyEntity_AllowsIp(t *testing.T) {
+	// arrange
+	apiKey := (&ApiKeyEntityTest{}).setup()
+	// act
+	insideBlock := apiKey.AllowsIp("10.20.30.40")
+	exactAddress := apiKey.AllowsIp("192.168.0.10")
+	outside := apiKey.AllowsIp("192.168.0.11")
+	unparseable := apiKey.AllowsIp("")
+	// assert
+	assert.True(t, insideBlock)
+	assert.True(t, exactAddress)
+	assert.False(t, outside)
+	assert.False(t, unparseable)
+}
@@ -0,0 +1,47 @@
+package test_entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditEventEntity_IsValid(t *testing.T) {
+	// arrange
+	data := &dtos.AuditEventDTO{
+		Id:        "4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11",
+		Type:      entities.AuditEventLoginFailed,
+		IpAddress: "203.0.113.7",
+		Detail:    entities.AuditReasonUnknownUser,
+		CreatedAt: time.Now().UTC(),
+	}
+	// act
+	_, err := entities.NewAuditEventEntity(data)
+	// assert
+	assert.Nil(t, err)
+
+	// arrange
+	data.Type = "user.deleted"
+	// act
+	_, err = entities.NewAuditEventEntity(data)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidAuditEventType())
+
+	// arrange
+	data.OrganizationId = "invalid"
+	// act
+	_, err = entities.NewAuditEventEntity(data)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidOrganizationId())
+
+	// arrange
+	data.Id = "invalid"
+	// act
+	_, err = entities.NewAuditEventEntity(data)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidAuditEventId())
+}
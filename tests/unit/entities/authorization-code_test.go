@@ -0,0 +1,68 @@
+package test_entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/stretchr/testify/assert"
+)
+
+type AuthorizationCodeEntityTest struct{}
+
+// Verifier and challenge are the example from RFC 7636 appendix B.
+func (*AuthorizationCodeEntityTest) setup() *entities.AuthorizationCodeEntity {
+	authorizationCode, _ := entities.NewAuthorizationCodeEntity(&dtos.AuthorizationCodeDTO{
+		Code:                "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q",
+		ClientId:            "cc58997a-2403-af1e-7836-f0b338edcd60",
+		UserId:              "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		SessionKey:          "session_key_example",
+		RedirectUri:         "https://app.example.com/callback",
+		CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+		CodeChallengeMethod: entities.CodeChallengeMethodS256,
+		ExpiresAt:           time.Now().UTC().Add(time.Minute),
+	})
+	return authorizationCode
+}
+
+func TestAuthorizationCodeEntity_isCodeChallengeValid(t *testing.T) {
+	// arrange
+	authorizationCode := (&AuthorizationCodeEntityTest{}).setup()
+	// act
+	err := authorizationCode.IsValid()
+	// assert
+	assert.Nil(t, err)
+
+	// arrange
+	authorizationCode.CodeChallengeMethod = "plain"
+	// act
+	err = authorizationCode.IsValid()
+	// assert
+	assert.Equal(t, err, exceptions.NewOAuthInvalidCodeChallenge())
+}
+
+func TestAuthorizationCodeEntity_VerifyCodeVerifier(t *testing.T) {
+	// arrange
+	authorizationCode := (&AuthorizationCodeEntityTest{}).setup()
+	// act
+	matches := authorizationCode.VerifyCodeVerifier("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk")
+	mismatches := authorizationCode.VerifyCodeVerifier("dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXl")
+	tooShort := authorizationCode.VerifyCodeVerifier("short")
+	// assert
+	assert.True(t, matches)
+	assert.False(t, mismatches)
+	assert.False(t, tooShort)
+}
+
+func TestAuthorizationCodeEntity_IsExpired(t *testing.T) {
+	// arrange
+	authorizationCode := (&AuthorizationCodeEntityTest{}).setup()
+	// act
+	now := authorizationCode.IsExpired(time.Now().UTC())
+	later := authorizationCode.IsExpired(time.Now().UTC().Add(2 * time.Minute))
+	// assert
+	assert.False(t, now)
+	assert.True(t, later)
+}
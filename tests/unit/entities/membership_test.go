@@ -0,0 +1,71 @@
+package test_entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/stretchr/testify/assert"
+)
+
+type MembershipEntityTest struct{}
+
+func (*MembershipEntityTest) setup() *entities.MembershipEntity {
+	membership, _ := entities.NewMembershipEntity(&dtos.MembershipDTO{
+		Id:             "cc58997a-2403-af1e-7836-f0b338edcd60",
+		OrganizationId: "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		UserId:         "4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11",
+		Role:           entities.MembershipRoleMember,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	})
+	return membership
+}
+
+func TestMembershipEntity_isIdValid(t *testing.T) {
+	// arrange
+	membership := (&MembershipEntityTest{}).setup()
+	// act
+	err := membership.IsValid()
+	// assert
+	assert.Nil(t, err)
+
+	// arrange
+	membership.OrganizationId = "not_an_uuid"
+	// act
+	err = membership.IsValid()
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidOrganizationId())
+}
+
+func TestMembershipEntity_isRoleValid(t *testing.T) {
+	// arrange
+	membership := (&MembershipEntityTest{}).setup()
+	membership.Role = "superuser"
+	// act
+	err := membership.IsValid()
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidMembershipRole())
+}
+
+func TestMembershipEntity_Permissions(t *testing.T) {
+	// arrange
+	membership := (&MembershipEntityTest{}).setup()
+	// act & assert
+	assert.False(t, membership.CanManageMembers())
+	assert.False(t, membership.IsOwner())
+
+	// arrange
+	membership.Role = entities.MembershipRoleAdmin
+	// act & assert
+	assert.True(t, membership.CanManageMembers())
+	assert.False(t, membership.IsOwner())
+
+	// arrange
+	membership.Role = entities.MembershipRoleOwner
+	// act & assert
+	assert.True(t, membership.CanManageMembers())
+	assert.True(t, membership.IsOwner())
+}
@@ -0,0 +1,53 @@
+package test_entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMfaChallengeEntity_IsValid(t *testing.T) {
+	// arrange
+	data := &dtos.MfaChallengeDTO{
+		Token:     "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q",
+		UserId:    "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}
+	// act
+	mfaChallenge, err := entities.NewMfaChallengeEntity(data)
+	// assert
+	assert.Nil(t, err)
+	assert.False(t, mfaChallenge.IsExpired(time.Now().UTC()))
+	assert.True(t, mfaChallenge.IsExpired(time.Now().UTC().Add(time.Hour)))
+
+	// arrange
+	data.Token = ""
+	// act
+	_, err = entities.NewMfaChallengeEntity(data)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidMfaChallenge())
+
+	// arrange
+	data.Token = "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q"
+	data.UserId = "invalid"
+	// act
+	_, err = entities.NewMfaChallengeEntity(data)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidUserId())
+}
+
+func TestMfaChallengeEntity_HasAttemptsLeft(t *testing.T) {
+	// arrange
+	mfaChallenge := &entities.MfaChallengeEntity{Attempts: entities.MfaChallengeMaxAttempts - 1}
+	// act
+	hasAttemptsLeft := mfaChallenge.HasAttemptsLeft()
+	mfaChallenge.Attempts++
+	exhausted := !mfaChallenge.HasAttemptsLeft()
+	// assert
+	assert.True(t, hasAttemptsLeft)
+	assert.True(t, exhausted)
+}
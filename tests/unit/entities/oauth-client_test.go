@@ -0,0 +1,137 @@
+package test_entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/stretchr/testify/assert"
+)
+
+type OAuthClientEntityTest struct{}
+
+func (*OAuthClientEntityTest) setup() *entities.OAuthClientEntity {
+	client, _ := entities.NewOAuthClientEntity(&dtos.OAuthClientDTO{
+		Id:             "cc58997a-2403-af1e-7836-f0b338edcd60",
+		OrganizationId: "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Name:           "Web app",
+		RedirectUris:   []string{"https://app.example.com/callback", "com.example.app:/oauth"},
+		AuthMethod:     entities.OAuthClientAuthMethodNone,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	})
+	return client
+}
+
+func TestOAuthClientEntity_isIdValid(t *testing.T) {
+	// arrange
+	client := (&OAuthClientEntityTest{}).setup()
+	// act
+	err := client.IsValid()
+	// assert
+	assert.Nil(t, err)
+
+	// arrange
+	client.Id = "not_an_uuid"
+	// act
+	err = client.IsValid()
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidOAuthClientId())
+}
+
+func TestOAuthClientEntity_isNameValid(t *testing.T) {
+	// arrange
+	client := (&OAuthClientEntityTest{}).setup()
+	client.Name = ""
+	// act
+	err := client.IsValid()
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidOAuthClientName())
+}
+
+func TestOAuthClientEntity_areRedirectUrisValid(t *testing.T) {
+	// arrange
+	client := (&OAuthClientEntityTest{}).setup()
+	client.RedirectUris = []string{"/relative/callback"}
+	// act
+	relativeErr := client.IsValid()
+	client.RedirectUris = []string{"https://app.example.com/callback#fragment"}
+	fragmentErr := client.IsValid()
+	client.RedirectUris = []string{}
+	emptyErr := client.IsValid()
+	// assert
+	assert.Equal(t, relativeErr, exceptions.NewInvalidOAuthClientRedirectUri())
+	assert.Equal(t, fragmentErr, exceptions.NewInvalidOAuthClientRedirectUri())
+	assert.Equal(t, emptyErr, exceptions.NewInvalidOAuthClientRedirectUri())
+}
+
+func TestOAuthClientEntity_HasRedirectUri(t *testing.T) {
+	// arrange
+	client := (&OAuthClientEntityTest{}).setup()
+	// act
+	exact := client.HasRedirectUri("https://app.example.com/callback")
+	prefixed := client.HasRedirectUri("https://app.example.com/callback/evil")
+	other := client.HasRedirectUri("https://evil.example.com/callback")
+	// assert
+	assert.True(t, exact)
+	assert.False(t, prefixed)
+	assert.False(t, other)
+}
+
+func TestOAuthClientEntity_isAuthMethodValid(t *testing.T) {
+	// arrange
+	client := (&OAuthClientEntityTest{}).setup()
+	client.AuthMethod = "client_secret_jwt"
+	// act
+	unknownErr := client.IsValid()
+	client.AuthMethod = entities.OAuthClientAuthMethodClientSecretBasic
+	missingSecretErr := client.IsValid()
+	client.SecretHash = "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW"
+	secretErr := client.IsValid()
+	client.AuthMethod = entities.OAuthClientAuthMethodNone
+	publicWithSecretErr := client.IsValid()
+	client.AuthMethod, client.SecretHash = entities.OAuthClientAuthMethodPrivateKeyJwt, ""
+	missingKeyErr := client.IsValid()
+	// assert
+	assert.Equal(t, unknownErr, exceptions.NewInvalidOAuthClientAuthMethod())
+	assert.Equal(t, missingSecretErr, exceptions.NewInvalidOAuthClientAuthMethod())
+	assert.Nil(t, secretErr)
+	assert.Equal(t, publicWithSecretErr, exceptions.NewInvalidOAuthClientAuthMethod())
+	assert.Equal(t, missingKeyErr, exceptions.NewInvalidOAuthClientPublicKey())
+}
+
+func TestOAuthClientEntity_ConfidentialClientWithoutRedirectUris(t *testing.T) {
+	// arrange
+	client := (&OAuthClientEntityTest{}).setup()
+	client.AuthMethod = entities.OAuthClientAuthMethodClientSecretPost
+	client.SecretHash = "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW"
+	client.RedirectUris = []string{}
+	// act
+	err := client.IsValid()
+	// assert
+	assert.Nil(t, err)
+	assert.True(t, client.IsConfidential())
+}
+
+func TestOAuthClientEntity_areScopesAndAudiencesValid(t *testing.T) {
+	// arrange
+	client := (&OAuthClientEntityTest{}).setup()
+	client.Scopes = []string{"Users:Read"}
+	// act
+	scopeErr := client.IsValid()
+	client.Scopes = []string{"users:read"}
+	client.Audiences = []string{"billing"}
+	audienceErr := client.IsValid()
+	client.Audiences = []string{"https://billing.example.com"}
+	validErr := client.IsValid()
+	// assert
+	assert.Equal(t, scopeErr, exceptions.NewInvalidOAuthClientScope())
+	assert.Equal(t, audienceErr, exceptions.NewInvalidOAuthClientAudience())
+	assert.Nil(t, validErr)
+	assert.True(t, client.HasScope("users:read"))
+	assert.False(t, client.HasScope("users:write"))
+	assert.True(t, client.HasAudience("https://billing.example.com"))
+	assert.False(t, client.HasAudience("https://billing.example.com/"))
+}
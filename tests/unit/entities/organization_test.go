@@ -0,0 +1,56 @@
+package test_entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/stretchr/testify/assert"
+)
+
+type OrganizationEntityTest struct{}
+
+func (*OrganizationEntityTest) setup() *entities.OrganizationEntity {
+	organization, _ := entities.NewOrganizationEntity(&dtos.OrganizationDTO{
+		Id:        "cc58997a-2403-af1e-7836-f0b338edcd60",
+		Name:      "Organization",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	})
+	return organization
+}
+
+func TestOrganizationEntity_isIdValid(t *testing.T) {
+	// arrange
+	organization := (&OrganizationEntityTest{}).setup()
+	// act
+	err := organization.IsValid()
+	// assert
+	assert.Nil(t, err)
+
+	// arrange
+	organization.Id = "not_an_uuid"
+	// act
+	err = organization.IsValid()
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidOrganizationId())
+}
+
+func TestOrganizationEntity_isNameValid(t *testing.T) {
+	// arrange
+	organization := (&OrganizationEntityTest{}).setup()
+	organization.Name = "a" // less than 2 chars
+	// act
+	err := organization.IsValid()
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidOrganizationName())
+
+	// arrange
+	organization.Name = " Organization "
+	// act
+	err = organization.IsValid()
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidOrganizationName())
+}
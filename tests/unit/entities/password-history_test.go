@@ -0,0 +1,46 @@
+package test_entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordHistoryEntity_IsValid(t *testing.T) {
+	// arrange
+	data := &dtos.PasswordHistoryDTO{
+		Id:        "4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11",
+		UserId:    "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Password:  "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW",
+		CreatedAt: time.Now().UTC(),
+	}
+	// act
+	_, err := entities.NewPasswordHistoryEntity(data)
+	// assert
+	assert.Nil(t, err)
+
+	// arrange
+	data.Password = "plain text"
+	// act
+	_, err = entities.NewPasswordHistoryEntity(data)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidUserPasswordHash())
+
+	// arrange
+	data.UserId = "invalid"
+	// act
+	_, err = entities.NewPasswordHistoryEntity(data)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidUserId())
+
+	// arrange
+	data.Id = "invalid"
+	// act
+	_, err = entities.NewPasswordHistoryEntity(data)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidPasswordHistoryId())
+}
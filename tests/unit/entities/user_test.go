@@ -94,52 +94,149 @@ func TestUserEntity_isPasswordHashValid(t *testing.T) {
 	// assert
 	assert.Nil(t, err)
 
+	// arrange
+	user.Password = "$argon2id$v=19$m=19456,t=2,p=1$c29tZXNhbHRzb21lc2FsdA$RdescudvJCsgt3ub+b+dWRWJTmaaJObG/XqB6i2fKr8"
+	// act
+	err = user.IsValid()
+	// assert
+	assert.Nil(t, err)
+
 	// arrange
 	user.Password = "not_a_bcrypt_hash"
 	// act
 	err = user.IsValid()
 	// assert
 	assert.Equal(t, err, exceptions.NewInvalidUserPasswordHash())
+
+	// arrange
+	user.Password = "$argon2i$v=19$m=19456,t=2,p=1$c29tZXNhbHRzb21lc2FsdA$RdescudvJCsgt3ub+b+dWRWJTmaaJObG/XqB6i2fKr8"
+	// act
+	err = user.IsValid()
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidUserPasswordHash())
 }
 
 func TestUserEntity_IsPasswordValid(t *testing.T) {
 	// arrange
 	user := (&UserEntityTest{}).setup()
+	policy, _ := entities.NewPasswordPolicyEntity(&dtos.PasswordPolicyDTO{
+		MinLength:             8,
+		MaxLength:             64,
+		RequiredClasses:       []string{entities.PasswordClassLetter, entities.PasswordClassDigit},
+		MaxRepeatedCharacters: 3,
+		DisallowUserInfo:      true,
+		DictionaryWords:       []string{"dragon", "Sunshine"},
+	})
 	password := "p4ssword"
 	// act
-	err := user.IsPasswordValid(password)
+	err := user.IsPasswordValid(password, policy)
 	// assert
 	assert.Nil(t, err)
 
 	// arrange
-	user = (&UserEntityTest{}).setup()
-	password = "password"
+	password = "correct horse battery staple 4 ever and ever"
 	// act
-	err = user.IsPasswordValid(password)
+	err = user.IsPasswordValid(password, policy)
 	// assert
-	assert.Equal(t, err, exceptions.NewInvalidUserPassword())
+	assert.Nil(t, err)
 
 	// arrange
-	user = (&UserEntityTest{}).setup()
-	password = "12345678"
+	password = "password"
 	// act
-	err = user.IsPasswordValid(password)
+	err = user.IsPasswordValid(password, policy)
 	// assert
-	assert.Equal(t, err, exceptions.NewInvalidUserPassword())
+	assert.Equal(t, err, exceptions.NewInvalidUserPassword(
+		"Must have at least one digit character.",
+	))
 
 	// arrange
-	user = (&UserEntityTest{}).setup()
 	password = "to0_sml" // less than 8 characters
 	// act
-	err = user.IsPasswordValid(password)
+	err = user.IsPasswordValid(password, policy)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidUserPassword(
+		"Must have at least 8 characters.",
+	))
+
+	// arrange
+	password = "toooooooooooooooooooooooooooooooooooooooooooooooooooooooooooooo_big" // more than 64 characters
+	// act
+	err = user.IsPasswordValid(password, policy)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidUserPassword(
+		"Must have at most 64 characters.",
+		"Must have at least one digit character.",
+		"Must not repeat the same character more than 3 times in a row.",
+	))
+
+	// arrange
+	password = "my-Username-1"
+	// act
+	err = user.IsPasswordValid(password, policy)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidUserPassword(
+		"Must not contain the username or email.",
+	))
+
+	// arrange
+	password = "Dragon2024!"
+	// act
+	err = user.IsPasswordValid(password, policy)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidUserPassword(
+		"Must not be a common word.",
+	))
+
+	// arrange
+	password = " p4ssword\t"
+	// act
+	err = user.IsPasswordValid(password, policy)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidUserPassword(
+		"Must not start or end with whitespace.",
+	))
+
+	// arrange
+	password = "user"
+	// act
+	err = user.IsPasswordValid(password, policy)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidUserPassword(
+		"Must have at least 8 characters.",
+		"Must have at least one digit character.",
+		"Must not contain the username or email.",
+	))
+}
+
+func TestPasswordPolicyEntity_IsValid(t *testing.T) {
+	// arrange
+	data := &dtos.PasswordPolicyDTO{
+		MinLength:       12,
+		MaxLength:       128,
+		RequiredClasses: []string{entities.PasswordClassUppercase, entities.PasswordClassSymbol},
+	}
+	// act
+	policy, err := entities.NewPasswordPolicyEntity(data)
+	// assert
+	assert.Nil(t, err)
+	assert.False(t, policy.HasDictionary())
+	assert.Equal(t, policy.Violations("lowercase only", "", ""), []string{
+		"Must have at least one uppercase character.",
+		"Must have at least one symbol character.",
+	})
+
+	// arrange
+	data.MaxLength = 11
+	// act
+	_, err = entities.NewPasswordPolicyEntity(data)
 	// assert
-	assert.Equal(t, err, exceptions.NewInvalidUserPassword())
+	assert.Equal(t, err, exceptions.NewInvalidPasswordPolicy())
 
 	// arrange
-	user = (&UserEntityTest{}).setup()
-	password = "toooooooooooooooooooooooooooo_big" // more than 32 characters
+	data.MaxLength = 128
+	data.RequiredClasses = []string{"emoji"}
 	// act
-	err = user.IsPasswordValid(password)
+	_, err = entities.NewPasswordPolicyEntity(data)
 	// assert
-	assert.Equal(t, err, exceptions.NewInvalidUserPassword())
+	assert.Equal(t, err, exceptions.NewInvalidPasswordPolicy())
 }
@@ -0,0 +1,41 @@
+package test_entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebAuthnChallengeEntity_IsValid(t *testing.T) {
+	// arrange
+	data := &dtos.WebAuthnChallengeDTO{
+		Challenge: "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q",
+		Ceremony:  entities.WebAuthnCeremonyAuthentication,
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}
+	// act
+	webAuthnChallenge, err := entities.NewWebAuthnChallengeEntity(data)
+	// assert
+	assert.Nil(t, err)
+	assert.False(t, webAuthnChallenge.IsExpired(time.Now().UTC()))
+	assert.True(t, webAuthnChallenge.IsExpired(time.Now().UTC().Add(time.Hour)))
+
+	// arrange
+	data.Ceremony = entities.WebAuthnCeremonyRegistration
+	// act
+	_, err = entities.NewWebAuthnChallengeEntity(data)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidUserId())
+
+	// arrange
+	data.UserId = "9b157773-fbb4-d04c-9de6-d086cf37d7c7"
+	data.Ceremony = "unknown"
+	// act
+	_, err = entities.NewWebAuthnChallengeEntity(data)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidWebAuthnChallenge())
+}
@@ -0,0 +1,77 @@
+package test_entities
+
+import (
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWebAuthnCredentialEntity_IsValid(t *testing.T) {
+	// arrange
+	data := &dtos.WebAuthnCredentialDTO{
+		Id:           "a0f2c4e6-1b3d-4f5a-8c7e-9d0b2a4c6e8f",
+		UserId:       "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		CredentialId: "q83vEjRWeJA",
+		PublicKey:    "pQECAyYgASFYIA",
+		Transports:   []string{"internal", "hybrid"},
+		CreatedAt:    time.Now().UTC(),
+		UpdatedAt:    time.Now().UTC(),
+	}
+	// act
+	_, err := entities.NewWebAuthnCredentialEntity(data)
+	// assert
+	assert.Nil(t, err)
+
+	// arrange
+	data.Id = "invalid"
+	// act
+	_, err = entities.NewWebAuthnCredentialEntity(data)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidWebAuthnCredentialId())
+
+	// arrange
+	data.Id = "a0f2c4e6-1b3d-4f5a-8c7e-9d0b2a4c6e8f"
+	data.CredentialId = "not base64url!"
+	// act
+	_, err = entities.NewWebAuthnCredentialEntity(data)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidWebAuthnCredentialRawId())
+
+	// arrange
+	data.CredentialId = "q83vEjRWeJA"
+	data.PublicKey = ""
+	// act
+	_, err = entities.NewWebAuthnCredentialEntity(data)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidWebAuthnPublicKey())
+
+	// arrange
+	data.PublicKey = "pQECAyYgASFYIA"
+	data.Transports = []string{"carrier-pigeon"}
+	// act
+	_, err = entities.NewWebAuthnCredentialEntity(data)
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidWebAuthnTransport())
+}
+
+func TestWebAuthnCredentialEntity_IsSignCountValid(t *testing.T) {
+	// arrange
+	counterless := &entities.WebAuthnCredentialEntity{SignCount: 0}
+	counting := &entities.WebAuthnCredentialEntity{SignCount: 7}
+	// act
+	counterlessValid := counterless.IsSignCountValid(0)
+	counterStarted := counterless.IsSignCountValid(1)
+	counterGrew := counting.IsSignCountValid(8)
+	counterRepeated := counting.IsSignCountValid(7)
+	counterReset := counting.IsSignCountValid(0)
+	// assert
+	assert.True(t, counterlessValid)
+	assert.True(t, counterStarted)
+	assert.True(t, counterGrew)
+	assert.False(t, counterRepeated)
+	assert.False(t, counterReset)
+}
@@ -0,0 +1,25 @@
+package test_factories
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMakeDatabaseConnection_SharesOnePoolUntilClosed(t *testing.T) {
+	// arrange
+	first, err := factories.MakeDatabaseConnection()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// act
+	second, err := factories.MakeDatabaseConnection()
+	factories.CloseDatabaseConnection()
+	goerr := first.PingContext(context.Background())
+	// assert
+	assert.Nil(t, err)
+	assert.Same(t, first, second)
+	assert.EqualError(t, goerr, "sql: database is closed")
+}
@@ -0,0 +1,133 @@
+package test_factories
+
+import (
+	"crypto/tls"
+	"path/filepath"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/factories"
+	"github.com/AndreyArthur/oganessone/tests/helpers/certificates"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMakeGrpcTlsConfig_FollowsTheEnvironment(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := certificates.Write(t, dir, "server", "server")
+	caFile, _ := certificates.Write(t, dir, "ca", "ca")
+	tests := []struct {
+		name         string
+		env          map[string]string
+		err          bool
+		plaintext    bool
+		minVersion   uint16
+		cipherSuites []uint16
+		clientAuth   tls.ClientAuthType
+	}{
+		{name: "plaintext", env: map[string]string{}, plaintext: true},
+		{
+			name:       "defaults",
+			env:        map[string]string{"GRPC_TLS_CERT_FILE": certFile, "GRPC_TLS_KEY_FILE": keyFile},
+			minVersion: tls.VersionTLS12,
+			clientAuth: tls.NoClientCert,
+		},
+		{
+			name:       "tls 1.3",
+			env:        map[string]string{"GRPC_TLS_CERT_FILE": certFile, "GRPC_TLS_KEY_FILE": keyFile, "GRPC_TLS_MIN_VERSION": "1.3"},
+			minVersion: tls.VersionTLS13,
+			clientAuth: tls.NoClientCert,
+		},
+		{
+			name: "tls 1.0",
+			env:  map[string]string{"GRPC_TLS_CERT_FILE": certFile, "GRPC_TLS_KEY_FILE": keyFile, "GRPC_TLS_MIN_VERSION": "1.0"},
+			err:  true,
+		},
+		{
+			name: "cipher suites",
+			env: map[string]string{
+				"GRPC_TLS_CERT_FILE":     certFile,
+				"GRPC_TLS_KEY_FILE":      keyFile,
+				"GRPC_TLS_CIPHER_SUITES": "TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384",
+			},
+			minVersion:   tls.VersionTLS12,
+			cipherSuites: []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256, tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384},
+			clientAuth:   tls.NoClientCert,
+		},
+		{
+			name: "insecure cipher suite",
+			env: map[string]string{
+				"GRPC_TLS_CERT_FILE":     certFile,
+				"GRPC_TLS_KEY_FILE":      keyFile,
+				"GRPC_TLS_CIPHER_SUITES": "TLS_RSA_WITH_RC4_128_SHA",
+			},
+			err: true,
+		},
+		{
+			name:       "client ca",
+			env:        map[string]string{"GRPC_TLS_CERT_FILE": certFile, "GRPC_TLS_KEY_FILE": keyFile, "GRPC_TLS_CLIENT_CA_FILE": caFile},
+			minVersion: tls.VersionTLS12,
+			clientAuth: tls.RequireAndVerifyClientCert,
+		},
+		{
+			name: "optional client certificates",
+			env: map[string]string{
+				"GRPC_TLS_CERT_FILE":      certFile,
+				"GRPC_TLS_KEY_FILE":       keyFile,
+				"GRPC_TLS_CLIENT_CA_FILE": caFile,
+				"GRPC_TLS_CLIENT_AUTH":    "optional",
+			},
+			minVersion: tls.VersionTLS12,
+			clientAuth: tls.VerifyClientCertIfGiven,
+		},
+		{
+			name: "unknown client auth",
+			env: map[string]string{
+				"GRPC_TLS_CERT_FILE":      certFile,
+				"GRPC_TLS_KEY_FILE":       keyFile,
+				"GRPC_TLS_CLIENT_CA_FILE": caFile,
+				"GRPC_TLS_CLIENT_AUTH":    "sometimes",
+			},
+			err: true,
+		},
+		{
+			name:       "client auth without ca",
+			env:        map[string]string{"GRPC_TLS_CERT_FILE": certFile, "GRPC_TLS_KEY_FILE": keyFile, "GRPC_TLS_CLIENT_AUTH": "optional"},
+			minVersion: tls.VersionTLS12,
+			clientAuth: tls.NoClientCert,
+		},
+		{
+			name: "missing key",
+			env:  map[string]string{"GRPC_TLS_CERT_FILE": certFile, "GRPC_TLS_KEY_FILE": filepath.Join(dir, "missing.key")},
+			err:  true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// arrange
+			for _, name := range []string{
+				"GRPC_TLS_CERT_FILE", "GRPC_TLS_KEY_FILE", "GRPC_TLS_MIN_VERSION",
+				"GRPC_TLS_CIPHER_SUITES", "GRPC_TLS_CLIENT_CA_FILE", "GRPC_TLS_CLIENT_AUTH",
+			} {
+				t.Setenv(name, test.env[name])
+			}
+			// act
+			config, err := factories.MakeGrpcTlsConfig()
+			// assert
+			if test.err {
+				assert.Nil(t, config)
+				assert.Equal(t, err, exceptions.NewInternalServerError())
+				return
+			}
+			assert.Nil(t, err)
+			if test.plaintext {
+				assert.Nil(t, config)
+				return
+			}
+			assert.Equal(t, config.MinVersion, test.minVersion)
+			assert.Equal(t, config.CipherSuites, test.cipherSuites)
+			assert.Equal(t, config.ClientAuth, test.clientAuth)
+			assert.Equal(t, config.NextProtos, []string{"h2"})
+			assert.NotNil(t, config.GetConfigForClient)
+		})
+	}
+}
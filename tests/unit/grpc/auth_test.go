@@ -0,0 +1,228 @@
+package test_grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_definitions "github.com/AndreyArthur/oganessone/src/application/definitions/mocks"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/grpc"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	google_grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type AuthInterceptorTest struct{}
+
+func (*AuthInterceptorTest) setup(t *testing.T) (*grpc.AuthInterceptor, *mock_definitions.MockAuthenticateSession, *mock_repositories.MockMembershipsRepository, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	authenticateSession := mock_definitions.NewMockAuthenticateSession(ctrl)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
+	authenticatePrincipal, _ := usecases.NewAuthenticatePrincipalUseCase(authenticateSession, memberships)
+	authInterceptor, _ := grpc.NewAuthInterceptor(authenticatePrincipal)
+	return authInterceptor, authenticateSession, memberships, ctrl
+}
+
+func (*AuthInterceptorTest) context(sessionKey string) context.Context {
+	return metadata.NewIncomingContext(
+		context.Background(), metadata.Pairs("authorization", "Bearer "+sessionKey),
+	)
+}
+
+type recordingHandler struct {
+	called    bool
+	principal *definitions.AuthenticatePrincipalResult
+}
+
+func (handler *recordingHandler) handle(ctx context.Context, request interface{}) (interface{}, error) {
+	handler.called = true
+	handler.principal, _ = definitions.PrincipalFromContext(ctx)
+	return request, nil
+}
+
+func TestAuthInterceptor_SuccessCase(t *testing.T) {
+	// arrange
+	authInterceptor, authenticateSession, memberships, ctrl := (&AuthInterceptorTest{}).setup(t)
+	defer ctrl.Finish()
+	sessionKey, userId, organizationId :=
+		"session_key_example",
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"cc58997a-2403-af1e-7836-f0b338edcd60"
+	authenticateSession.EXPECT().
+		Execute(gomock.Any(), &definitions.AuthenticateSessionDTO{SessionKey: sessionKey}).
+		Return(&definitions.AuthenticateSessionResult{
+			SessionKey:     sessionKey,
+			UserId:         userId,
+			OrganizationId: organizationId,
+		}, nil)
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, userId).
+		Return(&entities.MembershipEntity{
+			OrganizationId: organizationId,
+			UserId:         userId,
+			Role:           entities.MembershipRoleAdmin,
+		}, nil)
+	handler := &recordingHandler{}
+	// act
+	_, goerr := authInterceptor.Unary(
+		(&AuthInterceptorTest{}).context(sessionKey), "request",
+		&google_grpc.UnaryServerInfo{FullMethod: "/protobuf.OrganizationsService/InviteMember"},
+		handler.handle,
+	)
+	// assert
+	assert.Nil(t, goerr)
+	assert.True(t, handler.called)
+	assert.Equal(t, handler.principal.UserId, userId)
+	assert.Equal(t, handler.principal.Roles, []string{entities.MembershipRoleAdmin})
+}
+
+func TestAuthInterceptor_SkipsPublicMethods(t *testing.T) {
+	// arrange
+	authInterceptor, _, _, ctrl := (&AuthInterceptorTest{}).setup(t)
+	defer ctrl.Finish()
+	handler := &recordingHandler{}
+	// act
+	_, goerr := authInterceptor.Unary(
+		context.Background(), "request",
+		&google_grpc.UnaryServerInfo{FullMethod: "/protobuf.SessionsService/CreateSession"},
+		handler.handle,
+	)
+	// assert
+	assert.Nil(t, goerr)
+	assert.True(t, handler.called)
+	assert.Nil(t, handler.principal)
+}
+
+func TestAuthInterceptor_FailsWithoutPrincipal(t *testing.T) {
+	// arrange
+	authInterceptor, authenticateSession, _, ctrl := (&AuthInterceptorTest{}).setup(t)
+	defer ctrl.Finish()
+	authenticateSession.EXPECT().
+		Execute(gomock.Any(), &definitions.AuthenticateSessionDTO{}).
+		Return(nil, exceptions.NewSessionNotFound())
+	handler := &recordingHandler{}
+	// act
+	_, goerr := authInterceptor.Unary(
+		context.Background(), "request",
+		&google_grpc.UnaryServerInfo{FullMethod: "/protobuf.MfaService/GetMfaStatus"},
+		handler.handle,
+	)
+	// assert
+	assert.Equal(t, status.Code(goerr), codes.Unauthenticated)
+	assert.False(t, handler.called)
+}
+
+func TestAuthInterceptor_FailsWithStatusOnLegacyErrors(t *testing.T) {
+	// arrange
+	t.Setenv("GRPC_LEGACY_ERRORS", "true")
+	authInterceptor, authenticateSession, _, ctrl := (&AuthInterceptorTest{}).setup(t)
+	defer ctrl.Finish()
+	authenticateSession.EXPECT().
+		Execute(gomock.Any(), &definitions.AuthenticateSessionDTO{}).
+		Return(nil, exceptions.NewSessionNotFound())
+	handler := &recordingHandler{}
+	// act
+	_, goerr := authInterceptor.Unary(
+		context.Background(), "request",
+		&google_grpc.UnaryServerInfo{FullMethod: "/protobuf.MfaService/GetMfaStatus"},
+		handler.handle,
+	)
+	// assert
+	grpcStatus := status.Convert(goerr)
+	assert.Equal(t, grpcStatus.Code(), codes.Unauthenticated)
+	assert.Equal(t, grpcStatus.Message(), exceptions.NewSessionNotFound().Message)
+	assert.False(t, handler.called)
+}
+
+func TestAuthInterceptor_FailsWithWrongRole(t *testing.T) {
+	// arrange
+	authInterceptor, authenticateSession, memberships, ctrl := (&AuthInterceptorTest{}).setup(t)
+	defer ctrl.Finish()
+	sessionKey, userId, organizationId :=
+		"session_key_example",
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"cc58997a-2403-af1e-7836-f0b338edcd60"
+	authenticateSession.EXPECT().
+		Execute(gomock.Any(), &definitions.AuthenticateSessionDTO{SessionKey: sessionKey}).
+		Return(&definitions.AuthenticateSessionResult{
+			SessionKey:     sessionKey,
+			UserId:         userId,
+			OrganizationId: organizationId,
+		}, nil)
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, userId).
+		Return(&entities.MembershipEntity{
+			OrganizationId: organizationId,
+			UserId:         userId,
+			Role:           entities.MembershipRoleMember,
+		}, nil)
+	handler := &recordingHandler{}
+	// act
+	_, goerr := authInterceptor.Unary(
+		(&AuthInterceptorTest{}).context(sessionKey), "request",
+		&google_grpc.UnaryServerInfo{FullMethod: "/protobuf.OrganizationsService/RemoveMember"},
+		handler.handle,
+	)
+	// assert
+	assert.Equal(t, status.Code(goerr), codes.PermissionDenied)
+	assert.False(t, handler.called)
+}
+
+func TestAuthInterceptor_FailsWithoutScope(t *testing.T) {
+	// arrange
+	authInterceptor, authenticateSession, _, ctrl := (&AuthInterceptorTest{}).setup(t)
+	defer ctrl.Finish()
+	sessionKey, userId :=
+		"oga_abcdefgh_secret",
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7"
+	authenticateSession.EXPECT().
+		Execute(gomock.Any(), &definitions.AuthenticateSessionDTO{SessionKey: sessionKey}).
+		Return(&definitions.AuthenticateSessionResult{
+			SessionKey: sessionKey,
+			UserId:     userId,
+			ApiKeyId:   "0f2d5c1e-8a4b-4c7d-9e6f-1a2b3c4d5e6f",
+			Scopes:     []string{"audit:read"},
+		}, nil)
+	handler := &recordingHandler{}
+	// act
+	_, goerr := authInterceptor.Unary(
+		(&AuthInterceptorTest{}).context(sessionKey), "request",
+		&google_grpc.UnaryServerInfo{FullMethod: "/protobuf.MfaService/EnrollMfa"},
+		handler.handle,
+	)
+	// assert
+	assert.Equal(t, status.Code(goerr), codes.PermissionDenied)
+	assert.False(t, handler.called)
+}
+
+func TestAuthInterceptor_DeniesScopedApiKey(t *testing.T) {
+	// arrange
+	authInterceptor, authenticateSession, _, ctrl := (&AuthInterceptorTest{}).setup(t)
+	defer ctrl.Finish()
+	sessionKey := "og_AbCd1234_0123456789abcdefghijABCDEFGHIJkl"
+	authenticateSession.EXPECT().
+		Execute(gomock.Any(), &definitions.AuthenticateSessionDTO{SessionKey: sessionKey}).
+		Return(&definitions.AuthenticateSessionResult{
+			SessionKey: sessionKey,
+			UserId:     "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+			ApiKeyId:   "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+			Scopes:     []string{"api_keys:read"},
+		}, nil)
+	handler := &recordingHandler{}
+	// act
+	_, goerr := authInterceptor.Unary(
+		(&AuthInterceptorTest{}).context(sessionKey), "request",
+		&google_grpc.UnaryServerInfo{FullMethod: "/protobuf.ApiKeysService/CreateApiKey"},
+		handler.handle,
+	)
+	// assert
+	assert.Equal(t, status.Code(goerr), codes.PermissionDenied)
+	assert.False(t, handler.called)
+}
@@ -0,0 +1,114 @@
+package test_grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/grpc/protobuf"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	google_grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Each call fails before it needs a database.
+func TestGrpcServer_MapsErrorsToStatuses(t *testing.T) {
+	tests := []struct {
+		name       string
+		call       func(connection *google_grpc.ClientConn) error
+		err        *shared.Error
+		code       codes.Code
+		violations []string
+	}{
+		{
+			"authentication",
+			func(connection *google_grpc.ClientConn) error {
+				_, goerr := protobuf.NewUsersServiceClient(connection).
+					ChangePassword(context.Background(), &protobuf.ChangePasswordRequest{})
+				return goerr
+			},
+			exceptions.NewSessionNotFound(),
+			codes.Unauthenticated,
+			nil,
+		},
+		{
+			"oauth",
+			func(connection *google_grpc.ClientConn) error {
+				_, goerr := protobuf.NewTokensServiceClient(connection).
+					RevokeToken(context.Background(), &protobuf.RevokeTokenRequest{})
+				return goerr
+			},
+			exceptions.NewOAuthInvalidClient(),
+			codes.InvalidArgument,
+			nil,
+		},
+		{
+			"validation",
+			func(connection *google_grpc.ClientConn) error {
+				_, goerr := protobuf.NewWebAuthnServiceClient(connection).
+					FinishWebAuthnLogin(context.Background(), &protobuf.FinishWebAuthnLoginRequest{})
+				return goerr
+			},
+			exceptions.NewInvalidWebAuthnResponse(),
+			codes.InvalidArgument,
+			[]string{exceptions.NewInvalidWebAuthnResponse().Message},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// arrange
+			_, connection := (&GrpcServerTest{}).setup(t)
+			// act
+			grpcStatus := status.Convert(test.call(connection))
+			// assert
+			assert.Equal(t, grpcStatus.Code(), test.code)
+			assert.Equal(t, grpcStatus.Message(), test.err.Message)
+			details := grpcStatus.Details()
+			errorInfo := details[0].(*errdetails.ErrorInfo)
+			assert.Equal(t, errorInfo.Reason, test.err.Name)
+			assert.Equal(t, errorInfo.Domain, "oganessone")
+			assert.Equal(t, errorInfo.Metadata, map[string]string{"type": test.err.Type})
+			if test.violations == nil {
+				assert.Len(t, details, 1)
+				return
+			}
+			assert.Len(t, details, 2)
+			violations := []string{}
+			for _, violation := range details[1].(*errdetails.BadRequest).FieldViolations {
+				violations = append(violations, violation.Description)
+			}
+			assert.Equal(t, violations, test.violations)
+		})
+	}
+}
+
+func TestGrpcServer_LegacyErrors(t *testing.T) {
+	tests := []struct {
+		legacyErrors string
+		code         codes.Code
+	}{
+		{"", codes.Unauthenticated},
+		{"false", codes.Unauthenticated},
+		{"true", codes.OK},
+	}
+	for _, test := range tests {
+		t.Run("GRPC_LEGACY_ERRORS="+test.legacyErrors, func(t *testing.T) {
+			// arrange
+			t.Setenv("GRPC_LEGACY_ERRORS", test.legacyErrors)
+			_, connection := (&GrpcServerTest{}).setup(t)
+			client := protobuf.NewUsersServiceClient(connection)
+			// act
+			response, goerr := client.ChangePassword(context.Background(), &protobuf.ChangePasswordRequest{})
+			// assert
+			assert.Equal(t, status.Code(goerr), test.code)
+			if goerr != nil {
+				return
+			}
+			assert.Equal(t, response.Error.Name, exceptions.NewSessionNotFound().Name)
+			assert.Equal(t, response.Error.Type, exceptions.NewSessionNotFound().Type)
+		})
+	}
+}
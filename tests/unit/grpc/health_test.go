@@ -0,0 +1,95 @@
+package test_grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+)
+
+// Whether Postgres answers depends on where the tests run, so only the
+// agreement between the server and each service is checked here.
+func TestGrpcServer_ReportsOneStatusForEveryService(t *testing.T) {
+	// arrange
+	_, connection := (&GrpcServerTest{}).setup(t)
+	client := grpc_health_v1.NewHealthClient(connection)
+	overall, goerr := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{})
+	if goerr != nil {
+		t.Fatal(goerr)
+	}
+	tests := []struct {
+		service string
+		code    codes.Code
+	}{
+		{"protobuf.UsersService", codes.OK},
+		{"protobuf.SessionsService", codes.OK},
+		{"protobuf.OrganizationsService", codes.OK},
+		{"protobuf.ApiKeysService", codes.OK},
+		{"protobuf.OAuthClientsService", codes.OK},
+		{"protobuf.TokensService", codes.OK},
+		{"protobuf.MfaService", codes.OK},
+		{"protobuf.WebAuthnService", codes.OK},
+		{"protobuf.AuditService", codes.OK},
+		{"protobuf.UnknownService", codes.NotFound},
+	}
+	for _, test := range tests {
+		t.Run(test.service, func(t *testing.T) {
+			// act
+			response, goerr := client.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{
+				Service: test.service,
+			})
+			// assert
+			assert.Equal(t, status.Code(goerr), test.code)
+			if goerr != nil {
+				return
+			}
+			assert.Equal(t, response.Status, overall.Status)
+		})
+	}
+}
+
+func TestGrpcServer_ReflectionFollowsConfig(t *testing.T) {
+	tests := []struct {
+		reflection string
+		code       codes.Code
+	}{
+		{"", codes.Unimplemented},
+		{"false", codes.Unimplemented},
+		{"true", codes.OK},
+	}
+	for _, test := range tests {
+		t.Run("GRPC_REFLECTION="+test.reflection, func(t *testing.T) {
+			// arrange
+			t.Setenv("GRPC_REFLECTION", test.reflection)
+			_, connection := (&GrpcServerTest{}).setup(t)
+			stream, goerr := grpc_reflection_v1alpha.NewServerReflectionClient(connection).
+				ServerReflectionInfo(context.Background())
+			if goerr != nil {
+				t.Fatal(goerr)
+			}
+			// act
+			goerr = stream.Send(&grpc_reflection_v1alpha.ServerReflectionRequest{
+				MessageRequest: &grpc_reflection_v1alpha.ServerReflectionRequest_ListServices{},
+			})
+			if goerr != nil {
+				t.Fatal(goerr)
+			}
+			response, goerr := stream.Recv()
+			// assert
+			assert.Equal(t, status.Code(goerr), test.code)
+			if goerr != nil {
+				return
+			}
+			services := []string{}
+			for _, service := range response.GetListServicesResponse().GetService() {
+				services = append(services, service.Name)
+			}
+			assert.Contains(t, services, "protobuf.UsersService")
+			assert.Contains(t, services, "grpc.health.v1.Health")
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package test_grpc
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/grpc"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/stretchr/testify/assert"
+	google_grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func scrape() string {
+	output := &bytes.Buffer{}
+	helpers.Metrics.WriteText(output)
+	return output.String()
+}
+
+func TestUnaryMetricsInterceptor_CountsCallsByCode(t *testing.T) {
+	// arrange
+	fullMethod := "/protobuf.MetricsTest/CountsCallsByCode"
+	info := &google_grpc.UnaryServerInfo{FullMethod: fullMethod}
+	succeed := func(ctx context.Context, request interface{}) (interface{}, error) {
+		return request, nil
+	}
+	fail := func(ctx context.Context, request interface{}) (interface{}, error) {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+	// act
+	grpc.UnaryMetricsInterceptor(context.Background(), "request", info, succeed)
+	grpc.UnaryMetricsInterceptor(context.Background(), "request", info, succeed)
+	_, goerr := grpc.UnaryMetricsInterceptor(context.Background(), "request", info, fail)
+	text := scrape()
+	// assert
+	assert.Equal(t, status.Code(goerr), codes.NotFound)
+	assert.Contains(t, text, `oganessone_grpc_requests_total{method="`+fullMethod+`",code="OK"} 2`+"\n")
+	assert.Contains(t, text, `oganessone_grpc_requests_total{method="`+fullMethod+`",code="NotFound"} 1`+"\n")
+	assert.Contains(t, text, `oganessone_grpc_request_duration_seconds_count{method="`+fullMethod+`"} 3`+"\n")
+}
+
+func TestStreamMetricsInterceptor_CountsCallsByCode(t *testing.T) {
+	// arrange
+	fullMethod := "/protobuf.MetricsTest/StreamCountsCallsByCode"
+	info := &google_grpc.StreamServerInfo{FullMethod: fullMethod}
+	fail := func(server interface{}, stream google_grpc.ServerStream) error {
+		return status.Error(codes.Unavailable, "unavailable")
+	}
+	// act
+	goerr := grpc.StreamMetricsInterceptor(nil, nil, info, fail)
+	text := scrape()
+	// assert
+	assert.Equal(t, status.Code(goerr), codes.Unavailable)
+	assert.Contains(t, text, `oganessone_grpc_requests_total{method="`+fullMethod+`",code="Unavailable"} 1`+"\n")
+}
@@ -0,0 +1,104 @@
+package test_grpc
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/grpc"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/grpc/protobuf"
+	"github.com/stretchr/testify/assert"
+	google_grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type GrpcServerTest struct{}
+
+func (*GrpcServerTest) setup(t *testing.T, options ...google_grpc.ServerOption) (*grpc.GrpcServer, *google_grpc.ClientConn) {
+	listener, goerr := net.Listen("tcp", "127.0.0.1:0")
+	if goerr != nil {
+		t.Fatal(goerr)
+	}
+	server, err := grpc.NewGrpcServer(google_grpc.NewServer(options...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	go server.Start(listener)
+	t.Cleanup(func() { server.Shutdown(context.Background()) })
+	connection, goerr := google_grpc.Dial(
+		listener.Addr().String(), google_grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if goerr != nil {
+		t.Fatal(goerr)
+	}
+	t.Cleanup(func() { connection.Close() })
+	return server, connection
+}
+
+// Calls to GetPasswordPolicy signal started and then hold until release is
+// closed, standing in for a slow in-flight request.
+func (*GrpcServerTest) hold(started chan struct{}, release chan struct{}) google_grpc.ServerOption {
+	return google_grpc.UnaryInterceptor(func(
+		ctx context.Context, request interface{}, info *google_grpc.UnaryServerInfo, handler google_grpc.UnaryHandler,
+	) (interface{}, error) {
+		if info.FullMethod != "/protobuf.UsersService/GetPasswordPolicy" {
+			return handler(ctx, request)
+		}
+		close(started)
+		<-release
+		return &protobuf.GetPasswordPolicyResponse{}, nil
+	})
+}
+
+func TestGrpcServer_ShutdownDrainsInFlightCalls(t *testing.T) {
+	// arrange
+	started, release := make(chan struct{}), make(chan struct{})
+	server, connection := (&GrpcServerTest{}).setup(t, (&GrpcServerTest{}).hold(started, release))
+	client := protobuf.NewUsersServiceClient(connection)
+	called := make(chan error, 1)
+	go func() {
+		_, goerr := client.GetPasswordPolicy(context.Background(), &protobuf.GetPasswordPolicyRequest{})
+		called <- goerr
+	}()
+	<-started
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	stopped := make(chan struct{})
+	// act
+	go func() {
+		server.Shutdown(ctx)
+		stopped <- struct{}{}
+	}()
+	// assert
+	select {
+	case <-stopped:
+		t.Fatal("shutdown returned with a call still in flight")
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(release)
+	assert.Nil(t, <-called)
+	<-stopped
+}
+
+func TestGrpcServer_ShutdownCutsOffCallsAfterTheDeadline(t *testing.T) {
+	// arrange
+	started, release := make(chan struct{}), make(chan struct{})
+	defer close(release)
+	server, connection := (&GrpcServerTest{}).setup(t, (&GrpcServerTest{}).hold(started, release))
+	client := protobuf.NewUsersServiceClient(connection)
+	called := make(chan error, 1)
+	go func() {
+		_, goerr := client.GetPasswordPolicy(context.Background(), &protobuf.GetPasswordPolicyRequest{})
+		called <- goerr
+	}()
+	<-started
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	// act
+	server.Shutdown(ctx)
+	// assert
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.NotNil(t, <-called)
+}
@@ -0,0 +1,132 @@
+package test_helpers
+
+import (
+	"crypto/tls"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/AndreyArthur/oganessone/tests/helpers/certificates"
+	"github.com/stretchr/testify/assert"
+)
+
+type CertificatesTest struct{}
+
+func (*CertificatesTest) logErrors() (func(error), *[]error) {
+	logged := []error{}
+	return func(goerr error) { logged = append(logged, goerr) }, &logged
+}
+
+func (*CertificatesTest) handshake(t *testing.T, certs *helpers.Certificates) *tls.Config {
+	handshake, goerr := certs.Config(&tls.Config{MinVersion: tls.VersionTLS12}).
+		GetConfigForClient(&tls.ClientHelloInfo{})
+	if goerr != nil {
+		t.Fatal(goerr)
+	}
+	return handshake
+}
+
+func (*CertificatesTest) der(t *testing.T, certFile string) []byte {
+	contents, goerr := os.ReadFile(certFile)
+	if goerr != nil {
+		t.Fatal(goerr)
+	}
+	block, _ := pem.Decode(contents)
+	return block.Bytes
+}
+
+func (*CertificatesTest) copy(t *testing.T, from string, to string) {
+	contents, goerr := os.ReadFile(from)
+	if goerr != nil {
+		t.Fatal(goerr)
+	}
+	certificates.WriteFile(t, to, contents)
+}
+
+func TestNewCertificates_LoadsTheFiles(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := certificates.Write(t, dir, "server", "server")
+	otherCertFile, _ := certificates.Write(t, dir, "other", "other")
+	caFile, _ := certificates.Write(t, dir, "ca", "ca")
+	emptyFile := filepath.Join(dir, "empty.pem")
+	certificates.WriteFile(t, emptyFile, []byte("no certificates here"))
+	tests := []struct {
+		name      string
+		certFile  string
+		keyFile   string
+		caFile    string
+		err       bool
+		clientCAs bool
+	}{
+		{"key pair", certFile, keyFile, "", false, false},
+		{"key pair and ca", certFile, keyFile, caFile, false, true},
+		{"missing certificate", filepath.Join(dir, "missing.crt"), keyFile, "", true, false},
+		{"missing ca", certFile, keyFile, filepath.Join(dir, "missing.crt"), true, false},
+		{"key of another certificate", otherCertFile, keyFile, "", true, false},
+		{"ca without pem", certFile, keyFile, emptyFile, true, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// arrange
+			logError, logged := (&CertificatesTest{}).logErrors()
+			// act
+			certs, err := helpers.NewCertificates(test.certFile, test.keyFile, test.caFile, logError)
+			// assert
+			if test.err {
+				assert.Nil(t, certs)
+				assert.Equal(t, err, exceptions.NewInternalServerError())
+				assert.Len(t, *logged, 1)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Empty(t, *logged)
+			handshake := (&CertificatesTest{}).handshake(t, certs)
+			assert.Equal(t, handshake.Certificates[0].Certificate[0], (&CertificatesTest{}).der(t, test.certFile))
+			assert.Equal(t, handshake.ClientCAs != nil, test.clientCAs)
+			assert.Equal(t, handshake.MinVersion, uint16(tls.VersionTLS12))
+		})
+	}
+}
+
+func TestCertificates_ReloadsRotatedFiles(t *testing.T) {
+	// arrange
+	dir := t.TempDir()
+	certFile, keyFile := certificates.Write(t, dir, "server", "server")
+	rotatedCertFile, rotatedKeyFile := certificates.Write(t, t.TempDir(), "server", "rotated")
+	logError, logged := (&CertificatesTest{}).logErrors()
+	certs, err := helpers.NewCertificates(certFile, keyFile, "", logError)
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := (&CertificatesTest{}).handshake(t, certs)
+	// act
+	(&CertificatesTest{}).copy(t, rotatedCertFile, certFile)
+	(&CertificatesTest{}).copy(t, rotatedKeyFile, keyFile)
+	after := (&CertificatesTest{}).handshake(t, certs)
+	// assert
+	assert.Empty(t, *logged)
+	assert.NotEqual(t, before.Certificates[0].Certificate[0], after.Certificates[0].Certificate[0])
+	assert.Equal(t, after.Certificates[0].Certificate[0], (&CertificatesTest{}).der(t, rotatedCertFile))
+}
+
+func TestCertificates_KeepsThePreviousFilesOnAFailedReload(t *testing.T) {
+	// arrange
+	dir := t.TempDir()
+	certFile, keyFile := certificates.Write(t, dir, "server", "server")
+	rotatedCertFile, _ := certificates.Write(t, t.TempDir(), "server", "rotated")
+	logError, logged := (&CertificatesTest{}).logErrors()
+	certs, err := helpers.NewCertificates(certFile, keyFile, "", logError)
+	if err != nil {
+		t.Fatal(err)
+	}
+	original := (&CertificatesTest{}).der(t, certFile)
+	// act
+	(&CertificatesTest{}).copy(t, rotatedCertFile, certFile)
+	handshake := (&CertificatesTest{}).handshake(t, certs)
+	// assert
+	assert.Equal(t, handshake.Certificates[0].Certificate[0], original)
+	assert.Len(t, *logged, 1)
+}
@@ -0,0 +1,96 @@
+package test_helpers
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/infrastructure/helpers"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsRegistry_WriteText(t *testing.T) {
+	tests := []struct {
+		name     string
+		register func(registry *helpers.MetricsRegistry)
+		expected string
+	}{
+		{
+			name: "counter with labels",
+			register: func(registry *helpers.MetricsRegistry) {
+				counter := registry.NewCounter("logins_total", "Logins.", "result")
+				counter.Inc("failed")
+				counter.Add(2, "succeeded")
+				counter.Inc("failed")
+			},
+			expected: "# HELP logins_total Logins.\n" +
+				"# TYPE logins_total counter\n" +
+				"logins_total{result=\"failed\"} 2\n" +
+				"logins_total{result=\"succeeded\"} 2\n",
+		},
+		{
+			name: "histogram buckets are cumulative",
+			register: func(registry *helpers.MetricsRegistry) {
+				histogram := registry.NewHistogram("latency_seconds", "Latency.", []float64{0.1, 1}, "method")
+				histogram.Observe(0.05, "get")
+				histogram.Observe(0.5, "get")
+				histogram.Observe(5, "get")
+			},
+			expected: "# HELP latency_seconds Latency.\n" +
+				"# TYPE latency_seconds histogram\n" +
+				"latency_seconds_bucket{method=\"get\",le=\"0.1\"} 1\n" +
+				"latency_seconds_bucket{method=\"get\",le=\"1\"} 2\n" +
+				"latency_seconds_bucket{method=\"get\",le=\"+Inf\"} 3\n" +
+				"latency_seconds_sum{method=\"get\"} 5.55\n" +
+				"latency_seconds_count{method=\"get\"} 3\n",
+		},
+		{
+			name: "gauge read at scrape time",
+			register: func(registry *helpers.MetricsRegistry) {
+				registry.NewGaugeFunc("sessions_active", "Sessions.", func() float64 { return 3 })
+			},
+			expected: "# HELP sessions_active Sessions.\n" +
+				"# TYPE sessions_active gauge\n" +
+				"sessions_active 3\n",
+		},
+		{
+			name: "label values are escaped",
+			register: func(registry *helpers.MetricsRegistry) {
+				registry.NewCounter("errors_total", "Errors.", "message").Inc("say \"hi\"\\\n")
+			},
+			expected: "# HELP errors_total Errors.\n" +
+				"# TYPE errors_total counter\n" +
+				"errors_total{message=\"say \\\"hi\\\"\\\\\\n\"} 1\n",
+		},
+		{
+			name: "wrong number of label values is dropped",
+			register: func(registry *helpers.MetricsRegistry) {
+				registry.NewCounter("calls_total", "Calls.", "method", "code").Inc("get")
+			},
+			expected: "# HELP calls_total Calls.\n" +
+				"# TYPE calls_total counter\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// arrange
+			registry := helpers.NewMetricsRegistry()
+			test.register(registry)
+			output := &bytes.Buffer{}
+			// act
+			goerr := registry.WriteText(output)
+			// assert
+			assert.Nil(t, goerr)
+			assert.Equal(t, output.String(), test.expected)
+		})
+	}
+}
+
+func TestMetricsRegistry_RegisteringTwiceReturnsTheFirst(t *testing.T) {
+	// arrange
+	registry := helpers.NewMetricsRegistry()
+	first := registry.NewCounter("calls_total", "Calls.")
+	// act
+	second := registry.NewCounter("calls_total", "Calls.")
+	// assert
+	assert.Same(t, first, second)
+}
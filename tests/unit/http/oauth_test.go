@@ -0,0 +1,64 @@
+package test_http
+
+import (
+	"html/template"
+	"io"
+	net_http "net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/stretchr/testify/assert"
+)
+
+// Refused before the authorization request is validated, so no database is
+// needed.
+func TestAuthorize_RefusesLoginFormsWithoutTheCsrfToken(t *testing.T) {
+	tests := []struct {
+		name      string
+		cookie    string
+		csrfToken string
+	}{
+		{"no token", "", ""},
+		{"no cookie", "", "csrf_token_example"},
+		{"no form field", "csrf_token_example", ""},
+		{"different token", "csrf_token_example", "other_csrf_token"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// arrange
+			baseUrl, stop := (&RestTest{}).setup(t, &refusingAuthenticator{})
+			defer stop()
+			form := url.Values{
+				"response_type": {"code"},
+				"client_id":     {"client_id_example"},
+				"login":         {"username"},
+				"password":      {"password"},
+				"csrf_token":    {test.csrfToken},
+			}
+			request, goerr := net_http.NewRequest("POST", baseUrl+"/authorize", strings.NewReader(form.Encode()))
+			if goerr != nil {
+				t.Fatal(goerr)
+			}
+			request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			if test.cookie != "" {
+				request.AddCookie(&net_http.Cookie{Name: "authorize_csrf", Value: test.cookie})
+			}
+			// act
+			response, goerr := net_http.DefaultClient.Do(request)
+			if goerr != nil {
+				t.Fatal(goerr)
+			}
+			defer response.Body.Close()
+			body, goerr := io.ReadAll(response.Body)
+			if goerr != nil {
+				t.Fatal(goerr)
+			}
+			// assert
+			assert.Equal(t, response.StatusCode, net_http.StatusForbidden)
+			assert.Contains(t, string(body), template.HTMLEscapeString(exceptions.NewInvalidLoginForm().Message))
+			assert.Equal(t, response.Header.Get("X-Frame-Options"), "DENY")
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package test_http
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	net_http "net/http"
+	"strings"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/AndreyArthur/oganessone/src/infrastructure/http"
+	"github.com/stretchr/testify/assert"
+)
+
+// Refuses every request with err, so no presenter runs, and keeps the
+// operation it was asked about.
+type refusingAuthenticator struct {
+	err        *shared.Error
+	operations []string
+}
+
+func (authenticator *refusingAuthenticator) Authenticate(
+	ctx context.Context, operation string, sessionKey string, ipAddress string,
+) (context.Context, *shared.Error) {
+	authenticator.operations = append(authenticator.operations, operation)
+	return nil, authenticator.err
+}
+
+type restErrorBody struct {
+	Error struct {
+		Type          string `json:"type"`
+		Name          string `json:"name"`
+		Message       string `json:"message"`
+		CorrelationId string `json:"correlation_id"`
+	} `json:"error"`
+}
+
+type RestTest struct{}
+
+func (*RestTest) setup(t *testing.T, authenticator http.Authenticator) (string, func()) {
+	server, err := http.NewHttpServer(&net_http.Server{}, authenticator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listener, goerr := net.Listen("tcp", "127.0.0.1:0")
+	if goerr != nil {
+		t.Fatal(goerr)
+	}
+	go server.Start(listener)
+	return "http://" + listener.Addr().String(), server.Stop
+}
+
+func (*RestTest) request(t *testing.T, method string, url string) (*net_http.Response, *restErrorBody) {
+	request, goerr := net_http.NewRequest(method, url, strings.NewReader("{}"))
+	if goerr != nil {
+		t.Fatal(goerr)
+	}
+	response, goerr := net_http.DefaultClient.Do(request)
+	if goerr != nil {
+		t.Fatal(goerr)
+	}
+	defer response.Body.Close()
+	body := &restErrorBody{}
+	goerr = json.NewDecoder(response.Body).Decode(body)
+	if goerr != nil {
+		t.Fatal(goerr)
+	}
+	return response, body
+}
+
+func TestRestRouter_RoutesToTheMirroredOperation(t *testing.T) {
+	tests := []struct {
+		method    string
+		path      string
+		operation string
+	}{
+		{"POST", "/users", "/protobuf.UsersService/CreateUser"},
+		{"GET", "/users/password-policy", "/protobuf.UsersService/GetPasswordPolicy"},
+		{"PUT", "/users/me/password", "/protobuf.UsersService/ChangePassword"},
+		{"POST", "/sessions", "/protobuf.SessionsService/CreateSession"},
+		{"PUT", "/sessions/organization", "/protobuf.SessionsService/SwitchOrganization"},
+		{"POST", "/organizations", "/protobuf.OrganizationsService/CreateOrganization"},
+		{"POST", "/organizations/org/members", "/protobuf.OrganizationsService/InviteMember"},
+		{"DELETE", "/organizations/org/members/user", "/protobuf.OrganizationsService/RemoveMember"},
+		{"POST", "/api-keys", "/protobuf.ApiKeysService/CreateApiKey"},
+		{"GET", "/api-keys", "/protobuf.ApiKeysService/ListApiKeys"},
+		{"DELETE", "/api-keys/key", "/protobuf.ApiKeysService/RevokeApiKey"},
+		{"POST", "/oauth-clients", "/protobuf.OAuthClientsService/RegisterOAuthClient"},
+		{"GET", "/mfa", "/protobuf.MfaService/GetMfaStatus"},
+		{"POST", "/mfa/enrollment", "/protobuf.MfaService/EnrollMfa"},
+		{"POST", "/mfa/confirmation", "/protobuf.MfaService/ConfirmMfa"},
+		{"POST", "/mfa/verification", "/protobuf.MfaService/VerifyMfa"},
+		{"POST", "/mfa/recovery-codes", "/protobuf.MfaService/RegenerateRecoveryCodes"},
+		{"POST", "/webauthn/registration/begin", "/protobuf.WebAuthnService/BeginWebAuthnRegistration"},
+		{"POST", "/webauthn/registration/finish", "/protobuf.WebAuthnService/FinishWebAuthnRegistration"},
+		{"POST", "/webauthn/login/begin", "/protobuf.WebAuthnService/BeginWebAuthnLogin"},
+		{"POST", "/webauthn/login/finish", "/protobuf.WebAuthnService/FinishWebAuthnLogin"},
+		{"GET", "/audit-events", "/protobuf.AuditService/QueryAuditLog"},
+	}
+	for _, test := range tests {
+		t.Run(test.method+" "+test.path, func(t *testing.T) {
+			// arrange
+			authenticator := &refusingAuthenticator{err: exceptions.NewSessionNotFound()}
+			url, stop := (&RestTest{}).setup(t, authenticator)
+			defer stop()
+			// act
+			response, body := (&RestTest{}).request(t, test.method, url+test.path)
+			// assert
+			assert.Equal(t, authenticator.operations, []string{test.operation})
+			assert.Equal(t, response.StatusCode, net_http.StatusUnauthorized)
+			assert.Equal(t, body.Error.Name, "SessionNotFound")
+		})
+	}
+}
+
+func TestRestRouter_UnmatchedRequests(t *testing.T) {
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		status int
+		error  string
+		allow  string
+	}{
+		{"unknown path", "GET", "/unknown", net_http.StatusNotFound, "RouteNotFound", ""},
+		{"extra segment", "POST", "/users/me/password/extra", net_http.StatusNotFound, "RouteNotFound", ""},
+		{"empty parameter", "DELETE", "/organizations//members/user", net_http.StatusNotFound, "RouteNotFound", ""},
+		{"wrong method", "DELETE", "/users", net_http.StatusMethodNotAllowed, "MethodNotAllowed", "POST"},
+		{"several methods", "PUT", "/api-keys", net_http.StatusMethodNotAllowed, "MethodNotAllowed", "POST, GET"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// arrange
+			authenticator := &refusingAuthenticator{}
+			url, stop := (&RestTest{}).setup(t, authenticator)
+			defer stop()
+			// act
+			response, body := (&RestTest{}).request(t, test.method, url+test.path)
+			// assert
+			assert.Empty(t, authenticator.operations)
+			assert.Equal(t, response.StatusCode, test.status)
+			assert.Equal(t, body.Error.Name, test.error)
+			assert.Equal(t, response.Header.Get("Allow"), test.allow)
+		})
+	}
+}
+
+func TestRestRouter_MapsErrorTypesToStatuses(t *testing.T) {
+	tests := []struct {
+		err    *shared.Error
+		status int
+	}{
+		{exceptions.NewInvalidRequestBody(), net_http.StatusBadRequest},
+		{exceptions.NewOAuthInvalidScope(), net_http.StatusBadRequest},
+		{exceptions.NewSessionNotFound(), net_http.StatusUnauthorized},
+		{exceptions.NewPermissionDenied(), net_http.StatusForbidden},
+		{exceptions.NewOrganizationNotFound(), net_http.StatusNotFound},
+		{exceptions.NewUserEmailAlreadyInUse(), net_http.StatusConflict},
+		{exceptions.NewRequestCancelled(), net_http.StatusRequestTimeout},
+		{exceptions.NewInternalServerError(), net_http.StatusInternalServerError},
+	}
+	for _, test := range tests {
+		t.Run(test.err.Name, func(t *testing.T) {
+			// arrange
+			url, stop := (&RestTest{}).setup(t, &refusingAuthenticator{err: test.err})
+			defer stop()
+			// act
+			response, body := (&RestTest{}).request(t, "POST", url+"/users")
+			// assert
+			assert.Equal(t, response.StatusCode, test.status)
+			assert.Equal(t, response.Header.Get("Content-Type"), "application/json;charset=UTF-8")
+			assert.Equal(t, body.Error.Type, test.err.Type)
+			assert.Equal(t, body.Error.Name, test.err.Name)
+			assert.Equal(t, body.Error.Message, test.err.Message)
+			if test.status == net_http.StatusInternalServerError {
+				assert.Equal(t, body.Error.CorrelationId, response.Header.Get("X-Correlation-Id"))
+				assert.NotEmpty(t, body.Error.CorrelationId)
+			} else {
+				assert.Empty(t, body.Error.CorrelationId)
+			}
+		})
+	}
+}
@@ -1,11 +1,14 @@
 package test_presenters
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/AndreyArthur/oganessone/src/application/definitions"
 	mock_definitions "github.com/AndreyArthur/oganessone/src/application/definitions/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
 	"github.com/AndreyArthur/oganessone/src/core/dtos"
 	"github.com/AndreyArthur/oganessone/src/core/entities"
 	"github.com/AndreyArthur/oganessone/src/core/shared"
@@ -22,7 +25,17 @@ type CreateUserPresenterTest struct{}
 func (*CreateUserPresenterTest) setup(t *testing.T) (*presenters.CreateUserPresenter, *mock_definitions.MockCreateUser, *gomock.Controller) {
 	ctrl := gomock.NewController(t)
 	useCase := mock_definitions.NewMockCreateUser(ctrl)
-	presenter, _ := presenters.NewCreateUserPresenter(useCase)
+	tracer := mock_providers.NewMockTracerProvider(ctrl)
+	span := mock_providers.NewMockSpan(ctrl)
+	tracer.EXPECT().
+		Start(gomock.Any(), "CreateUserPresenter.Handle").
+		DoAndReturn(func(ctx context.Context, name string) (context.Context, providers.Span) {
+			return ctx, span
+		}).
+		AnyTimes()
+	span.EXPECT().RecordError(gomock.Any()).AnyTimes()
+	span.EXPECT().End().AnyTimes()
+	presenter, _ := presenters.NewCreateUserPresenter(useCase, tracer)
 	return presenter, useCase, ctrl
 }
 
@@ -48,14 +61,20 @@ func TestCreateUserPresenter_SuccessCase(t *testing.T) {
 		UpdatedAt: updatedAt,
 	})
 	useCase.EXPECT().
-		Execute(&definitions.CreateUserDTO{
-			Username: username,
-			Email:    email,
-			Password: password,
+		Execute(gomock.Any(), &definitions.CreateUserDTO{
+			Username:  username,
+			Email:     email,
+			Password:  password,
+			IpAddress: "203.0.113.7",
+			UserAgent: "grpc-go/1.45.0",
 		}).
 		Return(entity, nil)
 	// act
-	result, err := presenter.Handle(&contracts.CreateUserPresenterRequest{
+	result, err := presenter.Handle(context.Background(), &contracts.CreateUserPresenterRequest{
+		Headers: &contracts.PresenterRequestHeaders{
+			IpAddress: "203.0.113.7",
+			UserAgent: "grpc-go/1.45.0",
+		},
 		Body: &contracts.CreateUserPresenterRequestBody{
 			Username: username,
 			Email:    email,
@@ -79,14 +98,15 @@ func TestCreateUserPresenter_FailureCase(t *testing.T) {
 		"user@email.com",
 		"$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW"
 	useCase.EXPECT().
-		Execute(&definitions.CreateUserDTO{
+		Execute(gomock.Any(), &definitions.CreateUserDTO{
 			Username: username,
 			Email:    email,
 			Password: password,
 		}).
 		Return(nil, &shared.Error{})
 	// act
-	result, err := presenter.Handle(&contracts.CreateUserPresenterRequest{
+	result, err := presenter.Handle(context.Background(), &contracts.CreateUserPresenterRequest{
+		Headers: &contracts.PresenterRequestHeaders{},
 		Body: &contracts.CreateUserPresenterRequestBody{
 			Username: username,
 			Email:    email,
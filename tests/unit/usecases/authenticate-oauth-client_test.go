@@ -0,0 +1,165 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type AuthenticateOAuthClientUseCaseTest struct{}
+
+func (*AuthenticateOAuthClientUseCaseTest) setup(t *testing.T) (*usecases.AuthenticateOAuthClientUseCase, *mock_repositories.MockOAuthClientsRepository, *mock_providers.MockEncrypterProvider, *mock_providers.MockTokenVerifierProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	clients := mock_repositories.NewMockOAuthClientsRepository(ctrl)
+	encrypter := mock_providers.NewMockEncrypterProvider(ctrl)
+	verifier := mock_providers.NewMockTokenVerifierProvider(ctrl)
+	authenticateOAuthClientUseCase, _ := usecases.NewAuthenticateOAuthClientUseCase(
+		clients, encrypter, verifier, &definitions.OpenIdOptions{
+			Issuer:          "https://auth.example.com",
+			IdTokenLifetime: time.Hour,
+		},
+	)
+	return authenticateOAuthClientUseCase, clients, encrypter, verifier, ctrl
+}
+
+func (*AuthenticateOAuthClientUseCaseTest) client(authMethod string) *entities.OAuthClientEntity {
+	return &entities.OAuthClientEntity{
+		Id:             "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+		OrganizationId: "cc58997a-2403-af1e-7836-f0b338edcd60",
+		Name:           "Billing job",
+		AuthMethod:     authMethod,
+		SecretHash:     "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW",
+		PublicKey:      "-----BEGIN PUBLIC KEY-----",
+	}
+}
+
+func TestAuthenticateOAuthClientUseCase_PublicClient(t *testing.T) {
+	// arrange
+	useCase, clients, _, _, ctrl := (&AuthenticateOAuthClientUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	client := (&AuthenticateOAuthClientUseCaseTest{}).client(entities.OAuthClientAuthMethodNone)
+	clients.EXPECT().FindById(gomock.Any(), client.Id).Return(client, nil).Times(2)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthenticateOAuthClientDTO{
+		ClientId: client.Id,
+	})
+	withSecretResult, withSecretErr := useCase.Execute(context.Background(), &definitions.AuthenticateOAuthClientDTO{
+		ClientId:     client.Id,
+		ClientSecret: "unexpected_secret",
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result, client)
+	assert.Nil(t, withSecretResult)
+	assert.Equal(t, withSecretErr, exceptions.NewOAuthInvalidClient())
+}
+
+func TestAuthenticateOAuthClientUseCase_ClientSecret(t *testing.T) {
+	// arrange
+	useCase, clients, encrypter, _, ctrl := (&AuthenticateOAuthClientUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	client := (&AuthenticateOAuthClientUseCaseTest{}).client(entities.OAuthClientAuthMethodClientSecretBasic)
+	clients.EXPECT().FindById(gomock.Any(), client.Id).Return(client, nil).Times(3)
+	encrypter.EXPECT().Compare(gomock.Any(), "right_secret", client.SecretHash).Return(true, nil)
+	encrypter.EXPECT().Compare(gomock.Any(), "wrong_secret", client.SecretHash).Return(false, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthenticateOAuthClientDTO{
+		ClientId:     client.Id,
+		ClientSecret: "right_secret",
+	})
+	_, wrongErr := useCase.Execute(context.Background(), &definitions.AuthenticateOAuthClientDTO{
+		ClientId:     client.Id,
+		ClientSecret: "wrong_secret",
+	})
+	_, missingErr := useCase.Execute(context.Background(), &definitions.AuthenticateOAuthClientDTO{
+		ClientId: client.Id,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result, client)
+	assert.Equal(t, wrongErr, exceptions.NewOAuthInvalidClient())
+	assert.Equal(t, missingErr, exceptions.NewOAuthInvalidClient())
+}
+
+func TestAuthenticateOAuthClientUseCase_PrivateKeyJwt(t *testing.T) {
+	// arrange
+	useCase, clients, _, verifier, ctrl := (&AuthenticateOAuthClientUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	client := (&AuthenticateOAuthClientUseCaseTest{}).client(entities.OAuthClientAuthMethodPrivateKeyJwt)
+	clients.EXPECT().FindById(gomock.Any(), client.Id).Return(client, nil)
+	verifier.EXPECT().Verify("header.payload.signature", client.PublicKey).Return(map[string]interface{}{
+		"iss": client.Id,
+		"sub": client.Id,
+		"aud": []interface{}{"https://auth.example.com/token"},
+		"exp": float64(time.Now().Add(time.Minute).Unix()),
+	}, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthenticateOAuthClientDTO{
+		ClientId:            client.Id,
+		ClientAssertionType: definitions.ClientAssertionTypeJwtBearer,
+		ClientAssertion:     "header.payload.signature",
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result, client)
+}
+
+func TestAuthenticateOAuthClientUseCase_RejectedAssertions(t *testing.T) {
+	// arrange
+	useCase, clients, _, verifier, ctrl := (&AuthenticateOAuthClientUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	client := (&AuthenticateOAuthClientUseCaseTest{}).client(entities.OAuthClientAuthMethodPrivateKeyJwt)
+	valid := func() map[string]interface{} {
+		return map[string]interface{}{
+			"iss": client.Id,
+			"sub": client.Id,
+			"aud": "https://auth.example.com",
+			"exp": float64(time.Now().Add(time.Minute).Unix()),
+		}
+	}
+	otherAudience, expired, longLived := valid(), valid(), valid()
+	otherAudience["aud"] = "https://evil.example.com"
+	expired["exp"] = float64(time.Now().Add(-time.Minute).Unix())
+	longLived["exp"] = float64(time.Now().Add(time.Hour).Unix())
+	clients.EXPECT().FindById(gomock.Any(), client.Id).Return(client, nil).Times(4)
+	verifier.EXPECT().Verify(gomock.Any(), client.PublicKey).Return(otherAudience, nil)
+	verifier.EXPECT().Verify(gomock.Any(), client.PublicKey).Return(expired, nil)
+	verifier.EXPECT().Verify(gomock.Any(), client.PublicKey).Return(longLived, nil)
+	verifier.EXPECT().Verify(gomock.Any(), client.PublicKey).Return(nil, nil)
+	for i := 0; i < 4; i++ {
+		// act
+		result, err := useCase.Execute(context.Background(), &definitions.AuthenticateOAuthClientDTO{
+			ClientId:            client.Id,
+			ClientAssertionType: definitions.ClientAssertionTypeJwtBearer,
+			ClientAssertion:     "header.payload.signature",
+		})
+		// assert
+		assert.Nil(t, result)
+		assert.Equal(t, err, exceptions.NewOAuthInvalidClient())
+	}
+}
+
+func TestAuthenticateOAuthClientUseCase_UnknownClient(t *testing.T) {
+	// arrange
+	useCase, clients, _, _, ctrl := (&AuthenticateOAuthClientUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	clientId := "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+	clients.EXPECT().FindById(gomock.Any(), clientId).Return(nil, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthenticateOAuthClientDTO{
+		ClientId:     clientId,
+		ClientSecret: "secret",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOAuthInvalidClient())
+}
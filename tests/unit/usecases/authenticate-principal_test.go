@@ -0,0 +1,132 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_definitions "github.com/AndreyArthur/oganessone/src/application/definitions/mocks"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type AuthenticatePrincipalUseCaseTest struct{}
+
+func (*AuthenticatePrincipalUseCaseTest) setup(t *testing.T) (*usecases.AuthenticatePrincipalUseCase, *mock_definitions.MockAuthenticateSession, *mock_repositories.MockMembershipsRepository, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	authenticateSession := mock_definitions.NewMockAuthenticateSession(ctrl)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
+	authenticatePrincipalUseCase, _ := usecases.NewAuthenticatePrincipalUseCase(authenticateSession, memberships)
+	return authenticatePrincipalUseCase, authenticateSession, memberships, ctrl
+}
+
+func TestAuthenticatePrincipalUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, authenticateSession, memberships, ctrl := (&AuthenticatePrincipalUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	sessionKey, userId, organizationId :=
+		"session_key_example",
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"cc58997a-2403-af1e-7836-f0b338edcd60"
+	authenticateSession.EXPECT().
+		Execute(gomock.Any(), &definitions.AuthenticateSessionDTO{SessionKey: sessionKey, IpAddress: "10.0.0.1"}).
+		Return(&definitions.AuthenticateSessionResult{
+			SessionKey:     sessionKey,
+			UserId:         userId,
+			OrganizationId: organizationId,
+		}, nil)
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, userId).
+		Return(&entities.MembershipEntity{
+			OrganizationId: organizationId,
+			UserId:         userId,
+			Role:           entities.MembershipRoleAdmin,
+		}, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthenticatePrincipalDTO{
+		SessionKey: sessionKey,
+		IpAddress:  "10.0.0.1",
+		Roles:      []string{entities.MembershipRoleOwner, entities.MembershipRoleAdmin},
+		Scopes:     []string{"users:write"},
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.UserId, userId)
+	assert.Equal(t, result.OrganizationId, organizationId)
+	assert.Equal(t, result.Roles, []string{entities.MembershipRoleAdmin})
+	assert.Nil(t, result.Scopes)
+}
+
+func TestAuthenticatePrincipalUseCase_FailsWithoutSession(t *testing.T) {
+	// arrange
+	useCase, authenticateSession, _, ctrl := (&AuthenticatePrincipalUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	authenticateSession.EXPECT().
+		Execute(gomock.Any(), &definitions.AuthenticateSessionDTO{SessionKey: ""}).
+		Return(nil, exceptions.NewSessionNotFound())
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthenticatePrincipalDTO{})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewSessionNotFound())
+}
+
+func TestAuthenticatePrincipalUseCase_FailsWithoutRole(t *testing.T) {
+	// arrange
+	useCase, authenticateSession, memberships, ctrl := (&AuthenticatePrincipalUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	sessionKey, userId, organizationId :=
+		"session_key_example",
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"cc58997a-2403-af1e-7836-f0b338edcd60"
+	authenticateSession.EXPECT().
+		Execute(gomock.Any(), &definitions.AuthenticateSessionDTO{SessionKey: sessionKey}).
+		Return(&definitions.AuthenticateSessionResult{
+			SessionKey:     sessionKey,
+			UserId:         userId,
+			OrganizationId: organizationId,
+		}, nil)
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, userId).
+		Return(&entities.MembershipEntity{
+			OrganizationId: organizationId,
+			UserId:         userId,
+			Role:           entities.MembershipRoleMember,
+		}, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthenticatePrincipalDTO{
+		SessionKey: sessionKey,
+		Roles:      []string{entities.MembershipRoleOwner},
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewPermissionDenied())
+}
+
+func TestAuthenticatePrincipalUseCase_FailsWithoutScope(t *testing.T) {
+	// arrange
+	useCase, authenticateSession, _, ctrl := (&AuthenticatePrincipalUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	sessionKey, clientId :=
+		"session_key_example",
+		"4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11"
+	authenticateSession.EXPECT().
+		Execute(gomock.Any(), &definitions.AuthenticateSessionDTO{SessionKey: sessionKey}).
+		Return(&definitions.AuthenticateSessionResult{
+			SessionKey: sessionKey,
+			ClientId:   clientId,
+			Scopes:     []string{"users:read"},
+		}, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthenticatePrincipalDTO{
+		SessionKey: sessionKey,
+		Scopes:     []string{"users:write"},
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewPermissionDenied())
+}
@@ -0,0 +1,288 @@
+package test_usecases
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type AuthenticateSessionUseCaseTest struct{}
+
+func (*AuthenticateSessionUseCaseTest) setup(t *testing.T) (*usecases.AuthenticateSessionUseCase, *mock_providers.MockCacheProvider, *mock_repositories.MockApiKeysRepository, *mock_providers.MockDigestProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	cache := mock_providers.NewMockCacheProvider(ctrl)
+	apiKeys := mock_repositories.NewMockApiKeysRepository(ctrl)
+	digest := mock_providers.NewMockDigestProvider(ctrl)
+	authenticateSessionUseCase, _ := usecases.NewAuthenticateSessionUseCase(cache, apiKeys, digest)
+	return authenticateSessionUseCase, cache, apiKeys, digest, ctrl
+}
+
+func TestAuthenticateSessionUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, cache, _, _, ctrl := (&AuthenticateSessionUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	sessionKey, userId, organizationId :=
+		"session_key_example",
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"cc58997a-2403-af1e-7836-f0b338edcd60"
+	expiresIn := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+	cache.EXPECT().Get(gomock.Any(), sessionKey).Return(userId, nil)
+	cache.EXPECT().
+		Get(gomock.Any(), strings.Join([]string{sessionKey, "@", userId}, "")).
+		Return(expiresIn, nil)
+	cache.EXPECT().
+		Get(gomock.Any(), strings.Join([]string{sessionKey, "#", userId}, "")).
+		Return(organizationId, nil)
+	cache.EXPECT().
+		Get(gomock.Any(), strings.Join([]string{sessionKey, "$", userId}, "")).
+		Return("", nil)
+	cache.EXPECT().
+		Get(gomock.Any(), strings.Join([]string{sessionKey, "%", userId}, "")).
+		Return("", nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthenticateSessionDTO{
+		SessionKey: sessionKey,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.UserId, userId)
+	assert.Equal(t, result.OrganizationId, organizationId)
+	assert.Nil(t, result.Scopes)
+}
+
+func TestAuthenticateSessionUseCase_OAuthSessionScopes(t *testing.T) {
+	// arrange
+	useCase, cache, _, _, ctrl := (&AuthenticateSessionUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	sessionKey, userId := "session_key_example", "9b157773-fbb4-d04c-9de6-d086cf37d7c7"
+	expiresIn := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+	cache.EXPECT().Get(gomock.Any(), sessionKey).Return(userId, nil)
+	cache.EXPECT().
+		Get(gomock.Any(), strings.Join([]string{sessionKey, "@", userId}, "")).
+		Return(expiresIn, nil)
+	cache.EXPECT().
+		Get(gomock.Any(), strings.Join([]string{sessionKey, "#", userId}, "")).
+		Return("", nil)
+	cache.EXPECT().
+		Get(gomock.Any(), strings.Join([]string{sessionKey, "$", userId}, "")).
+		Return("openid profile", nil)
+	cache.EXPECT().
+		Get(gomock.Any(), strings.Join([]string{sessionKey, "%", userId}, "")).
+		Return("cc58997a-2403-af1e-7836-f0b338edcd60", nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthenticateSessionDTO{
+		SessionKey: sessionKey,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.UserId, userId)
+	assert.Equal(t, result.ClientId, "cc58997a-2403-af1e-7836-f0b338edcd60")
+	assert.Equal(t, result.Scopes, []string{"openid", "profile"})
+}
+
+func TestAuthenticateSessionUseCase_OAuthSessionWithoutScopes(t *testing.T) {
+	// arrange
+	useCase, cache, _, _, ctrl := (&AuthenticateSessionUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	sessionKey, userId := "session_key_example", "9b157773-fbb4-d04c-9de6-d086cf37d7c7"
+	expiresIn := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+	cache.EXPECT().Get(gomock.Any(), sessionKey).Return(userId, nil)
+	cache.EXPECT().
+		Get(gomock.Any(), strings.Join([]string{sessionKey, "@", userId}, "")).
+		Return(expiresIn, nil)
+	cache.EXPECT().
+		Get(gomock.Any(), strings.Join([]string{sessionKey, "#", userId}, "")).
+		Return("", nil)
+	cache.EXPECT().
+		Get(gomock.Any(), strings.Join([]string{sessionKey, "$", userId}, "")).
+		Return("", nil)
+	cache.EXPECT().
+		Get(gomock.Any(), strings.Join([]string{sessionKey, "%", userId}, "")).
+		Return("cc58997a-2403-af1e-7836-f0b338edcd60", nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthenticateSessionDTO{
+		SessionKey: sessionKey,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.NotNil(t, result.Scopes)
+	assert.Empty(t, result.Scopes)
+}
+
+func TestAuthenticateSessionUseCase_ClientCredentialsToken(t *testing.T) {
+	// arrange
+	useCase, cache, _, _, ctrl := (&AuthenticateSessionUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	sessionKey, clientId, organizationId :=
+		"session_key_example",
+		"1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+		"cc58997a-2403-af1e-7836-f0b338edcd60"
+	expiresIn := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+	cache.EXPECT().Get(gomock.Any(), sessionKey).Return(clientId, nil)
+	cache.EXPECT().
+		Get(gomock.Any(), strings.Join([]string{sessionKey, "@", clientId}, "")).
+		Return(expiresIn, nil)
+	cache.EXPECT().
+		Get(gomock.Any(), strings.Join([]string{sessionKey, "#", clientId}, "")).
+		Return(organizationId, nil)
+	cache.EXPECT().
+		Get(gomock.Any(), strings.Join([]string{sessionKey, "$", clientId}, "")).
+		Return("", nil)
+	cache.EXPECT().
+		Get(gomock.Any(), strings.Join([]string{sessionKey, "%", clientId}, "")).
+		Return(clientId, nil)
+	cache.EXPECT().
+		Get(gomock.Any(), strings.Join([]string{sessionKey, "&", clientId}, "")).
+		Return("https://billing.example.com", nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthenticateSessionDTO{
+		SessionKey: sessionKey,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.UserId, "")
+	assert.Equal(t, result.ClientId, clientId)
+	assert.Equal(t, result.OrganizationId, organizationId)
+	assert.Equal(t, result.Scopes, []string{})
+	assert.Equal(t, result.Audiences, []string{"https://billing.example.com"})
+}
+
+func TestAuthenticateSessionUseCase_SessionNotFound(t *testing.T) {
+	// arrange
+	useCase, cache, _, _, ctrl := (&AuthenticateSessionUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	sessionKey := "session_key_example"
+	cache.EXPECT().Get(gomock.Any(), sessionKey).Return("", nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthenticateSessionDTO{
+		SessionKey: sessionKey,
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewSessionNotFound())
+}
+
+func TestAuthenticateSessionUseCase_SessionExpired(t *testing.T) {
+	// arrange
+	useCase, cache, _, _, ctrl := (&AuthenticateSessionUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	sessionKey, userId := "session_key_example", "9b157773-fbb4-d04c-9de6-d086cf37d7c7"
+	expiredAt := time.Now().UTC().Add(-time.Hour).Format(time.RFC3339)
+	expirationKey := strings.Join([]string{sessionKey, "@", userId}, "")
+	organizationKey := strings.Join([]string{sessionKey, "#", userId}, "")
+	cache.EXPECT().Get(gomock.Any(), sessionKey).Return(userId, nil)
+	cache.EXPECT().Get(gomock.Any(), expirationKey).Return(expiredAt, nil)
+	cache.EXPECT().Delete(gomock.Any(), sessionKey).Return(nil)
+	cache.EXPECT().Delete(gomock.Any(), expirationKey).Return(nil)
+	cache.EXPECT().Delete(gomock.Any(), organizationKey).Return(nil)
+	cache.EXPECT().Delete(gomock.Any(), strings.Join([]string{sessionKey, "$", userId}, "")).Return(nil)
+	cache.EXPECT().Delete(gomock.Any(), strings.Join([]string{sessionKey, "%", userId}, "")).Return(nil)
+	cache.EXPECT().Delete(gomock.Any(), strings.Join([]string{sessionKey, "&", userId}, "")).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthenticateSessionDTO{
+		SessionKey: sessionKey,
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewSessionNotFound())
+}
+
+func (*AuthenticateSessionUseCaseTest) apiKey() *entities.ApiKeyEntity {
+	return &entities.ApiKeyEntity{
+		Id:             "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+		OrganizationId: "cc58997a-2403-af1e-7836-f0b338edcd60",
+		Name:           "billing job",
+		Prefix:         "AbCd1234",
+		Hash:           "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW",
+		Scopes:         []string{"users:read"},
+		AllowedIps:     []string{"10.0.0.0/8"},
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+}
+
+func TestAuthenticateSessionUseCase_ApiKeySuccessCase(t *testing.T) {
+	// arrange
+	useCase, _, apiKeys, digest, ctrl := (&AuthenticateSessionUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	key := "og_AbCd1234_0123456789abcdefghijABCDEFGHIJkl"
+	apiKey := (&AuthenticateSessionUseCaseTest{}).apiKey()
+	apiKeys.EXPECT().FindByPrefix(gomock.Any(), "AbCd1234").Return(apiKey, nil)
+	digest.EXPECT().Matches(key, apiKey.Hash).Return(true)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthenticateSessionDTO{
+		SessionKey: key,
+		IpAddress:  "10.1.2.3",
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.UserId, "")
+	assert.Equal(t, result.OrganizationId, apiKey.OrganizationId)
+	assert.Equal(t, result.ApiKeyId, apiKey.Id)
+	assert.Equal(t, result.Scopes, apiKey.Scopes)
+}
+
+func TestAuthenticateSessionUseCase_ApiKeyWrongSecret(t *testing.T) {
+	// arrange
+	useCase, _, apiKeys, digest, ctrl := (&AuthenticateSessionUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	key := "og_AbCd1234_wrongsecret"
+	apiKey := (&AuthenticateSessionUseCaseTest{}).apiKey()
+	apiKeys.EXPECT().FindByPrefix(gomock.Any(), "AbCd1234").Return(apiKey, nil)
+	digest.EXPECT().Matches(key, apiKey.Hash).Return(false)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthenticateSessionDTO{
+		SessionKey: key,
+		IpAddress:  "10.1.2.3",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewSessionNotFound())
+}
+
+func TestAuthenticateSessionUseCase_ApiKeyRevoked(t *testing.T) {
+	// arrange
+	useCase, _, apiKeys, digest, ctrl := (&AuthenticateSessionUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	key := "og_AbCd1234_0123456789abcdefghijABCDEFGHIJkl"
+	apiKey := (&AuthenticateSessionUseCaseTest{}).apiKey()
+	apiKey.RevokedAt = time.Now().UTC()
+	apiKeys.EXPECT().FindByPrefix(gomock.Any(), "AbCd1234").Return(apiKey, nil)
+	digest.EXPECT().Matches(key, apiKey.Hash).Return(true)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthenticateSessionDTO{
+		SessionKey: key,
+		IpAddress:  "10.1.2.3",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewSessionNotFound())
+}
+
+func TestAuthenticateSessionUseCase_ApiKeyIpNotAllowed(t *testing.T) {
+	// arrange
+	useCase, _, apiKeys, digest, ctrl := (&AuthenticateSessionUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	key := "og_AbCd1234_0123456789abcdefghijABCDEFGHIJkl"
+	apiKey := (&AuthenticateSessionUseCaseTest{}).apiKey()
+	apiKeys.EXPECT().FindByPrefix(gomock.Any(), "AbCd1234").Return(apiKey, nil)
+	digest.EXPECT().Matches(key, apiKey.Hash).Return(true)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthenticateSessionDTO{
+		SessionKey: key,
+		IpAddress:  "192.168.0.1",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewSessionNotFound())
+}
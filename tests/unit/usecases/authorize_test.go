@@ -0,0 +1,248 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_definitions "github.com/AndreyArthur/oganessone/src/application/definitions/mocks"
+	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type AuthorizeUseCaseTest struct{}
+
+func (*AuthorizeUseCaseTest) setup(t *testing.T) (*usecases.AuthorizeUseCase, *mock_definitions.MockValidateAuthorizationRequest, *mock_definitions.MockCreateSession, *mock_definitions.MockVerifyMfa, *mock_repositories.MockAuthorizationCodesRepository, *mock_providers.MockRandomProvider, *mock_providers.MockCacheProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	validateAuthorizationRequest := mock_definitions.NewMockValidateAuthorizationRequest(ctrl)
+	createSession := mock_definitions.NewMockCreateSession(ctrl)
+	verifyMfa := mock_definitions.NewMockVerifyMfa(ctrl)
+	authorizationCodes := mock_repositories.NewMockAuthorizationCodesRepository(ctrl)
+	random := mock_providers.NewMockRandomProvider(ctrl)
+	cache := mock_providers.NewMockCacheProvider(ctrl)
+	logger := mock_providers.NewMockLoggerProvider(ctrl)
+	tracer, _ := setupTracer(ctrl)
+	authorizeUseCase, _ := usecases.NewAuthorizeUseCase(
+		validateAuthorizationRequest, createSession, verifyMfa, authorizationCodes, random, cache, logger, tracer,
+	)
+	return authorizeUseCase, validateAuthorizationRequest, createSession, verifyMfa, authorizationCodes, random, cache, ctrl
+}
+
+func TestAuthorizeUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, validateAuthorizationRequest, createSession, _, authorizationCodes, random, cache, ctrl :=
+		(&AuthorizeUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	request := &definitions.AuthorizationRequestDTO{
+		ResponseType:        "code",
+		ClientId:            "cc58997a-2403-af1e-7836-f0b338edcd60",
+		RedirectUri:         "https://app.example.com/callback",
+		State:               "af0ifjsldkj",
+		Nonce:               "n-0S6_WzA2Mj",
+		CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+		CodeChallengeMethod: "S256",
+	}
+	user := &entities.UserEntity{Id: "9b157773-fbb4-d04c-9de6-d086cf37d7c7"}
+	code := "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q"
+	authorizationCode := &entities.AuthorizationCodeEntity{
+		Code:        code,
+		RedirectUri: request.RedirectUri,
+	}
+	validateAuthorizationRequest.EXPECT().Execute(gomock.Any(), request).
+		Return(&definitions.ValidateAuthorizationRequestResult{
+			Client: &entities.OAuthClientEntity{Id: request.ClientId},
+			Scopes: []string{},
+		}, nil)
+	createSession.EXPECT().
+		Execute(gomock.Any(), &definitions.CreateSessionDTO{Login: "username", Password: "p4ssword"}).
+		Return(&definitions.CreateSessionResult{
+			User:           user,
+			SessionKey:     "session_key_example",
+			ExpirationDate: time.Now().UTC().Add(time.Hour).Format(time.RFC3339),
+		}, nil)
+	random.EXPECT().String(43).Return(code, nil)
+	sessionExpirations := []time.Time{}
+	cache.EXPECT().
+		Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(3).
+		DoAndReturn(func(ctx context.Context, key string, value string, expiresAt time.Time) *shared.Error {
+			sessionExpirations = append(sessionExpirations, expiresAt)
+			return nil
+		})
+	authorizationCodes.EXPECT().Create(gomock.Any()).Return(authorizationCode, nil)
+	authorizationCodes.EXPECT().Save(gomock.Any(), authorizationCode).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthorizeDTO{
+		Request:  request,
+		Login:    "username",
+		Password: "p4ssword",
+	})
+	// assert
+	assert.Nil(t, err)
+	for _, expiresAt := range sessionExpirations {
+		assert.WithinDuration(t, expiresAt, time.Now().UTC().Add(time.Minute), 2*time.Second)
+	}
+	assert.Equal(t, result.Code, code)
+	assert.Equal(t, result.RedirectUri, request.RedirectUri)
+	assert.Equal(t, result.State, request.State)
+}
+
+func TestAuthorizeUseCase_LoginFailed(t *testing.T) {
+	// arrange
+	useCase, validateAuthorizationRequest, createSession, _, _, _, _, ctrl :=
+		(&AuthorizeUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	request := &definitions.AuthorizationRequestDTO{
+		ResponseType: "code",
+		ClientId:     "cc58997a-2403-af1e-7836-f0b338edcd60",
+	}
+	validateAuthorizationRequest.EXPECT().Execute(gomock.Any(), request).
+		Return(&definitions.ValidateAuthorizationRequestResult{}, nil)
+	createSession.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		Return(nil, exceptions.NewUserLoginFailed())
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthorizeDTO{
+		Request:  request,
+		Login:    "username",
+		Password: "wrong",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewUserLoginFailed())
+}
+
+func TestAuthorizeUseCase_MfaRequired(t *testing.T) {
+	// arrange
+	useCase, validateAuthorizationRequest, createSession, _, _, _, _, ctrl :=
+		(&AuthorizeUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	request := &definitions.AuthorizationRequestDTO{
+		ResponseType: "code",
+		ClientId:     "cc58997a-2403-af1e-7836-f0b338edcd60",
+	}
+	validateAuthorizationRequest.EXPECT().Execute(gomock.Any(), request).
+		Return(&definitions.ValidateAuthorizationRequestResult{}, nil)
+	createSession.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		Return(&definitions.CreateSessionResult{
+			User:        &entities.UserEntity{Id: "9b157773-fbb4-d04c-9de6-d086cf37d7c7"},
+			MfaRequired: true,
+			MfaToken:    "mfa_token_example",
+		}, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthorizeDTO{
+		Request:  request,
+		Login:    "username",
+		Password: "p4ssword",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewMfaRequired())
+}
+
+func TestAuthorizeUseCase_SuccessCaseWithMfaCode(t *testing.T) {
+	// arrange
+	useCase, validateAuthorizationRequest, createSession, verifyMfa, authorizationCodes, random, cache, ctrl :=
+		(&AuthorizeUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	request := &definitions.AuthorizationRequestDTO{
+		ResponseType:        "code",
+		ClientId:            "cc58997a-2403-af1e-7836-f0b338edcd60",
+		RedirectUri:         "https://app.example.com/callback",
+		CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+		CodeChallengeMethod: "S256",
+	}
+	user := &entities.UserEntity{Id: "9b157773-fbb4-d04c-9de6-d086cf37d7c7"}
+	code := "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q"
+	authorizationCode := &entities.AuthorizationCodeEntity{
+		Code:        code,
+		RedirectUri: request.RedirectUri,
+	}
+	validateAuthorizationRequest.EXPECT().Execute(gomock.Any(), request).
+		Return(&definitions.ValidateAuthorizationRequestResult{
+			Client: &entities.OAuthClientEntity{Id: request.ClientId},
+		}, nil)
+	createSession.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		Return(&definitions.CreateSessionResult{
+			User:        user,
+			MfaRequired: true,
+			MfaToken:    "mfa_token_example",
+		}, nil)
+	verifyMfa.EXPECT().
+		Execute(gomock.Any(), &definitions.VerifyMfaDTO{
+			MfaToken:  "mfa_token_example",
+			Code:      "123456",
+			IpAddress: "203.0.113.7",
+			UserAgent: "curl/7.81.0",
+		}).
+		Return(&definitions.VerifyMfaResult{
+			User:           user,
+			SessionKey:     "session_key_example",
+			ExpirationDate: time.Now().UTC().Add(time.Hour).Format(time.RFC3339),
+		}, nil)
+	random.EXPECT().String(43).Return(code, nil)
+	cache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(3).Return(nil)
+	authorizationCodes.EXPECT().Create(gomock.Any()).Return(authorizationCode, nil)
+	authorizationCodes.EXPECT().Save(gomock.Any(), authorizationCode).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthorizeDTO{
+		Request:   request,
+		Login:     "username",
+		Password:  "p4ssword",
+		MfaCode:   "123456",
+		IpAddress: "203.0.113.7",
+		UserAgent: "curl/7.81.0",
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.Code, code)
+}
+
+func TestAuthorizeUseCase_RecordsTheClientOnTheAuditEvent(t *testing.T) {
+	// arrange
+	createSession, users, _, _, auditEvents, createSessionCtrl := (&CreateSessionUseCaseTest{}).setupAudit(t)
+	defer createSessionCtrl.Finish()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	validateAuthorizationRequest := mock_definitions.NewMockValidateAuthorizationRequest(ctrl)
+	tracer, _ := setupTracer(ctrl)
+	useCase, _ := usecases.NewAuthorizeUseCase(
+		validateAuthorizationRequest, createSession, mock_definitions.NewMockVerifyMfa(ctrl),
+		mock_repositories.NewMockAuthorizationCodesRepository(ctrl), mock_providers.NewMockRandomProvider(ctrl),
+		mock_providers.NewMockCacheProvider(ctrl), mock_providers.NewMockLoggerProvider(ctrl), tracer,
+	)
+	request := &definitions.AuthorizationRequestDTO{
+		ResponseType: "code",
+		ClientId:     "cc58997a-2403-af1e-7836-f0b338edcd60",
+	}
+	validateAuthorizationRequest.EXPECT().Execute(gomock.Any(), request).
+		Return(&definitions.ValidateAuthorizationRequestResult{}, nil)
+	users.EXPECT().FindByEmail(gomock.Any(), "", "unknown").Return(nil, nil)
+	users.EXPECT().FindByUsername(gomock.Any(), "", "unknown", true).Return(nil, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.AuthorizeDTO{
+		Request:   request,
+		Login:     "unknown",
+		Password:  "p4ssword",
+		IpAddress: "203.0.113.7",
+		UserAgent: "curl/7.81.0",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewUserLoginFailed())
+	assert.Equal(t, *auditEvents, []*dtos.AuditEventDTO{
+		{
+			Type:      entities.AuditEventLoginFailed,
+			IpAddress: "203.0.113.7",
+			UserAgent: "curl/7.81.0",
+			Detail:    entities.AuditReasonUnknownUser,
+		},
+	})
+}
@@ -0,0 +1,81 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type BeginWebAuthnLoginUseCaseTest struct{}
+
+func (*BeginWebAuthnLoginUseCaseTest) setup(t *testing.T) (*usecases.BeginWebAuthnLoginUseCase, *mock_repositories.MockUsersRepository, *mock_repositories.MockWebAuthnCredentialsRepository, *mock_repositories.MockWebAuthnChallengesRepository, *mock_providers.MockRandomProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	users := mock_repositories.NewMockUsersRepository(ctrl)
+	credentials := mock_repositories.NewMockWebAuthnCredentialsRepository(ctrl)
+	challenges := mock_repositories.NewMockWebAuthnChallengesRepository(ctrl)
+	random := mock_providers.NewMockRandomProvider(ctrl)
+	beginWebAuthnLoginUseCase, _ := usecases.NewBeginWebAuthnLoginUseCase(
+		users, credentials, challenges, random,
+		&definitions.TenancyOptions{}, webAuthnTestOptions(),
+	)
+	return beginWebAuthnLoginUseCase, users, credentials, challenges, random, ctrl
+}
+
+func TestBeginWebAuthnLoginUseCase_KnownLogin(t *testing.T) {
+	// arrange
+	useCase, users, credentials, challenges, random, ctrl := (&BeginWebAuthnLoginUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := &entities.UserEntity{
+		Id:       "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Username: "username",
+	}
+	users.EXPECT().FindByUsername(gomock.Any(), "", user.Username, true).Return(user, nil)
+	users.EXPECT().FindByEmail(gomock.Any(), "", user.Username).Return(nil, nil)
+	credentials.EXPECT().FindByUserId(gomock.Any(), user.Id).Return([]*entities.WebAuthnCredentialEntity{
+		{CredentialId: "q83vEjRWeJA"},
+	}, nil)
+	random.EXPECT().String(43).Return("Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q", nil)
+	challenges.EXPECT().Create(gomock.Any()).
+		DoAndReturn(func(data *dtos.WebAuthnChallengeDTO) (*entities.WebAuthnChallengeEntity, *shared.Error) {
+			assert.Equal(t, data.Ceremony, entities.WebAuthnCeremonyAuthentication)
+			assert.Equal(t, data.UserId, user.Id)
+			return entities.NewWebAuthnChallengeEntity(data)
+		})
+	challenges.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.BeginWebAuthnLoginDTO{Login: user.Username})
+	// assert
+	assert.Nil(t, err)
+	assert.NotEmpty(t, result.Challenge)
+	assert.Equal(t, result.AllowCredentials, []string{"q83vEjRWeJA"})
+}
+
+func TestBeginWebAuthnLoginUseCase_UnknownLogin(t *testing.T) {
+	// arrange
+	useCase, users, _, challenges, random, ctrl := (&BeginWebAuthnLoginUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	users.EXPECT().FindByUsername(gomock.Any(), "", "nobody", true).Return(nil, nil)
+	users.EXPECT().FindByEmail(gomock.Any(), "", "nobody").Return(nil, nil)
+	random.EXPECT().String(43).Return("Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q", nil)
+	challenges.EXPECT().Create(gomock.Any()).
+		DoAndReturn(func(data *dtos.WebAuthnChallengeDTO) (*entities.WebAuthnChallengeEntity, *shared.Error) {
+			assert.Equal(t, data.UserId, "")
+			return entities.NewWebAuthnChallengeEntity(data)
+		})
+	challenges.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.BeginWebAuthnLoginDTO{Login: "nobody"})
+	// assert
+	assert.Nil(t, err)
+	assert.NotEmpty(t, result.Challenge)
+	assert.Equal(t, result.AllowCredentials, []string{})
+}
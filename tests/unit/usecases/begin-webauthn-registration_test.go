@@ -0,0 +1,89 @@
+package test_usecases
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func webAuthnTestOptions() *definitions.WebAuthnOptions {
+	return &definitions.WebAuthnOptions{
+		RpId:    "localhost",
+		RpName:  "Oganessone",
+		Origins: []string{"http://localhost:8080"},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+type BeginWebAuthnRegistrationUseCaseTest struct{}
+
+func (*BeginWebAuthnRegistrationUseCaseTest) setup(t *testing.T) (*usecases.BeginWebAuthnRegistrationUseCase, *mock_repositories.MockUsersRepository, *mock_repositories.MockWebAuthnCredentialsRepository, *mock_repositories.MockWebAuthnChallengesRepository, *mock_providers.MockRandomProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	users := mock_repositories.NewMockUsersRepository(ctrl)
+	credentials := mock_repositories.NewMockWebAuthnCredentialsRepository(ctrl)
+	challenges := mock_repositories.NewMockWebAuthnChallengesRepository(ctrl)
+	random := mock_providers.NewMockRandomProvider(ctrl)
+	beginWebAuthnRegistrationUseCase, _ := usecases.NewBeginWebAuthnRegistrationUseCase(
+		users, credentials, challenges, random, webAuthnTestOptions(),
+	)
+	return beginWebAuthnRegistrationUseCase, users, credentials, challenges, random, ctrl
+}
+
+func TestBeginWebAuthnRegistrationUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, users, credentials, challenges, random, ctrl := (&BeginWebAuthnRegistrationUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := &entities.UserEntity{
+		Id:       "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Username: "username",
+	}
+	raw := "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q"
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	credentials.EXPECT().FindByUserId(gomock.Any(), user.Id).Return([]*entities.WebAuthnCredentialEntity{
+		{CredentialId: "q83vEjRWeJA"},
+	}, nil)
+	random.EXPECT().String(43).Return(raw, nil)
+	challenges.EXPECT().Create(gomock.Any()).
+		DoAndReturn(func(data *dtos.WebAuthnChallengeDTO) (*entities.WebAuthnChallengeEntity, *shared.Error) {
+			assert.Equal(t, data.Ceremony, entities.WebAuthnCeremonyRegistration)
+			assert.Equal(t, data.UserId, user.Id)
+			return entities.NewWebAuthnChallengeEntity(data)
+		})
+	challenges.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.BeginWebAuthnRegistrationDTO{ActorId: user.Id})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.Challenge, base64.RawURLEncoding.EncodeToString([]byte(raw)))
+	assert.Equal(t, result.RpId, "localhost")
+	assert.Equal(t, result.UserHandle, base64.RawURLEncoding.EncodeToString([]byte(user.Id)))
+	assert.Equal(t, result.UserName, user.Username)
+	assert.Equal(t, result.ExcludeCredentials, []string{"q83vEjRWeJA"})
+	assert.Equal(t, result.Timeout, 5*time.Minute)
+}
+
+func TestBeginWebAuthnRegistrationUseCase_UserNotFound(t *testing.T) {
+	// arrange
+	useCase, users, _, _, _, ctrl := (&BeginWebAuthnRegistrationUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	users.EXPECT().FindById(gomock.Any(), "9b157773-fbb4-d04c-9de6-d086cf37d7c7").Return(nil, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.BeginWebAuthnRegistrationDTO{
+		ActorId: "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewUserNotFound())
+}
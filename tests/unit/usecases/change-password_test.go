@@ -0,0 +1,198 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type ChangePasswordUseCaseTest struct{}
+
+func (*ChangePasswordUseCaseTest) setup(t *testing.T, size int) (*usecases.ChangePasswordUseCase, *mock_repositories.MockUsersRepository, *mock_repositories.MockPasswordHistoryRepository, *mock_providers.MockEncrypterProvider, *mock_providers.MockPasswordScreenerProvider, *[]*dtos.AuditEventDTO, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	users := mock_repositories.NewMockUsersRepository(ctrl)
+	history := mock_repositories.NewMockPasswordHistoryRepository(ctrl)
+	encrypter := mock_providers.NewMockEncrypterProvider(ctrl)
+	screener := mock_providers.NewMockPasswordScreenerProvider(ctrl)
+	options := &definitions.PasswordHistoryOptions{Size: size, Retention: 24 * time.Hour}
+	auditLog, auditEvents := setupAuditLog(ctrl)
+	changePasswordUseCase, _ := usecases.NewChangePasswordUseCase(
+		users, history, encrypter, screener, createUserPasswordPolicy(), options, auditLog,
+	)
+	return changePasswordUseCase, users, history, encrypter, screener, auditEvents, ctrl
+}
+
+func (*ChangePasswordUseCaseTest) user() *entities.UserEntity {
+	return &entities.UserEntity{
+		Id:       "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Username: "username",
+		Email:    "user@email.com",
+		Password: "current_hash",
+	}
+}
+
+func TestChangePasswordUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, users, history, encrypter, screener, auditEvents, ctrl := (&ChangePasswordUseCaseTest{}).setup(t, 3)
+	defer ctrl.Finish()
+	user := (&ChangePasswordUseCaseTest{}).user()
+	entry := &entities.PasswordHistoryEntity{
+		Id:       "4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11",
+		UserId:   user.Id,
+		Password: "current_hash",
+	}
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	encrypter.EXPECT().Compare(gomock.Any(), "p4ssword", "current_hash").Return(true, nil)
+	screener.EXPECT().IsBreached(gomock.Any(), "n3w password").Return(false, nil)
+	history.EXPECT().
+		FindRecentByUserId(gomock.Any(), user.Id, 3, gomock.Any()).
+		Return([]*entities.PasswordHistoryEntity{{Password: "old_hash"}}, nil)
+	encrypter.EXPECT().Compare(gomock.Any(), "n3w password", "current_hash").Return(false, nil)
+	encrypter.EXPECT().Compare(gomock.Any(), "n3w password", "old_hash").Return(false, nil)
+	encrypter.EXPECT().Hash(gomock.Any(), "n3w password").Return("new_hash", nil)
+	users.EXPECT().Save(gomock.Any(), user).Return(nil)
+	history.EXPECT().
+		Create(gomock.AssignableToTypeOf(&dtos.PasswordHistoryDTO{})).
+		DoAndReturn(func(data *dtos.PasswordHistoryDTO) (*entities.PasswordHistoryEntity, error) {
+			assert.Equal(t, data.Password, "current_hash")
+			assert.Equal(t, data.UserId, user.Id)
+			return entry, nil
+		})
+	history.EXPECT().Save(gomock.Any(), entry).Return(nil)
+	history.EXPECT().
+		Prune(gomock.Any(), user.Id, 3, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, userId string, keep int, before time.Time) error {
+			assert.WithinDuration(t, before, time.Now().UTC().Add(-24*time.Hour), time.Minute)
+			return nil
+		})
+	// act
+	err := useCase.Execute(context.Background(), &definitions.ChangePasswordDTO{
+		ActorId:         user.Id,
+		CurrentPassword: "p4ssword",
+		NewPassword:     " n3w password ",
+		UserAgent:       "grpc-go/1.45.0",
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, user.Password, "new_hash")
+	assert.Equal(t, *auditEvents, []*dtos.AuditEventDTO{{
+		Type:      entities.AuditEventPasswordChanged,
+		ActorId:   user.Id,
+		TargetId:  user.Id,
+		UserAgent: "grpc-go/1.45.0",
+	}})
+}
+
+func TestChangePasswordUseCase_ReusedPassword(t *testing.T) {
+	// arrange
+	useCase, users, history, encrypter, screener, _, ctrl := (&ChangePasswordUseCaseTest{}).setup(t, 3)
+	defer ctrl.Finish()
+	user := (&ChangePasswordUseCaseTest{}).user()
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	encrypter.EXPECT().Compare(gomock.Any(), "p4ssword", "current_hash").Return(true, nil)
+	screener.EXPECT().IsBreached(gomock.Any(), "0ld password").Return(false, nil)
+	history.EXPECT().
+		FindRecentByUserId(gomock.Any(), user.Id, 3, gomock.Any()).
+		Return([]*entities.PasswordHistoryEntity{{Password: "newer_hash"}, {Password: "old_hash"}}, nil)
+	encrypter.EXPECT().Compare(gomock.Any(), "0ld password", "current_hash").Return(false, nil)
+	encrypter.EXPECT().Compare(gomock.Any(), "0ld password", "newer_hash").Return(false, nil)
+	encrypter.EXPECT().Compare(gomock.Any(), "0ld password", "old_hash").Return(true, nil)
+	// act
+	err := useCase.Execute(context.Background(), &definitions.ChangePasswordDTO{
+		ActorId:         user.Id,
+		CurrentPassword: "p4ssword",
+		NewPassword:     "0ld password",
+	})
+	// assert
+	assert.Equal(t, err, exceptions.NewUserPasswordReused())
+	assert.Equal(t, user.Password, "current_hash")
+}
+
+func TestChangePasswordUseCase_HistoryDisabled(t *testing.T) {
+	// arrange
+	useCase, users, _, encrypter, screener, _, ctrl := (&ChangePasswordUseCaseTest{}).setup(t, 0)
+	defer ctrl.Finish()
+	user := (&ChangePasswordUseCaseTest{}).user()
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	encrypter.EXPECT().Compare(gomock.Any(), "p4ssword", "current_hash").Return(true, nil).Times(2)
+	screener.EXPECT().IsBreached(gomock.Any(), "p4ssword").Return(false, nil)
+	// act
+	err := useCase.Execute(context.Background(), &definitions.ChangePasswordDTO{
+		ActorId:         user.Id,
+		CurrentPassword: "p4ssword",
+		NewPassword:     "p4ssword",
+	})
+	// assert
+	assert.Equal(t, err, exceptions.NewUserPasswordReused())
+}
+
+func TestChangePasswordUseCase_WrongCurrentPassword(t *testing.T) {
+	// arrange
+	useCase, users, _, encrypter, _, _, ctrl := (&ChangePasswordUseCaseTest{}).setup(t, 3)
+	defer ctrl.Finish()
+	user := (&ChangePasswordUseCaseTest{}).user()
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	encrypter.EXPECT().Compare(gomock.Any(), "wrong", "current_hash").Return(false, nil)
+	// act
+	err := useCase.Execute(context.Background(), &definitions.ChangePasswordDTO{
+		ActorId:         user.Id,
+		CurrentPassword: "wrong",
+		NewPassword:     "n3w password",
+	})
+	// assert
+	assert.Equal(t, err, exceptions.NewUserReauthenticationFailed())
+}
+
+func TestChangePasswordUseCase_PolicyAndBreachChecks(t *testing.T) {
+	// arrange
+	useCase, users, _, encrypter, screener, _, ctrl := (&ChangePasswordUseCaseTest{}).setup(t, 3)
+	defer ctrl.Finish()
+	user := (&ChangePasswordUseCaseTest{}).user()
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil).Times(2)
+	encrypter.EXPECT().Compare(gomock.Any(), "p4ssword", "current_hash").Return(true, nil).Times(2)
+	screener.EXPECT().IsBreached(gomock.Any(), "passw0rd").Return(true, nil)
+	// act
+	invalidErr := useCase.Execute(context.Background(), &definitions.ChangePasswordDTO{
+		ActorId:         user.Id,
+		CurrentPassword: "p4ssword",
+		NewPassword:     "short",
+	})
+	breachedErr := useCase.Execute(context.Background(), &definitions.ChangePasswordDTO{
+		ActorId:         user.Id,
+		CurrentPassword: "p4ssword",
+		NewPassword:     "passw0rd",
+	})
+	// assert
+	assert.Equal(t, invalidErr, exceptions.NewInvalidUserPassword(
+		"Must have at least 8 characters.",
+		"Must have at least one digit character.",
+	))
+	assert.Equal(t, breachedErr, exceptions.NewUserPasswordBreached())
+}
+
+func TestChangePasswordUseCase_UserNotFound(t *testing.T) {
+	// arrange
+	useCase, users, _, _, _, _, ctrl := (&ChangePasswordUseCaseTest{}).setup(t, 3)
+	defer ctrl.Finish()
+	users.EXPECT().FindById(gomock.Any(), "9b157773-fbb4-d04c-9de6-d086cf37d7c7").Return(nil, nil)
+	// act
+	err := useCase.Execute(context.Background(), &definitions.ChangePasswordDTO{
+		ActorId:         "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		CurrentPassword: "p4ssword",
+		NewPassword:     "n3w password",
+	})
+	emptyActorErr := useCase.Execute(context.Background(), &definitions.ChangePasswordDTO{})
+	// assert
+	assert.Equal(t, err, exceptions.NewUserNotFound())
+	assert.Equal(t, emptyActorErr, exceptions.NewUserNotFound())
+}
@@ -0,0 +1,74 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type ConfirmMfaUseCaseTest struct{}
+
+func (*ConfirmMfaUseCaseTest) setup(t *testing.T) (*usecases.ConfirmMfaUseCase, *mock_repositories.MockUsersRepository, *mock_providers.MockCipherProvider, *mock_providers.MockOtpProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	users := mock_repositories.NewMockUsersRepository(ctrl)
+	cipher := mock_providers.NewMockCipherProvider(ctrl)
+	otp := mock_providers.NewMockOtpProvider(ctrl)
+	confirmMfaUseCase, _ := usecases.NewConfirmMfaUseCase(users, cipher, otp)
+	return confirmMfaUseCase, users, cipher, otp, ctrl
+}
+
+func TestConfirmMfaUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, users, cipher, otp, ctrl := (&ConfirmMfaUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := &entities.UserEntity{
+		Id:        "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		MfaSecret: "encrypted_secret",
+	}
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	cipher.EXPECT().Decrypt(user.MfaSecret).Return("GEZDGNBVGY3TQOJQ", nil)
+	expectTotpCodes(otp, "GEZDGNBVGY3TQOJQ", "123456")
+	users.EXPECT().ClaimMfaStep(gomock.Any(), user.Id, gomock.Any()).Return(true, nil)
+	users.EXPECT().Save(gomock.Any(), user).Return(nil)
+	// act
+	err := useCase.Execute(context.Background(), &definitions.ConfirmMfaDTO{ActorId: user.Id, Code: "123456"})
+	// assert
+	assert.Nil(t, err)
+	assert.True(t, user.MfaEnabled)
+}
+
+func TestConfirmMfaUseCase_MalformedCode(t *testing.T) {
+	// arrange
+	useCase, users, _, _, ctrl := (&ConfirmMfaUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := &entities.UserEntity{
+		Id:        "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		MfaSecret: "encrypted_secret",
+	}
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	// act
+	err := useCase.Execute(context.Background(), &definitions.ConfirmMfaDTO{ActorId: user.Id, Code: "12 456"})
+	// assert
+	assert.Equal(t, err, exceptions.NewInvalidMfaCode())
+	assert.False(t, user.MfaEnabled)
+}
+
+func TestConfirmMfaUseCase_NotEnrolled(t *testing.T) {
+	// arrange
+	useCase, users, _, _, ctrl := (&ConfirmMfaUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := &entities.UserEntity{Id: "9b157773-fbb4-d04c-9de6-d086cf37d7c7"}
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	// act
+	err := useCase.Execute(context.Background(), &definitions.ConfirmMfaDTO{ActorId: user.Id, Code: "123456"})
+	// assert
+	assert.Equal(t, err, exceptions.NewMfaNotEnrolled())
+}
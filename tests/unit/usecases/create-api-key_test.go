@@ -0,0 +1,147 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type CreateApiKeyUseCaseTest struct{}
+
+func (*CreateApiKeyUseCaseTest) setup(t *testing.T) (*usecases.CreateApiKeyUseCase, *mock_repositories.MockApiKeysRepository, *mock_repositories.MockMembershipsRepository, *mock_providers.MockDigestProvider, *mock_providers.MockRandomProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	apiKeys := mock_repositories.NewMockApiKeysRepository(ctrl)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
+	digest := mock_providers.NewMockDigestProvider(ctrl)
+	random := mock_providers.NewMockRandomProvider(ctrl)
+	createApiKeyUseCase, _ := usecases.NewCreateApiKeyUseCase(apiKeys, memberships, digest, random)
+	return createApiKeyUseCase, apiKeys, memberships, digest, random, ctrl
+}
+
+func TestCreateApiKeyUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, apiKeys, _, digest, random, ctrl := (&CreateApiKeyUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	actorId, prefix, secret, hash :=
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"AbCd1234",
+		"0123456789abcdefghijABCDEFGHIJkl",
+		"sha256:5e884898da28047151d0e56f8dc6292773603d0d6aabbdd62a11ef721d1542d8"
+	key := "og_" + prefix + "_" + secret
+	apiKey := &entities.ApiKeyEntity{
+		Id:        "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+		UserId:    actorId,
+		Name:      "deploy job",
+		Prefix:    prefix,
+		Hash:      hash,
+		Scopes:    []string{"users:read"},
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	random.EXPECT().String(8).Return(prefix, nil)
+	random.EXPECT().String(32).Return(secret, nil)
+	digest.EXPECT().Digest(key).Return(hash)
+	apiKeys.EXPECT().
+		Create(&dtos.ApiKeyDTO{
+			UserId: actorId,
+			Name:   "deploy job",
+			Prefix: prefix,
+			Hash:   hash,
+			Scopes: []string{"users:read"},
+		}).
+		Return(apiKey, nil)
+	apiKeys.EXPECT().Save(gomock.Any(), apiKey).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.CreateApiKeyDTO{
+		ActorId: actorId,
+		Name:    " deploy job ",
+		Scopes:  []string{"users:read"},
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.Key, key)
+	assert.Equal(t, result.ApiKey, apiKey)
+}
+
+func TestCreateApiKeyUseCase_ServiceAccountRequiresAdmin(t *testing.T) {
+	// arrange
+	useCase, _, memberships, _, _, ctrl := (&CreateApiKeyUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	actorId, organizationId :=
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"cc58997a-2403-af1e-7836-f0b338edcd60"
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, actorId).
+		Return(&entities.MembershipEntity{
+			Id:             "0b1e8f4a-2c3d-4e5f-8a9b-1c2d3e4f5a6b",
+			OrganizationId: organizationId,
+			UserId:         actorId,
+			Role:           entities.MembershipRoleMember,
+		}, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.CreateApiKeyDTO{
+		ActorId:        actorId,
+		OrganizationId: organizationId,
+		ServiceAccount: true,
+		Name:           "billing job",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOrganizationPermissionDenied())
+}
+
+func TestCreateApiKeyUseCase_ServiceAccountWithoutOrganization(t *testing.T) {
+	// arrange
+	useCase, _, _, _, _, ctrl := (&CreateApiKeyUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.CreateApiKeyDTO{
+		ActorId:        "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		ServiceAccount: true,
+		Name:           "billing job",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewInvalidApiKeyOwner())
+}
+
+func TestCreateApiKeyUseCase_ExpirationInThePast(t *testing.T) {
+	// arrange
+	useCase, _, _, _, _, ctrl := (&CreateApiKeyUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.CreateApiKeyDTO{
+		ActorId:   "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Name:      "deploy job",
+		ExpiresAt: time.Now().UTC().Add(-time.Hour),
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewInvalidApiKeyExpiration())
+}
+
+func TestCreateApiKeyUseCase_ScopesBeyondTheActor(t *testing.T) {
+	// arrange
+	useCase, _, _, _, _, ctrl := (&CreateApiKeyUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.CreateApiKeyDTO{
+		ActorId:     "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		ActorScopes: []string{"api_keys:write"},
+		Name:        "deploy job",
+		Scopes:      []string{"api_keys:write", "members:write"},
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewApiKeyPermissionDenied())
+}
@@ -0,0 +1,120 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type CreateOrganizationUseCaseTest struct{}
+
+func (*CreateOrganizationUseCaseTest) setup(t *testing.T) (*usecases.CreateOrganizationUseCase, *mock_repositories.MockOrganizationsRepository, *mock_repositories.MockMembershipsRepository, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	organizations := mock_repositories.NewMockOrganizationsRepository(ctrl)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
+	createOrganizationUseCase, _ := usecases.NewCreateOrganizationUseCase(organizations, memberships)
+	return createOrganizationUseCase, organizations, memberships, ctrl
+}
+
+func TestCreateOrganizationUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, organizations, memberships, ctrl := (&CreateOrganizationUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	actorId, name := "9b157773-fbb4-d04c-9de6-d086cf37d7c7", "Organization"
+	organization := &entities.OrganizationEntity{
+		Id:        "cc58997a-2403-af1e-7836-f0b338edcd60",
+		Name:      name,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	membership := &entities.MembershipEntity{
+		Id:             "4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11",
+		OrganizationId: organization.Id,
+		UserId:         actorId,
+		Role:           entities.MembershipRoleOwner,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	organizations.EXPECT().
+		Create(&dtos.OrganizationDTO{Name: name}).
+		Return(organization, nil)
+	memberships.EXPECT().
+		Create(&dtos.MembershipDTO{
+			OrganizationId: organization.Id,
+			UserId:         actorId,
+			Role:           entities.MembershipRoleOwner,
+		}).
+		Return(membership, nil)
+	organizations.EXPECT().Save(gomock.Any(), organization).Return(nil)
+	memberships.EXPECT().Save(gomock.Any(), membership).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.CreateOrganizationDTO{
+		ActorId: actorId,
+		Name:    "  " + name + " ",
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Nil(t, result.IsValid())
+	assert.Equal(t, result.Name, name)
+}
+
+func TestCreateOrganizationUseCase_CreateReturnError(t *testing.T) {
+	// arrange
+	useCase, organizations, _, ctrl := (&CreateOrganizationUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	actorId, name := "9b157773-fbb4-d04c-9de6-d086cf37d7c7", "Organization"
+	organizations.EXPECT().
+		Create(&dtos.OrganizationDTO{Name: name}).
+		Return(nil, &shared.Error{})
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.CreateOrganizationDTO{
+		ActorId: actorId,
+		Name:    name,
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, &shared.Error{})
+}
+
+func TestCreateOrganizationUseCase_DeletesOrganizationWithoutOwner(t *testing.T) {
+	// arrange
+	useCase, organizations, memberships, ctrl := (&CreateOrganizationUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	actorId, name := "9b157773-fbb4-d04c-9de6-d086cf37d7c7", "Organization"
+	organization := &entities.OrganizationEntity{
+		Id:        "cc58997a-2403-af1e-7836-f0b338edcd60",
+		Name:      name,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	membership := &entities.MembershipEntity{
+		Id:             "4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11",
+		OrganizationId: organization.Id,
+		UserId:         actorId,
+		Role:           entities.MembershipRoleOwner,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	organizations.EXPECT().Create(gomock.Any()).Return(organization, nil)
+	memberships.EXPECT().Create(gomock.Any()).Return(membership, nil)
+	organizations.EXPECT().Save(gomock.Any(), organization).Return(nil)
+	memberships.EXPECT().Save(gomock.Any(), membership).Return(&shared.Error{})
+	organizations.EXPECT().Delete(gomock.Any(), organization.Id).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.CreateOrganizationDTO{
+		ActorId: actorId,
+		Name:    name,
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, &shared.Error{})
+}
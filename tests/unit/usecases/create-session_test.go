@@ -1,6 +1,7 @@
 package test_usecases
 
 import (
+	"context"
 	"strings"
 	"testing"
 	"time"
@@ -10,6 +11,7 @@ import (
 	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
 	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
 	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
 	"github.com/AndreyArthur/oganessone/src/core/entities"
 	"github.com/AndreyArthur/oganessone/src/core/exceptions"
 	"github.com/AndreyArthur/oganessone/src/core/shared"
@@ -22,10 +24,16 @@ type CreateSessionUseCaseTest struct{}
 func (*CreateSessionUseCaseTest) setup(t *testing.T) (*usecases.CreateSessionUseCase, *mock_repositories.MockUsersRepository, *mock_providers.MockEncrypterProvider, *mock_providers.MockSessionProvider, *mock_providers.MockCacheProvider, *gomock.Controller) {
 	ctrl := gomock.NewController(t)
 	repo := mock_repositories.NewMockUsersRepository(ctrl)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
 	encrypter := mock_providers.NewMockEncrypterProvider(ctrl)
 	session := mock_providers.NewMockSessionProvider(ctrl)
 	cache := mock_providers.NewMockCacheProvider(ctrl)
-	createSessionUseCase, _ := usecases.NewCreateSessionUseCase(repo, encrypter, session, cache)
+	tenancy := &definitions.TenancyOptions{}
+	mfaChallenges := mock_repositories.NewMockMfaChallengesRepository(ctrl)
+	random := mock_providers.NewMockRandomProvider(ctrl)
+	auditLog, _ := setupAuditLog(ctrl)
+	tracer, _ := setupTracer(ctrl)
+	createSessionUseCase, _ := usecases.NewCreateSessionUseCase(repo, memberships, encrypter, session, cache, tenancy, mfaChallenges, random, &definitions.AntiEnumerationOptions{}, auditLog, tracer)
 	return createSessionUseCase, repo, encrypter, session, cache, ctrl
 }
 
@@ -48,29 +56,36 @@ func TestCreateSessionUseCase_SuccessCaseByUsername(t *testing.T) {
 	tomorrow := time.Now().UTC().Add(ONE_DAY)
 	expiresIn := tomorrow.Format(time.RFC3339)
 	repo.EXPECT().
-		FindByEmail(username).
+		FindByEmail(gomock.Any(), "", username).
 		Return(nil, nil)
 	repo.EXPECT().
-		FindByUsername(username, true).
+		FindByUsername(gomock.Any(), "", username, true).
 		Return(repoUser, nil)
 	encrypter.EXPECT().
-		Compare(password, fakeBcryptHash).
+		Compare(gomock.Any(), password, fakeBcryptHash).
 		Return(true, nil)
+	encrypter.EXPECT().
+		NeedsRehash(fakeBcryptHash).
+		Return(false, nil)
 	session.EXPECT().
-		Generate(repoUser.Id).
+		Generate(repoUser.Id, "").
 		Return(&providers.SessionData{
 			Key:            sessionKey,
 			UserId:         repoUser.Id,
 			ExpirationDate: expiresIn,
 		}, nil)
+	expiresAt, _ := time.Parse(time.RFC3339, expiresIn)
+	cache.EXPECT().
+		Set(gomock.Any(), sessionKey, repoUser.Id, expiresAt).
+		Return(nil)
 	cache.EXPECT().
-		Set(sessionKey, repoUser.Id).
+		Set(gomock.Any(), strings.Join([]string{sessionKey, "@", repoUser.Id}, ""), expiresIn, expiresAt).
 		Return(nil)
 	cache.EXPECT().
-		Set(strings.Join([]string{sessionKey, "@", repoUser.Id}, ""), expiresIn).
+		Set(gomock.Any(), strings.Join([]string{sessionKey, "#", repoUser.Id}, ""), "", expiresAt).
 		Return(nil)
 	// act
-	result, err := useCase.Execute(&definitions.CreateSessionDTO{
+	result, err := useCase.Execute(context.Background(), &definitions.CreateSessionDTO{
 		Login:    username,
 		Password: password,
 	})
@@ -99,29 +114,35 @@ func TestCreateSessionUseCase_SuccessCaseByEmail(t *testing.T) {
 	tomorrow := time.Now().UTC().Add(ONE_DAY)
 	expiresIn := tomorrow.Format(time.RFC3339)
 	repo.EXPECT().
-		FindByEmail(email).
+		FindByEmail(gomock.Any(), "", email).
 		Return(repoUser, nil)
 	repo.EXPECT().
-		FindByUsername(email, true).
+		FindByUsername(gomock.Any(), "", email, true).
 		Return(nil, nil)
 	encrypter.EXPECT().
-		Compare(password, fakeBcryptHash).
+		Compare(gomock.Any(), password, fakeBcryptHash).
 		Return(true, nil)
+	encrypter.EXPECT().
+		NeedsRehash(fakeBcryptHash).
+		Return(false, nil)
 	session.EXPECT().
-		Generate(repoUser.Id).
+		Generate(repoUser.Id, "").
 		Return(&providers.SessionData{
 			Key:            sessionKey,
 			UserId:         repoUser.Id,
 			ExpirationDate: expiresIn,
 		}, nil)
 	cache.EXPECT().
-		Set(sessionKey, repoUser.Id).
+		Set(gomock.Any(), sessionKey, repoUser.Id, gomock.Any()).
 		Return(nil)
 	cache.EXPECT().
-		Set(strings.Join([]string{sessionKey, "@", repoUser.Id}, ""), expiresIn).
+		Set(gomock.Any(), strings.Join([]string{sessionKey, "@", repoUser.Id}, ""), expiresIn, gomock.Any()).
+		Return(nil)
+	cache.EXPECT().
+		Set(gomock.Any(), strings.Join([]string{sessionKey, "#", repoUser.Id}, ""), "", gomock.Any()).
 		Return(nil)
 	// act
-	result, err := useCase.Execute(&definitions.CreateSessionDTO{
+	result, err := useCase.Execute(context.Background(), &definitions.CreateSessionDTO{
 		Login:    email,
 		Password: password,
 	})
@@ -137,13 +158,13 @@ func TestCreateSessionUseCase_FindByUsernameReturnError(t *testing.T) {
 	defer ctrl.Finish()
 	login, password := "username", "p4ssword"
 	repo.EXPECT().
-		FindByEmail(login).
+		FindByEmail(gomock.Any(), "", login).
 		Return(nil, nil)
 	repo.EXPECT().
-		FindByUsername(login, true).
+		FindByUsername(gomock.Any(), "", login, true).
 		Return(nil, &shared.Error{})
 	// act
-	result, err := useCase.Execute(&definitions.CreateSessionDTO{
+	result, err := useCase.Execute(context.Background(), &definitions.CreateSessionDTO{
 		Login:    login,
 		Password: password,
 	})
@@ -158,13 +179,13 @@ func TestCreateSessionUseCase_FindByEmailReturnError(t *testing.T) {
 	defer ctrl.Finish()
 	login, password := "username", "p4ssword"
 	repo.EXPECT().
-		FindByEmail(login).
+		FindByEmail(gomock.Any(), "", login).
 		Return(nil, &shared.Error{})
 	repo.EXPECT().
-		FindByUsername(login, true).
+		FindByUsername(gomock.Any(), "", login, true).
 		Return(nil, nil)
 	// act
-	result, err := useCase.Execute(&definitions.CreateSessionDTO{
+	result, err := useCase.Execute(context.Background(), &definitions.CreateSessionDTO{
 		Login:    login,
 		Password: password,
 	})
@@ -179,13 +200,13 @@ func TestCreateSessionUseCase_UserNotFound(t *testing.T) {
 	defer ctrl.Finish()
 	login, password := "username", "p4ssword"
 	repo.EXPECT().
-		FindByEmail(login).
+		FindByEmail(gomock.Any(), "", login).
 		Return(nil, nil)
 	repo.EXPECT().
-		FindByUsername(login, true).
+		FindByUsername(gomock.Any(), "", login, true).
 		Return(nil, nil)
 	// act
-	result, err := useCase.Execute(&definitions.CreateSessionDTO{
+	result, err := useCase.Execute(context.Background(), &definitions.CreateSessionDTO{
 		Login:    login,
 		Password: password,
 	})
@@ -209,16 +230,16 @@ func TestCreateSessionUseCase_EncrypterCompareReturnError(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 	repo.EXPECT().
-		FindByEmail(username).
+		FindByEmail(gomock.Any(), "", username).
 		Return(nil, nil)
 	repo.EXPECT().
-		FindByUsername(username, true).
+		FindByUsername(gomock.Any(), "", username, true).
 		Return(repoUser, nil)
 	encrypter.EXPECT().
-		Compare(password, fakeBcryptHash).
+		Compare(gomock.Any(), password, fakeBcryptHash).
 		Return(true, &shared.Error{})
 	// act
-	result, err := useCase.Execute(&definitions.CreateSessionDTO{
+	result, err := useCase.Execute(context.Background(), &definitions.CreateSessionDTO{
 		Login:    username,
 		Password: password,
 	})
@@ -242,16 +263,16 @@ func TestCreateSessionUseCase_UserPasswordDoesNotMatch(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 	repo.EXPECT().
-		FindByEmail(username).
+		FindByEmail(gomock.Any(), "", username).
 		Return(nil, nil)
 	repo.EXPECT().
-		FindByUsername(username, true).
+		FindByUsername(gomock.Any(), "", username, true).
 		Return(repoUser, nil)
 	encrypter.EXPECT().
-		Compare(password, fakeBcryptHash).
+		Compare(gomock.Any(), password, fakeBcryptHash).
 		Return(false, nil)
 	// act
-	result, err := useCase.Execute(&definitions.CreateSessionDTO{
+	result, err := useCase.Execute(context.Background(), &definitions.CreateSessionDTO{
 		Login:    username,
 		Password: password,
 	})
@@ -274,19 +295,22 @@ func TestCreateSessionUseCase_SessionGenerateKeyReturnError(t *testing.T) {
 		CreatedAt: time.Now(),
 		UpdatedAt: time.Now(),
 	}
-	repo.EXPECT().FindByEmail(username).
+	repo.EXPECT().FindByEmail(gomock.Any(), "", username).
 		Return(nil, nil)
 	repo.EXPECT().
-		FindByUsername(username, true).
+		FindByUsername(gomock.Any(), "", username, true).
 		Return(repoUser, nil)
 	encrypter.EXPECT().
-		Compare(password, fakeBcryptHash).
+		Compare(gomock.Any(), password, fakeBcryptHash).
 		Return(true, nil)
+	encrypter.EXPECT().
+		NeedsRehash(fakeBcryptHash).
+		Return(false, nil)
 	session.EXPECT().
-		Generate(repoUser.Id).
+		Generate(repoUser.Id, "").
 		Return(nil, &shared.Error{})
 	// act
-	result, err := useCase.Execute(&definitions.CreateSessionDTO{
+	result, err := useCase.Execute(context.Background(), &definitions.CreateSessionDTO{
 		Login:    username,
 		Password: password,
 	})
@@ -314,26 +338,29 @@ func TestCreateSessionUseCase_FirstCacheSetReturnError(t *testing.T) {
 	tomorrow := time.Now().UTC().Add(ONE_DAY)
 	expiresIn := tomorrow.Format(time.RFC3339)
 	repo.EXPECT().
-		FindByEmail(username).
+		FindByEmail(gomock.Any(), "", username).
 		Return(nil, nil)
 	repo.EXPECT().
-		FindByUsername(username, true).
+		FindByUsername(gomock.Any(), "", username, true).
 		Return(repoUser, nil)
 	encrypter.EXPECT().
-		Compare(password, fakeBcryptHash).
+		Compare(gomock.Any(), password, fakeBcryptHash).
 		Return(true, nil)
+	encrypter.EXPECT().
+		NeedsRehash(fakeBcryptHash).
+		Return(false, nil)
 	session.EXPECT().
-		Generate(repoUser.Id).
+		Generate(repoUser.Id, "").
 		Return(&providers.SessionData{
 			Key:            sessionKey,
 			UserId:         repoUser.Id,
 			ExpirationDate: expiresIn,
 		}, nil)
 	cache.EXPECT().
-		Set(sessionKey, repoUser.Id).
+		Set(gomock.Any(), sessionKey, repoUser.Id, gomock.Any()).
 		Return(&shared.Error{})
 	// act
-	result, err := useCase.Execute(&definitions.CreateSessionDTO{
+	result, err := useCase.Execute(context.Background(), &definitions.CreateSessionDTO{
 		Login:    username,
 		Password: password,
 	})
@@ -361,29 +388,32 @@ func TestCreateSessionUseCase_SecondCacheSetReturnError(t *testing.T) {
 	tomorrow := time.Now().UTC().Add(ONE_DAY)
 	expiresIn := tomorrow.Format(time.RFC3339)
 	repo.EXPECT().
-		FindByEmail(username).
+		FindByEmail(gomock.Any(), "", username).
 		Return(nil, nil)
 	repo.EXPECT().
-		FindByUsername(username, true).
+		FindByUsername(gomock.Any(), "", username, true).
 		Return(repoUser, nil)
 	encrypter.EXPECT().
-		Compare(password, fakeBcryptHash).
+		Compare(gomock.Any(), password, fakeBcryptHash).
 		Return(true, nil)
+	encrypter.EXPECT().
+		NeedsRehash(fakeBcryptHash).
+		Return(false, nil)
 	session.EXPECT().
-		Generate(repoUser.Id).
+		Generate(repoUser.Id, "").
 		Return(&providers.SessionData{
 			Key:            sessionKey,
 			UserId:         repoUser.Id,
 			ExpirationDate: expiresIn,
 		}, nil)
 	cache.EXPECT().
-		Set(sessionKey, repoUser.Id).
+		Set(gomock.Any(), sessionKey, repoUser.Id, gomock.Any()).
 		Return(nil)
 	cache.EXPECT().
-		Set(strings.Join([]string{sessionKey, "@", repoUser.Id}, ""), expiresIn).
+		Set(gomock.Any(), strings.Join([]string{sessionKey, "@", repoUser.Id}, ""), expiresIn, gomock.Any()).
 		Return(&shared.Error{})
 	// act
-	result, err := useCase.Execute(&definitions.CreateSessionDTO{
+	result, err := useCase.Execute(context.Background(), &definitions.CreateSessionDTO{
 		Login:    username,
 		Password: password,
 	})
@@ -391,3 +421,395 @@ func TestCreateSessionUseCase_SecondCacheSetReturnError(t *testing.T) {
 	assert.Nil(t, result)
 	assert.Equal(t, err, &shared.Error{})
 }
+
+func (*CreateSessionUseCaseTest) setupTenancy(t *testing.T, tenancy *definitions.TenancyOptions) (*usecases.CreateSessionUseCase, *mock_repositories.MockUsersRepository, *mock_repositories.MockMembershipsRepository, *mock_providers.MockEncrypterProvider, *mock_providers.MockSessionProvider, *mock_providers.MockCacheProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	repo := mock_repositories.NewMockUsersRepository(ctrl)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
+	encrypter := mock_providers.NewMockEncrypterProvider(ctrl)
+	session := mock_providers.NewMockSessionProvider(ctrl)
+	cache := mock_providers.NewMockCacheProvider(ctrl)
+	mfaChallenges := mock_repositories.NewMockMfaChallengesRepository(ctrl)
+	random := mock_providers.NewMockRandomProvider(ctrl)
+	auditLog, _ := setupAuditLog(ctrl)
+	tracer, _ := setupTracer(ctrl)
+	createSessionUseCase, _ := usecases.NewCreateSessionUseCase(repo, memberships, encrypter, session, cache, tenancy, mfaChallenges, random, &definitions.AntiEnumerationOptions{}, auditLog, tracer)
+	return createSessionUseCase, repo, memberships, encrypter, session, cache, ctrl
+}
+
+func TestCreateSessionUseCase_SuccessCaseInScopedOrganization(t *testing.T) {
+	// arrange
+	useCase, repo, memberships, encrypter, session, cache, ctrl := (&CreateSessionUseCaseTest{}).
+		setupTenancy(t, &definitions.TenancyOptions{ScopedUsernames: true, ScopedEmails: true})
+	defer ctrl.Finish()
+	username, email, password := "username", "user@email.com", "p4ssword"
+	organizationId := "cc58997a-2403-af1e-7836-f0b338edcd60"
+	fakeBcryptHash := "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW"
+	repoUser := &entities.UserEntity{
+		Id:        "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Username:  username,
+		Email:     email,
+		Password:  fakeBcryptHash,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	sessionKey := "session_key_example"
+	expiresIn := time.Now().UTC().Add(time.Hour * 24).Format(time.RFC3339)
+	repo.EXPECT().
+		FindByEmail(gomock.Any(), organizationId, username).
+		Return(nil, nil)
+	repo.EXPECT().
+		FindByUsername(gomock.Any(), organizationId, username, true).
+		Return(repoUser, nil)
+	encrypter.EXPECT().
+		Compare(gomock.Any(), password, fakeBcryptHash).
+		Return(true, nil)
+	encrypter.EXPECT().
+		NeedsRehash(fakeBcryptHash).
+		Return(false, nil)
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, repoUser.Id).
+		Return(&entities.MembershipEntity{
+			OrganizationId: organizationId,
+			UserId:         repoUser.Id,
+			Role:           entities.MembershipRoleMember,
+		}, nil)
+	session.EXPECT().
+		Generate(repoUser.Id, organizationId).
+		Return(&providers.SessionData{
+			Key:            sessionKey,
+			UserId:         repoUser.Id,
+			OrganizationId: organizationId,
+			ExpirationDate: expiresIn,
+		}, nil)
+	cache.EXPECT().
+		Set(gomock.Any(), sessionKey, repoUser.Id, gomock.Any()).
+		Return(nil)
+	cache.EXPECT().
+		Set(gomock.Any(), strings.Join([]string{sessionKey, "@", repoUser.Id}, ""), expiresIn, gomock.Any()).
+		Return(nil)
+	cache.EXPECT().
+		Set(gomock.Any(), strings.Join([]string{sessionKey, "#", repoUser.Id}, ""), organizationId, gomock.Any()).
+		Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.CreateSessionDTO{
+		Login:          username,
+		Password:       password,
+		OrganizationId: organizationId,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.SessionKey, sessionKey)
+	assert.Equal(t, result.OrganizationId, organizationId)
+}
+
+func TestCreateSessionUseCase_UserIsNotAMemberOfOrganization(t *testing.T) {
+	// arrange
+	useCase, repo, memberships, encrypter, _, _, ctrl := (&CreateSessionUseCaseTest{}).
+		setupTenancy(t, &definitions.TenancyOptions{})
+	defer ctrl.Finish()
+	username, email, password := "username", "user@email.com", "p4ssword"
+	organizationId := "cc58997a-2403-af1e-7836-f0b338edcd60"
+	fakeBcryptHash := "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW"
+	repoUser := &entities.UserEntity{
+		Id:        "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Username:  username,
+		Email:     email,
+		Password:  fakeBcryptHash,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	repo.EXPECT().
+		FindByEmail(gomock.Any(), "", username).
+		Return(nil, nil)
+	repo.EXPECT().
+		FindByUsername(gomock.Any(), "", username, true).
+		Return(repoUser, nil)
+	encrypter.EXPECT().
+		Compare(gomock.Any(), password, fakeBcryptHash).
+		Return(true, nil)
+	encrypter.EXPECT().
+		NeedsRehash(fakeBcryptHash).
+		Return(false, nil)
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, repoUser.Id).
+		Return(nil, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.CreateSessionDTO{
+		Login:          username,
+		Password:       password,
+		OrganizationId: organizationId,
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewUserLoginFailed())
+}
+
+func (*CreateSessionUseCaseTest) setupAudit(t *testing.T) (*usecases.CreateSessionUseCase, *mock_repositories.MockUsersRepository, *mock_repositories.MockMembershipsRepository, *mock_providers.MockEncrypterProvider, *[]*dtos.AuditEventDTO, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	repo := mock_repositories.NewMockUsersRepository(ctrl)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
+	encrypter := mock_providers.NewMockEncrypterProvider(ctrl)
+	session := mock_providers.NewMockSessionProvider(ctrl)
+	cache := mock_providers.NewMockCacheProvider(ctrl)
+	mfaChallenges := mock_repositories.NewMockMfaChallengesRepository(ctrl)
+	random := mock_providers.NewMockRandomProvider(ctrl)
+	auditLog, auditEvents := setupAuditLog(ctrl)
+	tracer, _ := setupTracer(ctrl)
+	createSessionUseCase, _ := usecases.NewCreateSessionUseCase(repo, memberships, encrypter, session, cache, &definitions.TenancyOptions{}, mfaChallenges, random, &definitions.AntiEnumerationOptions{}, auditLog, tracer)
+	return createSessionUseCase, repo, memberships, encrypter, auditEvents, ctrl
+}
+
+func TestCreateSessionUseCase_RecordsFailedLogins(t *testing.T) {
+	// arrange
+	useCase, repo, memberships, encrypter, auditEvents, ctrl := (&CreateSessionUseCaseTest{}).setupAudit(t)
+	defer ctrl.Finish()
+	fakeBcryptHash := "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW"
+	organizationId := "cc58997a-2403-af1e-7836-f0b338edcd60"
+	repoUser := &entities.UserEntity{
+		Id:       "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Username: "username",
+		Password: fakeBcryptHash,
+	}
+	repo.EXPECT().FindByEmail(gomock.Any(), "", "unknown").Return(nil, nil)
+	repo.EXPECT().FindByUsername(gomock.Any(), "", "unknown", true).Return(nil, nil)
+	repo.EXPECT().FindByEmail(gomock.Any(), "", "username").Return(nil, nil).Times(2)
+	repo.EXPECT().FindByUsername(gomock.Any(), "", "username", true).Return(repoUser, nil).Times(2)
+	encrypter.EXPECT().Compare(gomock.Any(), "wrong", fakeBcryptHash).Return(false, nil)
+	encrypter.EXPECT().Compare(gomock.Any(), "p4ssword", fakeBcryptHash).Return(true, nil)
+	encrypter.EXPECT().NeedsRehash(fakeBcryptHash).Return(false, nil)
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, repoUser.Id).
+		Return(nil, nil)
+	// act
+	_, unknownErr := useCase.Execute(context.Background(), &definitions.CreateSessionDTO{
+		Login: "unknown", Password: "p4ssword", IpAddress: "203.0.113.7", UserAgent: "curl/7.81.0",
+	})
+	_, wrongErr := useCase.Execute(context.Background(), &definitions.CreateSessionDTO{
+		Login: "username", Password: "wrong",
+	})
+	_, memberErr := useCase.Execute(context.Background(), &definitions.CreateSessionDTO{
+		Login: "username", Password: "p4ssword", OrganizationId: organizationId,
+	})
+	// assert
+	assert.Equal(t, unknownErr, exceptions.NewUserLoginFailed())
+	assert.Equal(t, wrongErr, exceptions.NewUserLoginFailed())
+	assert.Equal(t, memberErr, exceptions.NewUserLoginFailed())
+	assert.Equal(t, *auditEvents, []*dtos.AuditEventDTO{
+		{
+			Type:      entities.AuditEventLoginFailed,
+			IpAddress: "203.0.113.7",
+			UserAgent: "curl/7.81.0",
+			Detail:    entities.AuditReasonUnknownUser,
+		},
+		{
+			Type:     entities.AuditEventLoginFailed,
+			TargetId: repoUser.Id,
+			Detail:   entities.AuditReasonWrongPassword,
+		},
+		{
+			Type:           entities.AuditEventLoginFailed,
+			TargetId:       repoUser.Id,
+			OrganizationId: organizationId,
+			Detail:         entities.AuditReasonNotAMember,
+		},
+	})
+}
+
+func (*CreateSessionUseCaseTest) setupMfa(t *testing.T) (*usecases.CreateSessionUseCase, *mock_repositories.MockUsersRepository, *mock_providers.MockEncrypterProvider, *mock_repositories.MockMfaChallengesRepository, *mock_providers.MockRandomProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	repo := mock_repositories.NewMockUsersRepository(ctrl)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
+	encrypter := mock_providers.NewMockEncrypterProvider(ctrl)
+	session := mock_providers.NewMockSessionProvider(ctrl)
+	cache := mock_providers.NewMockCacheProvider(ctrl)
+	mfaChallenges := mock_repositories.NewMockMfaChallengesRepository(ctrl)
+	random := mock_providers.NewMockRandomProvider(ctrl)
+	auditLog, _ := setupAuditLog(ctrl)
+	tracer, _ := setupTracer(ctrl)
+	createSessionUseCase, _ := usecases.NewCreateSessionUseCase(repo, memberships, encrypter, session, cache, &definitions.TenancyOptions{}, mfaChallenges, random, &definitions.AntiEnumerationOptions{}, auditLog, tracer)
+	return createSessionUseCase, repo, encrypter, mfaChallenges, random, ctrl
+}
+
+func TestCreateSessionUseCase_MfaRequired(t *testing.T) {
+	// arrange
+	useCase, repo, encrypter, mfaChallenges, random, ctrl := (&CreateSessionUseCaseTest{}).setupMfa(t)
+	defer ctrl.Finish()
+	username, password := "username", "p4ssword"
+	fakeBcryptHash := "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW"
+	repoUser := &entities.UserEntity{
+		Id:         "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Username:   username,
+		Email:      "user@email.com",
+		Password:   fakeBcryptHash,
+		MfaSecret:  "encrypted_secret",
+		MfaEnabled: true,
+	}
+	token := "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q"
+	challenge := &entities.MfaChallengeEntity{
+		Token:     token,
+		UserId:    repoUser.Id,
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}
+	repo.EXPECT().
+		FindByEmail(gomock.Any(), "", username).
+		Return(nil, nil)
+	repo.EXPECT().
+		FindByUsername(gomock.Any(), "", username, true).
+		Return(repoUser, nil)
+	encrypter.EXPECT().
+		Compare(gomock.Any(), password, fakeBcryptHash).
+		Return(true, nil)
+	encrypter.EXPECT().
+		NeedsRehash(fakeBcryptHash).
+		Return(false, nil)
+	random.EXPECT().String(43).Return(token, nil)
+	mfaChallenges.EXPECT().Create(gomock.Any()).Return(challenge, nil)
+	mfaChallenges.EXPECT().Save(gomock.Any(), challenge).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.CreateSessionDTO{
+		Login:    username,
+		Password: password,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.True(t, result.MfaRequired)
+	assert.Equal(t, result.MfaToken, token)
+	assert.Equal(t, result.SessionKey, "")
+}
+
+func TestCreateSessionUseCase_RehashesOutdatedPassword(t *testing.T) {
+	// arrange
+	useCase, repo, encrypter, session, cache, ctrl := (&CreateSessionUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	username, password := "username", "p4ssword"
+	fakeBcryptHash := "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW"
+	fakeArgon2idHash := "$argon2id$v=19$m=19456,t=2,p=1$c29tZXNhbHRzb21lc2FsdA$RdescudvJCsgt3ub+b+dWRWJTmaaJObG/XqB6i2fKr8"
+	repoUser := &entities.UserEntity{
+		Id:        "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Username:  username,
+		Email:     "user@email.com",
+		Password:  fakeBcryptHash,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	sessionKey := "session_key_example"
+	expiresIn := time.Now().UTC().Add(time.Hour * 24).Format(time.RFC3339)
+	repo.EXPECT().FindByEmail(gomock.Any(), "", username).Return(nil, nil)
+	repo.EXPECT().FindByUsername(gomock.Any(), "", username, true).Return(repoUser, nil)
+	encrypter.EXPECT().Compare(gomock.Any(), password, fakeBcryptHash).Return(true, nil)
+	encrypter.EXPECT().NeedsRehash(fakeBcryptHash).Return(true, nil)
+	encrypter.EXPECT().Hash(gomock.Any(), password).Return(fakeArgon2idHash, nil)
+	repo.EXPECT().Save(gomock.Any(), repoUser).Return(nil)
+	session.EXPECT().Generate(repoUser.Id, "").Return(&providers.SessionData{
+		Key:            sessionKey,
+		UserId:         repoUser.Id,
+		ExpirationDate: expiresIn,
+	}, nil)
+	cache.EXPECT().Set(gomock.Any(), sessionKey, repoUser.Id, gomock.Any()).Return(nil)
+	cache.EXPECT().Set(gomock.Any(), strings.Join([]string{sessionKey, "@", repoUser.Id}, ""), expiresIn, gomock.Any()).Return(nil)
+	cache.EXPECT().Set(gomock.Any(), strings.Join([]string{sessionKey, "#", repoUser.Id}, ""), "", gomock.Any()).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.CreateSessionDTO{
+		Login:    username,
+		Password: password,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.User.Password, fakeArgon2idHash)
+	assert.Nil(t, result.User.IsValid())
+}
+
+func (*CreateSessionUseCaseTest) setupEnumeration(ctrl *gomock.Controller, encrypter providers.EncrypterProvider, enumeration *definitions.AntiEnumerationOptions) (*usecases.CreateSessionUseCase, *mock_repositories.MockUsersRepository) {
+	repo := mock_repositories.NewMockUsersRepository(ctrl)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
+	session := mock_providers.NewMockSessionProvider(ctrl)
+	cache := mock_providers.NewMockCacheProvider(ctrl)
+	mfaChallenges := mock_repositories.NewMockMfaChallengesRepository(ctrl)
+	random := mock_providers.NewMockRandomProvider(ctrl)
+	auditLog, _ := setupAuditLog(ctrl)
+	tracer, _ := setupTracer(ctrl)
+	createSessionUseCase, _ := usecases.NewCreateSessionUseCase(repo, memberships, encrypter, session, cache, &definitions.TenancyOptions{}, mfaChallenges, random, enumeration, auditLog, tracer)
+	return createSessionUseCase, repo
+}
+
+func TestCreateSessionUseCase_UnknownUserComparesDummyHash(t *testing.T) {
+	// arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	encrypter := mock_providers.NewMockEncrypterProvider(ctrl)
+	dummyHash := "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW"
+	useCase, repo := (&CreateSessionUseCaseTest{}).setupEnumeration(ctrl, encrypter, &definitions.AntiEnumerationOptions{
+		Enabled:           true,
+		DummyPasswordHash: dummyHash,
+	})
+	repo.EXPECT().FindByEmail(gomock.Any(), "", "unknown").Return(nil, nil)
+	repo.EXPECT().FindByUsername(gomock.Any(), "", "unknown", true).Return(nil, nil)
+	encrypter.EXPECT().Compare(gomock.Any(), "p4ssword", dummyHash).Return(false, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.CreateSessionDTO{
+		Login:    "unknown",
+		Password: "p4ssword",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewUserLoginFailed())
+}
+
+// Timing itself is too noisy to assert on, the calls that cost the time are
+// counted instead.
+func TestCreateSessionUseCase_EqualWorkForUnknownLogins(t *testing.T) {
+	// arrange
+	user := &entities.UserEntity{
+		Id:       "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Username: "username",
+		Email:    "user@email.com",
+		Password: "$2a$10$U2WNqBoSRZ0gZ8Q7tB0Mne4Fb0jVcVq0Z1Y9mS7Vh0b2wC6b6vX9a",
+	}
+	count := func(enumeration *definitions.AntiEnumerationOptions, login string) map[string]int {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		calls := map[string]int{}
+		encrypter := mock_providers.NewMockEncrypterProvider(ctrl)
+		encrypter.EXPECT().
+			Compare(gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(context.Context, string, string) (bool, *shared.Error) {
+				calls["Compare"]++
+				return false, nil
+			}).
+			AnyTimes()
+		useCase, repo := (&CreateSessionUseCaseTest{}).setupEnumeration(ctrl, encrypter, enumeration)
+		repo.EXPECT().
+			FindByEmail(gomock.Any(), "", gomock.Any()).
+			DoAndReturn(func(context.Context, string, string) (*entities.UserEntity, *shared.Error) {
+				calls["FindByEmail"]++
+				return nil, nil
+			}).
+			AnyTimes()
+		repo.EXPECT().
+			FindByUsername(gomock.Any(), "", gomock.Any(), true).
+			DoAndReturn(func(_ context.Context, _ string, username string, _ bool) (*entities.UserEntity, *shared.Error) {
+				calls["FindByUsername"]++
+				if username == user.Username {
+					return user, nil
+				}
+				return nil, nil
+			}).
+			AnyTimes()
+		useCase.Execute(context.Background(), &definitions.CreateSessionDTO{Login: login, Password: "wr0ng password"})
+		return calls
+	}
+	protected := &definitions.AntiEnumerationOptions{
+		Enabled:           true,
+		DummyPasswordHash: "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW",
+	}
+	// act
+	protectedExisting, protectedUnknown := count(protected, "username"), count(protected, "unknown")
+	leakyExisting, leakyUnknown := count(&definitions.AntiEnumerationOptions{}, "username"),
+		count(&definitions.AntiEnumerationOptions{}, "unknown")
+	// assert
+	assert.Equal(t, protectedExisting["Compare"], 1)
+	assert.Equal(t, protectedUnknown, protectedExisting)
+	assert.Equal(t, leakyExisting["Compare"], 1)
+	assert.Equal(t, leakyUnknown["Compare"], 0)
+}
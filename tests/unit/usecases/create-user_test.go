@@ -1,11 +1,14 @@
 package test_usecases
 
 import (
+	"context"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
 	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
 	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
 	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
@@ -19,11 +22,52 @@ import (
 
 type CreateUserUseCaseTest struct{}
 
+func createUserPasswordPolicy() *entities.PasswordPolicyEntity {
+	policy, _ := entities.NewPasswordPolicyEntity(&dtos.PasswordPolicyDTO{
+		MinLength:       8,
+		MaxLength:       128,
+		RequiredClasses: []string{entities.PasswordClassLetter, entities.PasswordClassDigit},
+	})
+	return policy
+}
+
+// Spans pass ctx through, the names of the started ones are kept in order.
+func setupTracer(ctrl *gomock.Controller) (*mock_providers.MockTracerProvider, func() []string) {
+	tracer := mock_providers.NewMockTracerProvider(ctrl)
+	span := mock_providers.NewMockSpan(ctrl)
+	span.EXPECT().SetAttribute(gomock.Any(), gomock.Any()).AnyTimes()
+	span.EXPECT().RecordError(gomock.Any()).AnyTimes()
+	span.EXPECT().End().AnyTimes()
+	var mutex sync.Mutex
+	names := []string{}
+	tracer.EXPECT().
+		Start(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, name string) (context.Context, providers.Span) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			names = append(names, name)
+			return ctx, span
+		}).
+		AnyTimes()
+	return tracer, func() []string {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return append([]string{}, names...)
+	}
+}
+
 func (*CreateUserUseCaseTest) setup(t *testing.T) (*usecases.CreateUserUseCase, *mock_repositories.MockUsersRepository, *mock_providers.MockEncrypterProvider, *gomock.Controller) {
 	ctrl := gomock.NewController(t)
 	repo := mock_repositories.NewMockUsersRepository(ctrl)
+	organizations := mock_repositories.NewMockOrganizationsRepository(ctrl)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
 	encrypter := mock_providers.NewMockEncrypterProvider(ctrl)
-	createUserUseCase, _ := usecases.NewCreateUserUseCase(repo, encrypter)
+	screener := mock_providers.NewMockPasswordScreenerProvider(ctrl)
+	screener.EXPECT().IsBreached(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	tenancy := &definitions.TenancyOptions{}
+	auditLog, _ := setupAuditLog(ctrl)
+	tracer, _ := setupTracer(ctrl)
+	createUserUseCase, _ := usecases.NewCreateUserUseCase(repo, organizations, memberships, encrypter, screener, mock_providers.NewMockMailerProvider(ctrl), createUserPasswordPolicy(), tenancy, &definitions.AntiEnumerationOptions{}, auditLog, tracer)
 	return createUserUseCase, repo, encrypter, ctrl
 }
 
@@ -42,22 +86,22 @@ func TestCreateUserUseCase_SuccessCase(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 	repo.EXPECT().
-		FindByUsername(username, false).
+		FindByUsername(gomock.Any(), "", username, false).
 		Return(nil, nil)
 	repo.EXPECT().
-		FindByEmail(email).
+		FindByEmail(gomock.Any(), "", email).
 		Return(nil, nil)
 	encrypter.EXPECT().
-		Hash(password).
+		Hash(gomock.Any(), password).
 		Return(fakeBcryptHash, nil)
 	repo.EXPECT().
 		Create(&dtos.UserDTO{Username: username, Email: email, Password: fakeBcryptHash}).
 		Return(repoUser, nil)
 	repo.EXPECT().
-		Save(repoUser).
+		Save(gomock.Any(), repoUser).
 		Return(nil)
 	// act
-	user, err := useCase.Execute(&definitions.CreateUserDTO{
+	user, err := useCase.Execute(context.Background(), &definitions.CreateUserDTO{
 		Username: username,
 		Email:    email,
 		Password: password,
@@ -67,6 +111,35 @@ func TestCreateUserUseCase_SuccessCase(t *testing.T) {
 	assert.Nil(t, user.IsValid())
 }
 
+func TestCreateUserUseCase_TracesConcurrentLookups(t *testing.T) {
+	// arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	repo := mock_repositories.NewMockUsersRepository(ctrl)
+	tracer, spanNames := setupTracer(ctrl)
+	auditLog, _ := setupAuditLog(ctrl)
+	useCase, _ := usecases.NewCreateUserUseCase(repo, mock_repositories.NewMockOrganizationsRepository(ctrl), mock_repositories.NewMockMembershipsRepository(ctrl), mock_providers.NewMockEncrypterProvider(ctrl), mock_providers.NewMockPasswordScreenerProvider(ctrl), mock_providers.NewMockMailerProvider(ctrl), createUserPasswordPolicy(), &definitions.TenancyOptions{}, &definitions.AntiEnumerationOptions{}, auditLog, tracer)
+	repo.EXPECT().
+		FindByUsername(gomock.Any(), "", "username", false).
+		Return(&entities.UserEntity{Username: "username"}, nil)
+	repo.EXPECT().
+		FindByEmail(gomock.Any(), "", "user@email.com").
+		Return(nil, nil)
+	// act
+	_, err := useCase.Execute(context.Background(), &definitions.CreateUserDTO{
+		Username: "username",
+		Email:    "user@email.com",
+		Password: "p4ssword",
+	})
+	// assert
+	assert.Equal(t, err, exceptions.NewUserUsernameAlreadyInUse())
+	assert.Equal(t, spanNames()[0], "CreateUserUseCase.Execute")
+	assert.ElementsMatch(t, spanNames()[1:], []string{
+		"CreateUserUseCase.findUserByUsername",
+		"CreateUserUseCase.findUserByEmail",
+	})
+}
+
 func TestCreateUserUseCase_SanitizeValues(t *testing.T) {
 	// arrange
 	useCase, repo, encrypter, ctrl := (&CreateUserUseCaseTest{}).setup(t)
@@ -82,13 +155,13 @@ func TestCreateUserUseCase_SanitizeValues(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 	repo.EXPECT().
-		FindByUsername(strings.TrimSpace(username), false).
+		FindByUsername(gomock.Any(), "", strings.TrimSpace(username), false).
 		Return(nil, nil)
 	repo.EXPECT().
-		FindByEmail(strings.TrimSpace(email)).
+		FindByEmail(gomock.Any(), "", strings.TrimSpace(email)).
 		Return(nil, nil)
 	encrypter.EXPECT().
-		Hash(strings.TrimSpace(password)).
+		Hash(gomock.Any(), strings.TrimSpace(password)).
 		Return(fakeBcryptHash, nil)
 	repo.EXPECT().
 		Create(&dtos.UserDTO{
@@ -98,10 +171,10 @@ func TestCreateUserUseCase_SanitizeValues(t *testing.T) {
 		}).
 		Return(repoUser, nil)
 	repo.EXPECT().
-		Save(repoUser).
+		Save(gomock.Any(), repoUser).
 		Return(nil)
 	// act
-	user, err := useCase.Execute(&definitions.CreateUserDTO{
+	user, err := useCase.Execute(context.Background(), &definitions.CreateUserDTO{
 		Username: username,
 		Email:    email,
 		Password: password,
@@ -120,13 +193,13 @@ func TestCreateUserUseCase_FoundByUsername(t *testing.T) {
 	defer ctrl.Finish()
 	username, email, password := "username", "user@email.com", "p4ssword"
 	repo.EXPECT().
-		FindByUsername(username, false).
+		FindByUsername(gomock.Any(), "", username, false).
 		Return(&entities.UserEntity{}, nil)
 	repo.EXPECT().
-		FindByEmail(email).
+		FindByEmail(gomock.Any(), "", email).
 		Return(nil, nil)
 	// act
-	user, err := useCase.Execute(&definitions.CreateUserDTO{
+	user, err := useCase.Execute(context.Background(), &definitions.CreateUserDTO{
 		Username: username,
 		Email:    email,
 		Password: password,
@@ -142,13 +215,13 @@ func TestCreateUserUseCase_FindByUsernameReturnError(t *testing.T) {
 	defer ctrl.Finish()
 	username, email, password := "username", "user@email.com", "p4ssword"
 	repo.EXPECT().
-		FindByUsername(username, false).
+		FindByUsername(gomock.Any(), "", username, false).
 		Return(nil, &shared.Error{})
 	repo.EXPECT().
-		FindByEmail(email).
+		FindByEmail(gomock.Any(), "", email).
 		Return(&entities.UserEntity{}, nil)
 	// act
-	user, err := useCase.Execute(&definitions.CreateUserDTO{
+	user, err := useCase.Execute(context.Background(), &definitions.CreateUserDTO{
 		Username: username,
 		Email:    email,
 		Password: password,
@@ -164,13 +237,13 @@ func TestCreateUserUseCase_FoundByEmail(t *testing.T) {
 	defer ctrl.Finish()
 	username, email, password := "username", "user@email.com", "p4ssword"
 	repo.EXPECT().
-		FindByUsername(username, false).
+		FindByUsername(gomock.Any(), "", username, false).
 		Return(nil, nil)
 	repo.EXPECT().
-		FindByEmail(email).
+		FindByEmail(gomock.Any(), "", email).
 		Return(&entities.UserEntity{}, nil)
 	// act
-	user, err := useCase.Execute(&definitions.CreateUserDTO{
+	user, err := useCase.Execute(context.Background(), &definitions.CreateUserDTO{
 		Username: username,
 		Email:    email,
 		Password: password,
@@ -186,13 +259,13 @@ func TestCreateUserUseCase_FindByEmailReturnError(t *testing.T) {
 	defer ctrl.Finish()
 	username, email, password := "username", "user@email.com", "p4ssword"
 	repo.EXPECT().
-		FindByUsername(username, false).
+		FindByUsername(gomock.Any(), "", username, false).
 		Return(nil, nil)
 	repo.EXPECT().
-		FindByEmail(email).
+		FindByEmail(gomock.Any(), "", email).
 		Return(nil, &shared.Error{})
 	// act
-	user, err := useCase.Execute(&definitions.CreateUserDTO{
+	user, err := useCase.Execute(context.Background(), &definitions.CreateUserDTO{
 		Username: username,
 		Email:    email,
 		Password: password,
@@ -208,16 +281,16 @@ func TestCreateUserUseCase_EncrypterHashReturnError(t *testing.T) {
 	defer ctrl.Finish()
 	username, email, password := "username", "user@email.com", "p4ssword"
 	repo.EXPECT().
-		FindByUsername(username, false).
+		FindByUsername(gomock.Any(), "", username, false).
 		Return(nil, nil)
 	repo.EXPECT().
-		FindByEmail(email).
+		FindByEmail(gomock.Any(), "", email).
 		Return(nil, nil)
 	encrypter.EXPECT().
-		Hash(password).
+		Hash(gomock.Any(), password).
 		Return("", &shared.Error{})
 	// act
-	user, err := useCase.Execute(&definitions.CreateUserDTO{
+	user, err := useCase.Execute(context.Background(), &definitions.CreateUserDTO{
 		Username: username,
 		Email:    email,
 		Password: password,
@@ -234,19 +307,19 @@ func TestCreateUserUseCase_CreateReturnError(t *testing.T) {
 	username, email, password := "username", "user@email.com", "p4ssword"
 	fakeBcryptHash := "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW"
 	repo.EXPECT().
-		FindByUsername(username, false).
+		FindByUsername(gomock.Any(), "", username, false).
 		Return(nil, nil)
 	repo.EXPECT().
-		FindByEmail(email).
+		FindByEmail(gomock.Any(), "", email).
 		Return(nil, nil)
 	encrypter.EXPECT().
-		Hash(password).
+		Hash(gomock.Any(), password).
 		Return(fakeBcryptHash, nil)
 	repo.EXPECT().
 		Create(&dtos.UserDTO{Username: username, Email: email, Password: fakeBcryptHash}).
 		Return(nil, &shared.Error{})
 	// act
-	user, err := useCase.Execute(&definitions.CreateUserDTO{
+	user, err := useCase.Execute(context.Background(), &definitions.CreateUserDTO{
 		Username: username,
 		Email:    email,
 		Password: password,
@@ -271,26 +344,26 @@ func TestCreateUserUseCase_PasswordValidationReturnError(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 	repo.EXPECT().
-		FindByUsername(username, false).
+		FindByUsername(gomock.Any(), "", username, false).
 		Return(nil, nil)
 	repo.EXPECT().
-		FindByEmail(email).
+		FindByEmail(gomock.Any(), "", email).
 		Return(nil, nil)
 	encrypter.EXPECT().
-		Hash(invalidPassword).
+		Hash(gomock.Any(), invalidPassword).
 		Return(fakeBcryptHash, nil)
 	repo.EXPECT().
 		Create(&dtos.UserDTO{Username: username, Email: email, Password: fakeBcryptHash}).
 		Return(repoUser, nil)
 	// act
-	user, err := useCase.Execute(&definitions.CreateUserDTO{
+	user, err := useCase.Execute(context.Background(), &definitions.CreateUserDTO{
 		Username: username,
 		Email:    email,
 		Password: invalidPassword,
 	})
 	// assert
 	assert.Nil(t, user)
-	assert.Equal(t, err, exceptions.NewInvalidUserPassword())
+	assert.Equal(t, err, exceptions.NewInvalidUserPassword("Must have at least one digit character."))
 }
 
 func TestCreateUserUseCase_SaveReturnError(t *testing.T) {
@@ -308,22 +381,116 @@ func TestCreateUserUseCase_SaveReturnError(t *testing.T) {
 		UpdatedAt: time.Now(),
 	}
 	repo.EXPECT().
-		FindByUsername(username, false).
+		FindByUsername(gomock.Any(), "", username, false).
 		Return(nil, nil)
 	repo.EXPECT().
-		FindByEmail(email).
+		FindByEmail(gomock.Any(), "", email).
 		Return(nil, nil)
 	encrypter.EXPECT().
-		Hash(password).
+		Hash(gomock.Any(), password).
 		Return(fakeBcryptHash, nil)
 	repo.EXPECT().
 		Create(&dtos.UserDTO{Username: username, Email: email, Password: fakeBcryptHash}).
 		Return(repoUser, nil)
 	repo.EXPECT().
-		Save(repoUser).
+		Save(gomock.Any(), repoUser).
 		Return(&shared.Error{})
 	// act
-	user, err := useCase.Execute(&definitions.CreateUserDTO{
+	user, err := useCase.Execute(context.Background(), &definitions.CreateUserDTO{
+		Username: username,
+		Email:    email,
+		Password: password,
+	})
+	// assert
+	assert.Nil(t, user)
+	assert.Equal(t, err, &shared.Error{})
+}
+
+func (*CreateUserUseCaseTest) setupScreener(t *testing.T) (*usecases.CreateUserUseCase, *mock_repositories.MockUsersRepository, *mock_providers.MockEncrypterProvider, *mock_providers.MockPasswordScreenerProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	repo := mock_repositories.NewMockUsersRepository(ctrl)
+	organizations := mock_repositories.NewMockOrganizationsRepository(ctrl)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
+	encrypter := mock_providers.NewMockEncrypterProvider(ctrl)
+	screener := mock_providers.NewMockPasswordScreenerProvider(ctrl)
+	tenancy := &definitions.TenancyOptions{}
+	auditLog, _ := setupAuditLog(ctrl)
+	tracer, _ := setupTracer(ctrl)
+	createUserUseCase, _ := usecases.NewCreateUserUseCase(repo, organizations, memberships, encrypter, screener, mock_providers.NewMockMailerProvider(ctrl), createUserPasswordPolicy(), tenancy, &definitions.AntiEnumerationOptions{}, auditLog, tracer)
+	return createUserUseCase, repo, encrypter, screener, ctrl
+}
+
+func TestCreateUserUseCase_PasswordBreached(t *testing.T) {
+	// arrange
+	useCase, repo, encrypter, screener, ctrl := (&CreateUserUseCaseTest{}).setupScreener(t)
+	defer ctrl.Finish()
+	username, email, password := "username", "user@email.com", "p4ssword"
+	fakeBcryptHash := "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW"
+	repoUser := &entities.UserEntity{
+		Id:        "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Username:  username,
+		Email:     email,
+		Password:  fakeBcryptHash,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	repo.EXPECT().
+		FindByUsername(gomock.Any(), "", username, false).
+		Return(nil, nil)
+	repo.EXPECT().
+		FindByEmail(gomock.Any(), "", email).
+		Return(nil, nil)
+	encrypter.EXPECT().
+		Hash(gomock.Any(), password).
+		Return(fakeBcryptHash, nil)
+	repo.EXPECT().
+		Create(&dtos.UserDTO{Username: username, Email: email, Password: fakeBcryptHash}).
+		Return(repoUser, nil)
+	screener.EXPECT().
+		IsBreached(gomock.Any(), password).
+		Return(true, nil)
+	// act
+	user, err := useCase.Execute(context.Background(), &definitions.CreateUserDTO{
+		Username: username,
+		Email:    email,
+		Password: password,
+	})
+	// assert
+	assert.Nil(t, user)
+	assert.Equal(t, err, exceptions.NewUserPasswordBreached())
+}
+
+func TestCreateUserUseCase_ScreenerReturnError(t *testing.T) {
+	// arrange
+	useCase, repo, encrypter, screener, ctrl := (&CreateUserUseCaseTest{}).setupScreener(t)
+	defer ctrl.Finish()
+	username, email, password := "username", "user@email.com", "p4ssword"
+	fakeBcryptHash := "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW"
+	repoUser := &entities.UserEntity{
+		Id:        "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Username:  username,
+		Email:     email,
+		Password:  fakeBcryptHash,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	repo.EXPECT().
+		FindByUsername(gomock.Any(), "", username, false).
+		Return(nil, nil)
+	repo.EXPECT().
+		FindByEmail(gomock.Any(), "", email).
+		Return(nil, nil)
+	encrypter.EXPECT().
+		Hash(gomock.Any(), password).
+		Return(fakeBcryptHash, nil)
+	repo.EXPECT().
+		Create(&dtos.UserDTO{Username: username, Email: email, Password: fakeBcryptHash}).
+		Return(repoUser, nil)
+	screener.EXPECT().
+		IsBreached(gomock.Any(), password).
+		Return(false, &shared.Error{})
+	// act
+	user, err := useCase.Execute(context.Background(), &definitions.CreateUserDTO{
 		Username: username,
 		Email:    email,
 		Password: password,
@@ -332,3 +499,258 @@ func TestCreateUserUseCase_SaveReturnError(t *testing.T) {
 	assert.Nil(t, user)
 	assert.Equal(t, err, &shared.Error{})
 }
+
+func (*CreateUserUseCaseTest) setupTenancy(t *testing.T, tenancy *definitions.TenancyOptions) (*usecases.CreateUserUseCase, *mock_repositories.MockUsersRepository, *mock_repositories.MockOrganizationsRepository, *mock_repositories.MockMembershipsRepository, *mock_providers.MockEncrypterProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	repo := mock_repositories.NewMockUsersRepository(ctrl)
+	organizations := mock_repositories.NewMockOrganizationsRepository(ctrl)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
+	encrypter := mock_providers.NewMockEncrypterProvider(ctrl)
+	screener := mock_providers.NewMockPasswordScreenerProvider(ctrl)
+	screener.EXPECT().IsBreached(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	auditLog, _ := setupAuditLog(ctrl)
+	tracer, _ := setupTracer(ctrl)
+	createUserUseCase, _ := usecases.NewCreateUserUseCase(repo, organizations, memberships, encrypter, screener, mock_providers.NewMockMailerProvider(ctrl), createUserPasswordPolicy(), tenancy, &definitions.AntiEnumerationOptions{}, auditLog, tracer)
+	return createUserUseCase, repo, organizations, memberships, encrypter, ctrl
+}
+
+func TestCreateUserUseCase_SuccessCaseInScopedOrganization(t *testing.T) {
+	// arrange
+	useCase, repo, organizations, memberships, encrypter, ctrl := (&CreateUserUseCaseTest{}).
+		setupTenancy(t, &definitions.TenancyOptions{ScopedUsernames: true})
+	defer ctrl.Finish()
+	username, email, password := "username", "user@email.com", "p4ssword"
+	organizationId := "cc58997a-2403-af1e-7836-f0b338edcd60"
+	fakeBcryptHash := "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW"
+	repoUser := &entities.UserEntity{
+		Id:             "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		OrganizationId: organizationId,
+		Username:       username,
+		Email:          email,
+		Password:       fakeBcryptHash,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	membership := &entities.MembershipEntity{
+		Id:             "4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11",
+		OrganizationId: organizationId,
+		UserId:         repoUser.Id,
+		Role:           entities.MembershipRoleMember,
+	}
+	organizations.EXPECT().
+		FindById(gomock.Any(), organizationId).
+		Return(&entities.OrganizationEntity{Id: organizationId, Name: "Organization"}, nil)
+	repo.EXPECT().
+		FindByUsername(gomock.Any(), organizationId, username, false).
+		Return(nil, nil)
+	repo.EXPECT().
+		FindByEmail(gomock.Any(), "", email).
+		Return(nil, nil)
+	encrypter.EXPECT().
+		Hash(gomock.Any(), password).
+		Return(fakeBcryptHash, nil)
+	repo.EXPECT().
+		Create(&dtos.UserDTO{
+			OrganizationId: organizationId,
+			Username:       username,
+			Email:          email,
+			Password:       fakeBcryptHash,
+		}).
+		Return(repoUser, nil)
+	repo.EXPECT().
+		Save(gomock.Any(), repoUser).
+		Return(nil)
+	memberships.EXPECT().
+		Create(&dtos.MembershipDTO{
+			OrganizationId: organizationId,
+			UserId:         repoUser.Id,
+			Role:           entities.MembershipRoleMember,
+		}).
+		Return(membership, nil)
+	memberships.EXPECT().
+		Save(gomock.Any(), membership).
+		Return(nil)
+	// act
+	user, err := useCase.Execute(context.Background(), &definitions.CreateUserDTO{
+		Username:       username,
+		Email:          email,
+		Password:       password,
+		OrganizationId: organizationId,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Nil(t, user.IsValid())
+	assert.Equal(t, user.OrganizationId, organizationId)
+}
+
+func TestCreateUserUseCase_OrganizationNotFound(t *testing.T) {
+	// arrange
+	useCase, _, organizations, _, _, ctrl := (&CreateUserUseCaseTest{}).
+		setupTenancy(t, &definitions.TenancyOptions{})
+	defer ctrl.Finish()
+	organizationId := "cc58997a-2403-af1e-7836-f0b338edcd60"
+	organizations.EXPECT().
+		FindById(gomock.Any(), organizationId).
+		Return(nil, nil)
+	// act
+	user, err := useCase.Execute(context.Background(), &definitions.CreateUserDTO{
+		Username:       "username",
+		Email:          "user@email.com",
+		Password:       "p4ssword",
+		OrganizationId: organizationId,
+	})
+	// assert
+	assert.Nil(t, user)
+	assert.Equal(t, err, exceptions.NewOrganizationNotFound())
+}
+
+func (*CreateUserUseCaseTest) setupEnumeration(ctrl *gomock.Controller, encrypter providers.EncrypterProvider) (*usecases.CreateUserUseCase, *mock_repositories.MockUsersRepository, *mock_providers.MockMailerProvider, *[]*dtos.AuditEventDTO) {
+	repo := mock_repositories.NewMockUsersRepository(ctrl)
+	organizations := mock_repositories.NewMockOrganizationsRepository(ctrl)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
+	screener := mock_providers.NewMockPasswordScreenerProvider(ctrl)
+	screener.EXPECT().IsBreached(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	mailer := mock_providers.NewMockMailerProvider(ctrl)
+	auditLog, auditEvents := setupAuditLog(ctrl)
+	tracer, _ := setupTracer(ctrl)
+	createUserUseCase, _ := usecases.NewCreateUserUseCase(repo, organizations, memberships, encrypter, screener, mailer, createUserPasswordPolicy(), &definitions.TenancyOptions{}, &definitions.AntiEnumerationOptions{Enabled: true}, auditLog, tracer)
+	return createUserUseCase, repo, mailer, auditEvents
+}
+
+func TestCreateUserUseCase_EmailTakenWithAntiEnumeration(t *testing.T) {
+	// arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	encrypter := mock_providers.NewMockEncrypterProvider(ctrl)
+	useCase, repo, mailer, auditEvents := (&CreateUserUseCaseTest{}).setupEnumeration(ctrl, encrypter)
+	username, email, password := "newcomer", "user@email.com", "p4ssword"
+	fakeBcryptHash := "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW"
+	owner := &entities.UserEntity{
+		Id:       "4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11",
+		Username: "username",
+		Email:    email,
+	}
+	repoUser := &entities.UserEntity{
+		Id:        "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Username:  username,
+		Email:     email,
+		Password:  fakeBcryptHash,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	repo.EXPECT().
+		FindByUsername(gomock.Any(), "", username, false).
+		Return(nil, nil)
+	repo.EXPECT().
+		FindByEmail(gomock.Any(), "", email).
+		Return(owner, nil)
+	encrypter.EXPECT().
+		Hash(gomock.Any(), password).
+		Return(fakeBcryptHash, nil)
+	repo.EXPECT().
+		Create(&dtos.UserDTO{Username: username, Email: email, Password: fakeBcryptHash}).
+		Return(repoUser, nil)
+	repo.EXPECT().
+		FindById(gomock.Any(), repoUser.Id).
+		Return(nil, nil)
+	mailer.EXPECT().
+		Send(email, gomock.Any(), gomock.Any()).
+		Return(nil)
+	// act
+	user, err := useCase.Execute(context.Background(), &definitions.CreateUserDTO{
+		Username: username,
+		Email:    email,
+		Password: password,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, user, repoUser)
+	assert.Len(t, *auditEvents, 1)
+	assert.Equal(t, (*auditEvents)[0].Type, entities.AuditEventSignupEmailTaken)
+	assert.Equal(t, (*auditEvents)[0].TargetId, owner.Id)
+}
+
+func TestCreateUserUseCase_UsernameTakenWithAntiEnumeration(t *testing.T) {
+	// arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	encrypter := mock_providers.NewMockEncrypterProvider(ctrl)
+	useCase, repo, _, _ := (&CreateUserUseCaseTest{}).setupEnumeration(ctrl, encrypter)
+	repo.EXPECT().
+		FindByUsername(gomock.Any(), "", "username", false).
+		Return(&entities.UserEntity{Username: "username"}, nil)
+	repo.EXPECT().
+		FindByEmail(gomock.Any(), "", "other@email.com").
+		Return(nil, nil)
+	// act
+	user, err := useCase.Execute(context.Background(), &definitions.CreateUserDTO{
+		Username: "username",
+		Email:    "other@email.com",
+		Password: "p4ssword",
+	})
+	// assert
+	assert.Nil(t, user)
+	assert.Equal(t, err, exceptions.NewUserUsernameAlreadyInUse())
+}
+
+// Timing itself is too noisy to assert on, the calls that cost the time are
+// counted instead: one hash and one database round trip where the free email
+// saves the user.
+func TestCreateUserUseCase_EqualWorkForTakenEmails(t *testing.T) {
+	// arrange
+	owner := &entities.UserEntity{
+		Id:       "4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11",
+		Username: "username",
+		Email:    "taken@email.com",
+	}
+	count := func(email string) map[string]int {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+		calls := map[string]int{}
+		encrypter := mock_providers.NewMockEncrypterProvider(ctrl)
+		encrypter.EXPECT().
+			Hash(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(context.Context, string) (string, *shared.Error) {
+				calls["Hash"]++
+				return "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW", nil
+			}).
+			AnyTimes()
+		useCase, repo, mailer, _ := (&CreateUserUseCaseTest{}).setupEnumeration(ctrl, encrypter)
+		repo.EXPECT().FindByUsername(gomock.Any(), "", gomock.Any(), false).Return(nil, nil).AnyTimes()
+		repo.EXPECT().FindByEmail(gomock.Any(), "", owner.Email).Return(owner, nil).AnyTimes()
+		repo.EXPECT().FindByEmail(gomock.Any(), "", "free@email.com").Return(nil, nil).AnyTimes()
+		repo.EXPECT().
+			Create(gomock.Any()).
+			DoAndReturn(func(data *dtos.UserDTO) (*entities.UserEntity, *shared.Error) {
+				return &entities.UserEntity{
+					Id:       "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+					Username: data.Username,
+					Email:    data.Email,
+					Password: data.Password,
+				}, nil
+			}).
+			AnyTimes()
+		repo.EXPECT().
+			Save(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(context.Context, *entities.UserEntity) *shared.Error {
+				calls["RoundTrip"]++
+				return nil
+			}).
+			AnyTimes()
+		repo.EXPECT().
+			FindById(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(context.Context, string) (*entities.UserEntity, *shared.Error) {
+				calls["RoundTrip"]++
+				return nil, nil
+			}).
+			AnyTimes()
+		mailer.EXPECT().Send(owner.Email, gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		useCase.Execute(context.Background(), &definitions.CreateUserDTO{Username: "newcomer", Email: email, Password: "p4ssword"})
+		return calls
+	}
+	// act
+	taken, free := count(owner.Email), count("free@email.com")
+	// assert
+	assert.Equal(t, free, map[string]int{"Hash": 1, "RoundTrip": 1})
+	assert.Equal(t, taken, free)
+}
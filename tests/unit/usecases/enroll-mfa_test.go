@@ -0,0 +1,89 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type EnrollMfaUseCaseTest struct{}
+
+func (*EnrollMfaUseCaseTest) setup(t *testing.T) (*usecases.EnrollMfaUseCase, *mock_repositories.MockUsersRepository, *mock_providers.MockCipherProvider, *mock_providers.MockOtpProvider, *mock_providers.MockDigestProvider, *mock_providers.MockRandomProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	users := mock_repositories.NewMockUsersRepository(ctrl)
+	cipher := mock_providers.NewMockCipherProvider(ctrl)
+	otp := mock_providers.NewMockOtpProvider(ctrl)
+	digest := mock_providers.NewMockDigestProvider(ctrl)
+	random := mock_providers.NewMockRandomProvider(ctrl)
+	options := &definitions.MfaOptions{Issuer: "Oganessone"}
+	enrollMfaUseCase, _ := usecases.NewEnrollMfaUseCase(users, cipher, otp, digest, random, options)
+	return enrollMfaUseCase, users, cipher, otp, digest, random, ctrl
+}
+
+func TestEnrollMfaUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, users, cipher, otp, digest, random, ctrl := (&EnrollMfaUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := &entities.UserEntity{
+		Id:       "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Username: "username",
+	}
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	otp.EXPECT().Secret().Return(secret, nil)
+	cipher.EXPECT().Encrypt(secret).Return("encrypted_secret", nil)
+	random.EXPECT().String(10).Times(10).Return("abcdeFGHIJ", nil)
+	digest.EXPECT().Digest("abcdeFGHIJ").Times(10).Return("sha256:recovery_code")
+	users.EXPECT().Save(gomock.Any(), user).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.EnrollMfaDTO{ActorId: user.Id})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.Secret, secret)
+	assert.Equal(
+		t,
+		result.Uri,
+		"otpauth://totp/Oganessone:username?algorithm=SHA1&digits=6&issuer=Oganessone&period=30&secret="+secret,
+	)
+	assert.Len(t, result.RecoveryCodes, 10)
+	assert.Equal(t, result.RecoveryCodes[0], "abcde-FGHIJ")
+	assert.Equal(t, user.MfaSecret, "encrypted_secret")
+	assert.Len(t, user.MfaRecoveryCodes, 10)
+	assert.False(t, user.MfaEnabled)
+}
+
+func TestEnrollMfaUseCase_AlreadyEnabled(t *testing.T) {
+	// arrange
+	useCase, users, _, _, _, _, ctrl := (&EnrollMfaUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := &entities.UserEntity{
+		Id:         "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		MfaSecret:  "encrypted_secret",
+		MfaEnabled: true,
+	}
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.EnrollMfaDTO{ActorId: user.Id})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewMfaAlreadyEnabled())
+}
+
+func TestEnrollMfaUseCase_WithoutUser(t *testing.T) {
+	// arrange
+	useCase, _, _, _, _, _, ctrl := (&EnrollMfaUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.EnrollMfaDTO{})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewUserNotFound())
+}
@@ -0,0 +1,282 @@
+package test_usecases
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_definitions "github.com/AndreyArthur/oganessone/src/application/definitions/mocks"
+	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type ExchangeAuthorizationCodeUseCaseTest struct{}
+
+func (*ExchangeAuthorizationCodeUseCaseTest) setup(t *testing.T) (*usecases.ExchangeAuthorizationCodeUseCase, *mock_definitions.MockAuthenticateOAuthClient, *mock_repositories.MockAuthorizationCodesRepository, *mock_repositories.MockUsersRepository, *mock_providers.MockCacheProvider, *mock_providers.MockTokenSignerProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	authenticateClient := mock_definitions.NewMockAuthenticateOAuthClient(ctrl)
+	authorizationCodes := mock_repositories.NewMockAuthorizationCodesRepository(ctrl)
+	users := mock_repositories.NewMockUsersRepository(ctrl)
+	cache := mock_providers.NewMockCacheProvider(ctrl)
+	signer := mock_providers.NewMockTokenSignerProvider(ctrl)
+	tracer, _ := setupTracer(ctrl)
+	exchangeAuthorizationCodeUseCase, _ := usecases.NewExchangeAuthorizationCodeUseCase(
+		authenticateClient, authorizationCodes, users, cache, signer, &definitions.OpenIdOptions{
+			Issuer:          "https://auth.example.com",
+			IdTokenLifetime: time.Hour,
+		},
+		tracer,
+	)
+	return exchangeAuthorizationCodeUseCase, authenticateClient, authorizationCodes, users, cache, signer, ctrl
+}
+
+func (*ExchangeAuthorizationCodeUseCaseTest) authorizationCode() *entities.AuthorizationCodeEntity {
+	return &entities.AuthorizationCodeEntity{
+		Code:                "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q",
+		ClientId:            "cc58997a-2403-af1e-7836-f0b338edcd60",
+		UserId:              "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		SessionKey:          "session_key_example",
+		SessionExpiresAt:    time.Now().UTC().Add(time.Hour),
+		RedirectUri:         "https://app.example.com/callback",
+		Scopes:              []string{"users:read"},
+		CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+		CodeChallengeMethod: "S256",
+		ExpiresAt:           time.Now().UTC().Add(time.Minute),
+	}
+}
+
+// The session behind the code gets its full lifetime back.
+func (*ExchangeAuthorizationCodeUseCaseTest) expectSession(
+	cache *mock_providers.MockCacheProvider, authorizationCode *entities.AuthorizationCodeEntity,
+) {
+	expiresAt := authorizationCode.SessionExpiresAt.Truncate(time.Second)
+	sessionKey, userId := authorizationCode.SessionKey, authorizationCode.UserId
+	cache.EXPECT().Set(gomock.Any(), sessionKey, userId, expiresAt).Return(nil)
+	cache.EXPECT().
+		Set(gomock.Any(), strings.Join([]string{sessionKey, "@", userId}, ""), expiresAt.Format(time.RFC3339), expiresAt).
+		Return(nil)
+	cache.EXPECT().Set(gomock.Any(), strings.Join([]string{sessionKey, "#", userId}, ""), "", expiresAt).Return(nil)
+}
+
+func TestExchangeAuthorizationCodeUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, authenticateClient, authorizationCodes, _, cache, _, ctrl := (&ExchangeAuthorizationCodeUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	authorizationCode := (&ExchangeAuthorizationCodeUseCaseTest{}).authorizationCode()
+	authenticateClient.EXPECT().
+		Execute(gomock.Any(), &definitions.AuthenticateOAuthClientDTO{ClientId: authorizationCode.ClientId}).
+		Return(&entities.OAuthClientEntity{Id: authorizationCode.ClientId}, nil)
+	authorizationCodes.EXPECT().Consume(gomock.Any(), authorizationCode.Code).Return(authorizationCode, nil)
+	cache.EXPECT().Set(
+		gomock.Any(),
+		strings.Join([]string{authorizationCode.SessionKey, "$", authorizationCode.UserId}, ""),
+		"users:read",
+		authorizationCode.SessionExpiresAt,
+	).Return(nil)
+	cache.EXPECT().Set(
+		gomock.Any(),
+		strings.Join([]string{authorizationCode.SessionKey, "%", authorizationCode.UserId}, ""),
+		authorizationCode.ClientId,
+		authorizationCode.SessionExpiresAt,
+	).Return(nil)
+	(&ExchangeAuthorizationCodeUseCaseTest{}).expectSession(cache, authorizationCode)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.ExchangeAuthorizationCodeDTO{
+		Code:         authorizationCode.Code,
+		RedirectUri:  authorizationCode.RedirectUri,
+		Client:       &definitions.AuthenticateOAuthClientDTO{ClientId: authorizationCode.ClientId},
+		CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.AccessToken, authorizationCode.SessionKey)
+	assert.Equal(t, result.TokenType, "Bearer")
+	assert.Equal(t, result.Scopes, authorizationCode.Scopes)
+	assert.InDelta(t, result.ExpiresIn, int64(time.Hour.Seconds()), 5)
+	assert.Equal(t, result.IdToken, "")
+}
+
+func TestExchangeAuthorizationCodeUseCase_WithoutScopes(t *testing.T) {
+	// arrange
+	useCase, authenticateClient, authorizationCodes, _, cache, _, ctrl := (&ExchangeAuthorizationCodeUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	authorizationCode := (&ExchangeAuthorizationCodeUseCaseTest{}).authorizationCode()
+	authorizationCode.Scopes = []string{}
+	authenticateClient.EXPECT().
+		Execute(gomock.Any(), &definitions.AuthenticateOAuthClientDTO{ClientId: authorizationCode.ClientId}).
+		Return(&entities.OAuthClientEntity{Id: authorizationCode.ClientId}, nil)
+	authorizationCodes.EXPECT().Consume(gomock.Any(), authorizationCode.Code).Return(authorizationCode, nil)
+	cache.EXPECT().Set(
+		gomock.Any(),
+		strings.Join([]string{authorizationCode.SessionKey, "%", authorizationCode.UserId}, ""),
+		authorizationCode.ClientId,
+		authorizationCode.SessionExpiresAt,
+	).Return(nil)
+	cache.EXPECT().Set(
+		gomock.Any(),
+		strings.Join([]string{authorizationCode.SessionKey, "$", authorizationCode.UserId}, ""),
+		"",
+		authorizationCode.SessionExpiresAt,
+	).Return(nil)
+	(&ExchangeAuthorizationCodeUseCaseTest{}).expectSession(cache, authorizationCode)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.ExchangeAuthorizationCodeDTO{
+		Code:         authorizationCode.Code,
+		RedirectUri:  authorizationCode.RedirectUri,
+		Client:       &definitions.AuthenticateOAuthClientDTO{ClientId: authorizationCode.ClientId},
+		CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.AccessToken, authorizationCode.SessionKey)
+	assert.Empty(t, result.Scopes)
+}
+
+func TestExchangeAuthorizationCodeUseCase_IssuesIdToken(t *testing.T) {
+	// arrange
+	useCase, authenticateClient, authorizationCodes, users, cache, signer, ctrl := (&ExchangeAuthorizationCodeUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	authorizationCode := (&ExchangeAuthorizationCodeUseCaseTest{}).authorizationCode()
+	authorizationCode.Scopes = []string{"openid", "email"}
+	authorizationCode.Nonce = "n-0S6_WzA2Mj"
+	now := time.Now().UTC()
+	user := &entities.UserEntity{
+		Id:            authorizationCode.UserId,
+		Username:      "username",
+		Email:         "user@email.com",
+		EmailVerified: true,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	var claims map[string]interface{}
+	authenticateClient.EXPECT().
+		Execute(gomock.Any(), &definitions.AuthenticateOAuthClientDTO{ClientId: authorizationCode.ClientId}).
+		Return(&entities.OAuthClientEntity{Id: authorizationCode.ClientId}, nil)
+	authorizationCodes.EXPECT().Consume(gomock.Any(), authorizationCode.Code).Return(authorizationCode, nil)
+	users.EXPECT().FindById(gomock.Any(), authorizationCode.UserId).Return(user, nil)
+	signer.EXPECT().Sign(gomock.Any()).DoAndReturn(func(signed map[string]interface{}) (string, *shared.Error) {
+		claims = signed
+		return "header.payload.signature", nil
+	})
+	cache.EXPECT().Set(
+		gomock.Any(),
+		strings.Join([]string{authorizationCode.SessionKey, "$", authorizationCode.UserId}, ""),
+		"openid email",
+		authorizationCode.SessionExpiresAt,
+	).Return(nil)
+	cache.EXPECT().Set(
+		gomock.Any(),
+		strings.Join([]string{authorizationCode.SessionKey, "%", authorizationCode.UserId}, ""),
+		authorizationCode.ClientId,
+		authorizationCode.SessionExpiresAt,
+	).Return(nil)
+	(&ExchangeAuthorizationCodeUseCaseTest{}).expectSession(cache, authorizationCode)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.ExchangeAuthorizationCodeDTO{
+		Code:         authorizationCode.Code,
+		RedirectUri:  authorizationCode.RedirectUri,
+		Client:       &definitions.AuthenticateOAuthClientDTO{ClientId: authorizationCode.ClientId},
+		CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.IdToken, "header.payload.signature")
+	assert.Equal(t, claims["iss"], "https://auth.example.com")
+	assert.Equal(t, claims["sub"], authorizationCode.UserId)
+	assert.Equal(t, claims["aud"], authorizationCode.ClientId)
+	assert.Equal(t, claims["nonce"], authorizationCode.Nonce)
+	assert.Equal(t, claims["email"], "user@email.com")
+	assert.Equal(t, claims["email_verified"], true)
+	assert.Nil(t, claims["preferred_username"])
+	assert.Equal(t, claims["exp"].(int64)-claims["iat"].(int64), int64(time.Hour.Seconds()))
+}
+
+func TestExchangeAuthorizationCodeUseCase_CodeAlreadyUsed(t *testing.T) {
+	// arrange
+	useCase, authenticateClient, authorizationCodes, _, _, _, ctrl := (&ExchangeAuthorizationCodeUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	authorizationCode := (&ExchangeAuthorizationCodeUseCaseTest{}).authorizationCode()
+	authenticateClient.EXPECT().
+		Execute(gomock.Any(), &definitions.AuthenticateOAuthClientDTO{ClientId: authorizationCode.ClientId}).
+		Return(&entities.OAuthClientEntity{Id: authorizationCode.ClientId}, nil)
+	authorizationCodes.EXPECT().Consume(gomock.Any(), authorizationCode.Code).Return(nil, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.ExchangeAuthorizationCodeDTO{
+		Code:         authorizationCode.Code,
+		RedirectUri:  authorizationCode.RedirectUri,
+		Client:       &definitions.AuthenticateOAuthClientDTO{ClientId: authorizationCode.ClientId},
+		CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOAuthInvalidGrant())
+}
+
+func TestExchangeAuthorizationCodeUseCase_WrongVerifierDropsSession(t *testing.T) {
+	// arrange
+	useCase, authenticateClient, authorizationCodes, _, cache, _, ctrl := (&ExchangeAuthorizationCodeUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	authorizationCode := (&ExchangeAuthorizationCodeUseCaseTest{}).authorizationCode()
+	sessionKey, userId := authorizationCode.SessionKey, authorizationCode.UserId
+	authenticateClient.EXPECT().
+		Execute(gomock.Any(), &definitions.AuthenticateOAuthClientDTO{ClientId: authorizationCode.ClientId}).
+		Return(&entities.OAuthClientEntity{Id: authorizationCode.ClientId}, nil)
+	authorizationCodes.EXPECT().Consume(gomock.Any(), authorizationCode.Code).Return(authorizationCode, nil)
+	cache.EXPECT().Delete(gomock.Any(), sessionKey).Return(nil)
+	cache.EXPECT().Delete(gomock.Any(), strings.Join([]string{sessionKey, "@", userId}, "")).Return(nil)
+	cache.EXPECT().Delete(gomock.Any(), strings.Join([]string{sessionKey, "#", userId}, "")).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.ExchangeAuthorizationCodeDTO{
+		Code:         authorizationCode.Code,
+		RedirectUri:  authorizationCode.RedirectUri,
+		Client:       &definitions.AuthenticateOAuthClientDTO{ClientId: authorizationCode.ClientId},
+		CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXl",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOAuthInvalidGrant())
+}
+
+func TestExchangeAuthorizationCodeUseCase_MissingParameters(t *testing.T) {
+	// arrange
+	useCase, _, _, _, _, _, ctrl := (&ExchangeAuthorizationCodeUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.ExchangeAuthorizationCodeDTO{
+		Code:   "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q",
+		Client: &definitions.AuthenticateOAuthClientDTO{ClientId: "cc58997a-2403-af1e-7836-f0b338edcd60"},
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOAuthInvalidRequest())
+}
+
+func TestExchangeAuthorizationCodeUseCase_ClientAuthenticationFailed(t *testing.T) {
+	// arrange
+	useCase, authenticateClient, _, _, _, _, ctrl := (&ExchangeAuthorizationCodeUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	authorizationCode := (&ExchangeAuthorizationCodeUseCaseTest{}).authorizationCode()
+	client := &definitions.AuthenticateOAuthClientDTO{
+		ClientId:     authorizationCode.ClientId,
+		ClientSecret: "wrong_secret",
+	}
+	authenticateClient.EXPECT().Execute(gomock.Any(), client).Return(nil, exceptions.NewOAuthInvalidClient())
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.ExchangeAuthorizationCodeDTO{
+		Code:         authorizationCode.Code,
+		RedirectUri:  authorizationCode.RedirectUri,
+		Client:       client,
+		CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOAuthInvalidClient())
+}
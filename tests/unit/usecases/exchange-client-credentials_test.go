@@ -0,0 +1,171 @@
+package test_usecases
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_definitions "github.com/AndreyArthur/oganessone/src/application/definitions/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type ExchangeClientCredentialsUseCaseTest struct{}
+
+func (*ExchangeClientCredentialsUseCaseTest) setup(t *testing.T) (*usecases.ExchangeClientCredentialsUseCase, *mock_definitions.MockAuthenticateOAuthClient, *mock_providers.MockSessionProvider, *mock_providers.MockCacheProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	authenticateClient := mock_definitions.NewMockAuthenticateOAuthClient(ctrl)
+	session := mock_providers.NewMockSessionProvider(ctrl)
+	cache := mock_providers.NewMockCacheProvider(ctrl)
+	tracer, _ := setupTracer(ctrl)
+	exchangeClientCredentialsUseCase, _ := usecases.
+		NewExchangeClientCredentialsUseCase(authenticateClient, session, cache, tracer)
+	return exchangeClientCredentialsUseCase, authenticateClient, session, cache, ctrl
+}
+
+func (*ExchangeClientCredentialsUseCaseTest) client() (*definitions.AuthenticateOAuthClientDTO, *entities.OAuthClientEntity) {
+	client := &entities.OAuthClientEntity{
+		Id:             "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+		OrganizationId: "cc58997a-2403-af1e-7836-f0b338edcd60",
+		Name:           "Billing job",
+		AuthMethod:     entities.OAuthClientAuthMethodClientSecretBasic,
+		Scopes:         []string{"invoices:read", "invoices:write"},
+		Audiences:      []string{"https://billing.example.com", "https://ledger.example.com"},
+	}
+	return &definitions.AuthenticateOAuthClientDTO{
+		ClientId:     client.Id,
+		ClientSecret: "client_secret",
+	}, client
+}
+
+func TestExchangeClientCredentialsUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, authenticateClient, session, cache, ctrl := (&ExchangeClientCredentialsUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	credentials, client := (&ExchangeClientCredentialsUseCaseTest{}).client()
+	sessionKey := "session_key_example"
+	expirationDate := time.Now().UTC().Add(time.Hour).Format(time.RFC3339)
+	authenticateClient.EXPECT().Execute(gomock.Any(), credentials).Return(client, nil)
+	session.EXPECT().Generate(client.Id, client.OrganizationId).Return(&providers.SessionData{
+		Key:            sessionKey,
+		UserId:         client.Id,
+		OrganizationId: client.OrganizationId,
+		ExpirationDate: expirationDate,
+	}, nil)
+	cache.EXPECT().Set(gomock.Any(), sessionKey, client.Id, gomock.Any()).Return(nil)
+	cache.EXPECT().Set(gomock.Any(), strings.Join([]string{sessionKey, "@", client.Id}, ""), expirationDate, gomock.Any()).Return(nil)
+	cache.EXPECT().Set(gomock.Any(), strings.Join([]string{sessionKey, "#", client.Id}, ""), client.OrganizationId, gomock.Any()).Return(nil)
+	cache.EXPECT().Set(gomock.Any(), strings.Join([]string{sessionKey, "$", client.Id}, ""), "invoices:read", gomock.Any()).Return(nil)
+	cache.EXPECT().Set(gomock.Any(), strings.Join([]string{sessionKey, "%", client.Id}, ""), client.Id, gomock.Any()).Return(nil)
+	cache.EXPECT().Set(gomock.Any(), strings.Join([]string{sessionKey, "&", client.Id}, ""), "https://billing.example.com", gomock.Any()).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.ExchangeClientCredentialsDTO{
+		Client:    credentials,
+		Scope:     "invoices:read",
+		Audiences: []string{"https://billing.example.com"},
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.AccessToken, sessionKey)
+	assert.Equal(t, result.TokenType, "Bearer")
+	assert.Equal(t, result.Scopes, []string{"invoices:read"})
+	assert.Equal(t, result.Audiences, []string{"https://billing.example.com"})
+	assert.InDelta(t, result.ExpiresIn, int64(time.Hour.Seconds()), 5)
+}
+
+func TestExchangeClientCredentialsUseCase_DefaultsToRegisteredScopesAndAudiences(t *testing.T) {
+	// arrange
+	useCase, authenticateClient, session, cache, ctrl := (&ExchangeClientCredentialsUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	credentials, client := (&ExchangeClientCredentialsUseCaseTest{}).client()
+	authenticateClient.EXPECT().Execute(gomock.Any(), credentials).Return(client, nil)
+	session.EXPECT().Generate(client.Id, client.OrganizationId).Return(&providers.SessionData{
+		Key:            "session_key_example",
+		UserId:         client.Id,
+		OrganizationId: client.OrganizationId,
+		ExpirationDate: time.Now().UTC().Add(time.Hour).Format(time.RFC3339),
+	}, nil)
+	cache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(6)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.ExchangeClientCredentialsDTO{
+		Client: credentials,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.Scopes, client.Scopes)
+	assert.Equal(t, result.Audiences, client.Audiences)
+}
+
+func TestExchangeClientCredentialsUseCase_UnregisteredScopeOrAudience(t *testing.T) {
+	// arrange
+	useCase, authenticateClient, _, _, ctrl := (&ExchangeClientCredentialsUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	credentials, client := (&ExchangeClientCredentialsUseCaseTest{}).client()
+	authenticateClient.EXPECT().Execute(gomock.Any(), credentials).Return(client, nil).Times(2)
+	// act
+	scopeResult, scopeErr := useCase.Execute(context.Background(), &definitions.ExchangeClientCredentialsDTO{
+		Client: credentials,
+		Scope:  "invoices:read users:write",
+	})
+	audienceResult, audienceErr := useCase.Execute(context.Background(), &definitions.ExchangeClientCredentialsDTO{
+		Client:    credentials,
+		Audiences: []string{"https://evil.example.com"},
+	})
+	// assert
+	assert.Nil(t, scopeResult)
+	assert.Equal(t, scopeErr, exceptions.NewOAuthInvalidScope())
+	assert.Nil(t, audienceResult)
+	assert.Equal(t, audienceErr, exceptions.NewOAuthInvalidTarget())
+}
+
+func TestExchangeClientCredentialsUseCase_PublicClient(t *testing.T) {
+	// arrange
+	useCase, authenticateClient, _, _, ctrl := (&ExchangeClientCredentialsUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	credentials, client := (&ExchangeClientCredentialsUseCaseTest{}).client()
+	credentials.ClientSecret = ""
+	client.AuthMethod = entities.OAuthClientAuthMethodNone
+	authenticateClient.EXPECT().Execute(gomock.Any(), credentials).Return(client, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.ExchangeClientCredentialsDTO{
+		Client: credentials,
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOAuthUnauthorizedClient())
+}
+
+func TestExchangeClientCredentialsUseCase_RecordsErrorOnSpan(t *testing.T) {
+	// arrange
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	authenticateClient := mock_definitions.NewMockAuthenticateOAuthClient(ctrl)
+	tracer := mock_providers.NewMockTracerProvider(ctrl)
+	span := mock_providers.NewMockSpan(ctrl)
+	useCase, _ := usecases.NewExchangeClientCredentialsUseCase(
+		authenticateClient, mock_providers.NewMockSessionProvider(ctrl),
+		mock_providers.NewMockCacheProvider(ctrl), tracer,
+	)
+	credentials, _ := (&ExchangeClientCredentialsUseCaseTest{}).client()
+	tracer.EXPECT().
+		Start(gomock.Any(), "ExchangeClientCredentialsUseCase.Execute").
+		Return(context.Background(), span)
+	authenticateClient.EXPECT().
+		Execute(gomock.Any(), credentials).
+		Return(nil, exceptions.NewOAuthInvalidClient())
+	span.EXPECT().RecordError(exceptions.NewOAuthInvalidClient())
+	span.EXPECT().End()
+	// act
+	_, err := useCase.Execute(context.Background(), &definitions.ExchangeClientCredentialsDTO{
+		Client: credentials,
+	})
+	// assert
+	assert.Equal(t, err, exceptions.NewOAuthInvalidClient())
+}
@@ -0,0 +1,195 @@
+package test_usecases
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type FinishWebAuthnLoginUseCaseTest struct{}
+
+func (*FinishWebAuthnLoginUseCaseTest) setup(t *testing.T) (*usecases.FinishWebAuthnLoginUseCase, *mock_repositories.MockUsersRepository, *mock_repositories.MockWebAuthnCredentialsRepository, *mock_repositories.MockWebAuthnChallengesRepository, *mock_providers.MockWebAuthnProvider, *mock_providers.MockSessionProvider, *mock_providers.MockCacheProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	users := mock_repositories.NewMockUsersRepository(ctrl)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
+	credentials := mock_repositories.NewMockWebAuthnCredentialsRepository(ctrl)
+	challenges := mock_repositories.NewMockWebAuthnChallengesRepository(ctrl)
+	webAuthn := mock_providers.NewMockWebAuthnProvider(ctrl)
+	session := mock_providers.NewMockSessionProvider(ctrl)
+	cache := mock_providers.NewMockCacheProvider(ctrl)
+	auditLog, _ := setupAuditLog(ctrl)
+	finishWebAuthnLoginUseCase, _ := usecases.NewFinishWebAuthnLoginUseCase(
+		users, memberships, credentials, challenges, webAuthn, session, cache,
+		webAuthnTestOptions(), auditLog,
+	)
+	return finishWebAuthnLoginUseCase, users, credentials, challenges, webAuthn, session, cache, ctrl
+}
+
+func (*FinishWebAuthnLoginUseCaseTest) request(raw string) (*definitions.FinishWebAuthnLoginDTO, []byte) {
+	clientDataJson := webAuthnClientDataJson("webauthn.get", raw, "http://localhost:8080")
+	clientDataHash := sha256.Sum256(clientDataJson)
+	authenticatorData := []byte("authenticator data")
+	signed := append(append([]byte{}, authenticatorData...), clientDataHash[:]...)
+	return &definitions.FinishWebAuthnLoginDTO{
+		CredentialId:      "q83vEjRWeJA",
+		ClientDataJson:    base64.RawURLEncoding.EncodeToString(clientDataJson),
+		AuthenticatorData: base64.RawURLEncoding.EncodeToString(authenticatorData),
+		Signature:         base64.RawURLEncoding.EncodeToString([]byte("signature")),
+		UserHandle:        base64.RawURLEncoding.EncodeToString([]byte("9b157773-fbb4-d04c-9de6-d086cf37d7c7")),
+	}, signed
+}
+
+func TestFinishWebAuthnLoginUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, users, credentials, challenges, webAuthn, session, cache, ctrl := (&FinishWebAuthnLoginUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := &entities.UserEntity{
+		Id:         "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		MfaEnabled: true,
+	}
+	raw := "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q"
+	data, signed := (&FinishWebAuthnLoginUseCaseTest{}).request(raw)
+	credential := &entities.WebAuthnCredentialEntity{
+		UserId:       user.Id,
+		CredentialId: data.CredentialId,
+		PublicKey:    base64.RawURLEncoding.EncodeToString([]byte("public key")),
+		SignCount:    4,
+	}
+	authenticatorData := webAuthnAuthenticatorData(nil)
+	authenticatorData.SignCount = 5
+	sessionKey := "session_key_example"
+	expiresIn := time.Now().UTC().Add(time.Hour * 24).Format(time.RFC3339)
+	challenges.EXPECT().Consume(gomock.Any(), raw).Return(&entities.WebAuthnChallengeEntity{
+		Challenge: raw,
+		Ceremony:  entities.WebAuthnCeremonyAuthentication,
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}, nil)
+	credentials.EXPECT().FindByCredentialId(gomock.Any(), data.CredentialId).Return(credential, nil)
+	webAuthn.EXPECT().ParseAuthenticatorData([]byte("authenticator data")).Return(authenticatorData, nil)
+	webAuthn.EXPECT().VerifySignature([]byte("public key"), signed, []byte("signature")).Return(true, nil)
+	credentials.EXPECT().Save(gomock.Any(), credential).Return(nil)
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	session.EXPECT().Generate(user.Id, "").Return(&providers.SessionData{
+		Key:            sessionKey,
+		UserId:         user.Id,
+		ExpirationDate: expiresIn,
+	}, nil)
+	cache.EXPECT().Set(gomock.Any(), sessionKey, user.Id, gomock.Any()).Return(nil)
+	cache.EXPECT().Set(gomock.Any(), strings.Join([]string{sessionKey, "@", user.Id}, ""), expiresIn, gomock.Any()).Return(nil)
+	cache.EXPECT().Set(gomock.Any(), strings.Join([]string{sessionKey, "#", user.Id}, ""), "", gomock.Any()).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), data)
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.SessionKey, sessionKey)
+	assert.False(t, result.MfaRequired)
+	assert.Equal(t, credential.SignCount, int64(5))
+}
+
+func TestFinishWebAuthnLoginUseCase_WrongSignature(t *testing.T) {
+	// arrange
+	useCase, _, credentials, challenges, webAuthn, _, _, ctrl := (&FinishWebAuthnLoginUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	raw := "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q"
+	data, _ := (&FinishWebAuthnLoginUseCaseTest{}).request(raw)
+	challenges.EXPECT().Consume(gomock.Any(), raw).Return(&entities.WebAuthnChallengeEntity{
+		Challenge: raw,
+		Ceremony:  entities.WebAuthnCeremonyAuthentication,
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}, nil)
+	credentials.EXPECT().FindByCredentialId(gomock.Any(), data.CredentialId).Return(&entities.WebAuthnCredentialEntity{
+		UserId:       "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		CredentialId: data.CredentialId,
+		PublicKey:    base64.RawURLEncoding.EncodeToString([]byte("public key")),
+	}, nil)
+	webAuthn.EXPECT().ParseAuthenticatorData(gomock.Any()).Return(webAuthnAuthenticatorData(nil), nil)
+	webAuthn.EXPECT().VerifySignature(gomock.Any(), gomock.Any(), gomock.Any()).Return(false, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), data)
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewWebAuthnLoginFailed())
+}
+
+func TestFinishWebAuthnLoginUseCase_ClonedAuthenticator(t *testing.T) {
+	// arrange
+	useCase, _, credentials, challenges, webAuthn, _, _, ctrl := (&FinishWebAuthnLoginUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	raw := "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q"
+	data, _ := (&FinishWebAuthnLoginUseCaseTest{}).request(raw)
+	authenticatorData := webAuthnAuthenticatorData(nil)
+	authenticatorData.SignCount = 3
+	challenges.EXPECT().Consume(gomock.Any(), raw).Return(&entities.WebAuthnChallengeEntity{
+		Challenge: raw,
+		Ceremony:  entities.WebAuthnCeremonyAuthentication,
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}, nil)
+	credentials.EXPECT().FindByCredentialId(gomock.Any(), data.CredentialId).Return(&entities.WebAuthnCredentialEntity{
+		UserId:       "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		CredentialId: data.CredentialId,
+		PublicKey:    base64.RawURLEncoding.EncodeToString([]byte("public key")),
+		SignCount:    9,
+	}, nil)
+	webAuthn.EXPECT().ParseAuthenticatorData(gomock.Any()).Return(authenticatorData, nil)
+	webAuthn.EXPECT().VerifySignature(gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), data)
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewWebAuthnLoginFailed())
+}
+
+func TestFinishWebAuthnLoginUseCase_ChallengeForAnotherUser(t *testing.T) {
+	// arrange
+	useCase, _, credentials, challenges, _, _, _, ctrl := (&FinishWebAuthnLoginUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	raw := "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q"
+	data, _ := (&FinishWebAuthnLoginUseCaseTest{}).request(raw)
+	challenges.EXPECT().Consume(gomock.Any(), raw).Return(&entities.WebAuthnChallengeEntity{
+		Challenge: raw,
+		Ceremony:  entities.WebAuthnCeremonyAuthentication,
+		UserId:    "3f0a8f9e-6c3b-4c0e-9a7d-2b1e5f4c8d6a",
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}, nil)
+	credentials.EXPECT().FindByCredentialId(gomock.Any(), data.CredentialId).Return(&entities.WebAuthnCredentialEntity{
+		UserId:       "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		CredentialId: data.CredentialId,
+	}, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), data)
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewWebAuthnLoginFailed())
+}
+
+func TestFinishWebAuthnLoginUseCase_RegistrationChallenge(t *testing.T) {
+	// arrange
+	useCase, _, _, challenges, _, _, _, ctrl := (&FinishWebAuthnLoginUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	raw := "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q"
+	data, _ := (&FinishWebAuthnLoginUseCaseTest{}).request(raw)
+	challenges.EXPECT().Consume(gomock.Any(), raw).Return(&entities.WebAuthnChallengeEntity{
+		Challenge: raw,
+		Ceremony:  entities.WebAuthnCeremonyRegistration,
+		UserId:    "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), data)
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewInvalidWebAuthnChallenge())
+}
@@ -0,0 +1,193 @@
+package test_usecases
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func webAuthnClientDataJson(ceremonyType string, raw string, origin string) []byte {
+	clientData, _ := json.Marshal(map[string]interface{}{
+		"type":        ceremonyType,
+		"challenge":   base64.RawURLEncoding.EncodeToString([]byte(raw)),
+		"origin":      origin,
+		"crossOrigin": false,
+	})
+	return clientData
+}
+
+func webAuthnAuthenticatorData(credentialId []byte) *providers.WebAuthnAuthenticatorData {
+	rpIdHash := sha256.Sum256([]byte("localhost"))
+	return &providers.WebAuthnAuthenticatorData{
+		RpIdHash:     rpIdHash[:],
+		UserPresent:  true,
+		UserVerified: true,
+		CredentialId: credentialId,
+		PublicKey:    []byte{0xa5, 0x01, 0x02},
+	}
+}
+
+type FinishWebAuthnRegistrationUseCaseTest struct{}
+
+func (*FinishWebAuthnRegistrationUseCaseTest) setup(t *testing.T) (*usecases.FinishWebAuthnRegistrationUseCase, *mock_repositories.MockWebAuthnCredentialsRepository, *mock_repositories.MockWebAuthnChallengesRepository, *mock_providers.MockWebAuthnProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	credentials := mock_repositories.NewMockWebAuthnCredentialsRepository(ctrl)
+	challenges := mock_repositories.NewMockWebAuthnChallengesRepository(ctrl)
+	webAuthn := mock_providers.NewMockWebAuthnProvider(ctrl)
+	finishWebAuthnRegistrationUseCase, _ := usecases.NewFinishWebAuthnRegistrationUseCase(
+		credentials, challenges, webAuthn, webAuthnTestOptions(),
+	)
+	return finishWebAuthnRegistrationUseCase, credentials, challenges, webAuthn, ctrl
+}
+
+func TestFinishWebAuthnRegistrationUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, credentials, challenges, webAuthn, ctrl := (&FinishWebAuthnRegistrationUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	userId := "9b157773-fbb4-d04c-9de6-d086cf37d7c7"
+	raw := "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q"
+	clientDataJson := webAuthnClientDataJson("webauthn.create", raw, "http://localhost:8080")
+	clientDataHash := sha256.Sum256(clientDataJson)
+	attestationObject := []byte("attestation object")
+	challenges.EXPECT().Consume(gomock.Any(), raw).Return(&entities.WebAuthnChallengeEntity{
+		Challenge: raw,
+		Ceremony:  entities.WebAuthnCeremonyRegistration,
+		UserId:    userId,
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}, nil)
+	webAuthn.EXPECT().VerifyAttestation(attestationObject, clientDataHash[:]).
+		Return(webAuthnAuthenticatorData([]byte("credential")), nil)
+	credentialId := base64.RawURLEncoding.EncodeToString([]byte("credential"))
+	credentials.EXPECT().FindByCredentialId(gomock.Any(), credentialId).Return(nil, nil)
+	credentials.EXPECT().Create(gomock.Any()).
+		DoAndReturn(func(data *dtos.WebAuthnCredentialDTO) (*entities.WebAuthnCredentialEntity, *shared.Error) {
+			data.Id = "a0f2c4e6-1b3d-4f5a-8c7e-9d0b2a4c6e8f"
+			return entities.NewWebAuthnCredentialEntity(data)
+		})
+	credentials.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+	// act
+	credential, err := useCase.Execute(context.Background(), &definitions.FinishWebAuthnRegistrationDTO{
+		ActorId:           userId,
+		ClientDataJson:    base64.RawURLEncoding.EncodeToString(clientDataJson),
+		AttestationObject: base64.RawURLEncoding.EncodeToString(attestationObject),
+		Transports:        []string{"internal"},
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, credential.UserId, userId)
+	assert.Equal(t, credential.CredentialId, credentialId)
+	assert.Equal(t, credential.PublicKey, base64.RawURLEncoding.EncodeToString([]byte{0xa5, 0x01, 0x02}))
+	assert.Equal(t, credential.Transports, []string{"internal"})
+}
+
+func TestFinishWebAuthnRegistrationUseCase_WrongOrigin(t *testing.T) {
+	// arrange
+	useCase, _, challenges, _, ctrl := (&FinishWebAuthnRegistrationUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	userId := "9b157773-fbb4-d04c-9de6-d086cf37d7c7"
+	raw := "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q"
+	clientDataJson := webAuthnClientDataJson("webauthn.create", raw, "https://evil.example")
+	challenges.EXPECT().Consume(gomock.Any(), raw).Return(&entities.WebAuthnChallengeEntity{
+		Challenge: raw,
+		Ceremony:  entities.WebAuthnCeremonyRegistration,
+		UserId:    userId,
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}, nil)
+	// act
+	credential, err := useCase.Execute(context.Background(), &definitions.FinishWebAuthnRegistrationDTO{
+		ActorId:           userId,
+		ClientDataJson:    base64.RawURLEncoding.EncodeToString(clientDataJson),
+		AttestationObject: base64.RawURLEncoding.EncodeToString([]byte("attestation object")),
+	})
+	// assert
+	assert.Nil(t, credential)
+	assert.Equal(t, err, exceptions.NewInvalidWebAuthnResponse())
+}
+
+func TestFinishWebAuthnRegistrationUseCase_UnknownChallenge(t *testing.T) {
+	// arrange
+	useCase, _, challenges, _, ctrl := (&FinishWebAuthnRegistrationUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	raw := "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q"
+	clientDataJson := webAuthnClientDataJson("webauthn.create", raw, "http://localhost:8080")
+	challenges.EXPECT().Consume(gomock.Any(), raw).Return(nil, nil)
+	// act
+	credential, err := useCase.Execute(context.Background(), &definitions.FinishWebAuthnRegistrationDTO{
+		ActorId:           "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		ClientDataJson:    base64.RawURLEncoding.EncodeToString(clientDataJson),
+		AttestationObject: base64.RawURLEncoding.EncodeToString([]byte("attestation object")),
+	})
+	// assert
+	assert.Nil(t, credential)
+	assert.Equal(t, err, exceptions.NewInvalidWebAuthnChallenge())
+}
+
+func TestFinishWebAuthnRegistrationUseCase_AlreadyRegistered(t *testing.T) {
+	// arrange
+	useCase, credentials, challenges, webAuthn, ctrl := (&FinishWebAuthnRegistrationUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	userId := "9b157773-fbb4-d04c-9de6-d086cf37d7c7"
+	raw := "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q"
+	clientDataJson := webAuthnClientDataJson("webauthn.create", raw, "http://localhost:8080")
+	challenges.EXPECT().Consume(gomock.Any(), raw).Return(&entities.WebAuthnChallengeEntity{
+		Challenge: raw,
+		Ceremony:  entities.WebAuthnCeremonyRegistration,
+		UserId:    userId,
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}, nil)
+	webAuthn.EXPECT().VerifyAttestation(gomock.Any(), gomock.Any()).
+		Return(webAuthnAuthenticatorData([]byte("credential")), nil)
+	credentials.EXPECT().FindByCredentialId(gomock.Any(), gomock.Any()).
+		Return(&entities.WebAuthnCredentialEntity{UserId: userId}, nil)
+	// act
+	credential, err := useCase.Execute(context.Background(), &definitions.FinishWebAuthnRegistrationDTO{
+		ActorId:           userId,
+		ClientDataJson:    base64.RawURLEncoding.EncodeToString(clientDataJson),
+		AttestationObject: base64.RawURLEncoding.EncodeToString([]byte("attestation object")),
+	})
+	// assert
+	assert.Nil(t, credential)
+	assert.Equal(t, err, exceptions.NewWebAuthnCredentialAlreadyRegistered())
+}
+
+func TestFinishWebAuthnRegistrationUseCase_UserNotVerified(t *testing.T) {
+	// arrange
+	useCase, _, challenges, webAuthn, ctrl := (&FinishWebAuthnRegistrationUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	userId := "9b157773-fbb4-d04c-9de6-d086cf37d7c7"
+	raw := "Xw3nL0Qh6o1bC7m8pYtZ0aS9dF4gH2jK5lR7uV1eW3q"
+	clientDataJson := webAuthnClientDataJson("webauthn.create", raw, "http://localhost:8080")
+	challenges.EXPECT().Consume(gomock.Any(), raw).Return(&entities.WebAuthnChallengeEntity{
+		Challenge: raw,
+		Ceremony:  entities.WebAuthnCeremonyRegistration,
+		UserId:    userId,
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}, nil)
+	authenticatorData := webAuthnAuthenticatorData([]byte("credential"))
+	authenticatorData.UserVerified = false
+	webAuthn.EXPECT().VerifyAttestation(gomock.Any(), gomock.Any()).Return(authenticatorData, nil)
+	// act
+	credential, err := useCase.Execute(context.Background(), &definitions.FinishWebAuthnRegistrationDTO{
+		ActorId:           userId,
+		ClientDataJson:    base64.RawURLEncoding.EncodeToString(clientDataJson),
+		AttestationObject: base64.RawURLEncoding.EncodeToString([]byte("attestation object")),
+	})
+	// assert
+	assert.Nil(t, credential)
+	assert.Equal(t, err, exceptions.NewInvalidWebAuthnResponse())
+}
@@ -0,0 +1,58 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type GetMfaStatusUseCaseTest struct{}
+
+func (*GetMfaStatusUseCaseTest) setup(t *testing.T) (*usecases.GetMfaStatusUseCase, *mock_repositories.MockUsersRepository, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	users := mock_repositories.NewMockUsersRepository(ctrl)
+	getMfaStatusUseCase, _ := usecases.NewGetMfaStatusUseCase(users)
+	return getMfaStatusUseCase, users, ctrl
+}
+
+func TestGetMfaStatusUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, users, ctrl := (&GetMfaStatusUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := &entities.UserEntity{
+		Id:               "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		MfaEnabled:       true,
+		MfaRecoveryCodes: []string{"first_hash", "second_hash"},
+	}
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.GetMfaStatusDTO{ActorId: user.Id})
+	// assert
+	assert.Nil(t, err)
+	assert.True(t, result.Enabled)
+	assert.Equal(t, result.RecoveryCodesRemaining, 2)
+}
+
+func TestGetMfaStatusUseCase_PendingEnrollment(t *testing.T) {
+	// arrange
+	useCase, users, ctrl := (&GetMfaStatusUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := &entities.UserEntity{
+		Id:               "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		MfaSecret:        "encrypted_secret",
+		MfaRecoveryCodes: []string{"first_hash"},
+	}
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.GetMfaStatusDTO{ActorId: user.Id})
+	// assert
+	assert.Nil(t, err)
+	assert.False(t, result.Enabled)
+	assert.Equal(t, result.RecoveryCodesRemaining, 0)
+}
@@ -0,0 +1,54 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	usecases "github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/stretchr/testify/assert"
+)
+
+type GetPasswordPolicyUseCaseTest struct{}
+
+func (*GetPasswordPolicyUseCaseTest) setup(dictionaryWords []string) *usecases.GetPasswordPolicyUseCase {
+	policy, _ := entities.NewPasswordPolicyEntity(&dtos.PasswordPolicyDTO{
+		MinLength:             10,
+		MaxLength:             128,
+		RequiredClasses:       []string{entities.PasswordClassLetter, entities.PasswordClassDigit},
+		MaxRepeatedCharacters: 4,
+		DisallowUserInfo:      true,
+		DictionaryWords:       dictionaryWords,
+	})
+	getPasswordPolicyUseCase, _ := usecases.NewGetPasswordPolicyUseCase(policy)
+	return getPasswordPolicyUseCase
+}
+
+func TestGetPasswordPolicyUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase := (&GetPasswordPolicyUseCaseTest{}).setup([]string{"dragon"})
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.GetPasswordPolicyDTO{})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result, &definitions.GetPasswordPolicyResult{
+		MinLength:               10,
+		MaxLength:               128,
+		RequiredClasses:         []string{entities.PasswordClassLetter, entities.PasswordClassDigit},
+		MaxRepeatedCharacters:   4,
+		DisallowUserInfo:        true,
+		DisallowDictionaryWords: true,
+	})
+}
+
+func TestGetPasswordPolicyUseCase_WithoutDictionary(t *testing.T) {
+	// arrange
+	useCase := (&GetPasswordPolicyUseCaseTest{}).setup(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.GetPasswordPolicyDTO{})
+	// assert
+	assert.Nil(t, err)
+	assert.False(t, result.DisallowDictionaryWords)
+}
@@ -0,0 +1,103 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type GetUserInfoUseCaseTest struct{}
+
+func (*GetUserInfoUseCaseTest) setup(t *testing.T) (*usecases.GetUserInfoUseCase, *mock_repositories.MockUsersRepository, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	users := mock_repositories.NewMockUsersRepository(ctrl)
+	getUserInfoUseCase, _ := usecases.NewGetUserInfoUseCase(users)
+	return getUserInfoUseCase, users, ctrl
+}
+
+func (*GetUserInfoUseCaseTest) user() *entities.UserEntity {
+	now := time.Now().UTC()
+	return &entities.UserEntity{
+		Id:            "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Username:      "username",
+		Email:         "user@email.com",
+		EmailVerified: false,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+}
+
+func TestGetUserInfoUseCase_ProfileScope(t *testing.T) {
+	// arrange
+	useCase, users, ctrl := (&GetUserInfoUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := (&GetUserInfoUseCaseTest{}).user()
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.GetUserInfoDTO{
+		UserId: user.Id,
+		Scopes: []string{"openid", "profile"},
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result["sub"], user.Id)
+	assert.Equal(t, result["preferred_username"], user.Username)
+	assert.Equal(t, result["updated_at"], user.UpdatedAt.Unix())
+	assert.Nil(t, result["email"])
+}
+
+func TestGetUserInfoUseCase_EmailScope(t *testing.T) {
+	// arrange
+	useCase, users, ctrl := (&GetUserInfoUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := (&GetUserInfoUseCaseTest{}).user()
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.GetUserInfoDTO{
+		UserId: user.Id,
+		Scopes: []string{"openid", "email"},
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result["email"], user.Email)
+	assert.Equal(t, result["email_verified"], false)
+	assert.Nil(t, result["preferred_username"])
+}
+
+func TestGetUserInfoUseCase_MissingOpenIdScope(t *testing.T) {
+	// arrange
+	useCase, _, ctrl := (&GetUserInfoUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.GetUserInfoDTO{
+		UserId: "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Scopes: []string{"profile"},
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOAuthInsufficientScope())
+}
+
+func TestGetUserInfoUseCase_UserNotFound(t *testing.T) {
+	// arrange
+	useCase, users, ctrl := (&GetUserInfoUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	userId := "9b157773-fbb4-d04c-9de6-d086cf37d7c7"
+	users.EXPECT().FindById(gomock.Any(), userId).Return(nil, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.GetUserInfoDTO{
+		UserId: userId,
+		Scopes: []string{"openid"},
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewUserNotFound())
+}
@@ -0,0 +1,183 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_definitions "github.com/AndreyArthur/oganessone/src/application/definitions/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type IntrospectTokenUseCaseTest struct{}
+
+func (*IntrospectTokenUseCaseTest) setup(t *testing.T) (*usecases.IntrospectTokenUseCase, *mock_definitions.MockAuthenticateOAuthClient, *mock_definitions.MockAuthenticateSession, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	authenticateClient := mock_definitions.NewMockAuthenticateOAuthClient(ctrl)
+	authenticateSession := mock_definitions.NewMockAuthenticateSession(ctrl)
+	introspectTokenUseCase, _ := usecases.
+		NewIntrospectTokenUseCase(authenticateClient, authenticateSession)
+	return introspectTokenUseCase, authenticateClient, authenticateSession, ctrl
+}
+
+func (*IntrospectTokenUseCaseTest) client() (*definitions.AuthenticateOAuthClientDTO, *entities.OAuthClientEntity) {
+	client := &entities.OAuthClientEntity{
+		Id:             "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+		OrganizationId: "cc58997a-2403-af1e-7836-f0b338edcd60",
+		AuthMethod:     entities.OAuthClientAuthMethodClientSecretBasic,
+	}
+	return &definitions.AuthenticateOAuthClientDTO{
+		ClientId:     client.Id,
+		ClientSecret: "client_secret",
+	}, client
+}
+
+func TestIntrospectTokenUseCase_ActiveToken(t *testing.T) {
+	// arrange
+	useCase, authenticateClient, authenticateSession, ctrl := (&IntrospectTokenUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	credentials, client := (&IntrospectTokenUseCaseTest{}).client()
+	expiresAt := time.Now().UTC().Add(time.Hour)
+	authenticateClient.EXPECT().Execute(gomock.Any(), credentials).Return(client, nil)
+	authenticateSession.EXPECT().
+		Execute(gomock.Any(), &definitions.AuthenticateSessionDTO{SessionKey: "session_key_example"}).
+		Return(&definitions.AuthenticateSessionResult{
+			SessionKey:     "session_key_example",
+			UserId:         "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+			OrganizationId: client.OrganizationId,
+			ClientId:       "e3b0c442-98fc-1c14-9afb-f4c8996fb924",
+			Scopes:         []string{"openid", "profile"},
+			ExpiresAt:      expiresAt,
+		}, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.IntrospectTokenDTO{
+		Client: credentials,
+		Token:  "session_key_example",
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.True(t, result.Active)
+	assert.Equal(t, result.Subject, "9b157773-fbb4-d04c-9de6-d086cf37d7c7")
+	assert.Equal(t, result.ClientId, "e3b0c442-98fc-1c14-9afb-f4c8996fb924")
+	assert.Equal(t, result.Scopes, []string{"openid", "profile"})
+	assert.Equal(t, result.ExpiresAt, expiresAt.Unix())
+}
+
+func TestIntrospectTokenUseCase_ClientCredentialsToken(t *testing.T) {
+	// arrange
+	useCase, authenticateClient, authenticateSession, ctrl := (&IntrospectTokenUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	credentials, client := (&IntrospectTokenUseCaseTest{}).client()
+	authenticateClient.EXPECT().Execute(gomock.Any(), credentials).Return(client, nil)
+	authenticateSession.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(&definitions.AuthenticateSessionResult{
+		SessionKey:     "session_key_example",
+		OrganizationId: client.OrganizationId,
+		ClientId:       client.Id,
+		Scopes:         []string{},
+		Audiences:      []string{"https://billing.example.com"},
+		ExpiresAt:      time.Now().UTC().Add(time.Hour),
+	}, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.IntrospectTokenDTO{
+		Client: credentials,
+		Token:  "session_key_example",
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.True(t, result.Active)
+	assert.Equal(t, result.Subject, client.Id)
+	assert.Equal(t, result.Audiences, []string{"https://billing.example.com"})
+}
+
+func TestIntrospectTokenUseCase_InactiveTokens(t *testing.T) {
+	// arrange
+	useCase, authenticateClient, authenticateSession, ctrl := (&IntrospectTokenUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	credentials, client := (&IntrospectTokenUseCaseTest{}).client()
+	authenticateClient.EXPECT().Execute(gomock.Any(), credentials).Return(client, nil).Times(3)
+	authenticateSession.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(nil, exceptions.NewSessionNotFound())
+	authenticateSession.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(&definitions.AuthenticateSessionResult{
+		SessionKey:     "session_key_example",
+		UserId:         "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		OrganizationId: "7d865e95-9c6b-4f2e-8a1d-3b5c7e9f1a2b",
+	}, nil)
+	authenticateSession.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(&definitions.AuthenticateSessionResult{
+		SessionKey: "personal_session_key",
+		UserId:     "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+	}, nil)
+	// act
+	unknown, unknownErr := useCase.Execute(context.Background(), &definitions.IntrospectTokenDTO{
+		Client: credentials,
+		Token:  "unknown_session_key",
+	})
+	foreign, foreignErr := useCase.Execute(context.Background(), &definitions.IntrospectTokenDTO{
+		Client: credentials,
+		Token:  "session_key_example",
+	})
+	personal, personalErr := useCase.Execute(context.Background(), &definitions.IntrospectTokenDTO{
+		Client: credentials,
+		Token:  "personal_session_key",
+	})
+	// assert
+	assert.Nil(t, unknownErr)
+	assert.Equal(t, unknown, &definitions.IntrospectTokenResult{Active: false})
+	assert.Nil(t, foreignErr)
+	assert.Equal(t, foreign, &definitions.IntrospectTokenResult{Active: false})
+	assert.Nil(t, personalErr)
+	assert.Equal(t, personal, &definitions.IntrospectTokenResult{Active: false})
+}
+
+func TestIntrospectTokenUseCase_ClientWithoutOrganization(t *testing.T) {
+	// arrange
+	useCase, authenticateClient, authenticateSession, ctrl := (&IntrospectTokenUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	credentials, client := (&IntrospectTokenUseCaseTest{}).client()
+	client.OrganizationId = ""
+	authenticateClient.EXPECT().Execute(gomock.Any(), credentials).Return(client, nil).Times(2)
+	authenticateSession.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(&definitions.AuthenticateSessionResult{
+		SessionKey: "personal_session_key",
+		UserId:     "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+	}, nil)
+	authenticateSession.EXPECT().Execute(gomock.Any(), gomock.Any()).Return(&definitions.AuthenticateSessionResult{
+		SessionKey:     "session_key_example",
+		UserId:         "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		OrganizationId: "cc58997a-2403-af1e-7836-f0b338edcd60",
+	}, nil)
+	// act
+	personal, personalErr := useCase.Execute(context.Background(), &definitions.IntrospectTokenDTO{
+		Client: credentials,
+		Token:  "personal_session_key",
+	})
+	organization, organizationErr := useCase.Execute(context.Background(), &definitions.IntrospectTokenDTO{
+		Client: credentials,
+		Token:  "session_key_example",
+	})
+	// assert
+	assert.Nil(t, personalErr)
+	assert.True(t, personal.Active)
+	assert.Equal(t, personal.Subject, "9b157773-fbb4-d04c-9de6-d086cf37d7c7")
+	assert.Nil(t, organizationErr)
+	assert.Equal(t, organization, &definitions.IntrospectTokenResult{Active: false})
+}
+
+func TestIntrospectTokenUseCase_PublicClient(t *testing.T) {
+	// arrange
+	useCase, authenticateClient, _, ctrl := (&IntrospectTokenUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	credentials, client := (&IntrospectTokenUseCaseTest{}).client()
+	client.AuthMethod = entities.OAuthClientAuthMethodNone
+	authenticateClient.EXPECT().Execute(gomock.Any(), credentials).Return(client, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.IntrospectTokenDTO{
+		Client: credentials,
+		Token:  "session_key_example",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOAuthUnauthorizedClient())
+}
@@ -0,0 +1,162 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type InviteMemberUseCaseTest struct{}
+
+func (*InviteMemberUseCaseTest) setup(t *testing.T) (*usecases.InviteMemberUseCase, *mock_repositories.MockUsersRepository, *mock_repositories.MockMembershipsRepository, *[]*dtos.AuditEventDTO, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	users := mock_repositories.NewMockUsersRepository(ctrl)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
+	auditLog, auditEvents := setupAuditLog(ctrl)
+	inviteMemberUseCase, _ := usecases.NewInviteMemberUseCase(users, memberships, auditLog)
+	return inviteMemberUseCase, users, memberships, auditEvents, ctrl
+}
+
+func TestInviteMemberUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, users, memberships, auditEvents, ctrl := (&InviteMemberUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	organizationId, actorId, userId :=
+		"cc58997a-2403-af1e-7836-f0b338edcd60",
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11"
+	actor := &entities.MembershipEntity{
+		Id:             "0b1e8f4a-2c3d-4e5f-8a9b-1c2d3e4f5a6b",
+		OrganizationId: organizationId,
+		UserId:         actorId,
+		Role:           entities.MembershipRoleAdmin,
+	}
+	user := &entities.UserEntity{
+		Id:        userId,
+		Username:  "username",
+		Email:     "user@email.com",
+		Password:  "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	membership := &entities.MembershipEntity{
+		Id:             "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+		OrganizationId: organizationId,
+		UserId:         userId,
+		Role:           entities.MembershipRoleMember,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, actorId).
+		Return(actor, nil)
+	users.EXPECT().FindById(gomock.Any(), userId).Return(user, nil)
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, userId).
+		Return(nil, nil)
+	memberships.EXPECT().
+		Create(&dtos.MembershipDTO{
+			OrganizationId: organizationId,
+			UserId:         userId,
+			Role:           entities.MembershipRoleMember,
+		}).
+		Return(membership, nil)
+	memberships.EXPECT().Save(gomock.Any(), membership).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.InviteMemberDTO{
+		ActorId:        actorId,
+		OrganizationId: organizationId,
+		UserId:         userId,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Nil(t, result.IsValid())
+	assert.Equal(t, result.Role, entities.MembershipRoleMember)
+	assert.Equal(t, *auditEvents, []*dtos.AuditEventDTO{{
+		Type:           entities.AuditEventRoleGranted,
+		ActorId:        actorId,
+		TargetId:       userId,
+		OrganizationId: organizationId,
+		Detail:         entities.MembershipRoleMember,
+	}})
+}
+
+func TestInviteMemberUseCase_ActorIsNotAdmin(t *testing.T) {
+	// arrange
+	useCase, _, memberships, _, ctrl := (&InviteMemberUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	organizationId, actorId, userId :=
+		"cc58997a-2403-af1e-7836-f0b338edcd60",
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11"
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, actorId).
+		Return(&entities.MembershipEntity{Role: entities.MembershipRoleMember}, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.InviteMemberDTO{
+		ActorId:        actorId,
+		OrganizationId: organizationId,
+		UserId:         userId,
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOrganizationPermissionDenied())
+}
+
+func TestInviteMemberUseCase_AdminCanNotGrantOwner(t *testing.T) {
+	// arrange
+	useCase, _, memberships, _, ctrl := (&InviteMemberUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	organizationId, actorId, userId :=
+		"cc58997a-2403-af1e-7836-f0b338edcd60",
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11"
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, actorId).
+		Return(&entities.MembershipEntity{Role: entities.MembershipRoleAdmin}, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.InviteMemberDTO{
+		ActorId:        actorId,
+		OrganizationId: organizationId,
+		UserId:         userId,
+		Role:           entities.MembershipRoleOwner,
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOrganizationPermissionDenied())
+}
+
+func TestInviteMemberUseCase_MembershipAlreadyExists(t *testing.T) {
+	// arrange
+	useCase, users, memberships, _, ctrl := (&InviteMemberUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	organizationId, actorId, userId :=
+		"cc58997a-2403-af1e-7836-f0b338edcd60",
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11"
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, actorId).
+		Return(&entities.MembershipEntity{Role: entities.MembershipRoleOwner}, nil)
+	users.EXPECT().FindById(gomock.Any(), userId).Return(&entities.UserEntity{Id: userId}, nil)
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, userId).
+		Return(&entities.MembershipEntity{Role: entities.MembershipRoleMember}, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.InviteMemberDTO{
+		ActorId:        actorId,
+		OrganizationId: organizationId,
+		UserId:         userId,
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewMembershipAlreadyExists())
+}
@@ -0,0 +1,86 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type ListApiKeysUseCaseTest struct{}
+
+func (*ListApiKeysUseCaseTest) setup(t *testing.T) (*usecases.ListApiKeysUseCase, *mock_repositories.MockApiKeysRepository, *mock_repositories.MockMembershipsRepository, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	apiKeys := mock_repositories.NewMockApiKeysRepository(ctrl)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
+	listApiKeysUseCase, _ := usecases.NewListApiKeysUseCase(apiKeys, memberships)
+	return listApiKeysUseCase, apiKeys, memberships, ctrl
+}
+
+func TestListApiKeysUseCase_OwnKeys(t *testing.T) {
+	// arrange
+	useCase, apiKeys, _, ctrl := (&ListApiKeysUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	actorId := "9b157773-fbb4-d04c-9de6-d086cf37d7c7"
+	found := []*entities.ApiKeyEntity{{Id: "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d", UserId: actorId}}
+	apiKeys.EXPECT().FindByUserId(gomock.Any(), actorId).Return(found, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.ListApiKeysDTO{
+		ActorId: actorId,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result, found)
+}
+
+func TestListApiKeysUseCase_ServiceAccountKeys(t *testing.T) {
+	// arrange
+	useCase, apiKeys, memberships, ctrl := (&ListApiKeysUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	actorId, organizationId :=
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"cc58997a-2403-af1e-7836-f0b338edcd60"
+	found := []*entities.ApiKeyEntity{{Id: "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d", OrganizationId: organizationId}}
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, actorId).
+		Return(&entities.MembershipEntity{
+			OrganizationId: organizationId,
+			UserId:         actorId,
+			Role:           entities.MembershipRoleOwner,
+		}, nil)
+	apiKeys.EXPECT().FindServiceAccountKeys(gomock.Any(), organizationId).Return(found, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.ListApiKeysDTO{
+		ActorId:        actorId,
+		OrganizationId: organizationId,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result, found)
+}
+
+func TestListApiKeysUseCase_NotAMember(t *testing.T) {
+	// arrange
+	useCase, _, memberships, ctrl := (&ListApiKeysUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	actorId, organizationId :=
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"cc58997a-2403-af1e-7836-f0b338edcd60"
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, actorId).
+		Return(nil, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.ListApiKeysDTO{
+		ActorId:        actorId,
+		OrganizationId: organizationId,
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOrganizationPermissionDenied())
+}
@@ -0,0 +1,176 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+// Accepts any event and keeps what the use case recorded, in order.
+func setupAuditLog(ctrl *gomock.Controller) (*mock_repositories.MockAuditLogRepository, *[]*dtos.AuditEventDTO) {
+	auditLog := mock_repositories.NewMockAuditLogRepository(ctrl)
+	auditEvents := []*dtos.AuditEventDTO{}
+	auditLog.EXPECT().
+		Create(gomock.Any()).
+		DoAndReturn(func(data *dtos.AuditEventDTO) (*entities.AuditEventEntity, *shared.Error) {
+			auditEvents = append(auditEvents, data)
+			return &entities.AuditEventEntity{
+				Id:   "7d9f2c1e-3b4a-4c5d-8e6f-a1b2c3d4e5f6",
+				Type: data.Type,
+			}, nil
+		}).
+		AnyTimes()
+	auditLog.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+	return auditLog, &auditEvents
+}
+
+type QueryAuditLogUseCaseTest struct{}
+
+func (*QueryAuditLogUseCaseTest) setup(t *testing.T) (*usecases.QueryAuditLogUseCase, *mock_repositories.MockAuditLogRepository, *mock_repositories.MockMembershipsRepository, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	auditLog := mock_repositories.NewMockAuditLogRepository(ctrl)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
+	queryAuditLogUseCase, _ := usecases.NewQueryAuditLogUseCase(auditLog, memberships)
+	return queryAuditLogUseCase, auditLog, memberships, ctrl
+}
+
+func (*QueryAuditLogUseCaseTest) events(count int) []*entities.AuditEventEntity {
+	ids := []string{
+		"0b1e8f4a-2c3d-4e5f-8a9b-1c2d3e4f5a6b",
+		"1c2f9a5b-3d4e-4f6a-9b0c-2d3e4f5a6b7c",
+		"2d3a0b6c-4e5f-4a7b-8c1d-3e4f5a6b7c8d",
+	}
+	events := []*entities.AuditEventEntity{}
+	for index := 0; index < count; index++ {
+		events = append(events, &entities.AuditEventEntity{
+			Id:   ids[index],
+			Type: entities.AuditEventLoginSucceeded,
+		})
+	}
+	return events
+}
+
+func TestQueryAuditLogUseCase_AdminSeesOrganization(t *testing.T) {
+	// arrange
+	useCase, auditLog, memberships, ctrl := (&QueryAuditLogUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	organizationId, actorId :=
+		"cc58997a-2403-af1e-7836-f0b338edcd60",
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7"
+	since := time.Now().UTC().Add(-time.Hour)
+	events := (&QueryAuditLogUseCaseTest{}).events(3)
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, actorId).
+		Return(&entities.MembershipEntity{Role: entities.MembershipRoleAdmin}, nil)
+	auditLog.EXPECT().
+		Query(gomock.Any(), &dtos.AuditLogFilterDTO{
+			Type:           entities.AuditEventLoginFailed,
+			OrganizationId: organizationId,
+			Since:          since,
+			Limit:          3,
+		}).
+		Return(events, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.QueryAuditLogDTO{
+		ActorId:        actorId,
+		OrganizationId: organizationId,
+		Type:           entities.AuditEventLoginFailed,
+		Since:          since,
+		PageSize:       2,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.Events, events[:2])
+	assert.Equal(t, result.NextPageToken, events[1].Id)
+}
+
+func TestQueryAuditLogUseCase_MemberSeesOwnEvents(t *testing.T) {
+	// arrange
+	useCase, auditLog, memberships, ctrl := (&QueryAuditLogUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	organizationId, actorId, pageToken :=
+		"cc58997a-2403-af1e-7836-f0b338edcd60",
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11"
+	events := (&QueryAuditLogUseCaseTest{}).events(1)
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, actorId).
+		Return(&entities.MembershipEntity{Role: entities.MembershipRoleMember}, nil)
+	auditLog.EXPECT().
+		Query(gomock.Any(), &dtos.AuditLogFilterDTO{
+			Participant: actorId,
+			Cursor:      pageToken,
+			Limit:       51,
+		}).
+		Return(events, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.QueryAuditLogDTO{
+		ActorId:        actorId,
+		OrganizationId: organizationId,
+		PageToken:      pageToken,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.Events, events)
+	assert.Equal(t, result.NextPageToken, "")
+}
+
+func TestQueryAuditLogUseCase_AdminOfAnotherOrganization(t *testing.T) {
+	// arrange
+	useCase, _, memberships, ctrl := (&QueryAuditLogUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	organizationId, actorId :=
+		"cc58997a-2403-af1e-7836-f0b338edcd60",
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7"
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, actorId).
+		Return(&entities.MembershipEntity{Role: entities.MembershipRoleOwner}, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.QueryAuditLogDTO{
+		ActorId:              actorId,
+		OrganizationId:       organizationId,
+		FilterOrganizationId: "4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOrganizationPermissionDenied())
+}
+
+func TestQueryAuditLogUseCase_InvalidQuery(t *testing.T) {
+	// arrange
+	useCase, _, _, ctrl := (&QueryAuditLogUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	now := time.Now().UTC()
+	for _, data := range []*definitions.QueryAuditLogDTO{
+		{PageSize: 201},
+		{PageSize: -1},
+		{PageToken: "not_an_uuid"},
+		{Since: now, Until: now.Add(-time.Hour)},
+	} {
+		data.ActorId = "9b157773-fbb4-d04c-9de6-d086cf37d7c7"
+		// act
+		result, err := useCase.Execute(context.Background(), data)
+		// assert
+		assert.Nil(t, result)
+		assert.Equal(t, err, exceptions.NewInvalidAuditLogQuery())
+	}
+
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.QueryAuditLogDTO{
+		ActorId: "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Type:    "user.deleted",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewInvalidAuditEventType())
+}
@@ -0,0 +1,95 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type RegenerateRecoveryCodesUseCaseTest struct{}
+
+func (*RegenerateRecoveryCodesUseCaseTest) setup(t *testing.T) (*usecases.RegenerateRecoveryCodesUseCase, *mock_repositories.MockUsersRepository, *mock_providers.MockEncrypterProvider, *mock_providers.MockDigestProvider, *mock_providers.MockRandomProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	users := mock_repositories.NewMockUsersRepository(ctrl)
+	encrypter := mock_providers.NewMockEncrypterProvider(ctrl)
+	digest := mock_providers.NewMockDigestProvider(ctrl)
+	random := mock_providers.NewMockRandomProvider(ctrl)
+	regenerateRecoveryCodesUseCase, _ := usecases.NewRegenerateRecoveryCodesUseCase(users, encrypter, digest, random)
+	return regenerateRecoveryCodesUseCase, users, encrypter, digest, random, ctrl
+}
+
+func TestRegenerateRecoveryCodesUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, users, encrypter, digest, random, ctrl := (&RegenerateRecoveryCodesUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := &entities.UserEntity{
+		Id:               "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Password:         "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW",
+		MfaEnabled:       true,
+		MfaRecoveryCodes: []string{"old_hash"},
+	}
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	encrypter.EXPECT().Compare(gomock.Any(), "p4ssword", user.Password).Return(true, nil)
+	random.EXPECT().String(10).Times(10).Return("abcdeFGHIJ", nil)
+	digest.EXPECT().Digest("abcdeFGHIJ").Times(10).Return("sha256:new")
+	users.EXPECT().Save(gomock.Any(), user).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.RegenerateRecoveryCodesDTO{
+		ActorId:  user.Id,
+		Password: "p4ssword",
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Len(t, result.RecoveryCodes, 10)
+	assert.Len(t, user.MfaRecoveryCodes, 10)
+	assert.NotContains(t, user.MfaRecoveryCodes, "old_hash")
+}
+
+func TestRegenerateRecoveryCodesUseCase_WrongPassword(t *testing.T) {
+	// arrange
+	useCase, users, encrypter, _, _, ctrl := (&RegenerateRecoveryCodesUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := &entities.UserEntity{
+		Id:         "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Password:   "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW",
+		MfaEnabled: true,
+	}
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	encrypter.EXPECT().Compare(gomock.Any(), "wrong", user.Password).Return(false, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.RegenerateRecoveryCodesDTO{
+		ActorId:  user.Id,
+		Password: "wrong",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewUserReauthenticationFailed())
+}
+
+func TestRegenerateRecoveryCodesUseCase_MfaDisabled(t *testing.T) {
+	// arrange
+	useCase, users, encrypter, _, _, ctrl := (&RegenerateRecoveryCodesUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := &entities.UserEntity{
+		Id:       "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Password: "$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW",
+	}
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	encrypter.EXPECT().Compare(gomock.Any(), "p4ssword", user.Password).Return(true, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.RegenerateRecoveryCodesDTO{
+		ActorId:  user.Id,
+		Password: "p4ssword",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewMfaNotEnrolled())
+}
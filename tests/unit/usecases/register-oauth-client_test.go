@@ -0,0 +1,155 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type RegisterOAuthClientUseCaseTest struct{}
+
+func (*RegisterOAuthClientUseCaseTest) setup(t *testing.T) (*usecases.RegisterOAuthClientUseCase, *mock_repositories.MockOAuthClientsRepository, *mock_repositories.MockMembershipsRepository, *mock_providers.MockEncrypterProvider, *mock_providers.MockRandomProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	clients := mock_repositories.NewMockOAuthClientsRepository(ctrl)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
+	encrypter := mock_providers.NewMockEncrypterProvider(ctrl)
+	random := mock_providers.NewMockRandomProvider(ctrl)
+	registerOAuthClientUseCase, _ := usecases.
+		NewRegisterOAuthClientUseCase(clients, memberships, encrypter, random)
+	return registerOAuthClientUseCase, clients, memberships, encrypter, random, ctrl
+}
+
+func TestRegisterOAuthClientUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, clients, memberships, _, _, ctrl := (&RegisterOAuthClientUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	actorId, organizationId :=
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"cc58997a-2403-af1e-7836-f0b338edcd60"
+	redirectUris := []string{"https://app.example.com/callback"}
+	client := &entities.OAuthClientEntity{
+		Id:             "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+		OrganizationId: organizationId,
+		Name:           "Web app",
+		RedirectUris:   redirectUris,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, actorId).
+		Return(&entities.MembershipEntity{
+			OrganizationId: organizationId,
+			UserId:         actorId,
+			Role:           entities.MembershipRoleAdmin,
+		}, nil)
+	clients.EXPECT().
+		Create(&dtos.OAuthClientDTO{
+			OrganizationId: organizationId,
+			Name:           "Web app",
+			RedirectUris:   redirectUris,
+			AuthMethod:     entities.OAuthClientAuthMethodNone,
+		}).
+		Return(client, nil)
+	clients.EXPECT().Save(gomock.Any(), client).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.RegisterOAuthClientDTO{
+		ActorId:        actorId,
+		OrganizationId: organizationId,
+		Name:           " Web app ",
+		RedirectUris:   redirectUris,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.Client, client)
+	assert.Equal(t, result.ClientSecret, "")
+}
+
+func TestRegisterOAuthClientUseCase_ConfidentialClient(t *testing.T) {
+	// arrange
+	useCase, clients, memberships, encrypter, random, ctrl := (&RegisterOAuthClientUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	actorId, organizationId :=
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"cc58997a-2403-af1e-7836-f0b338edcd60"
+	secret, hash :=
+		"Jt5cQ2xVw8LrN0pZ4mKd7YhB1sGf3aUe9TqC6oXiR2lWn8Dv",
+		"$2a$10$KtwHGGRiKWRDEq/g/2RAguaqIqU7iJNM11aFeqcwzDhuv9jDY35uW"
+	client := &entities.OAuthClientEntity{
+		Id:             "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+		OrganizationId: organizationId,
+		Name:           "Billing job",
+		AuthMethod:     entities.OAuthClientAuthMethodClientSecretBasic,
+		SecretHash:     hash,
+		Scopes:         []string{"invoices:write"},
+		Audiences:      []string{"https://billing.example.com"},
+	}
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, actorId).
+		Return(&entities.MembershipEntity{
+			OrganizationId: organizationId,
+			UserId:         actorId,
+			Role:           entities.MembershipRoleAdmin,
+		}, nil)
+	random.EXPECT().String(48).Return(secret, nil)
+	encrypter.EXPECT().Hash(gomock.Any(), secret).Return(hash, nil)
+	clients.EXPECT().
+		Create(&dtos.OAuthClientDTO{
+			OrganizationId: organizationId,
+			Name:           "Billing job",
+			AuthMethod:     entities.OAuthClientAuthMethodClientSecretBasic,
+			SecretHash:     hash,
+			Scopes:         client.Scopes,
+			Audiences:      client.Audiences,
+		}).
+		Return(client, nil)
+	clients.EXPECT().Save(gomock.Any(), client).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.RegisterOAuthClientDTO{
+		ActorId:        actorId,
+		OrganizationId: organizationId,
+		Name:           "Billing job",
+		AuthMethod:     entities.OAuthClientAuthMethodClientSecretBasic,
+		Scopes:         client.Scopes,
+		Audiences:      client.Audiences,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.Client, client)
+	assert.Equal(t, result.ClientSecret, secret)
+}
+
+func TestRegisterOAuthClientUseCase_NotAnAdmin(t *testing.T) {
+	// arrange
+	useCase, _, memberships, _, _, ctrl := (&RegisterOAuthClientUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	actorId, organizationId :=
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"cc58997a-2403-af1e-7836-f0b338edcd60"
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, actorId).
+		Return(&entities.MembershipEntity{
+			OrganizationId: organizationId,
+			UserId:         actorId,
+			Role:           entities.MembershipRoleMember,
+		}, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.RegisterOAuthClientDTO{
+		ActorId:        actorId,
+		OrganizationId: organizationId,
+		Name:           "Web app",
+		RedirectUris:   []string{"https://app.example.com/callback"},
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOrganizationPermissionDenied())
+}
@@ -0,0 +1,102 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type RemoveMemberUseCaseTest struct{}
+
+func (*RemoveMemberUseCaseTest) setup(t *testing.T) (*usecases.RemoveMemberUseCase, *mock_repositories.MockMembershipsRepository, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
+	removeMemberUseCase, _ := usecases.NewRemoveMemberUseCase(memberships)
+	return removeMemberUseCase, memberships, ctrl
+}
+
+func TestRemoveMemberUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, memberships, ctrl := (&RemoveMemberUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	organizationId, actorId, userId :=
+		"cc58997a-2403-af1e-7836-f0b338edcd60",
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11"
+	actor := &entities.MembershipEntity{Id: "actor", Role: entities.MembershipRoleAdmin}
+	target := &entities.MembershipEntity{Id: "target", Role: entities.MembershipRoleMember}
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, actorId).
+		Return(actor, nil)
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, userId).
+		Return(target, nil)
+	memberships.EXPECT().Delete(gomock.Any(), target.Id).Return(nil)
+	// act
+	err := useCase.Execute(context.Background(), &definitions.RemoveMemberDTO{
+		ActorId:        actorId,
+		OrganizationId: organizationId,
+		UserId:         userId,
+	})
+	// assert
+	assert.Nil(t, err)
+}
+
+func TestRemoveMemberUseCase_AdminCanNotRemoveOwner(t *testing.T) {
+	// arrange
+	useCase, memberships, ctrl := (&RemoveMemberUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	organizationId, actorId, userId :=
+		"cc58997a-2403-af1e-7836-f0b338edcd60",
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11"
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, actorId).
+		Return(&entities.MembershipEntity{Id: "actor", Role: entities.MembershipRoleAdmin}, nil)
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, userId).
+		Return(&entities.MembershipEntity{Id: "target", Role: entities.MembershipRoleOwner}, nil)
+	// act
+	err := useCase.Execute(context.Background(), &definitions.RemoveMemberDTO{
+		ActorId:        actorId,
+		OrganizationId: organizationId,
+		UserId:         userId,
+	})
+	// assert
+	assert.Equal(t, err, exceptions.NewOrganizationPermissionDenied())
+}
+
+func TestRemoveMemberUseCase_LastOwnerCanNotLeave(t *testing.T) {
+	// arrange
+	useCase, memberships, ctrl := (&RemoveMemberUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	organizationId, actorId :=
+		"cc58997a-2403-af1e-7836-f0b338edcd60",
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7"
+	owner := &entities.MembershipEntity{Id: "owner", Role: entities.MembershipRoleOwner}
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, actorId).
+		Return(owner, nil).
+		Times(2)
+	memberships.EXPECT().
+		FindByOrganizationId(gomock.Any(), organizationId).
+		Return([]*entities.MembershipEntity{
+			owner,
+			{Id: "member", Role: entities.MembershipRoleMember},
+		}, nil)
+	// act
+	err := useCase.Execute(context.Background(), &definitions.RemoveMemberDTO{
+		ActorId:        actorId,
+		OrganizationId: organizationId,
+		UserId:         actorId,
+	})
+	// assert
+	assert.Equal(t, err, exceptions.NewMembershipLastOwner())
+}
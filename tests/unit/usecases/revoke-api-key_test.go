@@ -0,0 +1,99 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type RevokeApiKeyUseCaseTest struct{}
+
+func (*RevokeApiKeyUseCaseTest) setup(t *testing.T) (*usecases.RevokeApiKeyUseCase, *mock_repositories.MockApiKeysRepository, *mock_repositories.MockMembershipsRepository, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	apiKeys := mock_repositories.NewMockApiKeysRepository(ctrl)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
+	revokeApiKeyUseCase, _ := usecases.NewRevokeApiKeyUseCase(apiKeys, memberships)
+	return revokeApiKeyUseCase, apiKeys, memberships, ctrl
+}
+
+func TestRevokeApiKeyUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, apiKeys, _, ctrl := (&RevokeApiKeyUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	actorId, apiKeyId :=
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+	apiKey := &entities.ApiKeyEntity{
+		Id:        apiKeyId,
+		UserId:    actorId,
+		Name:      "deploy job",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	apiKeys.EXPECT().FindById(gomock.Any(), apiKeyId).Return(apiKey, nil)
+	apiKeys.EXPECT().Save(gomock.Any(), apiKey).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.RevokeApiKeyDTO{
+		ActorId:  actorId,
+		ApiKeyId: apiKeyId,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.True(t, result.IsRevoked())
+}
+
+func TestRevokeApiKeyUseCase_SomeoneElsesKey(t *testing.T) {
+	// arrange
+	useCase, apiKeys, _, ctrl := (&RevokeApiKeyUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	apiKeyId := "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+	apiKeys.EXPECT().FindById(gomock.Any(), apiKeyId).Return(&entities.ApiKeyEntity{
+		Id:     apiKeyId,
+		UserId: "4f7a3e0c-6f3b-4a4e-9a3b-0c1e5d7c2b11",
+	}, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.RevokeApiKeyDTO{
+		ActorId:  "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		ApiKeyId: apiKeyId,
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewApiKeyNotFound())
+}
+
+func TestRevokeApiKeyUseCase_ServiceAccountKeyRequiresAdmin(t *testing.T) {
+	// arrange
+	useCase, apiKeys, memberships, ctrl := (&RevokeApiKeyUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	actorId, organizationId, apiKeyId :=
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"cc58997a-2403-af1e-7836-f0b338edcd60",
+		"1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"
+	apiKeys.EXPECT().FindById(gomock.Any(), apiKeyId).Return(&entities.ApiKeyEntity{
+		Id:             apiKeyId,
+		OrganizationId: organizationId,
+	}, nil)
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, actorId).
+		Return(&entities.MembershipEntity{
+			OrganizationId: organizationId,
+			UserId:         actorId,
+			Role:           entities.MembershipRoleMember,
+		}, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.RevokeApiKeyDTO{
+		ActorId:  actorId,
+		ApiKeyId: apiKeyId,
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewApiKeyPermissionDenied())
+}
@@ -0,0 +1,115 @@
+package test_usecases
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_definitions "github.com/AndreyArthur/oganessone/src/application/definitions/mocks"
+	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/dtos"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type RevokeTokenUseCaseTest struct{}
+
+func (*RevokeTokenUseCaseTest) setup(t *testing.T) (*usecases.RevokeTokenUseCase, *mock_definitions.MockAuthenticateOAuthClient, *mock_providers.MockCacheProvider, *[]*dtos.AuditEventDTO, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	authenticateClient := mock_definitions.NewMockAuthenticateOAuthClient(ctrl)
+	cache := mock_providers.NewMockCacheProvider(ctrl)
+	auditLog, auditEvents := setupAuditLog(ctrl)
+	revokeTokenUseCase, _ := usecases.NewRevokeTokenUseCase(authenticateClient, cache, auditLog)
+	return revokeTokenUseCase, authenticateClient, cache, auditEvents, ctrl
+}
+
+func (*RevokeTokenUseCaseTest) client() (*definitions.AuthenticateOAuthClientDTO, *entities.OAuthClientEntity) {
+	client := &entities.OAuthClientEntity{
+		Id:             "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+		OrganizationId: "cc58997a-2403-af1e-7836-f0b338edcd60",
+		AuthMethod:     entities.OAuthClientAuthMethodNone,
+	}
+	return &definitions.AuthenticateOAuthClientDTO{ClientId: client.Id}, client
+}
+
+func TestRevokeTokenUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, authenticateClient, cache, auditEvents, ctrl := (&RevokeTokenUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	credentials, client := (&RevokeTokenUseCaseTest{}).client()
+	sessionKey, userId := "session_key_example", "9b157773-fbb4-d04c-9de6-d086cf37d7c7"
+	authenticateClient.EXPECT().Execute(gomock.Any(), credentials).Return(client, nil)
+	cache.EXPECT().Get(gomock.Any(), sessionKey).Return(userId, nil)
+	cache.EXPECT().Get(gomock.Any(), strings.Join([]string{sessionKey, "%", userId}, "")).Return(client.Id, nil)
+	cache.EXPECT().Delete(gomock.Any(), sessionKey).Return(nil)
+	for _, marker := range []string{"@", "#", "$", "%", "&"} {
+		cache.EXPECT().Delete(gomock.Any(), strings.Join([]string{sessionKey, marker, userId}, "")).Return(nil)
+	}
+	// act
+	err := useCase.Execute(context.Background(), &definitions.RevokeTokenDTO{
+		Client:    credentials,
+		Token:     sessionKey,
+		IpAddress: "203.0.113.7",
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, *auditEvents, []*dtos.AuditEventDTO{{
+		Type:      entities.AuditEventSessionRevoked,
+		ActorId:   client.Id,
+		TargetId:  userId,
+		IpAddress: "203.0.113.7",
+	}})
+}
+
+func TestRevokeTokenUseCase_TokenOfAnotherClient(t *testing.T) {
+	// arrange
+	useCase, authenticateClient, cache, _, ctrl := (&RevokeTokenUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	credentials, client := (&RevokeTokenUseCaseTest{}).client()
+	sessionKey, userId := "session_key_example", "9b157773-fbb4-d04c-9de6-d086cf37d7c7"
+	authenticateClient.EXPECT().Execute(gomock.Any(), credentials).Return(client, nil)
+	cache.EXPECT().Get(gomock.Any(), sessionKey).Return(userId, nil)
+	cache.EXPECT().Get(gomock.Any(), strings.Join([]string{sessionKey, "%", userId}, "")).Return("", nil)
+	// act
+	err := useCase.Execute(context.Background(), &definitions.RevokeTokenDTO{
+		Client: credentials,
+		Token:  sessionKey,
+	})
+	// assert
+	assert.Nil(t, err)
+}
+
+func TestRevokeTokenUseCase_UnknownToken(t *testing.T) {
+	// arrange
+	useCase, authenticateClient, cache, _, ctrl := (&RevokeTokenUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	credentials, client := (&RevokeTokenUseCaseTest{}).client()
+	authenticateClient.EXPECT().Execute(gomock.Any(), credentials).Return(client, nil)
+	cache.EXPECT().Get(gomock.Any(), "unknown_session_key").Return("", nil)
+	// act
+	err := useCase.Execute(context.Background(), &definitions.RevokeTokenDTO{
+		Client: credentials,
+		Token:  "unknown_session_key",
+	})
+	// assert
+	assert.Nil(t, err)
+}
+
+func TestRevokeTokenUseCase_ApiKey(t *testing.T) {
+	// arrange
+	useCase, authenticateClient, _, _, ctrl := (&RevokeTokenUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	credentials, client := (&RevokeTokenUseCaseTest{}).client()
+	authenticateClient.EXPECT().Execute(gomock.Any(), credentials).Return(client, nil)
+	// act
+	err := useCase.Execute(context.Background(), &definitions.RevokeTokenDTO{
+		Client: credentials,
+		Token:  "og_AbCd1234_Jt5cQ2xVw8LrN0pZ4mKd7YhB1sGf3aUe",
+	})
+	// assert
+	assert.Equal(t, err, exceptions.NewOAuthUnsupportedTokenType())
+}
@@ -0,0 +1,78 @@
+package test_usecases
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type SwitchOrganizationUseCaseTest struct{}
+
+func (*SwitchOrganizationUseCaseTest) setup(t *testing.T) (*usecases.SwitchOrganizationUseCase, *mock_repositories.MockMembershipsRepository, *mock_providers.MockCacheProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	memberships := mock_repositories.NewMockMembershipsRepository(ctrl)
+	cache := mock_providers.NewMockCacheProvider(ctrl)
+	switchOrganizationUseCase, _ := usecases.NewSwitchOrganizationUseCase(memberships, cache)
+	return switchOrganizationUseCase, memberships, cache, ctrl
+}
+
+func TestSwitchOrganizationUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, memberships, cache, ctrl := (&SwitchOrganizationUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	sessionKey, userId, organizationId :=
+		"session_key_example",
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"cc58997a-2403-af1e-7836-f0b338edcd60"
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, userId).
+		Return(&entities.MembershipEntity{Role: entities.MembershipRoleMember}, nil)
+	expiresAt := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	cache.EXPECT().
+		Get(gomock.Any(), strings.Join([]string{sessionKey, "@", userId}, "")).
+		Return(expiresAt.Format(time.RFC3339), nil)
+	cache.EXPECT().
+		Set(gomock.Any(), strings.Join([]string{sessionKey, "#", userId}, ""), organizationId, expiresAt).
+		Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.SwitchOrganizationDTO{
+		SessionKey:     sessionKey,
+		UserId:         userId,
+		OrganizationId: organizationId,
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.OrganizationId, organizationId)
+}
+
+func TestSwitchOrganizationUseCase_NotAMember(t *testing.T) {
+	// arrange
+	useCase, memberships, _, ctrl := (&SwitchOrganizationUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	sessionKey, userId, organizationId :=
+		"session_key_example",
+		"9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		"cc58997a-2403-af1e-7836-f0b338edcd60"
+	memberships.EXPECT().
+		FindByOrganizationIdAndUserId(gomock.Any(), organizationId, userId).
+		Return(nil, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.SwitchOrganizationDTO{
+		SessionKey:     sessionKey,
+		UserId:         userId,
+		OrganizationId: organizationId,
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewMembershipNotFound())
+}
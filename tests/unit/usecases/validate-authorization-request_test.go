@@ -0,0 +1,139 @@
+package test_usecases
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type ValidateAuthorizationRequestUseCaseTest struct{}
+
+func (*ValidateAuthorizationRequestUseCaseTest) setup(t *testing.T) (*usecases.ValidateAuthorizationRequestUseCase, *mock_repositories.MockOAuthClientsRepository, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	clients := mock_repositories.NewMockOAuthClientsRepository(ctrl)
+	validateAuthorizationRequestUseCase, _ := usecases.NewValidateAuthorizationRequestUseCase(clients)
+	return validateAuthorizationRequestUseCase, clients, ctrl
+}
+
+func (*ValidateAuthorizationRequestUseCaseTest) request() (*definitions.AuthorizationRequestDTO, *entities.OAuthClientEntity) {
+	client := &entities.OAuthClientEntity{
+		Id:             "cc58997a-2403-af1e-7836-f0b338edcd60",
+		OrganizationId: "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Name:           "Web app",
+		RedirectUris:   []string{"https://app.example.com/callback"},
+		Scopes:         []string{"users:read", "organizations:write"},
+	}
+	return &definitions.AuthorizationRequestDTO{
+		ResponseType:        "code",
+		ClientId:            client.Id,
+		RedirectUri:         "https://app.example.com/callback",
+		Scope:               "users:read users:read organizations:write",
+		State:               "af0ifjsldkj",
+		CodeChallenge:       "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM",
+		CodeChallengeMethod: "S256",
+	}, client
+}
+
+func TestValidateAuthorizationRequestUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, clients, ctrl := (&ValidateAuthorizationRequestUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	request, client := (&ValidateAuthorizationRequestUseCaseTest{}).request()
+	clients.EXPECT().FindById(gomock.Any(), client.Id).Return(client, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), request)
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.Client, client)
+	assert.Equal(t, result.Scopes, []string{"users:read", "organizations:write"})
+}
+
+func TestValidateAuthorizationRequestUseCase_UnknownClient(t *testing.T) {
+	// arrange
+	useCase, clients, ctrl := (&ValidateAuthorizationRequestUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	request, client := (&ValidateAuthorizationRequestUseCaseTest{}).request()
+	clients.EXPECT().FindById(gomock.Any(), client.Id).Return(nil, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), request)
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOAuthInvalidClient())
+}
+
+func TestValidateAuthorizationRequestUseCase_UnregisteredRedirectUri(t *testing.T) {
+	// arrange
+	useCase, clients, ctrl := (&ValidateAuthorizationRequestUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	request, client := (&ValidateAuthorizationRequestUseCaseTest{}).request()
+	request.RedirectUri = "https://evil.example.com/callback"
+	clients.EXPECT().FindById(gomock.Any(), client.Id).Return(client, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), request)
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOAuthInvalidRedirectUri())
+}
+
+func TestValidateAuthorizationRequestUseCase_MissingCodeChallenge(t *testing.T) {
+	// arrange
+	useCase, clients, ctrl := (&ValidateAuthorizationRequestUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	request, client := (&ValidateAuthorizationRequestUseCaseTest{}).request()
+	request.CodeChallenge, request.CodeChallengeMethod = "", ""
+	clients.EXPECT().FindById(gomock.Any(), client.Id).Return(client, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), request)
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOAuthInvalidCodeChallenge())
+}
+
+func TestValidateAuthorizationRequestUseCase_UnsupportedResponseType(t *testing.T) {
+	// arrange
+	useCase, clients, ctrl := (&ValidateAuthorizationRequestUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	request, client := (&ValidateAuthorizationRequestUseCaseTest{}).request()
+	request.ResponseType = "token"
+	clients.EXPECT().FindById(gomock.Any(), client.Id).Return(client, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), request)
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOAuthUnsupportedResponseType())
+}
+
+func TestValidateAuthorizationRequestUseCase_InvalidScope(t *testing.T) {
+	// arrange
+	useCase, clients, ctrl := (&ValidateAuthorizationRequestUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	request, client := (&ValidateAuthorizationRequestUseCaseTest{}).request()
+	request.Scope = "users:read \"quoted\""
+	clients.EXPECT().FindById(gomock.Any(), client.Id).Return(client, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), request)
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOAuthInvalidScope())
+}
+
+func TestValidateAuthorizationRequestUseCase_UnregisteredScope(t *testing.T) {
+	// arrange
+	useCase, clients, ctrl := (&ValidateAuthorizationRequestUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	request, client := (&ValidateAuthorizationRequestUseCaseTest{}).request()
+	request.Scope = "users:read api_keys:write"
+	clients.EXPECT().FindById(gomock.Any(), client.Id).Return(client, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), request)
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewOAuthInvalidScope())
+}
@@ -0,0 +1,257 @@
+package test_usecases
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AndreyArthur/oganessone/src/application/definitions"
+	"github.com/AndreyArthur/oganessone/src/application/providers"
+	mock_providers "github.com/AndreyArthur/oganessone/src/application/providers/mocks"
+	mock_repositories "github.com/AndreyArthur/oganessone/src/application/repositories/mocks"
+	"github.com/AndreyArthur/oganessone/src/application/usecases"
+	"github.com/AndreyArthur/oganessone/src/core/entities"
+	"github.com/AndreyArthur/oganessone/src/core/exceptions"
+	"github.com/AndreyArthur/oganessone/src/core/shared"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+type VerifyMfaUseCaseTest struct{}
+
+func (*VerifyMfaUseCaseTest) setup(t *testing.T) (*usecases.VerifyMfaUseCase, *mock_repositories.MockMfaChallengesRepository, *mock_repositories.MockUsersRepository, *mock_providers.MockCipherProvider, *mock_providers.MockOtpProvider, *mock_providers.MockDigestProvider, *mock_providers.MockSessionProvider, *mock_providers.MockCacheProvider, *gomock.Controller) {
+	ctrl := gomock.NewController(t)
+	mfaChallenges := mock_repositories.NewMockMfaChallengesRepository(ctrl)
+	users := mock_repositories.NewMockUsersRepository(ctrl)
+	cipher := mock_providers.NewMockCipherProvider(ctrl)
+	otp := mock_providers.NewMockOtpProvider(ctrl)
+	digest := mock_providers.NewMockDigestProvider(ctrl)
+	session := mock_providers.NewMockSessionProvider(ctrl)
+	cache := mock_providers.NewMockCacheProvider(ctrl)
+	auditLog, _ := setupAuditLog(ctrl)
+	verifyMfaUseCase, _ := usecases.NewVerifyMfaUseCase(
+		mfaChallenges, users, cipher, otp, digest, session, cache, auditLog,
+	)
+	return verifyMfaUseCase, mfaChallenges, users, cipher, otp, digest, session, cache, ctrl
+}
+
+// Only the current time step, the second one checked, yields the given code.
+func expectTotpCodes(otp *mock_providers.MockOtpProvider, secret string, code string) {
+	calls := 0
+	otp.EXPECT().Code(secret, gomock.Any()).Times(3).
+		DoAndReturn(func(string, int64) (string, *shared.Error) {
+			calls++
+			if calls == 2 {
+				return code, nil
+			}
+			return "000000", nil
+		})
+}
+
+func TestVerifyMfaUseCase_SuccessCase(t *testing.T) {
+	// arrange
+	useCase, mfaChallenges, users, cipher, otp, _, session, cache, ctrl := (&VerifyMfaUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := &entities.UserEntity{
+		Id:         "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		MfaSecret:  "encrypted_secret",
+		MfaEnabled: true,
+	}
+	challenge := &entities.MfaChallengeEntity{
+		Token:     "mfa_token_example",
+		UserId:    user.Id,
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}
+	sessionKey := "session_key_example"
+	expiresIn := time.Now().UTC().Add(time.Hour * 24).Format(time.RFC3339)
+	mfaChallenges.EXPECT().FindByToken(gomock.Any(), challenge.Token).Return(challenge, nil)
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	cipher.EXPECT().Decrypt(user.MfaSecret).Return("GEZDGNBVGY3TQOJQ", nil)
+	expectTotpCodes(otp, "GEZDGNBVGY3TQOJQ", "123456")
+	users.EXPECT().ClaimMfaStep(gomock.Any(), user.Id, gomock.Any()).Return(true, nil)
+	mfaChallenges.EXPECT().Delete(gomock.Any(), challenge.Token).Return(nil)
+	session.EXPECT().Generate(user.Id, "").Return(&providers.SessionData{
+		Key:            sessionKey,
+		UserId:         user.Id,
+		ExpirationDate: expiresIn,
+	}, nil)
+	cache.EXPECT().Set(gomock.Any(), sessionKey, user.Id, gomock.Any()).Return(nil)
+	cache.EXPECT().Set(gomock.Any(), strings.Join([]string{sessionKey, "@", user.Id}, ""), expiresIn, gomock.Any()).Return(nil)
+	cache.EXPECT().Set(gomock.Any(), strings.Join([]string{sessionKey, "#", user.Id}, ""), "", gomock.Any()).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.VerifyMfaDTO{
+		MfaToken: challenge.Token,
+		Code:     "123456",
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.SessionKey, sessionKey)
+	assert.False(t, result.MfaRequired)
+}
+
+func TestVerifyMfaUseCase_WrongCode(t *testing.T) {
+	// arrange
+	useCase, mfaChallenges, users, cipher, otp, _, _, _, ctrl := (&VerifyMfaUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := &entities.UserEntity{
+		Id:         "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		MfaSecret:  "encrypted_secret",
+		MfaEnabled: true,
+	}
+	challenge := &entities.MfaChallengeEntity{
+		Token:     "mfa_token_example",
+		UserId:    user.Id,
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}
+	mfaChallenges.EXPECT().FindByToken(gomock.Any(), challenge.Token).Return(challenge, nil)
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	cipher.EXPECT().Decrypt(user.MfaSecret).Return("GEZDGNBVGY3TQOJQ", nil)
+	expectTotpCodes(otp, "GEZDGNBVGY3TQOJQ", "123456")
+	mfaChallenges.EXPECT().Save(gomock.Any(), challenge).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.VerifyMfaDTO{
+		MfaToken: challenge.Token,
+		Code:     "654321",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewInvalidMfaCode())
+	assert.Equal(t, challenge.Attempts, 1)
+}
+
+func TestVerifyMfaUseCase_ReplayedCode(t *testing.T) {
+	// arrange
+	useCase, mfaChallenges, users, cipher, otp, _, _, _, ctrl := (&VerifyMfaUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := &entities.UserEntity{
+		Id:         "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		MfaSecret:  "encrypted_secret",
+		MfaEnabled: true,
+	}
+	challenge := &entities.MfaChallengeEntity{
+		Token:     "mfa_token_example",
+		UserId:    user.Id,
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}
+	mfaChallenges.EXPECT().FindByToken(gomock.Any(), challenge.Token).Return(challenge, nil)
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	cipher.EXPECT().Decrypt(user.MfaSecret).Return("GEZDGNBVGY3TQOJQ", nil)
+	expectTotpCodes(otp, "GEZDGNBVGY3TQOJQ", "123456")
+	users.EXPECT().ClaimMfaStep(gomock.Any(), user.Id, gomock.Any()).Return(false, nil)
+	mfaChallenges.EXPECT().Save(gomock.Any(), challenge).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.VerifyMfaDTO{
+		MfaToken: challenge.Token,
+		Code:     "123456",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewInvalidMfaCode())
+}
+
+func TestVerifyMfaUseCase_ExhaustedChallenge(t *testing.T) {
+	// arrange
+	useCase, mfaChallenges, _, _, _, _, _, _, ctrl := (&VerifyMfaUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	challenge := &entities.MfaChallengeEntity{
+		Token:     "mfa_token_example",
+		UserId:    "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		Attempts:  entities.MfaChallengeMaxAttempts,
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}
+	mfaChallenges.EXPECT().FindByToken(gomock.Any(), challenge.Token).Return(challenge, nil)
+	mfaChallenges.EXPECT().Delete(gomock.Any(), challenge.Token).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.VerifyMfaDTO{
+		MfaToken: challenge.Token,
+		Code:     "123456",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewInvalidMfaChallenge())
+}
+
+func TestVerifyMfaUseCase_UnknownChallenge(t *testing.T) {
+	// arrange
+	useCase, mfaChallenges, _, _, _, _, _, _, ctrl := (&VerifyMfaUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	mfaChallenges.EXPECT().FindByToken(gomock.Any(), "unknown").Return(nil, nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.VerifyMfaDTO{
+		MfaToken: "unknown",
+		Code:     "123456",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewInvalidMfaChallenge())
+}
+
+func TestVerifyMfaUseCase_RecoveryCode(t *testing.T) {
+	// arrange
+	useCase, mfaChallenges, users, _, _, digest, session, cache, ctrl := (&VerifyMfaUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := &entities.UserEntity{
+		Id:               "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		MfaSecret:        "encrypted_secret",
+		MfaEnabled:       true,
+		MfaRecoveryCodes: []string{"sha256:first", "sha256:second"},
+	}
+	challenge := &entities.MfaChallengeEntity{
+		Token:     "mfa_token_example",
+		UserId:    user.Id,
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}
+	sessionKey := "session_key_example"
+	expiresIn := time.Now().UTC().Add(time.Hour * 24).Format(time.RFC3339)
+	mfaChallenges.EXPECT().FindByToken(gomock.Any(), challenge.Token).Return(challenge, nil)
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	digest.EXPECT().Matches("abcdeFGHIJ", "sha256:first").Return(false)
+	digest.EXPECT().Matches("abcdeFGHIJ", "sha256:second").Return(true)
+	users.EXPECT().ConsumeMfaRecoveryCode(gomock.Any(), user.Id, "sha256:second").Return(true, nil)
+	mfaChallenges.EXPECT().Delete(gomock.Any(), challenge.Token).Return(nil)
+	session.EXPECT().Generate(user.Id, "").Return(&providers.SessionData{
+		Key:            sessionKey,
+		UserId:         user.Id,
+		ExpirationDate: expiresIn,
+	}, nil)
+	cache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(3).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.VerifyMfaDTO{
+		MfaToken: challenge.Token,
+		Code:     "abcde-FGHIJ",
+	})
+	// assert
+	assert.Nil(t, err)
+	assert.Equal(t, result.SessionKey, sessionKey)
+}
+
+func TestVerifyMfaUseCase_UsedRecoveryCode(t *testing.T) {
+	// arrange
+	useCase, mfaChallenges, users, _, _, digest, _, _, ctrl := (&VerifyMfaUseCaseTest{}).setup(t)
+	defer ctrl.Finish()
+	user := &entities.UserEntity{
+		Id:               "9b157773-fbb4-d04c-9de6-d086cf37d7c7",
+		MfaSecret:        "encrypted_secret",
+		MfaEnabled:       true,
+		MfaRecoveryCodes: []string{"sha256:first"},
+	}
+	challenge := &entities.MfaChallengeEntity{
+		Token:     "mfa_token_example",
+		UserId:    user.Id,
+		ExpiresAt: time.Now().UTC().Add(time.Minute),
+	}
+	mfaChallenges.EXPECT().FindByToken(gomock.Any(), challenge.Token).Return(challenge, nil)
+	users.EXPECT().FindById(gomock.Any(), user.Id).Return(user, nil)
+	digest.EXPECT().Matches("abcdeFGHIJ", "sha256:first").Return(true)
+	users.EXPECT().ConsumeMfaRecoveryCode(gomock.Any(), user.Id, "sha256:first").Return(false, nil)
+	mfaChallenges.EXPECT().Save(gomock.Any(), challenge).Return(nil)
+	// act
+	result, err := useCase.Execute(context.Background(), &definitions.VerifyMfaDTO{
+		MfaToken: challenge.Token,
+		Code:     "abcde FGHIJ",
+	})
+	// assert
+	assert.Nil(t, result)
+	assert.Equal(t, err, exceptions.NewInvalidMfaCode())
+}